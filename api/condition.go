@@ -69,11 +69,13 @@ const (
 
 // AtlasDeployment condition types
 const (
-	DeploymentReadyType                ConditionType = "DeploymentReady"
-	ServerlessPrivateEndpointReadyType ConditionType = "ServerlessPrivateEndpointReady"
-	ManagedNamespacesReadyType         ConditionType = "ManagedNamespacesReady"
-	CustomZoneMappingReadyType         ConditionType = "CustomZoneMappingReady"
-	SearchNodesReadyType               ConditionType = "SearchNodesReady"
+	DeploymentReadyType                   ConditionType = "DeploymentReady"
+	ServerlessPrivateEndpointReadyType    ConditionType = "ServerlessPrivateEndpointReady"
+	ManagedNamespacesReadyType            ConditionType = "ManagedNamespacesReady"
+	CustomZoneMappingReadyType            ConditionType = "CustomZoneMappingReady"
+	SearchNodesReadyType                  ConditionType = "SearchNodesReady"
+	MajorVersionUpgradeReadyType          ConditionType = "MajorVersionUpgradeReady"
+	FeatureCompatibilityVersionPinnedType ConditionType = "FeatureCompatibilityVersionPinned"
 )
 
 // AtlasDatabaseUser condition types
@@ -125,6 +127,16 @@ const (
 	NetworkContainerReady ConditionType = "NetworkContainerReady"
 )
 
+// Atlas Cloud Provider Access condition types
+const (
+	CloudProviderAccessReady ConditionType = "CloudProviderAccessReady"
+)
+
+// Atlas Backup Export Bucket condition types
+const (
+	BackupExportBucketReady ConditionType = "BackupExportBucketReady"
+)
+
 // Generic condition type
 const (
 	ResourceVersionStatus ConditionType = "ResourceVersionIsValid"
@@ -0,0 +1,111 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasBackupExportBucket{}, &AtlasBackupExportBucketList{})
+}
+
+// AtlasBackupExportBucket is the Schema for the AtlasBackupExportBucket API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Provider",type=string,JSONPath=`.spec.cloudProvider`
+// +kubebuilder:printcolumn:name="Export Bucket Id",type=string,JSONPath=`.status.exportBucketId`
+// +kubebuilder:subresource:status
+// +groupName:=atlas.mongodb.com
+// +kubebuilder:resource:categories=atlas,shortName=abeb
+type AtlasBackupExportBucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasBackupExportBucketSpec          `json:"spec,omitempty"`
+	Status status.AtlasBackupExportBucketStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AtlasBackupExportBucketList contains a list of AtlasBackupExportBucket
+type AtlasBackupExportBucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasBackupExportBucket `json:"items"`
+}
+
+// +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && !has(self.projectRef)) || (!has(self.externalProjectRef) && has(self.projectRef))",message="must define only one project reference through externalProjectRef or projectRef"
+// +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && has(self.connectionSecret)) || !has(self.externalProjectRef)",message="must define a local connection secret when referencing an external project"
+// +kubebuilder:validation:XValidation:rule="self.cloudProvider == oldSelf.cloudProvider",message="cloudProvider is immutable"
+// +kubebuilder:validation:XValidation:rule="self.cloudProvider == 'AZURE' || has(self.bucketName)",message="bucketName is required for the AWS and GCP providers"
+// +kubebuilder:validation:XValidation:rule="(self.cloudProvider == 'AZURE') == has(self.serviceUrl)",message="serviceUrl is required for, and only allowed with, the AZURE provider"
+
+// AtlasBackupExportBucketSpec defines the desired state of an AtlasBackupExportBucket
+type AtlasBackupExportBucketSpec struct {
+	ProjectDualReference `json:",inline"`
+
+	// CloudProvider hosting the bucket snapshots are exported to.
+	// This field is immutable.
+	// +kubebuilder:validation:Enum=AWS;AZURE;GCP
+	// +kubebuilder:validation:Required
+	CloudProvider string `json:"cloudProvider"`
+
+	// BucketName is the name of the AWS S3 or GCS bucket, or of the Azure Blob Storage container.
+	// Azure can omit it when the serviceUrl already includes the container name.
+	// This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="bucketName is immutable"
+	// +optional
+	BucketName string `json:"bucketName,omitempty"`
+
+	// ServiceURL is the URL of the Azure Storage Account to export to,
+	// e.g. https://examplestorageaccount.blob.core.windows.net/exportcontainer.
+	// This field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="serviceUrl is immutable"
+	// +optional
+	ServiceURL string `json:"serviceUrl,omitempty"`
+
+	// CloudProviderAccessRef is the AtlasCloudProviderAccess holding the role Atlas uses to write to the bucket.
+	// The role must belong to the same project and cloud provider as the bucket.
+	// +kubebuilder:validation:Required
+	CloudProviderAccessRef common.ResourceRefNamespaced `json:"cloudProviderAccessRef"`
+}
+
+func (eb *AtlasBackupExportBucket) GetStatus() api.Status {
+	return eb.Status
+}
+
+func (eb *AtlasBackupExportBucket) Credentials() *api.LocalObjectReference {
+	return eb.Spec.ConnectionSecret
+}
+
+func (eb *AtlasBackupExportBucket) ProjectDualRef() *ProjectDualReference {
+	return &eb.Spec.ProjectDualReference
+}
+
+func (eb *AtlasBackupExportBucket) UpdateStatus(conditions []api.Condition, options ...api.Option) {
+	eb.Status.Conditions = conditions
+	eb.Status.ObservedGeneration = eb.ObjectMeta.Generation
+
+	for _, o := range options {
+		v := o.(status.AtlasBackupExportBucketStatusOption)
+		v(&eb.Status)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestBackupExportBucketCELChecks(t *testing.T) {
+	for _, tc := range []struct {
+		title          string
+		old, obj       *AtlasBackupExportBucket
+		expectedErrors []string
+	}{
+		{
+			title: "AWS succeeds with a bucket name",
+			obj: &AtlasBackupExportBucket{
+				Spec: AtlasBackupExportBucketSpec{
+					CloudProvider: string(provider.ProviderAWS),
+					BucketName:    "my-bucket",
+				},
+			},
+		},
+		{
+			title: "GCP fails without a bucket name",
+			obj: &AtlasBackupExportBucket{
+				Spec: AtlasBackupExportBucketSpec{
+					CloudProvider: string(provider.ProviderGCP),
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": bucketName is required for the AWS and GCP providers"},
+		},
+		{
+			title: "AWS fails with a service URL",
+			obj: &AtlasBackupExportBucket{
+				Spec: AtlasBackupExportBucketSpec{
+					CloudProvider: string(provider.ProviderAWS),
+					BucketName:    "my-bucket",
+					ServiceURL:    "https://example.blob.core.windows.net/my-container",
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": serviceUrl is required for, and only allowed with, the AZURE provider"},
+		},
+		{
+			title: "Azure succeeds with a service URL",
+			obj: &AtlasBackupExportBucket{
+				Spec: AtlasBackupExportBucketSpec{
+					CloudProvider: string(provider.ProviderAzure),
+					ServiceURL:    "https://example.blob.core.windows.net/my-container",
+				},
+			},
+		},
+		{
+			title: "Azure fails without a service URL",
+			obj: &AtlasBackupExportBucket{
+				Spec: AtlasBackupExportBucketSpec{
+					CloudProvider: string(provider.ProviderAzure),
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": serviceUrl is required for, and only allowed with, the AZURE provider"},
+		},
+		{
+			title: "Cloud provider cannot be changed",
+			old: &AtlasBackupExportBucket{
+				Spec: AtlasBackupExportBucketSpec{
+					CloudProvider: string(provider.ProviderAWS),
+					BucketName:    "my-bucket",
+				},
+			},
+			obj: &AtlasBackupExportBucket{
+				Spec: AtlasBackupExportBucketSpec{
+					CloudProvider: string(provider.ProviderGCP),
+					BucketName:    "my-bucket",
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": cloudProvider is immutable"},
+		},
+		{
+			title: "Bucket name cannot be changed",
+			old: &AtlasBackupExportBucket{
+				Spec: AtlasBackupExportBucketSpec{
+					CloudProvider: string(provider.ProviderAWS),
+					BucketName:    "my-bucket",
+				},
+			},
+			obj: &AtlasBackupExportBucket{
+				Spec: AtlasBackupExportBucketSpec{
+					CloudProvider: string(provider.ProviderAWS),
+					BucketName:    "other-bucket",
+				},
+			},
+			expectedErrors: []string{"spec.bucketName: Invalid value: \"string\": bucketName is immutable"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			// inject references to avoid other CEL validations being hit
+			tc.obj.Spec.ProjectRef = &common.ResourceRefNamespaced{Name: "some-project"}
+			tc.obj.Spec.CloudProviderAccessRef = common.ResourceRefNamespaced{Name: "some-role"}
+			if tc.old != nil {
+				tc.old.Spec.ProjectRef = &common.ResourceRefNamespaced{Name: "some-project"}
+				tc.old.Spec.CloudProviderAccessRef = common.ResourceRefNamespaced{Name: "some-role"}
+			}
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.old)
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasbackupexportbuckets.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
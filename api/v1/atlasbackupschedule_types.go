@@ -70,9 +70,15 @@ type AtlasBackupScheduleSpec struct {
 	CopySettings []CopySetting `json:"copySettings,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="has(self.exportBucketId) != has(self.exportBucketRef)",message="must define only one export bucket through exportBucketId or exportBucketRef"
+
 type AtlasBackupExportSpec struct {
 	// Unique Atlas identifier of the AWS bucket which was granted access to export backup snapshot
-	ExportBucketID string `json:"exportBucketId"`
+	// +optional
+	ExportBucketID string `json:"exportBucketId,omitempty"`
+	// ExportBucketRef is the AtlasBackupExportBucket snapshots are exported to
+	// +optional
+	ExportBucketRef *common.ResourceRefNamespaced `json:"exportBucketRef,omitempty"`
 	// +kubebuilder:validation:Enum:=monthly
 	// +kubebuilder:default:=monthly
 	FrequencyType string `json:"frequencyType"`
@@ -0,0 +1,98 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasCIDRPool{}, &AtlasCIDRPoolList{})
+}
+
+// AtlasCIDRPool is the Schema for a set of IPv4 ranges the CIDR blocks of AtlasNetworkContainers are allocated from
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Allocated",type=integer,JSONPath=`.status.allocatedCount`
+// +kubebuilder:subresource:status
+// +groupName:=atlas.mongodb.com
+// +kubebuilder:resource:scope=Cluster,categories=atlas,shortName=acp
+type AtlasCIDRPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasCIDRPoolSpec          `json:"spec,omitempty"`
+	Status status.AtlasCIDRPoolStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AtlasCIDRPoolList contains a list of AtlasCIDRPool
+type AtlasCIDRPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasCIDRPool `json:"items"`
+}
+
+// AtlasCIDRPoolSpec defines the desired state of an AtlasCIDRPool
+type AtlasCIDRPoolSpec struct {
+	// CIDRBlocks are the IPv4 ranges the blocks of the network containers are allocated from
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Required
+	CIDRBlocks []string `json:"cidrBlocks"`
+
+	// Reserved are IPv4 ranges within the CIDR blocks that are never allocated,
+	// for instance because they are already used by the peered networks.
+	// Allocations only avoid the Atlas containers of the project of the allocating container,
+	// ranges used by containers of other projects or outside of the pool must be reserved here.
+	// +optional
+	Reserved []string `json:"reserved,omitempty"`
+
+	// Release are retained CIDR blocks to free, once the containers kept in Atlas with them are gone.
+	// Listed blocks are never allocated, remove them from the list once they left the retained blocks.
+	// +optional
+	Release []string `json:"release,omitempty"`
+}
+
+// CIDRPoolReference allocates the CIDR block of an AtlasNetworkContainer from an AtlasCIDRPool
+type CIDRPoolReference struct {
+	// Name of the AtlasCIDRPool
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// PrefixLength is the size of the allocated CIDR block, such as 21 for a /21 block
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=24
+	// +kubebuilder:validation:Required
+	PrefixLength int `json:"prefixLength"`
+}
+
+func (p *AtlasCIDRPool) GetStatus() api.Status {
+	return p.Status
+}
+
+func (p *AtlasCIDRPool) UpdateStatus(conditions []api.Condition, options ...api.Option) {
+	p.Status.Conditions = conditions
+	p.Status.ObservedGeneration = p.ObjectMeta.Generation
+
+	for _, o := range options {
+		v := o.(status.AtlasCIDRPoolStatusOption)
+		v(&p.Status)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasCloudProviderAccess{}, &AtlasCloudProviderAccessList{})
+}
+
+const (
+	CloudProviderAccessExportConfigMap = "ConfigMap"
+	CloudProviderAccessExportSecret    = "Secret"
+)
+
+// AtlasCloudProviderAccess is the Schema for the AtlasCloudProviderAccess API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Provider",type=string,JSONPath=`.spec.providerName`
+// +kubebuilder:printcolumn:name="Role Id",type=string,JSONPath=`.status.roleId`
+// +kubebuilder:subresource:status
+// +groupName:=atlas.mongodb.com
+// +kubebuilder:resource:categories=atlas,shortName=acpa
+type AtlasCloudProviderAccess struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasCloudProviderAccessSpec          `json:"spec,omitempty"`
+	Status status.AtlasCloudProviderAccessStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AtlasCloudProviderAccessList contains a list of AtlasCloudProviderAccess
+type AtlasCloudProviderAccessList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasCloudProviderAccess `json:"items"`
+}
+
+// +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && !has(self.projectRef)) || (!has(self.externalProjectRef) && has(self.projectRef))",message="must define only one project reference through externalProjectRef or projectRef"
+// +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && has(self.connectionSecret)) || !has(self.externalProjectRef)",message="must define a local connection secret when referencing an external project"
+// +kubebuilder:validation:XValidation:rule="self.providerName == oldSelf.providerName",message="providerName is immutable"
+// +kubebuilder:validation:XValidation:rule="self.providerName == 'AWS' || !has(self.aws)",message="aws settings are only allowed for the AWS provider"
+// +kubebuilder:validation:XValidation:rule="(self.providerName == 'AZURE') == has(self.azure)",message="azure settings are required for, and only allowed with, the AZURE provider"
+
+// AtlasCloudProviderAccessSpec defines the desired state of an AtlasCloudProviderAccess
+type AtlasCloudProviderAccessSpec struct {
+	ProjectDualReference `json:",inline"`
+
+	// ProviderName is the cloud provider Atlas is granted access to.
+	// This field is immutable.
+	// +kubebuilder:validation:Enum=AWS;AZURE;GCP
+	// +kubebuilder:validation:Required
+	ProviderName string `json:"providerName"`
+
+	// AWS holds the IAM role Atlas assumes. The role is authorized in Atlas once its ARN is set,
+	// which requires its trust policy to use the exported Atlas AWS account ARN and external ID.
+	// +optional
+	AWS *AWSCloudProviderAccess `json:"aws,omitempty"`
+
+	// Azure holds the service principal Atlas uses
+	// +optional
+	Azure *AzureCloudProviderAccess `json:"azure,omitempty"`
+
+	// ExportTo is the ConfigMap or Secret the Atlas side identifiers of the role are written to,
+	// for other tools to complete the trust relationship in the cloud provider.
+	// An existing object the resource does not control is never overwritten
+	// +optional
+	ExportTo *CloudProviderAccessExport `json:"exportTo,omitempty"`
+}
+
+// AWSCloudProviderAccess defines the AWS IAM role assumed by Atlas
+type AWSCloudProviderAccess struct {
+	// IamAssumedRoleArn is the ARN of the IAM role that Atlas assumes when accessing resources in your AWS account
+	// +optional
+	IamAssumedRoleArn string `json:"iamAssumedRoleArn,omitempty"`
+}
+
+// AzureCloudProviderAccess defines the Azure service principal used by Atlas
+type AzureCloudProviderAccess struct {
+	// AtlasAzureAppID is the Azure Active Directory application ID of Atlas
+	// +kubebuilder:validation:Required
+	AtlasAzureAppID string `json:"atlasAzureAppId"`
+	// ServicePrincipalID is the UUID of the Azure service principal
+	// +kubebuilder:validation:Required
+	ServicePrincipalID string `json:"servicePrincipalId"`
+	// TenantID is the UUID of the Azure Active Directory tenant
+	// +kubebuilder:validation:Required
+	TenantID string `json:"tenantId"`
+}
+
+// CloudProviderAccessExport references the object the role identifiers are exported to
+type CloudProviderAccessExport struct {
+	// Kind of the object to export to, either ConfigMap or Secret
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	// +kubebuilder:default=ConfigMap
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Name of the object to export to, in the namespace of the AtlasCloudProviderAccess
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+func (cpa *AtlasCloudProviderAccess) GetStatus() api.Status {
+	return cpa.Status
+}
+
+func (cpa *AtlasCloudProviderAccess) Credentials() *api.LocalObjectReference {
+	return cpa.Spec.ConnectionSecret
+}
+
+func (cpa *AtlasCloudProviderAccess) ProjectDualRef() *ProjectDualReference {
+	return &cpa.Spec.ProjectDualReference
+}
+
+func (cpa *AtlasCloudProviderAccess) UpdateStatus(conditions []api.Condition, options ...api.Option) {
+	cpa.Status.Conditions = conditions
+	cpa.Status.ObservedGeneration = cpa.ObjectMeta.Generation
+
+	for _, o := range options {
+		v := o.(status.AtlasCloudProviderAccessStatusOption)
+		v(&cpa.Status)
+	}
+}
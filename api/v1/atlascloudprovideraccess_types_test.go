@@ -0,0 +1,143 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestCloudProviderAccessCELChecks(t *testing.T) {
+	azure := &AzureCloudProviderAccess{
+		AtlasAzureAppID:    "app-id",
+		ServicePrincipalID: "principal-id",
+		TenantID:           "tenant-id",
+	}
+	for _, tc := range []struct {
+		title          string
+		old, obj       *AtlasCloudProviderAccess
+		expectedErrors []string
+	}{
+		{
+			title: "AWS succeeds without a role ARN",
+			obj: &AtlasCloudProviderAccess{
+				Spec: AtlasCloudProviderAccessSpec{
+					ProviderName: string(provider.ProviderAWS),
+				},
+			},
+		},
+		{
+			title: "AWS succeeds with a role ARN",
+			obj: &AtlasCloudProviderAccess{
+				Spec: AtlasCloudProviderAccessSpec{
+					ProviderName: string(provider.ProviderAWS),
+					AWS:          &AWSCloudProviderAccess{IamAssumedRoleArn: "arn:aws:iam::123456789012:role/atlas"},
+				},
+			},
+		},
+		{
+			title: "GCP fails with AWS settings",
+			obj: &AtlasCloudProviderAccess{
+				Spec: AtlasCloudProviderAccessSpec{
+					ProviderName: string(provider.ProviderGCP),
+					AWS:          &AWSCloudProviderAccess{},
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": aws settings are only allowed for the AWS provider"},
+		},
+		{
+			title: "Azure succeeds with Azure settings",
+			obj: &AtlasCloudProviderAccess{
+				Spec: AtlasCloudProviderAccessSpec{
+					ProviderName: string(provider.ProviderAzure),
+					Azure:        azure,
+				},
+			},
+		},
+		{
+			title: "Azure fails without Azure settings",
+			obj: &AtlasCloudProviderAccess{
+				Spec: AtlasCloudProviderAccessSpec{
+					ProviderName: string(provider.ProviderAzure),
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": azure settings are required for, and only allowed with, the AZURE provider"},
+		},
+		{
+			title: "AWS fails with Azure settings",
+			obj: &AtlasCloudProviderAccess{
+				Spec: AtlasCloudProviderAccessSpec{
+					ProviderName: string(provider.ProviderAWS),
+					Azure:        azure,
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": azure settings are required for, and only allowed with, the AZURE provider"},
+		},
+		{
+			title: "Provider name cannot be changed",
+			old: &AtlasCloudProviderAccess{
+				Spec: AtlasCloudProviderAccessSpec{
+					ProviderName: string(provider.ProviderAWS),
+				},
+			},
+			obj: &AtlasCloudProviderAccess{
+				Spec: AtlasCloudProviderAccessSpec{
+					ProviderName: string(provider.ProviderGCP),
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": providerName is immutable"},
+		},
+		{
+			title: "Role ARN can be changed",
+			old: &AtlasCloudProviderAccess{
+				Spec: AtlasCloudProviderAccessSpec{
+					ProviderName: string(provider.ProviderAWS),
+				},
+			},
+			obj: &AtlasCloudProviderAccess{
+				Spec: AtlasCloudProviderAccessSpec{
+					ProviderName: string(provider.ProviderAWS),
+					AWS:          &AWSCloudProviderAccess{IamAssumedRoleArn: "arn:aws:iam::123456789012:role/atlas"},
+				},
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			// inject a project to avoid other CEL validations being hit
+			tc.obj.Spec.ProjectRef = &common.ResourceRefNamespaced{Name: "some-project"}
+			if tc.old != nil {
+				tc.old.Spec.ProjectRef = &common.ResourceRefNamespaced{Name: "some-project"}
+			}
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.old)
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlascloudprovideraccesses.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
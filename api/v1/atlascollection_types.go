@@ -0,0 +1,197 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasCollection{}, &AtlasCollectionList{})
+}
+
+// AtlasCollection is the Schema for a MongoDB collection, its validator and indexes, in an AtlasDeployment
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Database",type=string,JSONPath=`.spec.database`
+// +kubebuilder:printcolumn:name="Collection",type=string,JSONPath=`.spec.name`
+// +kubebuilder:subresource:status
+// +groupName:=atlas.mongodb.com
+// +kubebuilder:resource:categories=atlas,shortName=acol
+type AtlasCollection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasCollectionSpec          `json:"spec,omitempty"`
+	Status status.AtlasCollectionStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AtlasCollectionList contains a list of AtlasCollection
+type AtlasCollectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasCollection `json:"items"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!(has(self.capped) && has(self.timeSeries))",message="a collection cannot be both capped and a time series"
+// +kubebuilder:validation:XValidation:rule="self.database == oldSelf.database && self.name == oldSelf.name",message="database and name are immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.capped) == has(oldSelf.capped) && (!has(self.capped) || self.capped == oldSelf.capped)",message="capped is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.timeSeries) == has(oldSelf.timeSeries) && (!has(self.timeSeries) || (self.timeSeries.timeField == oldSelf.timeSeries.timeField && (has(self.timeSeries.metaField) ? self.timeSeries.metaField : '') == (has(oldSelf.timeSeries.metaField) ? oldSelf.timeSeries.metaField : '')))",message="timeSeries fields are immutable"
+
+// AtlasCollectionSpec defines the desired state of a collection
+type AtlasCollectionSpec struct {
+	// DeploymentRef is a reference to the AtlasDeployment holding the collection
+	// +kubebuilder:validation:Required
+	DeploymentRef common.ResourceRefNamespaced `json:"deploymentRef"`
+
+	// ConnectionSecretRef is the name of a connection Secret created by the operator for an AtlasDatabaseUser
+	// and the referenced deployment. The user needs privileges to create collections and indexes in the database.
+	// +kubebuilder:validation:Required
+	ConnectionSecretRef api.LocalObjectReference `json:"connectionSecretRef"`
+
+	// Database holding the collection. This field is immutable.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Database string `json:"database"`
+
+	// Name of the collection. This field is immutable.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Capped makes the collection a fixed size collection. This field is immutable.
+	// +optional
+	Capped *CappedCollection `json:"capped,omitempty"`
+
+	// TimeSeries makes the collection a time series collection.
+	// timeField and metaField are immutable, granularity can only be increased.
+	// +optional
+	TimeSeries *TimeSeriesCollection `json:"timeSeries,omitempty"`
+
+	// Validator validates the documents inserted and updated in the collection
+	// +optional
+	Validator *CollectionValidator `json:"validator,omitempty"`
+
+	// Indexes of the collection. Indexes removed from this list are dropped,
+	// indexes created out of the operator are left untouched.
+	// +optional
+	Indexes []CollectionIndex `json:"indexes,omitempty"`
+}
+
+// CappedCollection defines the limits of a capped collection
+type CappedCollection struct {
+	// SizeBytes is the maximum size of the collection in bytes
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Required
+	SizeBytes int64 `json:"sizeBytes"`
+	// MaxDocuments is the maximum number of documents of the collection
+	// +optional
+	MaxDocuments int64 `json:"maxDocuments,omitempty"`
+}
+
+// TimeSeriesCollection defines the fields of a time series collection
+type TimeSeriesCollection struct {
+	// TimeField is the field holding the date of each document
+	// +kubebuilder:validation:Required
+	TimeField string `json:"timeField"`
+	// MetaField is the field holding the metadata of each document
+	// +optional
+	MetaField string `json:"metaField,omitempty"`
+	// Granularity is the interval between consecutive documents of the same series
+	// +kubebuilder:validation:Enum=seconds;minutes;hours
+	// +optional
+	Granularity string `json:"granularity,omitempty"`
+	// ExpireAfterSeconds removes documents older than the given number of seconds
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ExpireAfterSeconds *int64 `json:"expireAfterSeconds,omitempty"`
+}
+
+// CollectionValidator defines the JSON schema documents are validated against
+type CollectionValidator struct {
+	// JSONSchema is the $jsonSchema the documents are validated against
+	// +kubebuilder:validation:Required
+	JSONSchema *apiextensions.JSON `json:"jsonSchema"`
+	// ValidationLevel defines which documents are validated
+	// +kubebuilder:validation:Enum=off;strict;moderate
+	// +kubebuilder:default=strict
+	// +optional
+	ValidationLevel string `json:"validationLevel,omitempty"`
+	// ValidationAction defines whether invalid documents are rejected or only logged
+	// +kubebuilder:validation:Enum=error;warn
+	// +kubebuilder:default=error
+	// +optional
+	ValidationAction string `json:"validationAction,omitempty"`
+}
+
+const (
+	IndexKeyAscending  = "ascending"
+	IndexKeyDescending = "descending"
+	IndexKey2dsphere   = "2dsphere"
+	IndexKey2d         = "2d"
+	IndexKeyHashed     = "hashed"
+)
+
+// CollectionIndex defines a regular index of a collection
+type CollectionIndex struct {
+	// Name of the index
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Keys are the fields indexed, in order
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Required
+	Keys []CollectionIndexKey `json:"keys"`
+	// Unique rejects documents with a duplicated value of the indexed fields
+	// +optional
+	Unique bool `json:"unique,omitempty"`
+	// Sparse only indexes the documents holding the indexed fields
+	// +optional
+	Sparse bool `json:"sparse,omitempty"`
+	// ExpireAfterSeconds makes a TTL index removing documents older than the given number of seconds
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ExpireAfterSeconds *int32 `json:"expireAfterSeconds,omitempty"`
+	// PartialFilterExpression only indexes the documents matching the given filter
+	// +optional
+	PartialFilterExpression *apiextensions.JSON `json:"partialFilterExpression,omitempty"`
+}
+
+// CollectionIndexKey is a field of an index
+type CollectionIndexKey struct {
+	// Field is the path of the indexed field
+	// +kubebuilder:validation:Required
+	Field string `json:"field"`
+	// Type of the index on the field
+	// +kubebuilder:validation:Enum=ascending;descending;"2dsphere";"2d";hashed
+	// +kubebuilder:default=ascending
+	// +optional
+	Type string `json:"type,omitempty"`
+}
+
+func (c *AtlasCollection) GetConditions() []metav1.Condition {
+	if c.Status.Conditions == nil {
+		return []metav1.Condition{}
+	}
+	return c.Status.Conditions
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestCollectionCELChecks(t *testing.T) {
+	capped := &CappedCollection{SizeBytes: 1024}
+	timeSeries := &TimeSeriesCollection{TimeField: "ts"}
+	for _, tc := range []struct {
+		title          string
+		old, obj       *AtlasCollectionSpec
+		expectedErrors []string
+	}{
+		{
+			title: "a capped collection succeeds",
+			obj:   &AtlasCollectionSpec{Capped: capped},
+		},
+		{
+			title:          "a capped time series fails",
+			obj:            &AtlasCollectionSpec{Capped: capped, TimeSeries: timeSeries},
+			expectedErrors: []string{"spec: Invalid value: \"object\": a collection cannot be both capped and a time series"},
+		},
+		{
+			title:          "the name cannot be changed",
+			old:            &AtlasCollectionSpec{Name: "other"},
+			obj:            &AtlasCollectionSpec{},
+			expectedErrors: []string{"spec: Invalid value: \"object\": database and name are immutable"},
+		},
+		{
+			title:          "a collection cannot become capped",
+			old:            &AtlasCollectionSpec{},
+			obj:            &AtlasCollectionSpec{Capped: capped},
+			expectedErrors: []string{"spec: Invalid value: \"object\": capped is immutable"},
+		},
+		{
+			title:          "the capped size cannot be changed",
+			old:            &AtlasCollectionSpec{Capped: capped},
+			obj:            &AtlasCollectionSpec{Capped: &CappedCollection{SizeBytes: 2048}},
+			expectedErrors: []string{"spec: Invalid value: \"object\": capped is immutable"},
+		},
+		{
+			title:          "the time field cannot be changed",
+			old:            &AtlasCollectionSpec{TimeSeries: timeSeries},
+			obj:            &AtlasCollectionSpec{TimeSeries: &TimeSeriesCollection{TimeField: "timestamp"}},
+			expectedErrors: []string{"spec: Invalid value: \"object\": timeSeries fields are immutable"},
+		},
+		{
+			title:          "the meta field cannot be removed",
+			old:            &AtlasCollectionSpec{TimeSeries: &TimeSeriesCollection{TimeField: "ts", MetaField: "host"}},
+			obj:            &AtlasCollectionSpec{TimeSeries: timeSeries},
+			expectedErrors: []string{"spec: Invalid value: \"object\": timeSeries fields are immutable"},
+		},
+		{
+			title: "the time series expiration and granularity can be changed",
+			old:   &AtlasCollectionSpec{TimeSeries: timeSeries},
+			obj: &AtlasCollectionSpec{TimeSeries: &TimeSeriesCollection{
+				TimeField:          "ts",
+				Granularity:        "hours",
+				ExpireAfterSeconds: pointer.MakePtr(int64(3600)),
+			}},
+		},
+		{
+			title: "indexes can be changed",
+			old:   &AtlasCollectionSpec{},
+			obj: &AtlasCollectionSpec{Indexes: []CollectionIndex{
+				{Name: "by_location", Keys: []CollectionIndexKey{{Field: "location", Type: IndexKey2dsphere}}},
+			}},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			obj := &AtlasCollection{Spec: *tc.obj}
+			setCollectionDefaults(&obj.Spec)
+			var old *AtlasCollection
+			if tc.old != nil {
+				old = &AtlasCollection{Spec: *tc.old}
+				setCollectionDefaults(&old.Spec)
+			}
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&old)
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlascollections.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
+
+func setCollectionDefaults(spec *AtlasCollectionSpec) {
+	spec.DeploymentRef = common.ResourceRefNamespaced{Name: "my-deployment"}
+	spec.ConnectionSecretRef = api.LocalObjectReference{Name: "my-connection-secret"}
+	if spec.Database == "" {
+		spec.Database = "db"
+	}
+	if spec.Name == "" {
+		spec.Name = "collection"
+	}
+}
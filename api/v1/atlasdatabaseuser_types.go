@@ -51,6 +51,8 @@ const (
 // AtlasDatabaseUserSpec defines the desired state of Database User in Atlas
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && !has(self.projectRef)) || (!has(self.externalProjectRef) && has(self.projectRef))",message="must define only one project reference through externalProjectRef or projectRef"
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && has(self.connectionSecret)) || !has(self.externalProjectRef)",message="must define a local connection secret when referencing an external project"
+// +kubebuilder:validation:XValidation:rule="!has(self.x509) || (has(self.x509Type) && self.x509Type == 'MANAGED')",message="x509 settings are only allowed for the MANAGED x509Type"
+// +kubebuilder:validation:XValidation:rule="!has(self.serviceAccountRef) || (has(self.oidcAuthType) && self.oidcAuthType == 'USER')",message="serviceAccountRef is only allowed for the USER oidcAuthType"
 type AtlasDatabaseUserSpec struct {
 	// ProjectReference is the dual external or kubernetes reference with access credentials
 	ProjectDualReference `json:",inline"`
@@ -101,6 +103,13 @@ type AtlasDatabaseUserSpec struct {
 	// +optional
 	OIDCAuthType string `json:"oidcAuthType,omitempty"`
 
+	// ServiceAccountRef binds a ServiceAccount in the namespace of this resource to an OIDC Workload user.
+	// The user authenticates with the tokens of the ServiceAccount, issued for the audience of the Workload identity provider.
+	// With the default 'sub' user claim, the username is the Atlas OIDC IdP ID, followed by a '/',
+	// followed by 'system:serviceaccount:<namespace>:<name>'.
+	// +optional
+	ServiceAccountRef *common.ResourceRef `json:"serviceAccountRef,omitempty"`
+
 	// Human-readable label that indicates whether the new database
 	// user authenticates with the Amazon Web Services (AWS)
 	// Identity and Access Management (IAM) credentials associated with
@@ -115,6 +124,26 @@ type AtlasDatabaseUserSpec struct {
 	// +kubebuilder:validation:Enum:=NONE;MANAGED;CUSTOMER
 	// +optional
 	X509Type string `json:"x509Type,omitempty"`
+
+	// X509 configures the certificates Atlas issues for users with the MANAGED x509Type.
+	// The certificate and its key are stored in a kubernetes.io/tls Secret and renewed before they expire.
+	// +optional
+	X509 *X509ManagedCertificate `json:"x509,omitempty"`
+}
+
+// X509ManagedCertificate defines the validity and renewal of Atlas managed X.509 certificates
+type X509ManagedCertificate struct {
+	// MonthsUntilExpiration is the number of months the issued certificates are valid for
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=24
+	// +kubebuilder:default=3
+	// +optional
+	MonthsUntilExpiration int `json:"monthsUntilExpiration,omitempty"`
+
+	// RenewBefore is how long before the certificate expires a new one is requested
+	// +kubebuilder:default="720h"
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,102 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestDatabaseUserCELChecks(t *testing.T) {
+	for _, tc := range []struct {
+		title          string
+		obj            *AtlasDatabaseUser
+		expectedErrors []string
+	}{
+		{
+			title: "Managed user succeeds without x509 settings",
+			obj: &AtlasDatabaseUser{
+				Spec: AtlasDatabaseUserSpec{X509Type: "MANAGED"},
+			},
+		},
+		{
+			title: "Managed user succeeds with x509 settings",
+			obj: &AtlasDatabaseUser{
+				Spec: AtlasDatabaseUserSpec{
+					X509Type: "MANAGED",
+					X509:     &X509ManagedCertificate{MonthsUntilExpiration: 6},
+				},
+			},
+		},
+		{
+			title: "Customer user fails with x509 settings",
+			obj: &AtlasDatabaseUser{
+				Spec: AtlasDatabaseUserSpec{
+					X509Type: "CUSTOMER",
+					X509:     &X509ManagedCertificate{MonthsUntilExpiration: 6},
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": x509 settings are only allowed for the MANAGED x509Type"},
+		},
+		{
+			title: "Password user fails with x509 settings",
+			obj: &AtlasDatabaseUser{
+				Spec: AtlasDatabaseUserSpec{
+					X509: &X509ManagedCertificate{MonthsUntilExpiration: 6},
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": x509 settings are only allowed for the MANAGED x509Type"},
+		},
+		{
+			title: "OIDC Workload user succeeds with a service account",
+			obj: &AtlasDatabaseUser{
+				Spec: AtlasDatabaseUserSpec{
+					OIDCAuthType:      "USER",
+					ServiceAccountRef: &common.ResourceRef{Name: "app"},
+				},
+			},
+		},
+		{
+			title: "OIDC Workforce user fails with a service account",
+			obj: &AtlasDatabaseUser{
+				Spec: AtlasDatabaseUserSpec{
+					OIDCAuthType:      "IDP_GROUP",
+					ServiceAccountRef: &common.ResourceRef{Name: "app"},
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": serviceAccountRef is only allowed for the USER oidcAuthType"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			// inject a project to avoid other CEL validations being hit
+			tc.obj.Spec.ProjectRef = &common.ResourceRefNamespaced{Name: "some-project"}
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tc.obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasdatabaseusers.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, nil)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
@@ -43,6 +43,7 @@ const (
 // Only one of DeploymentSpec, AdvancedDeploymentSpec and ServerlessSpec should be defined
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && !has(self.projectRef)) || (!has(self.externalProjectRef) && has(self.projectRef))",message="must define only one project reference through externalProjectRef or projectRef"
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && has(self.connectionSecret)) || !has(self.externalProjectRef)",message="must define a local connection secret when referencing an external project"
+// +kubebuilder:validation:XValidation:rule="!has(self.finalSnapshot) || (has(self.deploymentSpec) && has(self.deploymentSpec.backupEnabled) && self.deploymentSpec.backupEnabled)",message="finalSnapshot requires a dedicated deployment with backups enabled"
 type AtlasDeploymentSpec struct {
 	// ProjectReference is the dual external or kubernetes reference with access credentials
 	ProjectDualReference `json:",inline"`
@@ -73,6 +74,34 @@ type AtlasDeploymentSpec struct {
 	// Configuration for the Flex cluster API. https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Flex-Clusters
 	// +optional
 	FlexSpec *FlexSpec `json:"flexSpec,omitempty"`
+
+	// FinalSnapshot takes an on-demand snapshot of the deployment before removing it from Atlas,
+	// so that an accidental deletion can be recovered
+	// +optional
+	FinalSnapshot *FinalSnapshot `json:"finalSnapshot,omitempty"`
+
+	// MajorVersionUpgrade configures how changes to the MongoDB major version are rolled out
+	// +optional
+	MajorVersionUpgrade *MajorVersionUpgrade `json:"majorVersionUpgrade,omitempty"`
+}
+
+// FinalSnapshot configures the snapshot taken before the deployment is removed from Atlas
+type FinalSnapshot struct {
+	// RetentionInDays is the number of days Atlas keeps the final snapshot
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=7
+	// +optional
+	RetentionInDays int `json:"retentionInDays,omitempty"`
+}
+
+// MajorVersionUpgrade configures the upgrade of mongoDBMajorVersion
+type MajorVersionUpgrade struct {
+	// PinFCVDays keeps the feature compatibility version pinned to the current major version
+	// for the given number of days when upgrading, so that the upgrade can be rolled back
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=28
+	// +optional
+	PinFCVDays int `json:"pinFCVDays,omitempty"`
 }
 
 type SearchNode struct {
@@ -427,6 +456,7 @@ var _ api.AtlasCustomResource = &AtlasDeployment{}
 // +kubebuilder:printcolumn:name="Atlas State",type=string,JSONPath=`.status.stateName`
 // +kubebuilder:printcolumn:name="MongoDB Version",type=string,JSONPath=`.status.mongoDBVersion`
 // +kubebuilder:resource:categories=atlas,shortName=ad
+// +kubebuilder:storageversion
 
 // AtlasDeployment is the Schema for the atlasdeployments API
 type AtlasDeployment struct {
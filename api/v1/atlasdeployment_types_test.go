@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
 )
 
@@ -49,6 +50,30 @@ func TestDeploymentCELChecks(t *testing.T) {
 			},
 			expectedErrors: []string{"spec.deploymentSpec.name: Invalid value: \"string\": Name cannot be modified after deployment creation"},
 		},
+		{
+			title: "Final snapshot requires backups",
+			obj: &AtlasDeployment{
+				Spec: AtlasDeploymentSpec{
+					DeploymentSpec: &AdvancedDeploymentSpec{
+						Name: "name",
+					},
+					FinalSnapshot: &FinalSnapshot{RetentionInDays: 7},
+				},
+			},
+			expectedErrors: []string{"spec: Invalid value: \"object\": finalSnapshot requires a dedicated deployment with backups enabled"},
+		},
+		{
+			title: "Final snapshot of a deployment with backups",
+			obj: &AtlasDeployment{
+				Spec: AtlasDeploymentSpec{
+					DeploymentSpec: &AdvancedDeploymentSpec{
+						Name:          "name",
+						BackupEnabled: pointer.MakePtr(true),
+					},
+					FinalSnapshot: &FinalSnapshot{RetentionInDays: 7},
+				},
+			},
+		},
 	} {
 		t.Run(tc.title, func(t *testing.T) {
 			// inject a project to avoid other CEL validations being hit
@@ -0,0 +1,169 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+const (
+	DeploymentActionTestFailover          = "TestFailover"
+	DeploymentActionStartOutageSimulation = "StartOutageSimulation"
+	DeploymentActionEndOutageSimulation   = "EndOutageSimulation"
+	DeploymentActionTakeSnapshot          = "TakeSnapshot"
+	DeploymentActionExportSnapshot        = "ExportSnapshot"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasDeploymentAction{}, &AtlasDeploymentActionList{})
+}
+
+// AtlasDeploymentAction is the Schema for a one-shot operation run once against an AtlasDeployment
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Started",type=date,JSONPath=`.status.startTime`
+// +kubebuilder:printcolumn:name="Completed",type=date,JSONPath=`.status.completionTime`
+// +kubebuilder:subresource:status
+// +groupName:=atlas.mongodb.com
+// +kubebuilder:resource:categories=atlas,shortName=ada
+type AtlasDeploymentAction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasDeploymentActionSpec          `json:"spec,omitempty"`
+	Status status.AtlasDeploymentActionStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AtlasDeploymentActionList contains a list of AtlasDeploymentAction
+type AtlasDeploymentActionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasDeploymentAction `json:"items"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.deploymentRef) != has(self.externalDeploymentRef)",message="must define only one deployment reference through deploymentRef or externalDeploymentRef"
+// +kubebuilder:validation:XValidation:rule="!has(self.externalDeploymentRef) || has(self.connectionSecret)",message="must define a local connection secret when referencing an external deployment"
+// +kubebuilder:validation:XValidation:rule="self.action != 'StartOutageSimulation' || has(self.outageSimulation)",message="outageSimulation is required to start an outage simulation"
+// +kubebuilder:validation:XValidation:rule="self.action == 'StartOutageSimulation' || !has(self.outageSimulation)",message="outageSimulation is only allowed to start an outage simulation"
+// +kubebuilder:validation:XValidation:rule="self.action == 'TakeSnapshot' || !has(self.snapshot)",message="snapshot is only allowed to take a snapshot"
+// +kubebuilder:validation:XValidation:rule="(self.action == 'ExportSnapshot') == has(self.snapshotExport)",message="snapshotExport is required for, and only allowed with, the ExportSnapshot action"
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable, create a new action instead"
+
+// AtlasDeploymentActionSpec defines the operation to run against a deployment
+type AtlasDeploymentActionSpec struct {
+	// DeploymentRef is a reference to the AtlasDeployment the action runs against
+	// +optional
+	DeploymentRef *common.ResourceRefNamespaced `json:"deploymentRef,omitempty"`
+
+	// ExternalDeploymentRef identifies a deployment not managed by the operator
+	// +optional
+	ExternalDeploymentRef *ExternalDeploymentReference `json:"externalDeploymentRef,omitempty"`
+
+	// ConnectionSecret is the name of the Kubernetes Secret which contains the information about the way to connect to
+	// Atlas (Public & Private API keys). Defaults to the credentials of the referenced AtlasDeployment.
+	// +optional
+	ConnectionSecret *api.LocalObjectReference `json:"connectionSecret,omitempty"`
+
+	// Action to run. TestFailover restarts the primary of each replica set to test the failover of the deployment.
+	// +kubebuilder:validation:Enum:=TestFailover;StartOutageSimulation;EndOutageSimulation;TakeSnapshot;ExportSnapshot
+	// +kubebuilder:validation:Required
+	Action string `json:"action"`
+
+	// OutageSimulation sets the regions to simulate an outage of for StartOutageSimulation
+	// +optional
+	OutageSimulation *OutageSimulation `json:"outageSimulation,omitempty"`
+
+	// Snapshot configures the on-demand snapshot taken by TakeSnapshot
+	// +optional
+	Snapshot *OnDemandSnapshot `json:"snapshot,omitempty"`
+
+	// SnapshotExport sets the snapshot and the bucket to export it to for ExportSnapshot
+	// +optional
+	SnapshotExport *SnapshotExport `json:"snapshotExport,omitempty"`
+}
+
+// OutageSimulation lists the regions to simulate an outage of
+type OutageSimulation struct {
+	// Regions to simulate an outage of. A majority of the electable nodes of the deployment must remain.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Required
+	Regions []OutageSimulationRegion `json:"regions"`
+}
+
+// OutageSimulationRegion is a region of a cloud provider to simulate an outage of
+type OutageSimulationRegion struct {
+	// CloudProvider of the region
+	// +kubebuilder:validation:Enum:=AWS;GCP;AZURE
+	// +kubebuilder:validation:Required
+	CloudProvider string `json:"cloudProvider"`
+
+	// RegionName as used by Atlas, e.g. US_EAST_1
+	// +kubebuilder:validation:Required
+	RegionName string `json:"regionName"`
+}
+
+// OnDemandSnapshot configures an on-demand snapshot
+type OnDemandSnapshot struct {
+	// Description of the snapshot
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// RetentionInDays is the number of days Atlas keeps the snapshot
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	RetentionInDays int `json:"retentionInDays,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.exportBucketId) != has(self.exportBucketRef)",message="must define only one export bucket through exportBucketId or exportBucketRef"
+
+// SnapshotExport configures the export of a backup snapshot to a bucket
+type SnapshotExport struct {
+	// SnapshotID is the ID of the snapshot to export, e.g. the snapshotID of a TakeSnapshot action
+	// +kubebuilder:validation:Required
+	SnapshotID string `json:"snapshotID"`
+
+	// ExportBucketID is the Atlas ID of the bucket to export to
+	// +optional
+	ExportBucketID string `json:"exportBucketId,omitempty"`
+
+	// ExportBucketRef is the AtlasBackupExportBucket to export to
+	// +optional
+	ExportBucketRef *common.ResourceRefNamespaced `json:"exportBucketRef,omitempty"`
+
+	// CustomData is added to the metadata file Atlas uploads with the exported snapshot
+	// +optional
+	CustomData map[string]string `json:"customData,omitempty"`
+}
+
+func (ada *AtlasDeploymentAction) Credentials() *api.LocalObjectReference {
+	return ada.Spec.ConnectionSecret
+}
+
+func (ada *AtlasDeploymentAction) GetConditions() []metav1.Condition {
+	if ada.Status.Conditions == nil {
+		return []metav1.Condition{}
+	}
+	return ada.Status.Conditions
+}
@@ -59,6 +59,34 @@ type AtlasFederatedAuthSpec struct {
 	// Currently connected data access identity providers missing from the this field will be disconnected.
 	// +optional
 	DataAccessIdentityProviders *[]string `json:"dataAccessIdentityProviders,omitempty"`
+	// WorkloadIdentityProvider registers the service account token issuer of this Kubernetes cluster
+	// as an Atlas OIDC Workload identity provider and connects it to the organization for data access.
+	// The provider is removed from Atlas when this field is unset.
+	// +optional
+	WorkloadIdentityProvider *WorkloadIdentityProvider `json:"workloadIdentityProvider,omitempty"`
+}
+
+// WorkloadIdentityProvider is an OIDC Workload identity provider trusting the service account tokens of the Kubernetes cluster.
+type WorkloadIdentityProvider struct {
+	// DisplayName is the human-readable label of the identity provider in Atlas.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=50
+	DisplayName string `json:"displayName"`
+	// Description of the identity provider.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// IssuerURI is the issuer of the service account tokens.
+	// Defaults to the issuer announced by the OIDC discovery document of the Kubernetes API server.
+	// The issuer must be reachable by Atlas to fetch the signing keys.
+	// +optional
+	IssuerURI string `json:"issuerURI,omitempty"`
+	// Audience the service account tokens are requested for.
+	// +kubebuilder:validation:MinLength:=1
+	Audience string `json:"audience"`
+	// UserClaim is the token claim holding the user identifier.
+	// +kubebuilder:default:=sub
+	// +optional
+	UserClaim string `json:"userClaim,omitempty"`
 }
 
 func (f *AtlasFederatedAuthSpec) ToAtlas(orgID, idpID string, projectNameToID map[string]string) (*admin.ConnectedOrgConfig, error) {
@@ -0,0 +1,197 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasIdentityProvider{}, &AtlasIdentityProviderList{})
+}
+
+const (
+	IdentityProviderProtocolSAML = "SAML"
+	IdentityProviderProtocolOIDC = "OIDC"
+)
+
+// AtlasIdentityProvider is the Schema for the AtlasIdentityProvider API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Protocol",type=string,JSONPath=`.spec.protocol`
+// +kubebuilder:printcolumn:name="Id",type=string,JSONPath=`.status.id`
+// +kubebuilder:subresource:status
+// +groupName:=atlas.mongodb.com
+// +kubebuilder:resource:categories=atlas,shortName=aidp
+type AtlasIdentityProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasIdentityProviderSpec          `json:"spec,omitempty"`
+	Status status.AtlasIdentityProviderStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AtlasIdentityProviderList contains a list of AtlasIdentityProvider
+type AtlasIdentityProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasIdentityProvider `json:"items"`
+}
+
+// +kubebuilder:validation:XValidation:rule="self.protocol == oldSelf.protocol",message="protocol is immutable"
+// +kubebuilder:validation:XValidation:rule="(self.protocol == 'OIDC') == has(self.oidc)",message="oidc settings are required for, and only allowed with, the OIDC protocol"
+// +kubebuilder:validation:XValidation:rule="(self.protocol == 'SAML') == has(self.saml)",message="saml settings are required for, and only allowed with, the SAML protocol"
+
+// AtlasIdentityProviderSpec defines the desired state of an identity provider of an Atlas federation
+type AtlasIdentityProviderSpec struct {
+	// OrgID is the ID of the organization whose federation holds the identity provider
+	// +kubebuilder:validation:Required
+	OrgID string `json:"orgID"`
+
+	// ConnectionSecretRef is the name of the Kubernetes Secret which contains the information about the way to connect to
+	// Atlas (Public & Private API keys).
+	// +optional
+	ConnectionSecretRef *api.LocalObjectReference `json:"connectionSecretRef,omitempty"`
+
+	// Protocol of the identity provider, SAML identity providers cannot be created through the Atlas Admin API,
+	// an existing one with the same issuerUri is managed instead. This field is immutable.
+	// +kubebuilder:validation:Enum=SAML;OIDC
+	// +kubebuilder:validation:Required
+	Protocol string `json:"protocol"`
+
+	// DisplayName is the human-readable label of the identity provider
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=50
+	// +kubebuilder:validation:Required
+	DisplayName string `json:"displayName"`
+
+	// Description of the identity provider
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// IssuerURI is the unique identifier of the issuer of the SAML assertions or OIDC tokens
+	// +kubebuilder:validation:Required
+	IssuerURI string `json:"issuerUri"`
+
+	// AssociatedDomains are the domains whose users sign in through this identity provider
+	// +optional
+	AssociatedDomains []string `json:"associatedDomains,omitempty"`
+
+	// ConnectToOrg connects the identity provider to the organization, SAML identity providers
+	// become its UI access identity provider and OIDC ones are allowed for data access
+	// +kubebuilder:default=true
+	// +optional
+	ConnectToOrg *bool `json:"connectToOrg,omitempty"`
+
+	// OIDC holds the settings of OIDC identity providers
+	// +optional
+	OIDC *OIDCIdentityProviderSettings `json:"oidc,omitempty"`
+
+	// SAML holds the settings of SAML identity providers
+	// +optional
+	SAML *SAMLIdentityProviderSettings `json:"saml,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="self.idpType == 'WORKFORCE' || (!has(self.clientId) && !has(self.requestedScopes))",message="clientId and requestedScopes are only allowed for WORKFORCE identity providers"
+// +kubebuilder:validation:XValidation:rule="self.idpType == 'WORKLOAD' || has(self.clientId)",message="clientId is required for WORKFORCE identity providers"
+
+// OIDCIdentityProviderSettings defines the settings of an OIDC identity provider
+type OIDCIdentityProviderSettings struct {
+	// IdpType tells whether the identity provider authenticates humans (WORKFORCE) or
+	// applications (WORKLOAD). This field is immutable.
+	// +kubebuilder:validation:Enum=WORKFORCE;WORKLOAD
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="idpType is immutable"
+	// +kubebuilder:validation:Required
+	IdpType string `json:"idpType"`
+
+	// Audience is the intended recipient of the tokens
+	// +kubebuilder:validation:Required
+	Audience string `json:"audience"`
+
+	// ClientID is the client identifier Atlas is registered with in the identity provider
+	// +optional
+	ClientID string `json:"clientId,omitempty"`
+
+	// AuthorizationType tells whether database users are authorized as individual users or by group
+	// +kubebuilder:validation:Enum=GROUP;USER
+	// +kubebuilder:default=GROUP
+	// +optional
+	AuthorizationType string `json:"authorizationType,omitempty"`
+
+	// GroupsClaim is the token claim holding the groups of the user
+	// +kubebuilder:default=groups
+	// +optional
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+
+	// UserClaim is the token claim identifying the user
+	// +kubebuilder:default=sub
+	// +optional
+	UserClaim string `json:"userClaim,omitempty"`
+
+	// RequestedScopes are the scopes requested to the identity provider on sign in
+	// +optional
+	RequestedScopes []string `json:"requestedScopes,omitempty"`
+}
+
+// SAMLIdentityProviderSettings defines the settings of a SAML identity provider
+type SAMLIdentityProviderSettings struct {
+	// SSOURL is the URL of the identity provider Atlas redirects users to for sign in
+	// +kubebuilder:validation:Required
+	SSOURL string `json:"ssoUrl"`
+
+	// RequestBinding is the SAML authentication request protocol binding
+	// +kubebuilder:validation:Enum=HTTP-POST;HTTP-REDIRECT
+	// +kubebuilder:default=HTTP-POST
+	// +optional
+	RequestBinding string `json:"requestBinding,omitempty"`
+
+	// ResponseSignatureAlgorithm is the algorithm the identity provider signs its responses with
+	// +kubebuilder:validation:Enum=SHA-1;SHA-256
+	// +kubebuilder:default=SHA-256
+	// +optional
+	ResponseSignatureAlgorithm string `json:"responseSignatureAlgorithm,omitempty"`
+
+	// SSODebugEnabled enables SSO debugging for the identity provider
+	// +optional
+	SSODebugEnabled bool `json:"ssoDebugEnabled,omitempty"`
+
+	// Status of the identity provider
+	// +kubebuilder:validation:Enum=ACTIVE;INACTIVE
+	// +kubebuilder:default=ACTIVE
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// Certificates are the PEM encoded certificates the identity provider signs its responses with
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Required
+	Certificates []string `json:"certificates"`
+}
+
+func (idp *AtlasIdentityProvider) Credentials() *api.LocalObjectReference {
+	return idp.Spec.ConnectionSecretRef
+}
+
+func (idp *AtlasIdentityProvider) GetConditions() []metav1.Condition {
+	if idp.Status.Conditions == nil {
+		return []metav1.Condition{}
+	}
+	return idp.Status.Conditions
+}
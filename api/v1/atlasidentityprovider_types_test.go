@@ -0,0 +1,122 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestIdentityProviderCELChecks(t *testing.T) {
+	workforce := &OIDCIdentityProviderSettings{IdpType: "WORKFORCE", Audience: "atlas", ClientID: "client"}
+	workload := &OIDCIdentityProviderSettings{IdpType: "WORKLOAD", Audience: "atlas"}
+	saml := &SAMLIdentityProviderSettings{SSOURL: "https://sso.example.com", Certificates: []string{"cert"}}
+	for _, tc := range []struct {
+		title          string
+		old, obj       *AtlasIdentityProviderSpec
+		expectedErrors []string
+	}{
+		{
+			title: "OIDC succeeds with OIDC settings",
+			obj:   &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolOIDC, OIDC: workforce},
+		},
+		{
+			title:          "OIDC fails without OIDC settings",
+			obj:            &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolOIDC},
+			expectedErrors: []string{"spec: Invalid value: \"object\": oidc settings are required for, and only allowed with, the OIDC protocol"},
+		},
+		{
+			title: "OIDC fails with SAML settings",
+			obj:   &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolOIDC, OIDC: workforce, SAML: saml},
+			expectedErrors: []string{
+				"spec: Invalid value: \"object\": saml settings are required for, and only allowed with, the SAML protocol",
+			},
+		},
+		{
+			title: "SAML succeeds with SAML settings",
+			obj:   &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolSAML, SAML: saml},
+		},
+		{
+			title: "SAML fails with OIDC settings",
+			obj:   &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolSAML, SAML: saml, OIDC: workload},
+			expectedErrors: []string{
+				"spec: Invalid value: \"object\": oidc settings are required for, and only allowed with, the OIDC protocol",
+			},
+		},
+		{
+			title: "workload providers succeed without a client ID",
+			obj:   &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolOIDC, OIDC: workload},
+		},
+		{
+			title: "workload providers fail with a client ID",
+			obj: &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolOIDC, OIDC: &OIDCIdentityProviderSettings{
+				IdpType: "WORKLOAD", Audience: "atlas", ClientID: "client",
+			}},
+			expectedErrors: []string{"spec.oidc: Invalid value: \"object\": clientId and requestedScopes are only allowed for WORKFORCE identity providers"},
+		},
+		{
+			title: "workforce providers fail without a client ID",
+			obj: &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolOIDC, OIDC: &OIDCIdentityProviderSettings{
+				IdpType: "WORKFORCE", Audience: "atlas",
+			}},
+			expectedErrors: []string{"spec.oidc: Invalid value: \"object\": clientId is required for WORKFORCE identity providers"},
+		},
+		{
+			title:          "protocol cannot be changed",
+			old:            &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolSAML, SAML: saml},
+			obj:            &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolOIDC, OIDC: workforce},
+			expectedErrors: []string{"spec: Invalid value: \"object\": protocol is immutable"},
+		},
+		{
+			title:          "OIDC provider type cannot be changed",
+			old:            &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolOIDC, OIDC: workload},
+			obj:            &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolOIDC, OIDC: workforce},
+			expectedErrors: []string{"spec.oidc.idpType: Invalid value: \"string\": idpType is immutable"},
+		},
+		{
+			title: "OIDC settings can be changed",
+			old:   &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolOIDC, OIDC: workforce},
+			obj: &AtlasIdentityProviderSpec{Protocol: IdentityProviderProtocolOIDC, OIDC: &OIDCIdentityProviderSettings{
+				IdpType: "WORKFORCE", Audience: "other", ClientID: "client",
+			}},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			obj := &AtlasIdentityProvider{Spec: *tc.obj}
+			obj.Spec.OrgID, obj.Spec.DisplayName, obj.Spec.IssuerURI = "org-id", "idp", "https://issuer.example.com"
+			var old *AtlasIdentityProvider
+			if tc.old != nil {
+				old = &AtlasIdentityProvider{Spec: *tc.old}
+				old.Spec.OrgID, old.Spec.DisplayName, old.Spec.IssuerURI = "org-id", "idp", "https://issuer.example.com"
+			}
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&old)
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasidentityproviders.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
@@ -27,15 +27,49 @@ func init() {
 
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && !has(self.projectRef)) || (!has(self.externalProjectRef) && has(self.projectRef))",message="must define only one project reference through externalProjectRef or projectRef"
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && has(self.connectionSecret)) || !has(self.externalProjectRef)",message="must define a local connection secret when referencing an external project"
+// +kubebuilder:validation:XValidation:rule="(has(self.entries) && size(self.entries) > 0) || (has(self.dynamicSources) && size(self.dynamicSources) > 0)",message="must define at least one entry or dynamic source"
 
 // AtlasIPAccessListSpec defines the desired state of AtlasIPAccessList.
 type AtlasIPAccessListSpec struct {
 	// ProjectReference is the dual external or kubernetes reference with access credentials
 	ProjectDualReference `json:",inline"`
 	// Entries is the list of IP Access to be managed
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:MinItems=1
-	Entries []IPAccessEntry `json:"entries"`
+	// +optional
+	Entries []IPAccessEntry `json:"entries,omitempty"`
+	// DynamicSources add the IP addresses read from Kubernetes objects to the entries, following their changes.
+	// +optional
+	DynamicSources []IPAccessDynamicSource `json:"dynamicSources,omitempty"`
+	// GracePeriod is how long an IP address is kept in Atlas after its dynamic source stopped listing it.
+	// +kubebuilder:default:="1h"
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="[has(self.nodeSelector), has(self.serviceRef), has(self.configMapRef)].filter(x, x).size() == 1",message="must define exactly one of nodeSelector, serviceRef or configMapRef"
+
+// IPAccessDynamicSource is a Kubernetes object listing IP addresses. Only IPv4 addresses are added.
+type IPAccessDynamicSource struct {
+	// NodeSelector adds the ExternalIP addresses of the nodes matching the label selector.
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+	// ServiceRef adds the load balancer ingress IPs of a Service of the namespace.
+	// +optional
+	ServiceRef *api.LocalObjectReference `json:"serviceRef,omitempty"`
+	// ConfigMapRef adds the IP addresses and CIDR blocks of a ConfigMap of the namespace.
+	// +optional
+	ConfigMapRef *IPAccessListConfigMapReference `json:"configMapRef,omitempty"`
+	// Comment associated with the entries of this source.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+// IPAccessListConfigMapReference is a ConfigMap listing IP addresses and CIDR blocks, separated by whitespaces or commas.
+type IPAccessListConfigMapReference struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+	// Key of the ConfigMap holding the addresses. All keys are read when not set.
+	// +optional
+	Key string `json:"key,omitempty"`
 }
 
 // +kubebuilder:validation:XValidation:rule="!(has(self.ipAddress) && (has(self.cidrBlock) || has(self.awsSecurityGroup))) && !(has(self.cidrBlock) && has(self.awsSecurityGroup))",message="Only one of ipAddress, cidrBlock, or awsSecurityGroup may be set."
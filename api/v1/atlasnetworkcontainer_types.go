@@ -57,6 +57,8 @@ type AtlasNetworkContainerList struct {
 // +kubebuilder:validation:XValidation:rule="((self.provider == 'AWS' || self.provider == 'AZURE') && has(self.region)) || (self.provider == 'GCP')",message="must set region for AWS and Azure containers"
 // +kubebuilder:validation:XValidation:rule="(self.id == oldSelf.id) || (!has(self.id) && !has(oldSelf.id))",message="id is immutable"
 // +kubebuilder:validation:XValidation:rule="(self.region == oldSelf.region) || (!has(self.region) && !has(oldSelf.region))",message="region is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(self.cidrPoolRef) || !has(self.cidrBlock) || self.cidrBlock == ''",message="must not set both cidrBlock and cidrPoolRef"
+// +kubebuilder:validation:XValidation:rule="(has(self.cidrPoolRef) == has(oldSelf.cidrPoolRef)) && (!has(self.cidrPoolRef) || self.cidrPoolRef == oldSelf.cidrPoolRef)",message="cidrPoolRef is immutable"
 
 // AtlasNetworkContainerSpec defines the desired state of an AtlasNetworkContainer
 type AtlasNetworkContainerSpec struct {
@@ -68,6 +70,11 @@ type AtlasNetworkContainerSpec struct {
 	Provider string `json:"provider"`
 
 	AtlasNetworkContainerConfig `json:",inline"`
+
+	// CIDRPoolRef allocates the CIDR block of the container from an AtlasCIDRPool instead of setting cidrBlock.
+	// This field is immutable.
+	// +optional
+	CIDRPoolRef *CIDRPoolReference `json:"cidrPoolRef,omitempty"`
 }
 
 // AtlasNetworkContainerConfig defines the Atlas specifics of the desired state of a Network Container
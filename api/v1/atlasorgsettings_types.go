@@ -84,6 +84,12 @@ type AtlasOrgSettingsSpec struct {
 	// group's clusters in the same organization.
 	// +optional
 	StreamsCrossGroupEnabled *bool `json:"streamsCrossGroupEnabled,omitempty"`
+
+	// PruneUndeclaredUsers Flag that indicates whether to remove members and pending
+	// invitations of the organization which are declared neither by an AtlasOrgUser
+	// nor as a username of an AtlasTeam. Organization owners are never removed.
+	// +optional
+	PruneUndeclaredUsers *bool `json:"pruneUndeclaredUsers,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
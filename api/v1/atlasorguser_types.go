@@ -0,0 +1,125 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasOrgUser{}, &AtlasOrgUserList{})
+}
+
+// +kubebuilder:validation:Enum=ORG_OWNER;ORG_GROUP_CREATOR;ORG_BILLING_ADMIN;ORG_BILLING_READ_ONLY;ORG_READ_ONLY;ORG_MEMBER
+
+type OrgRole string
+
+const (
+	OrgRoleOwner           OrgRole = "ORG_OWNER"
+	OrgRoleGroupCreator    OrgRole = "ORG_GROUP_CREATOR"
+	OrgRoleBillingAdmin    OrgRole = "ORG_BILLING_ADMIN"
+	OrgRoleBillingReadOnly OrgRole = "ORG_BILLING_READ_ONLY"
+	OrgRoleReadOnly        OrgRole = "ORG_READ_ONLY"
+	OrgRoleMember          OrgRole = "ORG_MEMBER"
+)
+
+// AtlasOrgUser is the Schema for the AtlasOrgUser API
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Username",type=string,JSONPath=`.spec.username`
+// +kubebuilder:printcolumn:name="Membership",type=string,JSONPath=`.status.membershipStatus`
+// +kubebuilder:subresource:status
+// +groupName:=atlas.mongodb.com
+// +kubebuilder:resource:categories=atlas,shortName=aou
+type AtlasOrgUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasOrgUserSpec          `json:"spec,omitempty"`
+	Status status.AtlasOrgUserStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AtlasOrgUserList contains a list of AtlasOrgUser
+type AtlasOrgUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasOrgUser `json:"items"`
+}
+
+// +kubebuilder:validation:XValidation:rule="self.username == oldSelf.username",message="username is immutable"
+// +kubebuilder:validation:XValidation:rule="self.orgID == oldSelf.orgID",message="orgID is immutable"
+
+// AtlasOrgUserSpec defines the desired membership of a human user in an Atlas organization
+type AtlasOrgUserSpec struct {
+	// OrgID is the ID of the organization the user is a member of. This field is immutable.
+	// +kubebuilder:validation:Required
+	OrgID string `json:"orgID"`
+
+	// ConnectionSecretRef is the name of the Kubernetes Secret which contains the information about the way to connect to
+	// Atlas (Public & Private API keys).
+	// +optional
+	ConnectionSecretRef *api.LocalObjectReference `json:"connectionSecretRef,omitempty"`
+
+	// Username is the email address of the user, an invitation is sent to it when the
+	// user is not a member of the organization yet. This field is immutable.
+	// +kubebuilder:validation:Format=email
+	// +kubebuilder:validation:Required
+	Username string `json:"username"`
+
+	// OrgRoles the user holds in the organization
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Required
+	OrgRoles []OrgRole `json:"orgRoles"`
+
+	// ProjectRoles the user holds in projects of the organization
+	// +optional
+	ProjectRoles []OrgUserProjectRoles `json:"projectRoles,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.projectRef) != has(self.externalProjectRef)",message="must define only one project reference through externalProjectRef or projectRef"
+
+// OrgUserProjectRoles assigns roles in a single project
+type OrgUserProjectRoles struct {
+	// ProjectRef is a reference to an AtlasProject custom resource
+	// +optional
+	ProjectRef *common.ResourceRefNamespaced `json:"projectRef,omitempty"`
+
+	// ExternalProjectRef holds the ID of a project not managed by the operator
+	// +optional
+	ExternalProjectRef *ExternalProjectReference `json:"externalProjectRef,omitempty"`
+
+	// Roles the user holds in the project
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Required
+	Roles []TeamRole `json:"roles"`
+}
+
+func (u *AtlasOrgUser) Credentials() *api.LocalObjectReference {
+	return u.Spec.ConnectionSecretRef
+}
+
+func (u *AtlasOrgUser) GetConditions() []metav1.Condition {
+	if u.Status.Conditions == nil {
+		return []metav1.Condition{}
+	}
+	return u.Status.Conditions
+}
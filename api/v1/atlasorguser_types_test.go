@@ -0,0 +1,108 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestOrgUserCELChecks(t *testing.T) {
+	projectRef := &common.ResourceRefNamespaced{Name: "my-project"}
+	externalProjectRef := &ExternalProjectReference{ID: "project-id"}
+	roles := []TeamRole{TeamRoleReadOnly}
+	for _, tc := range []struct {
+		title          string
+		old, obj       *AtlasOrgUserSpec
+		expectedErrors []string
+	}{
+		{
+			title: "project roles succeed with a project reference",
+			obj:   &AtlasOrgUserSpec{ProjectRoles: []OrgUserProjectRoles{{ProjectRef: projectRef, Roles: roles}}},
+		},
+		{
+			title: "project roles succeed with an external project reference",
+			obj:   &AtlasOrgUserSpec{ProjectRoles: []OrgUserProjectRoles{{ExternalProjectRef: externalProjectRef, Roles: roles}}},
+		},
+		{
+			title: "project roles fail with both project references",
+			obj: &AtlasOrgUserSpec{ProjectRoles: []OrgUserProjectRoles{
+				{ProjectRef: projectRef, ExternalProjectRef: externalProjectRef, Roles: roles},
+			}},
+			expectedErrors: []string{"spec.projectRoles[0]: Invalid value: \"object\": must define only one project reference through externalProjectRef or projectRef"},
+		},
+		{
+			title:          "project roles fail without a project reference",
+			obj:            &AtlasOrgUserSpec{ProjectRoles: []OrgUserProjectRoles{{Roles: roles}}},
+			expectedErrors: []string{"spec.projectRoles[0]: Invalid value: \"object\": must define only one project reference through externalProjectRef or projectRef"},
+		},
+		{
+			title: "roles can be changed",
+			old:   &AtlasOrgUserSpec{},
+			obj:   &AtlasOrgUserSpec{OrgRoles: []OrgRole{OrgRoleReadOnly}},
+		},
+		{
+			title:          "username cannot be changed",
+			old:            &AtlasOrgUserSpec{Username: "john.doe@example.com"},
+			obj:            &AtlasOrgUserSpec{},
+			expectedErrors: []string{"spec: Invalid value: \"object\": username is immutable"},
+		},
+		{
+			title:          "orgID cannot be changed",
+			old:            &AtlasOrgUserSpec{OrgID: "other-org-id"},
+			obj:            &AtlasOrgUserSpec{},
+			expectedErrors: []string{"spec: Invalid value: \"object\": orgID is immutable"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			obj := &AtlasOrgUser{Spec: *tc.obj}
+			setOrgUserDefaults(&obj.Spec)
+			var old *AtlasOrgUser
+			if tc.old != nil {
+				old = &AtlasOrgUser{Spec: *tc.old}
+				setOrgUserDefaults(&old.Spec)
+			}
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&old)
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasorgusers.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
+
+func setOrgUserDefaults(spec *AtlasOrgUserSpec) {
+	if spec.OrgID == "" {
+		spec.OrgID = "org-id"
+	}
+	if spec.Username == "" {
+		spec.Username = "jane.doe@example.com"
+	}
+	if len(spec.OrgRoles) == 0 {
+		spec.OrgRoles = []OrgRole{OrgRoleMember}
+	}
+}
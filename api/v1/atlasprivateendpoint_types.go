@@ -27,6 +27,11 @@ func init() {
 	SchemeBuilder.Register(&AtlasPrivateEndpoint{}, &AtlasPrivateEndpointList{})
 }
 
+const (
+	PrivateEndpointExportConfigMap = "ConfigMap"
+	PrivateEndpointExportSecret    = "Secret"
+)
+
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && !has(self.projectRef)) || (!has(self.externalProjectRef) && has(self.projectRef))",message="must define only one project reference through externalProjectRef or projectRef"
 // +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && has(self.connectionSecret)) || !has(self.externalProjectRef)",message="must define a local connection secret when referencing an external project"
 
@@ -57,6 +62,22 @@ type AtlasPrivateEndpointSpec struct {
 	// +listMapKey=groupName
 	// +kubebuilder:validation:Optional
 	GCPConfiguration []GCPPrivateEndpointConfiguration `json:"gcpConfiguration,omitempty"`
+	// ExportTo is the ConfigMap or Secret the private endpoint service details and the expected DNS names are written to,
+	// for other tools to create the endpoints in the cloud provider
+	// +kubebuilder:validation:Optional
+	ExportTo *PrivateEndpointExport `json:"exportTo,omitempty"`
+}
+
+// PrivateEndpointExport references the object the private endpoint service details are exported to
+type PrivateEndpointExport struct {
+	// Kind of the object to export to, either ConfigMap or Secret
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	// +kubebuilder:default=ConfigMap
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Name of the object to export to, in the namespace of the AtlasPrivateEndpoint
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
 }
 
 // AWSPrivateEndpointConfiguration holds the AWS configuration done on customer network
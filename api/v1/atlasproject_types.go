@@ -40,6 +40,7 @@ func init() {
 }
 
 // AtlasProjectSpec defines the desired state of Project in Atlas
+// +kubebuilder:validation:XValidation:rule="!(has(self.connectionSecretRef) && has(self.credentialsRef))",message="connectionSecretRef and credentialsRef are mutually exclusive"
 type AtlasProjectSpec struct {
 
 	// Name is the name of the Project that is created in Atlas by the Operator if it doesn't exist yet.
@@ -59,6 +60,11 @@ type AtlasProjectSpec struct {
 	// +optional
 	ConnectionSecret *common.ResourceRefNamespaced `json:"connectionSecretRef,omitempty"`
 
+	// CredentialsRef reads the Atlas API credentials of the project from a source other than a Kubernetes Secret,
+	// such as files mounted in the Operator or HashiCorp Vault. It cannot be set together with the ConnectionSecret.
+	// +optional
+	CredentialsRef *CredentialsReference `json:"credentialsRef,omitempty"`
+
 	// ProjectIPAccessList allows to enable the IP Access List for the Project. See more information at
 	// https://docs.atlas.mongodb.com/reference/api/ip-access-list/add-entries-to-access-list/
 	// +optional
@@ -163,6 +169,7 @@ var _ api.AtlasCustomResource = &AtlasProject{}
 // +kubebuilder:subresource:status
 // +groupName:=atlas.mongodb.com
 // +kubebuilder:resource:categories=atlas,shortName=ap
+// +kubebuilder:storageversion
 
 // AtlasProject is the Schema for the atlasprojects API
 type AtlasProject struct {
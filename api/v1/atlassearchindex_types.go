@@ -16,10 +16,80 @@ package v1
 
 import (
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 )
 
+func init() {
+	SchemeBuilder.Register(&AtlasSearchIndex{}, &AtlasSearchIndexList{})
+}
+
+// AtlasSearchIndex is the Schema for a search or vector search index managed independently of its AtlasDeployment
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Index",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.indexStatus`
+// +kubebuilder:printcolumn:name="Queryable",type=boolean,JSONPath=`.status.queryable`
+// +kubebuilder:subresource:status
+// +groupName:=atlas.mongodb.com
+// +kubebuilder:resource:categories=atlas,shortName=asi
+type AtlasSearchIndex struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasSearchIndexSpec          `json:"spec,omitempty"`
+	Status status.AtlasSearchIndexStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AtlasSearchIndexList contains a list of AtlasSearchIndex
+type AtlasSearchIndexList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasSearchIndex `json:"items"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.deploymentRef) != has(self.externalDeploymentRef)",message="must define only one deployment reference through deploymentRef or externalDeploymentRef"
+// +kubebuilder:validation:XValidation:rule="!has(self.externalDeploymentRef) || has(self.connectionSecret)",message="must define a local connection secret when referencing an external deployment"
+// +kubebuilder:validation:XValidation:rule="self.type != 'search' || has(self.search)",message="search settings are required for search indexes"
+// +kubebuilder:validation:XValidation:rule="self.type != 'vectorSearch' || has(self.vectorSearch)",message="vectorSearch settings are required for vector search indexes"
+// +kubebuilder:validation:XValidation:rule="self.name == oldSelf.name && self.DBName == oldSelf.DBName && self.collectionName == oldSelf.collectionName",message="name, DBName and collectionName are immutable"
+// +kubebuilder:validation:XValidation:rule="self.type == oldSelf.type",message="type is immutable"
+
+// AtlasSearchIndexSpec defines the desired state of an AtlasSearchIndex
+type AtlasSearchIndexSpec struct {
+	// DeploymentRef is a reference to the AtlasDeployment the index is created in
+	// +optional
+	DeploymentRef *common.ResourceRefNamespaced `json:"deploymentRef,omitempty"`
+
+	// ExternalDeploymentRef identifies a deployment not managed by the operator
+	// +optional
+	ExternalDeploymentRef *ExternalDeploymentReference `json:"externalDeploymentRef,omitempty"`
+
+	// ConnectionSecret is the name of the Kubernetes Secret which contains the information about the way to connect to
+	// Atlas (Public & Private API keys). Defaults to the credentials of the referenced AtlasDeployment.
+	// +optional
+	ConnectionSecret *api.LocalObjectReference `json:"connectionSecret,omitempty"`
+
+	SearchIndex `json:",inline"`
+}
+
+func (asi *AtlasSearchIndex) Credentials() *api.LocalObjectReference {
+	return asi.Spec.ConnectionSecret
+}
+
+func (asi *AtlasSearchIndex) GetConditions() []metav1.Condition {
+	if asi.Status.Conditions == nil {
+		return []metav1.Condition{}
+	}
+	return asi.Status.Conditions
+}
+
 // SearchIndex is the CRD to configure part of the Atlas Search Index
 type SearchIndex struct {
 	// Human-readable label that identifies this index. Must be unique for a deployment
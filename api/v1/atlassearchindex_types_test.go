@@ -0,0 +1,124 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestSearchIndexCELChecks(t *testing.T) {
+	deploymentRef := &common.ResourceRefNamespaced{Name: "my-deployment"}
+	externalDeploymentRef := &ExternalDeploymentReference{ProjectID: "project-id", ClusterName: "cluster"}
+	connectionSecret := &api.LocalObjectReference{Name: "atlas-credentials"}
+	for _, tc := range []struct {
+		title          string
+		old, obj       *AtlasSearchIndexSpec
+		expectedErrors []string
+	}{
+		{
+			title: "deployment reference succeeds",
+			obj:   &AtlasSearchIndexSpec{DeploymentRef: deploymentRef},
+		},
+		{
+			title: "external deployment reference with a connection secret succeeds",
+			obj:   &AtlasSearchIndexSpec{ExternalDeploymentRef: externalDeploymentRef, ConnectionSecret: connectionSecret},
+		},
+		{
+			title:          "external deployment reference without a connection secret fails",
+			obj:            &AtlasSearchIndexSpec{ExternalDeploymentRef: externalDeploymentRef},
+			expectedErrors: []string{"spec: Invalid value: \"object\": must define a local connection secret when referencing an external deployment"},
+		},
+		{
+			title:          "both deployment references fail",
+			obj:            &AtlasSearchIndexSpec{DeploymentRef: deploymentRef, ExternalDeploymentRef: externalDeploymentRef, ConnectionSecret: connectionSecret},
+			expectedErrors: []string{"spec: Invalid value: \"object\": must define only one deployment reference through deploymentRef or externalDeploymentRef"},
+		},
+		{
+			title:          "no deployment reference fails",
+			obj:            &AtlasSearchIndexSpec{},
+			expectedErrors: []string{"spec: Invalid value: \"object\": must define only one deployment reference through deploymentRef or externalDeploymentRef"},
+		},
+		{
+			title:          "search indexes require search settings",
+			obj:            &AtlasSearchIndexSpec{DeploymentRef: deploymentRef, SearchIndex: SearchIndex{Type: "search"}},
+			expectedErrors: []string{"spec: Invalid value: \"object\": search settings are required for search indexes"},
+		},
+		{
+			title: "the deployment reference can be changed",
+			old:   &AtlasSearchIndexSpec{DeploymentRef: deploymentRef},
+			obj:   &AtlasSearchIndexSpec{ExternalDeploymentRef: externalDeploymentRef, ConnectionSecret: connectionSecret},
+		},
+		{
+			title:          "the collection cannot be changed",
+			old:            &AtlasSearchIndexSpec{DeploymentRef: deploymentRef, SearchIndex: SearchIndex{CollectionName: "other"}},
+			obj:            &AtlasSearchIndexSpec{DeploymentRef: deploymentRef},
+			expectedErrors: []string{"spec: Invalid value: \"object\": name, DBName and collectionName are immutable"},
+		},
+		{
+			title: "the type cannot be changed",
+			old: &AtlasSearchIndexSpec{DeploymentRef: deploymentRef, SearchIndex: SearchIndex{
+				Type:   "search",
+				Search: &Search{SearchConfigurationRef: common.ResourceRefNamespaced{Name: "config"}},
+			}},
+			obj:            &AtlasSearchIndexSpec{DeploymentRef: deploymentRef},
+			expectedErrors: []string{"spec: Invalid value: \"object\": type is immutable"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			obj := &AtlasSearchIndex{Spec: *tc.obj}
+			setSearchIndexDefaults(&obj.Spec)
+			var old *AtlasSearchIndex
+			if tc.old != nil {
+				old = &AtlasSearchIndex{Spec: *tc.old}
+				setSearchIndexDefaults(&old.Spec)
+			}
+			unstructuredOldObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&old)
+			require.NoError(t, err)
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlassearchindices.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, unstructuredOldObject)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
+
+func setSearchIndexDefaults(spec *AtlasSearchIndexSpec) {
+	if spec.Name == "" {
+		spec.Name = "index"
+	}
+	if spec.DBName == "" {
+		spec.DBName = "db"
+	}
+	if spec.CollectionName == "" {
+		spec.CollectionName = "collection"
+	}
+	if spec.Type == "" {
+		spec.Type = "vectorSearch"
+		spec.VectorSearch = &VectorSearch{}
+	}
+}
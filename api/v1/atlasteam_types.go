@@ -45,12 +45,74 @@ type AtlasTeam struct {
 
 type TeamUser string
 
+// +kubebuilder:validation:XValidation:rule="has(self.usernames) || has(self.membersFrom)",message="must define usernames, membersFrom or both"
+
 // TeamSpec defines the desired state of a Team in Atlas
 type TeamSpec struct {
 	// The name of the team you want to create.
 	Name string `json:"name"`
 	// Valid email addresses of users to add to the new team
-	Usernames []TeamUser `json:"usernames"`
+	// +optional
+	Usernames []TeamUser `json:"usernames,omitempty"`
+	// MembersFrom is a source of additional usernames for the team, the members of
+	// the team are the union of Usernames and the usernames read from this source
+	// +optional
+	MembersFrom *TeamMembersSource `json:"membersFrom,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.configMapRef) != has(self.http)",message="must define only one members source through configMapRef or http"
+
+// TeamMembersSource defines where to read the usernames of the members of a team from
+type TeamMembersSource struct {
+	// ConfigMapRef reads one username per line from a key of a ConfigMap in the namespace of the team,
+	// blank lines and lines starting with # are ignored
+	// +optional
+	ConfigMapRef *TeamMembersConfigMapReference `json:"configMapRef,omitempty"`
+	// HTTP periodically fetches the usernames from an HTTP endpoint
+	// +optional
+	HTTP *TeamMembersHTTPSource `json:"http,omitempty"`
+}
+
+// TeamMembersConfigMapReference is a reference to a key of a ConfigMap
+type TeamMembersConfigMapReference struct {
+	// Name of the ConfigMap
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Key holding the usernames
+	// +kubebuilder:default=usernames
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+const (
+	TeamMembersFormatJSON = "JSON"
+	TeamMembersFormatSCIM = "SCIM"
+)
+
+// TeamMembersHTTPSource defines an HTTP endpoint returning the usernames of the members of a team
+type TeamMembersHTTPSource struct {
+	// URL of the endpoint
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+	// Format of the response, JSON expects an array of usernames and SCIM a SCIM 2.0 ListResponse
+	// of Users whose active userName are the usernames, following its pagination
+	// +kubebuilder:validation:Enum=JSON;SCIM
+	// +kubebuilder:default=JSON
+	// +optional
+	Format string `json:"format,omitempty"`
+	// BearerTokenSecretRef is the name of a Secret in the namespace of the team whose "token" key
+	// is sent as a bearer token
+	// +optional
+	BearerTokenSecretRef *api.LocalObjectReference `json:"bearerTokenSecretRef,omitempty"`
+	// RefreshInterval is how often the usernames are fetched again
+	// +kubebuilder:default="1h"
+	// +optional
+	RefreshInterval metav1.Duration `json:"refreshInterval,omitempty"`
+	// AllowEmpty accepts a response without any username, which is otherwise rejected so that an
+	// outage of the endpoint returning an empty list does not remove every member of the team
+	// +optional
+	AllowEmpty bool `json:"allowEmpty,omitempty"`
 }
 
 // +kubebuilder:object:root=true
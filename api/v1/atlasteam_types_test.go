@@ -0,0 +1,83 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func TestTeamCELChecks(t *testing.T) {
+	configMapRef := &TeamMembersConfigMapReference{Name: "team-members", Key: "usernames"}
+	httpSource := &TeamMembersHTTPSource{URL: "https://directory.example.com/users", Format: TeamMembersFormatJSON}
+	for _, tc := range []struct {
+		title          string
+		spec           TeamSpec
+		expectedErrors []string
+	}{
+		{
+			title: "usernames only succeed",
+			spec:  TeamSpec{Name: "team", Usernames: []TeamUser{"jane.doe@example.com"}},
+		},
+		{
+			title: "members from a config map succeed",
+			spec:  TeamSpec{Name: "team", MembersFrom: &TeamMembersSource{ConfigMapRef: configMapRef}},
+		},
+		{
+			title: "usernames and members from an http source succeed",
+			spec: TeamSpec{
+				Name:        "team",
+				Usernames:   []TeamUser{"jane.doe@example.com"},
+				MembersFrom: &TeamMembersSource{HTTP: httpSource},
+			},
+		},
+		{
+			title:          "no members fail",
+			spec:           TeamSpec{Name: "team"},
+			expectedErrors: []string{"spec: Invalid value: \"object\": must define usernames, membersFrom or both"},
+		},
+		{
+			title: "both members sources fail",
+			spec: TeamSpec{
+				Name:        "team",
+				MembersFrom: &TeamMembersSource{ConfigMapRef: configMapRef, HTTP: httpSource},
+			},
+			expectedErrors: []string{"spec.membersFrom: Invalid value: \"object\": must define only one members source through configMapRef or http"},
+		},
+		{
+			title:          "empty members source fails",
+			spec:           TeamSpec{Name: "team", MembersFrom: &TeamMembersSource{}},
+			expectedErrors: []string{"spec.membersFrom: Invalid value: \"object\": must define only one members source through configMapRef or http"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			obj := &AtlasTeam{Spec: tc.spec}
+			unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&obj)
+			require.NoError(t, err)
+
+			crdPath := "../../config/crd/bases/atlas.mongodb.com_atlasteams.yaml"
+			validator, err := cel.VersionValidatorFromFile(t, crdPath, "v1")
+			assert.NoError(t, err)
+			errs := validator(unstructuredObject, nil)
+
+			require.Equal(t, tc.expectedErrors, cel.ErrorListAsStrings(errs))
+		})
+	}
+}
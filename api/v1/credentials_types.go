@@ -0,0 +1,48 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+// CredentialsReference selects where the Atlas API credentials are read from. Exactly one source must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.file) != has(self.vault)",message="exactly one of file or vault must be set"
+type CredentialsReference struct {
+	// File reads the credentials from files mounted in the Operator, for instance from a projected volume.
+	// +optional
+	File *FileCredentialsSource `json:"file,omitempty"`
+
+	// Vault reads the credentials from a secret of a HashiCorp Vault KV version 2 secrets engine.
+	// +optional
+	Vault *VaultCredentialsSource `json:"vault,omitempty"`
+}
+
+// FileCredentialsSource reads the credentials from the orgId, publicApiKey, privateApiKey and optional atlasDomain
+// files of a directory.
+type FileCredentialsSource struct {
+	// Name is the name of the directory holding the files, under the credentials directory of the Operator.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`
+	Name string `json:"name"`
+}
+
+// VaultCredentialsSource reads the credentials from the orgId, publicApiKey, privateApiKey and optional atlasDomain
+// keys of a Vault secret.
+type VaultCredentialsSource struct {
+	// Mount is the path the KV secrets engine is mounted at.
+	// +kubebuilder:default:=secret
+	// +optional
+	Mount string `json:"mount,omitempty"`
+
+	// Path is the path of the secret in the secrets engine.
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
@@ -19,3 +19,12 @@ type ExternalProjectReference struct {
 	// +kubebuilder:validation:Required
 	ID string `json:"id"`
 }
+
+type ExternalDeploymentReference struct {
+	// ProjectID is the Atlas project ID the deployment belongs to
+	// +kubebuilder:validation:Required
+	ProjectID string `json:"projectID"`
+	// ClusterName is the name of the deployment in Atlas
+	// +kubebuilder:validation:Required
+	ClusterName string `json:"clusterName"`
+}
@@ -50,7 +50,11 @@ var dualRefCRDs = []struct {
 		filename: "atlas.mongodb.com_atlasdeployments.yaml",
 	},
 	{
-		obj:      &AtlasIPAccessList{},
+		obj: &AtlasIPAccessList{
+			Spec: AtlasIPAccessListSpec{
+				Entries: []IPAccessEntry{{CIDRBlock: "192.168.0.0/24"}}, // Avoid triggering ip access list specific validations
+			},
+		},
 		filename: "atlas.mongodb.com_atlasipaccesslists.yaml",
 	},
 	{
@@ -58,7 +62,11 @@ var dualRefCRDs = []struct {
 		filename: "atlas.mongodb.com_atlasprivateendpoints.yaml",
 	},
 	{
-		obj:      &AtlasIPAccessList{},
+		obj: &AtlasIPAccessList{
+			Spec: AtlasIPAccessListSpec{
+				Entries: []IPAccessEntry{{CIDRBlock: "192.168.0.0/24"}}, // Avoid triggering ip access list specific validations
+			},
+		},
 		filename: "atlas.mongodb.com_atlasipaccesslists.yaml",
 	},
 	{
@@ -77,6 +85,23 @@ var dualRefCRDs = []struct {
 		},
 		filename: "atlas.mongodb.com_atlasnetworkpeerings.yaml",
 	},
+	{
+		obj: &AtlasCloudProviderAccess{
+			Spec: AtlasCloudProviderAccessSpec{
+				ProviderName: "GCP", // Avoid triggering provider specific validations
+			},
+		},
+		filename: "atlas.mongodb.com_atlascloudprovideraccesses.yaml",
+	},
+	{
+		obj: &AtlasBackupExportBucket{
+			Spec: AtlasBackupExportBucketSpec{
+				CloudProvider: "GCP", // Avoid triggering provider specific validations
+				BucketName:    "fake-bucket",
+			},
+		},
+		filename: "atlas.mongodb.com_atlasbackupexportbuckets.yaml",
+	},
 }
 
 var testCases = []struct {
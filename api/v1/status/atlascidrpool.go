@@ -0,0 +1,64 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import "github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+
+// AtlasCIDRPoolStatus is a status for the AtlasCIDRPool Custom resource
+type AtlasCIDRPoolStatus struct {
+	api.Common `json:",inline"`
+
+	// Allocations are the CIDR blocks allocated to AtlasNetworkContainers
+	// +optional
+	Allocations []CIDRAllocation `json:"allocations,omitempty"`
+
+	// AllocatedCount is the number of allocated CIDR blocks
+	// +optional
+	AllocatedCount int `json:"allocatedCount,omitempty"`
+
+	// Retained are the CIDR blocks of AtlasNetworkContainers removed from Kubernetes but possibly kept in Atlas.
+	// They are never allocated to other containers, a container of the same namespace and name takes its block back.
+	// +optional
+	Retained []CIDRAllocation `json:"retained,omitempty"`
+}
+
+// CIDRAllocation is a CIDR block allocated to an AtlasNetworkContainer
+type CIDRAllocation struct {
+	// CIDRBlock is the allocated block
+	CIDRBlock string `json:"cidrBlock"`
+
+	// Namespace of the AtlasNetworkContainer
+	Namespace string `json:"namespace"`
+
+	// Name of the AtlasNetworkContainer
+	Name string `json:"name"`
+}
+
+// +kubebuilder:object:generate=false
+
+type AtlasCIDRPoolStatusOption func(s *AtlasCIDRPoolStatus)
+
+func WithCIDRPoolAllocations(allocations []CIDRAllocation) AtlasCIDRPoolStatusOption {
+	return func(s *AtlasCIDRPoolStatus) {
+		s.Allocations = allocations
+		s.AllocatedCount = len(allocations)
+	}
+}
+
+func WithCIDRPoolRetained(retained []CIDRAllocation) AtlasCIDRPoolStatusOption {
+	return func(s *AtlasCIDRPoolStatus) {
+		s.Retained = retained
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import "github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+
+// AtlasCloudProviderAccessStatus is a status for the AtlasCloudProviderAccess Custom resource.
+// Not the one included in the AtlasProject
+type AtlasCloudProviderAccessStatus struct {
+	api.Common `json:",inline"`
+
+	// RoleID is the identifier of the role in Atlas
+	RoleID string `json:"roleId,omitempty"`
+
+	// AtlasAWSAccountArn is the ARN of the AWS account Atlas uses to assume the IAM role
+	AtlasAWSAccountArn string `json:"atlasAWSAccountArn,omitempty"`
+
+	// AtlasAssumedRoleExternalID is the external ID Atlas uses to assume the IAM role
+	AtlasAssumedRoleExternalID string `json:"atlasAssumedRoleExternalId,omitempty"`
+
+	// IamAssumedRoleArn is the ARN of the IAM role currently authorized in Atlas
+	IamAssumedRoleArn string `json:"iamAssumedRoleArn,omitempty"`
+
+	// GCPServiceAccountForAtlas is the email address of the GCP service account created by Atlas
+	GCPServiceAccountForAtlas string `json:"gcpServiceAccountForAtlas,omitempty"`
+
+	// Authorized is true once the role is authorized in Atlas
+	Authorized bool `json:"authorized,omitempty"`
+
+	// CreatedDate is the date the role was created in Atlas
+	CreatedDate string `json:"createdDate,omitempty"`
+
+	// AuthorizedDate is the date the role was last authorized in Atlas
+	AuthorizedDate string `json:"authorizedDate,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+
+type AtlasCloudProviderAccessStatusOption func(s *AtlasCloudProviderAccessStatus)
@@ -0,0 +1,28 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+// +k8s:deepcopy-gen=true
+
+// AtlasCollectionStatus holds the status of a collection
+type AtlasCollectionStatus struct {
+	UnifiedStatus `json:",inline"`
+
+	// Type of the collection, either collection or timeseries
+	Type string `json:"type,omitempty"`
+
+	// Indexes are the names of the indexes managed by the operator
+	Indexes []string `json:"indexes,omitempty"`
+}
@@ -35,6 +35,13 @@ func AtlasDatabaseUserNameOption(name string) AtlasDatabaseUserStatusOption {
 	}
 }
 
+func AtlasDatabaseUserX509CertificateOption(secretName, notAfter string) AtlasDatabaseUserStatusOption {
+	return func(s *AtlasDatabaseUserStatus) {
+		s.X509CertificateSecret = secretName
+		s.X509CertificateNotAfter = notAfter
+	}
+}
+
 // AtlasDatabaseUserStatus defines the observed state of AtlasProject
 type AtlasDatabaseUserStatus struct {
 	api.Common `json:",inline"`
@@ -44,4 +51,10 @@ type AtlasDatabaseUserStatus struct {
 
 	// UserName is the current name of database user.
 	UserName string `json:"name,omitempty"`
+
+	// X509CertificateSecret is the name of the Secret holding the Atlas managed X.509 certificate of the user
+	X509CertificateSecret string `json:"x509CertificateSecret,omitempty"`
+
+	// X509CertificateNotAfter is the expiry date of the current Atlas managed X.509 certificate in ISO 8601 format
+	X509CertificateNotAfter string `json:"x509CertificateNotAfter,omitempty"`
 }
@@ -46,6 +46,9 @@ type AtlasDeploymentStatus struct {
 
 	// SearchIndexes contains a list of search indexes statuses configured for a project
 	SearchIndexes []DeploymentSearchIndexStatus `json:"searchIndexes,omitempty"`
+
+	// FinalSnapshotID is the identifier of the snapshot taken before removing the deployment from Atlas
+	FinalSnapshotID string `json:"finalSnapshotId,omitempty"`
 }
 
 const (
@@ -174,6 +177,12 @@ func AtlasDeploymentConnectionStringsOption(connStr *ConnectionStrings) AtlasDep
 	}
 }
 
+func AtlasDeploymentFinalSnapshotIDOption(snapshotID string) AtlasDeploymentStatusOption {
+	return func(s *AtlasDeploymentStatus) {
+		s.FinalSnapshotID = snapshotID
+	}
+}
+
 func AtlasDeploymentRemoveStatusesWithEmptyIDs() AtlasDeploymentStatusOption {
 	return func(s *AtlasDeploymentStatus) {
 		var result []DeploymentSearchIndexStatus
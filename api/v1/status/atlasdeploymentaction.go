@@ -0,0 +1,58 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+const (
+	DeploymentActionRunning   = "Running"
+	DeploymentActionSucceeded = "Succeeded"
+	DeploymentActionFailed    = "Failed"
+)
+
+// +k8s:deepcopy-gen=true
+
+// AtlasDeploymentActionStatus records the run of a deployment action
+type AtlasDeploymentActionStatus struct {
+	UnifiedStatus `json:",inline"`
+
+	// ProjectID is the Atlas project of the deployment the action ran against
+	ProjectID string `json:"projectID,omitempty"`
+
+	// ClusterName is the name of the deployment the action ran against
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Phase is Running until Atlas completed the action, then Succeeded or Failed
+	Phase string `json:"phase,omitempty"`
+
+	// StartTime is when the action was sent to Atlas
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when Atlas completed the action
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// AtlasState is the last state of the operation reported by Atlas,
+	// e.g. the status of a snapshot, the phase of an outage simulation or the state of an export job
+	AtlasState string `json:"atlasState,omitempty"`
+
+	// SnapshotID is the ID of the snapshot taken by a TakeSnapshot action
+	SnapshotID string `json:"snapshotID,omitempty"`
+
+	// OutageSimulationID is the ID of the outage simulation started or ended by the action
+	OutageSimulationID string `json:"outageSimulationID,omitempty"`
+
+	// ExportJobID is the ID of the snapshot export job started by an ExportSnapshot action
+	ExportJobID string `json:"exportJobID,omitempty"`
+}
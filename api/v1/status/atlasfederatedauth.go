@@ -20,8 +20,21 @@ import (
 
 type AtlasFederatedAuthStatus struct {
 	api.Common `json:",inline"`
+
+	// WorkloadIdentityProviderID is the Atlas ID of the Workload identity provider registered for the Kubernetes cluster
+	WorkloadIdentityProviderID string `json:"workloadIdentityProviderId,omitempty"`
+
+	// WorkloadIdentityProviderIssuerURI is the issuer of the service account tokens the Workload identity provider trusts
+	WorkloadIdentityProviderIssuerURI string `json:"workloadIdentityProviderIssuerURI,omitempty"`
 }
 
 // +k8s:deepcopy-gen=false
 
 type AtlasFederatedAuthStatusOption func(s *AtlasFederatedAuthStatus)
+
+func AtlasFederatedAuthWorkloadIdentityProviderOption(id, issuerURI string) AtlasFederatedAuthStatusOption {
+	return func(s *AtlasFederatedAuthStatus) {
+		s.WorkloadIdentityProviderID = id
+		s.WorkloadIdentityProviderIssuerURI = issuerURI
+	}
+}
@@ -15,6 +15,8 @@
 package status
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 )
 
@@ -24,6 +26,15 @@ type AtlasIPAccessListStatus struct {
 	//
 	// Status is the state of the ip access list
 	Entries []IPAccessEntryStatus `json:"entries,omitempty"`
+	// DynamicEntries are the entries added from dynamic sources, with when a source last listed them
+	DynamicEntries []IPAccessDynamicEntryStatus `json:"dynamicEntries,omitempty"`
+}
+
+type IPAccessDynamicEntryStatus struct {
+	// Entry is the CIDR block added from a dynamic source
+	Entry string `json:"entry"`
+	// LastSeen is the last time a dynamic source listed the entry
+	LastSeen metav1.Time `json:"lastSeen"`
 }
 
 type IPAccessEntryStatus struct {
@@ -68,3 +79,9 @@ func RemoveIPAccessListEntryStatus(entry string) AtlasIPAccessListStatusOption {
 		}
 	}
 }
+
+func WithIPAccessListDynamicEntries(entries []IPAccessDynamicEntryStatus) AtlasIPAccessListStatusOption {
+	return func(s *AtlasIPAccessListStatus) {
+		s.DynamicEntries = entries
+	}
+}
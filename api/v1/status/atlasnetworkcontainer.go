@@ -27,6 +27,9 @@ type AtlasNetworkContainerStatus struct {
 	// Provisioned is true when clusters have been deployed to the container before
 	// the last reconciliation
 	Provisioned bool `json:"provisioned,omitempty"`
+
+	// CIDRBlock is the block allocated to the container from its AtlasCIDRPool
+	CIDRBlock string `json:"cidrBlock,omitempty"`
 }
 
 // +kubebuilder:object:generate=false
@@ -0,0 +1,33 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +k8s:deepcopy-gen=true
+
+// AtlasOrgUserStatus holds the status of an organization membership
+type AtlasOrgUserStatus struct {
+	UnifiedStatus `json:",inline"`
+
+	// ID of the user in Atlas
+	ID string `json:"id,omitempty"`
+
+	// MembershipStatus is PENDING while the invitation has not been accepted and ACTIVE afterwards
+	MembershipStatus string `json:"membershipStatus,omitempty"`
+
+	// InvitationExpiresAt is when a pending invitation expires
+	InvitationExpiresAt *metav1.Time `json:"invitationExpiresAt,omitempty"`
+}
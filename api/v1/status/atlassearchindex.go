@@ -0,0 +1,52 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+// +k8s:deepcopy-gen=true
+
+// AtlasSearchIndexStatus holds the status of a standalone search index
+type AtlasSearchIndexStatus struct {
+	UnifiedStatus `json:",inline"`
+
+	// ID of the index in Atlas
+	ID string `json:"id,omitempty"`
+
+	// ProjectID is the Atlas project of the deployment holding the index
+	ProjectID string `json:"projectID,omitempty"`
+
+	// ClusterName is the name of the deployment holding the index
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// IndexStatus is the build status of the index reported by Atlas, e.g. PENDING, BUILDING, READY or FAILED
+	IndexStatus string `json:"indexStatus,omitempty"`
+
+	// Queryable tells whether the index can be used by queries
+	Queryable bool `json:"queryable"`
+
+	// Hosts holds the build status and queryability of the index on each host of the deployment
+	Hosts []SearchIndexHostStatus `json:"hosts,omitempty"`
+}
+
+// +k8s:deepcopy-gen=true
+
+// SearchIndexHostStatus is the status of an index on a single host
+type SearchIndexHostStatus struct {
+	// Hostname of the host
+	Hostname string `json:"hostname"`
+	// Status of the index on the host
+	Status string `json:"status,omitempty"`
+	// Queryable tells whether the index can be used by queries on the host
+	Queryable bool `json:"queryable"`
+}
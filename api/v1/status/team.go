@@ -15,6 +15,8 @@
 package status
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 )
 
@@ -41,6 +43,12 @@ func AtlasTeamSetProjects(projects []TeamProject) AtlasTeamStatusOption {
 	}
 }
 
+func AtlasTeamSetMembers(members *TeamMembersStatus) AtlasTeamStatusOption {
+	return func(s *TeamStatus) {
+		s.Members = members
+	}
+}
+
 type TeamStatus struct {
 	api.Common `json:",inline"`
 
@@ -48,6 +56,23 @@ type TeamStatus struct {
 	ID string `json:"id,omitempty"`
 	// List of projects which the team is assigned
 	Projects []TeamProject `json:"projects,omitempty"`
+	// Members reports the outcome of the last synchronization of the members of the team
+	Members *TeamMembersStatus `json:"members,omitempty"`
+}
+
+type TeamMembersStatus struct {
+	// Source of the usernames, either Usernames, ConfigMap or HTTP
+	Source string `json:"source"`
+	// Count of the usernames the team should have
+	Count int `json:"count"`
+	// FetchedAt is when the usernames were last read from an HTTP source
+	FetchedAt *metav1.Time `json:"fetchedAt,omitempty"`
+	// Added lists the usernames added to the team by the last synchronization
+	Added []string `json:"added,omitempty"`
+	// Removed lists the usernames removed from the team by the last synchronization
+	Removed []string `json:"removed,omitempty"`
+	// Unresolved lists the usernames without an Atlas user, they are added once they sign up
+	Unresolved []string `json:"unresolved,omitempty"`
 }
 
 type TeamProject struct {
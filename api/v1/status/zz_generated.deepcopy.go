@@ -88,6 +88,85 @@ func (in *AlertConfiguration) DeepCopy() *AlertConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupExportBucketStatus) DeepCopyInto(out *AtlasBackupExportBucketStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupExportBucketStatus.
+func (in *AtlasBackupExportBucketStatus) DeepCopy() *AtlasBackupExportBucketStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupExportBucketStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasCIDRPoolStatus) DeepCopyInto(out *AtlasCIDRPoolStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+	if in.Allocations != nil {
+		in, out := &in.Allocations, &out.Allocations
+		*out = make([]CIDRAllocation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Retained != nil {
+		in, out := &in.Retained, &out.Retained
+		*out = make([]CIDRAllocation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCIDRPoolStatus.
+func (in *AtlasCIDRPoolStatus) DeepCopy() *AtlasCIDRPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasCIDRPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasCloudProviderAccessStatus) DeepCopyInto(out *AtlasCloudProviderAccessStatus) {
+	*out = *in
+	in.Common.DeepCopyInto(&out.Common)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCloudProviderAccessStatus.
+func (in *AtlasCloudProviderAccessStatus) DeepCopy() *AtlasCloudProviderAccessStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasCloudProviderAccessStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasCollectionStatus) DeepCopyInto(out *AtlasCollectionStatus) {
+	*out = *in
+	in.UnifiedStatus.DeepCopyInto(&out.UnifiedStatus)
+	if in.Indexes != nil {
+		in, out := &in.Indexes, &out.Indexes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCollectionStatus.
+func (in *AtlasCollectionStatus) DeepCopy() *AtlasCollectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasCollectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasCustomRoleStatus) DeepCopyInto(out *AtlasCustomRoleStatus) {
 	*out = *in
@@ -120,6 +199,30 @@ func (in *AtlasDatabaseUserStatus) DeepCopy() *AtlasDatabaseUserStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeploymentActionStatus) DeepCopyInto(out *AtlasDeploymentActionStatus) {
+	*out = *in
+	in.UnifiedStatus.DeepCopyInto(&out.UnifiedStatus)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentActionStatus.
+func (in *AtlasDeploymentActionStatus) DeepCopy() *AtlasDeploymentActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeploymentActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasDeploymentStatus) DeepCopyInto(out *AtlasDeploymentStatus) {
 	*out = *in
@@ -193,6 +296,13 @@ func (in *AtlasIPAccessListStatus) DeepCopyInto(out *AtlasIPAccessListStatus) {
 		*out = make([]IPAccessEntryStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.DynamicEntries != nil {
+		in, out := &in.DynamicEntries, &out.DynamicEntries
+		*out = make([]IPAccessDynamicEntryStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasIPAccessListStatus.
@@ -205,6 +315,22 @@ func (in *AtlasIPAccessListStatus) DeepCopy() *AtlasIPAccessListStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasIdentityProviderStatus) DeepCopyInto(out *AtlasIdentityProviderStatus) {
+	*out = *in
+	in.UnifiedStatus.DeepCopyInto(&out.UnifiedStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasIdentityProviderStatus.
+func (in *AtlasIdentityProviderStatus) DeepCopy() *AtlasIdentityProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasIdentityProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasNetworkContainerStatus) DeepCopyInto(out *AtlasNetworkContainerStatus) {
 	*out = *in
@@ -283,6 +409,26 @@ func (in *AtlasOrgSettingsStatus) DeepCopy() *AtlasOrgSettingsStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrgUserStatus) DeepCopyInto(out *AtlasOrgUserStatus) {
+	*out = *in
+	in.UnifiedStatus.DeepCopyInto(&out.UnifiedStatus)
+	if in.InvitationExpiresAt != nil {
+		in, out := &in.InvitationExpiresAt, &out.InvitationExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrgUserStatus.
+func (in *AtlasOrgUserStatus) DeepCopy() *AtlasOrgUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrgUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasPrivateEndpointStatus) DeepCopyInto(out *AtlasPrivateEndpointStatus) {
 	*out = *in
@@ -394,6 +540,27 @@ func (in *AtlasSearchIndexConfigStatus) DeepCopy() *AtlasSearchIndexConfigStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndexStatus) DeepCopyInto(out *AtlasSearchIndexStatus) {
+	*out = *in
+	in.UnifiedStatus.DeepCopyInto(&out.UnifiedStatus)
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]SearchIndexHostStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndexStatus.
+func (in *AtlasSearchIndexStatus) DeepCopy() *AtlasSearchIndexStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasSearchIndexStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasStreamConnectionStatus) DeepCopyInto(out *AtlasStreamConnectionStatus) {
 	*out = *in
@@ -530,6 +697,21 @@ func (in *BackupScheduleStatus) DeepCopy() *BackupScheduleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CIDRAllocation) DeepCopyInto(out *CIDRAllocation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CIDRAllocation.
+func (in *CIDRAllocation) DeepCopy() *CIDRAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(CIDRAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProviderIntegration) DeepCopyInto(out *CloudProviderIntegration) {
 	*out = *in
@@ -750,6 +932,22 @@ func (in *GCPPeeringStatus) DeepCopy() *GCPPeeringStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAccessDynamicEntryStatus) DeepCopyInto(out *IPAccessDynamicEntryStatus) {
+	*out = *in
+	in.LastSeen.DeepCopyInto(&out.LastSeen)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAccessDynamicEntryStatus.
+func (in *IPAccessDynamicEntryStatus) DeepCopy() *IPAccessDynamicEntryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAccessDynamicEntryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAccessEntryStatus) DeepCopyInto(out *IPAccessEntryStatus) {
 	*out = *in
@@ -951,6 +1149,21 @@ func (in *ReplicaSet) DeepCopy() *ReplicaSet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchIndexHostStatus) DeepCopyInto(out *SearchIndexHostStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchIndexHostStatus.
+func (in *SearchIndexHostStatus) DeepCopy() *SearchIndexHostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchIndexHostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerlessPrivateEndpoint) DeepCopyInto(out *ServerlessPrivateEndpoint) {
 	*out = *in
@@ -982,6 +1195,40 @@ func (in *StreamConnection) DeepCopy() *StreamConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMembersStatus) DeepCopyInto(out *TeamMembersStatus) {
+	*out = *in
+	if in.FetchedAt != nil {
+		in, out := &in.FetchedAt, &out.FetchedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Added != nil {
+		in, out := &in.Added, &out.Added
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Removed != nil {
+		in, out := &in.Removed, &out.Removed
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Unresolved != nil {
+		in, out := &in.Unresolved, &out.Unresolved
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMembersStatus.
+func (in *TeamMembersStatus) DeepCopy() *TeamMembersStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMembersStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TeamProject) DeepCopyInto(out *TeamProject) {
 	*out = *in
@@ -1006,6 +1253,11 @@ func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
 		*out = make([]TeamProject, len(*in))
 		copy(*out, *in)
 	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = new(TeamMembersStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
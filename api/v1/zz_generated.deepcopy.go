@@ -20,6 +20,7 @@ package v1
 
 import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
@@ -27,6 +28,21 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/project"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudProviderAccess) DeepCopyInto(out *AWSCloudProviderAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudProviderAccess.
+func (in *AWSCloudProviderAccess) DeepCopy() *AWSCloudProviderAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudProviderAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSNetworkPeeringConfiguration) DeepCopyInto(out *AWSNetworkPeeringConfiguration) {
 	*out = *in
@@ -397,9 +413,90 @@ func (in *AtlasBackupCompliancePolicySpec) DeepCopy() *AtlasBackupCompliancePoli
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupExportBucket) DeepCopyInto(out *AtlasBackupExportBucket) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupExportBucket.
+func (in *AtlasBackupExportBucket) DeepCopy() *AtlasBackupExportBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupExportBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasBackupExportBucket) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupExportBucketList) DeepCopyInto(out *AtlasBackupExportBucketList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasBackupExportBucket, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupExportBucketList.
+func (in *AtlasBackupExportBucketList) DeepCopy() *AtlasBackupExportBucketList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupExportBucketList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasBackupExportBucketList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasBackupExportBucketSpec) DeepCopyInto(out *AtlasBackupExportBucketSpec) {
+	*out = *in
+	in.ProjectDualReference.DeepCopyInto(&out.ProjectDualReference)
+	out.CloudProviderAccessRef = in.CloudProviderAccessRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupExportBucketSpec.
+func (in *AtlasBackupExportBucketSpec) DeepCopy() *AtlasBackupExportBucketSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasBackupExportBucketSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasBackupExportSpec) DeepCopyInto(out *AtlasBackupExportSpec) {
 	*out = *in
+	if in.ExportBucketRef != nil {
+		in, out := &in.ExportBucketRef, &out.ExportBucketRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasBackupExportSpec.
@@ -571,7 +668,7 @@ func (in *AtlasBackupScheduleSpec) DeepCopyInto(out *AtlasBackupScheduleSpec) {
 	if in.Export != nil {
 		in, out := &in.Export, &out.Export
 		*out = new(AtlasBackupExportSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	out.PolicyRef = in.PolicyRef
 	if in.CopySettings != nil {
@@ -594,7 +691,7 @@ func (in *AtlasBackupScheduleSpec) DeepCopy() *AtlasBackupScheduleSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasCustomRole) DeepCopyInto(out *AtlasCustomRole) {
+func (in *AtlasCIDRPool) DeepCopyInto(out *AtlasCIDRPool) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -602,18 +699,18 @@ func (in *AtlasCustomRole) DeepCopyInto(out *AtlasCustomRole) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCustomRole.
-func (in *AtlasCustomRole) DeepCopy() *AtlasCustomRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCIDRPool.
+func (in *AtlasCIDRPool) DeepCopy() *AtlasCIDRPool {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasCustomRole)
+	out := new(AtlasCIDRPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasCustomRole) DeepCopyObject() runtime.Object {
+func (in *AtlasCIDRPool) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -621,31 +718,31 @@ func (in *AtlasCustomRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasCustomRoleList) DeepCopyInto(out *AtlasCustomRoleList) {
+func (in *AtlasCIDRPoolList) DeepCopyInto(out *AtlasCIDRPoolList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AtlasCustomRole, len(*in))
+		*out = make([]AtlasCIDRPool, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCustomRoleList.
-func (in *AtlasCustomRoleList) DeepCopy() *AtlasCustomRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCIDRPoolList.
+func (in *AtlasCIDRPoolList) DeepCopy() *AtlasCIDRPoolList {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasCustomRoleList)
+	out := new(AtlasCIDRPoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasCustomRoleList) DeepCopyObject() runtime.Object {
+func (in *AtlasCIDRPoolList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -653,24 +750,37 @@ func (in *AtlasCustomRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasCustomRoleSpec) DeepCopyInto(out *AtlasCustomRoleSpec) {
+func (in *AtlasCIDRPoolSpec) DeepCopyInto(out *AtlasCIDRPoolSpec) {
 	*out = *in
-	in.ProjectDualReference.DeepCopyInto(&out.ProjectDualReference)
-	in.Role.DeepCopyInto(&out.Role)
+	if in.CIDRBlocks != nil {
+		in, out := &in.CIDRBlocks, &out.CIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Reserved != nil {
+		in, out := &in.Reserved, &out.Reserved
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Release != nil {
+		in, out := &in.Release, &out.Release
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCustomRoleSpec.
-func (in *AtlasCustomRoleSpec) DeepCopy() *AtlasCustomRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCIDRPoolSpec.
+func (in *AtlasCIDRPoolSpec) DeepCopy() *AtlasCIDRPoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasCustomRoleSpec)
+	out := new(AtlasCIDRPoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasDataFederation) DeepCopyInto(out *AtlasDataFederation) {
+func (in *AtlasCloudProviderAccess) DeepCopyInto(out *AtlasCloudProviderAccess) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -678,18 +788,18 @@ func (in *AtlasDataFederation) DeepCopyInto(out *AtlasDataFederation) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDataFederation.
-func (in *AtlasDataFederation) DeepCopy() *AtlasDataFederation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCloudProviderAccess.
+func (in *AtlasCloudProviderAccess) DeepCopy() *AtlasCloudProviderAccess {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasDataFederation)
+	out := new(AtlasCloudProviderAccess)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasDataFederation) DeepCopyObject() runtime.Object {
+func (in *AtlasCloudProviderAccess) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -697,31 +807,31 @@ func (in *AtlasDataFederation) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasDataFederationList) DeepCopyInto(out *AtlasDataFederationList) {
+func (in *AtlasCloudProviderAccessList) DeepCopyInto(out *AtlasCloudProviderAccessList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AtlasDataFederation, len(*in))
+		*out = make([]AtlasCloudProviderAccess, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDataFederationList.
-func (in *AtlasDataFederationList) DeepCopy() *AtlasDataFederationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCloudProviderAccessList.
+func (in *AtlasCloudProviderAccessList) DeepCopy() *AtlasCloudProviderAccessList {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasDataFederationList)
+	out := new(AtlasCloudProviderAccessList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasDataFederationList) DeepCopyObject() runtime.Object {
+func (in *AtlasCloudProviderAccessList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -729,7 +839,38 @@ func (in *AtlasDataFederationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasDatabaseUser) DeepCopyInto(out *AtlasDatabaseUser) {
+func (in *AtlasCloudProviderAccessSpec) DeepCopyInto(out *AtlasCloudProviderAccessSpec) {
+	*out = *in
+	in.ProjectDualReference.DeepCopyInto(&out.ProjectDualReference)
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSCloudProviderAccess)
+		**out = **in
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureCloudProviderAccess)
+		**out = **in
+	}
+	if in.ExportTo != nil {
+		in, out := &in.ExportTo, &out.ExportTo
+		*out = new(CloudProviderAccessExport)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCloudProviderAccessSpec.
+func (in *AtlasCloudProviderAccessSpec) DeepCopy() *AtlasCloudProviderAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasCloudProviderAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasCollection) DeepCopyInto(out *AtlasCollection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -737,18 +878,18 @@ func (in *AtlasDatabaseUser) DeepCopyInto(out *AtlasDatabaseUser) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUser.
-func (in *AtlasDatabaseUser) DeepCopy() *AtlasDatabaseUser {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCollection.
+func (in *AtlasCollection) DeepCopy() *AtlasCollection {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasDatabaseUser)
+	out := new(AtlasCollection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasDatabaseUser) DeepCopyObject() runtime.Object {
+func (in *AtlasCollection) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -756,31 +897,31 @@ func (in *AtlasDatabaseUser) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasDatabaseUserList) DeepCopyInto(out *AtlasDatabaseUserList) {
+func (in *AtlasCollectionList) DeepCopyInto(out *AtlasCollectionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AtlasDatabaseUser, len(*in))
+		*out = make([]AtlasCollection, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUserList.
-func (in *AtlasDatabaseUserList) DeepCopy() *AtlasDatabaseUserList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCollectionList.
+func (in *AtlasCollectionList) DeepCopy() *AtlasCollectionList {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasDatabaseUserList)
+	out := new(AtlasCollectionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasDatabaseUserList) DeepCopyObject() runtime.Object {
+func (in *AtlasCollectionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -788,43 +929,46 @@ func (in *AtlasDatabaseUserList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasDatabaseUserSpec) DeepCopyInto(out *AtlasDatabaseUserSpec) {
+func (in *AtlasCollectionSpec) DeepCopyInto(out *AtlasCollectionSpec) {
 	*out = *in
-	in.ProjectDualReference.DeepCopyInto(&out.ProjectDualReference)
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make([]common.LabelSpec, len(*in))
-		copy(*out, *in)
+	out.DeploymentRef = in.DeploymentRef
+	out.ConnectionSecretRef = in.ConnectionSecretRef
+	if in.Capped != nil {
+		in, out := &in.Capped, &out.Capped
+		*out = new(CappedCollection)
+		**out = **in
 	}
-	if in.Roles != nil {
-		in, out := &in.Roles, &out.Roles
-		*out = make([]RoleSpec, len(*in))
-		copy(*out, *in)
+	if in.TimeSeries != nil {
+		in, out := &in.TimeSeries, &out.TimeSeries
+		*out = new(TimeSeriesCollection)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Scopes != nil {
-		in, out := &in.Scopes, &out.Scopes
-		*out = make([]ScopeSpec, len(*in))
-		copy(*out, *in)
+	if in.Validator != nil {
+		in, out := &in.Validator, &out.Validator
+		*out = new(CollectionValidator)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.PasswordSecret != nil {
-		in, out := &in.PasswordSecret, &out.PasswordSecret
-		*out = new(common.ResourceRef)
-		**out = **in
+	if in.Indexes != nil {
+		in, out := &in.Indexes, &out.Indexes
+		*out = make([]CollectionIndex, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUserSpec.
-func (in *AtlasDatabaseUserSpec) DeepCopy() *AtlasDatabaseUserSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCollectionSpec.
+func (in *AtlasCollectionSpec) DeepCopy() *AtlasCollectionSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasDatabaseUserSpec)
+	out := new(AtlasCollectionSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasDeployment) DeepCopyInto(out *AtlasDeployment) {
+func (in *AtlasCustomRole) DeepCopyInto(out *AtlasCustomRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -832,18 +976,18 @@ func (in *AtlasDeployment) DeepCopyInto(out *AtlasDeployment) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeployment.
-func (in *AtlasDeployment) DeepCopy() *AtlasDeployment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCustomRole.
+func (in *AtlasCustomRole) DeepCopy() *AtlasCustomRole {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasDeployment)
+	out := new(AtlasCustomRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasDeployment) DeepCopyObject() runtime.Object {
+func (in *AtlasCustomRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -851,31 +995,31 @@ func (in *AtlasDeployment) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasDeploymentList) DeepCopyInto(out *AtlasDeploymentList) {
+func (in *AtlasCustomRoleList) DeepCopyInto(out *AtlasCustomRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AtlasDeployment, len(*in))
+		*out = make([]AtlasCustomRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentList.
-func (in *AtlasDeploymentList) DeepCopy() *AtlasDeploymentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCustomRoleList.
+func (in *AtlasCustomRoleList) DeepCopy() *AtlasCustomRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasDeploymentList)
+	out := new(AtlasCustomRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasDeploymentList) DeepCopyObject() runtime.Object {
+func (in *AtlasCustomRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -883,44 +1027,24 @@ func (in *AtlasDeploymentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasDeploymentSpec) DeepCopyInto(out *AtlasDeploymentSpec) {
+func (in *AtlasCustomRoleSpec) DeepCopyInto(out *AtlasCustomRoleSpec) {
 	*out = *in
 	in.ProjectDualReference.DeepCopyInto(&out.ProjectDualReference)
-	if in.DeploymentSpec != nil {
-		in, out := &in.DeploymentSpec, &out.DeploymentSpec
-		*out = new(AdvancedDeploymentSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	out.BackupScheduleRef = in.BackupScheduleRef
-	if in.ServerlessSpec != nil {
-		in, out := &in.ServerlessSpec, &out.ServerlessSpec
-		*out = new(ServerlessSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ProcessArgs != nil {
-		in, out := &in.ProcessArgs, &out.ProcessArgs
-		*out = new(ProcessArgs)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.FlexSpec != nil {
-		in, out := &in.FlexSpec, &out.FlexSpec
-		*out = new(FlexSpec)
-		(*in).DeepCopyInto(*out)
-	}
+	in.Role.DeepCopyInto(&out.Role)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentSpec.
-func (in *AtlasDeploymentSpec) DeepCopy() *AtlasDeploymentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasCustomRoleSpec.
+func (in *AtlasCustomRoleSpec) DeepCopy() *AtlasCustomRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasDeploymentSpec)
+	out := new(AtlasCustomRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasFederatedAuth) DeepCopyInto(out *AtlasFederatedAuth) {
+func (in *AtlasDataFederation) DeepCopyInto(out *AtlasDataFederation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -928,18 +1052,18 @@ func (in *AtlasFederatedAuth) DeepCopyInto(out *AtlasFederatedAuth) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasFederatedAuth.
-func (in *AtlasFederatedAuth) DeepCopy() *AtlasFederatedAuth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDataFederation.
+func (in *AtlasDataFederation) DeepCopy() *AtlasDataFederation {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasFederatedAuth)
+	out := new(AtlasDataFederation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasFederatedAuth) DeepCopyObject() runtime.Object {
+func (in *AtlasDataFederation) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -947,31 +1071,31 @@ func (in *AtlasFederatedAuth) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasFederatedAuthList) DeepCopyInto(out *AtlasFederatedAuthList) {
+func (in *AtlasDataFederationList) DeepCopyInto(out *AtlasDataFederationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]AtlasFederatedAuth, len(*in))
+		*out = make([]AtlasDataFederation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasFederatedAuthList.
-func (in *AtlasFederatedAuthList) DeepCopy() *AtlasFederatedAuthList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDataFederationList.
+func (in *AtlasDataFederationList) DeepCopy() *AtlasDataFederationList {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasFederatedAuthList)
+	out := new(AtlasDataFederationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasFederatedAuthList) DeepCopyObject() runtime.Object {
+func (in *AtlasDataFederationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -979,16 +1103,390 @@ func (in *AtlasFederatedAuthList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasFederatedAuthSpec) DeepCopyInto(out *AtlasFederatedAuthSpec) {
+func (in *AtlasDatabaseUser) DeepCopyInto(out *AtlasDatabaseUser) {
 	*out = *in
-	out.ConnectionSecretRef = in.ConnectionSecretRef
-	if in.DomainAllowList != nil {
-		in, out := &in.DomainAllowList, &out.DomainAllowList
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.DomainRestrictionEnabled != nil {
-		in, out := &in.DomainRestrictionEnabled, &out.DomainRestrictionEnabled
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUser.
+func (in *AtlasDatabaseUser) DeepCopy() *AtlasDatabaseUser {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDatabaseUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasDatabaseUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDatabaseUserList) DeepCopyInto(out *AtlasDatabaseUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasDatabaseUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUserList.
+func (in *AtlasDatabaseUserList) DeepCopy() *AtlasDatabaseUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDatabaseUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasDatabaseUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDatabaseUserSpec) DeepCopyInto(out *AtlasDatabaseUserSpec) {
+	*out = *in
+	in.ProjectDualReference.DeepCopyInto(&out.ProjectDualReference)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]common.LabelSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RoleSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]ScopeSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(common.ResourceRef)
+		**out = **in
+	}
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(common.ResourceRef)
+		**out = **in
+	}
+	if in.X509 != nil {
+		in, out := &in.X509, &out.X509
+		*out = new(X509ManagedCertificate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDatabaseUserSpec.
+func (in *AtlasDatabaseUserSpec) DeepCopy() *AtlasDatabaseUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDatabaseUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeployment) DeepCopyInto(out *AtlasDeployment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeployment.
+func (in *AtlasDeployment) DeepCopy() *AtlasDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasDeployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeploymentAction) DeepCopyInto(out *AtlasDeploymentAction) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentAction.
+func (in *AtlasDeploymentAction) DeepCopy() *AtlasDeploymentAction {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeploymentAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasDeploymentAction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeploymentActionList) DeepCopyInto(out *AtlasDeploymentActionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasDeploymentAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentActionList.
+func (in *AtlasDeploymentActionList) DeepCopy() *AtlasDeploymentActionList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeploymentActionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasDeploymentActionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeploymentActionSpec) DeepCopyInto(out *AtlasDeploymentActionSpec) {
+	*out = *in
+	if in.DeploymentRef != nil {
+		in, out := &in.DeploymentRef, &out.DeploymentRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.ExternalDeploymentRef != nil {
+		in, out := &in.ExternalDeploymentRef, &out.ExternalDeploymentRef
+		*out = new(ExternalDeploymentReference)
+		**out = **in
+	}
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(api.LocalObjectReference)
+		**out = **in
+	}
+	if in.OutageSimulation != nil {
+		in, out := &in.OutageSimulation, &out.OutageSimulation
+		*out = new(OutageSimulation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(OnDemandSnapshot)
+		**out = **in
+	}
+	if in.SnapshotExport != nil {
+		in, out := &in.SnapshotExport, &out.SnapshotExport
+		*out = new(SnapshotExport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentActionSpec.
+func (in *AtlasDeploymentActionSpec) DeepCopy() *AtlasDeploymentActionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeploymentActionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeploymentList) DeepCopyInto(out *AtlasDeploymentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasDeployment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentList.
+func (in *AtlasDeploymentList) DeepCopy() *AtlasDeploymentList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasDeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeploymentSpec) DeepCopyInto(out *AtlasDeploymentSpec) {
+	*out = *in
+	in.ProjectDualReference.DeepCopyInto(&out.ProjectDualReference)
+	if in.DeploymentSpec != nil {
+		in, out := &in.DeploymentSpec, &out.DeploymentSpec
+		*out = new(AdvancedDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	out.BackupScheduleRef = in.BackupScheduleRef
+	if in.ServerlessSpec != nil {
+		in, out := &in.ServerlessSpec, &out.ServerlessSpec
+		*out = new(ServerlessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProcessArgs != nil {
+		in, out := &in.ProcessArgs, &out.ProcessArgs
+		*out = new(ProcessArgs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlexSpec != nil {
+		in, out := &in.FlexSpec, &out.FlexSpec
+		*out = new(FlexSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FinalSnapshot != nil {
+		in, out := &in.FinalSnapshot, &out.FinalSnapshot
+		*out = new(FinalSnapshot)
+		**out = **in
+	}
+	if in.MajorVersionUpgrade != nil {
+		in, out := &in.MajorVersionUpgrade, &out.MajorVersionUpgrade
+		*out = new(MajorVersionUpgrade)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentSpec.
+func (in *AtlasDeploymentSpec) DeepCopy() *AtlasDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasFederatedAuth) DeepCopyInto(out *AtlasFederatedAuth) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasFederatedAuth.
+func (in *AtlasFederatedAuth) DeepCopy() *AtlasFederatedAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasFederatedAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasFederatedAuth) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasFederatedAuthList) DeepCopyInto(out *AtlasFederatedAuthList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasFederatedAuth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasFederatedAuthList.
+func (in *AtlasFederatedAuthList) DeepCopy() *AtlasFederatedAuthList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasFederatedAuthList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasFederatedAuthList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasFederatedAuthSpec) DeepCopyInto(out *AtlasFederatedAuthSpec) {
+	*out = *in
+	out.ConnectionSecretRef = in.ConnectionSecretRef
+	if in.DomainAllowList != nil {
+		in, out := &in.DomainAllowList, &out.DomainAllowList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DomainRestrictionEnabled != nil {
+		in, out := &in.DomainRestrictionEnabled, &out.DomainRestrictionEnabled
 		*out = new(bool)
 		**out = **in
 	}
@@ -1018,6 +1516,11 @@ func (in *AtlasFederatedAuthSpec) DeepCopyInto(out *AtlasFederatedAuthSpec) {
 			copy(*out, *in)
 		}
 	}
+	if in.WorkloadIdentityProvider != nil {
+		in, out := &in.WorkloadIdentityProvider, &out.WorkloadIdentityProvider
+		*out = new(WorkloadIdentityProvider)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasFederatedAuthSpec.
@@ -1100,6 +1603,18 @@ func (in *AtlasIPAccessListSpec) DeepCopyInto(out *AtlasIPAccessListSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DynamicSources != nil {
+		in, out := &in.DynamicSources, &out.DynamicSources
+		*out = make([]IPAccessDynamicSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasIPAccessListSpec.
@@ -1112,6 +1627,105 @@ func (in *AtlasIPAccessListSpec) DeepCopy() *AtlasIPAccessListSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasIdentityProvider) DeepCopyInto(out *AtlasIdentityProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasIdentityProvider.
+func (in *AtlasIdentityProvider) DeepCopy() *AtlasIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasIdentityProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasIdentityProviderList) DeepCopyInto(out *AtlasIdentityProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasIdentityProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasIdentityProviderList.
+func (in *AtlasIdentityProviderList) DeepCopy() *AtlasIdentityProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasIdentityProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasIdentityProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasIdentityProviderSpec) DeepCopyInto(out *AtlasIdentityProviderSpec) {
+	*out = *in
+	if in.ConnectionSecretRef != nil {
+		in, out := &in.ConnectionSecretRef, &out.ConnectionSecretRef
+		*out = new(api.LocalObjectReference)
+		**out = **in
+	}
+	if in.AssociatedDomains != nil {
+		in, out := &in.AssociatedDomains, &out.AssociatedDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConnectToOrg != nil {
+		in, out := &in.ConnectToOrg, &out.ConnectToOrg
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCIdentityProviderSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SAML != nil {
+		in, out := &in.SAML, &out.SAML
+		*out = new(SAMLIdentityProviderSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasIdentityProviderSpec.
+func (in *AtlasIdentityProviderSpec) DeepCopy() *AtlasIdentityProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasIdentityProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasNetworkContainer) DeepCopyInto(out *AtlasNetworkContainer) {
 	*out = *in
@@ -1191,6 +1805,11 @@ func (in *AtlasNetworkContainerSpec) DeepCopyInto(out *AtlasNetworkContainerSpec
 	*out = *in
 	in.ProjectDualReference.DeepCopyInto(&out.ProjectDualReference)
 	out.AtlasNetworkContainerConfig = in.AtlasNetworkContainerConfig
+	if in.CIDRPoolRef != nil {
+		in, out := &in.CIDRPoolRef, &out.CIDRPoolRef
+		*out = new(CIDRPoolReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasNetworkContainerSpec.
@@ -1371,13 +1990,132 @@ func (in *AtlasOrgSettingsList) DeepCopy() *AtlasOrgSettingsList {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasOrgSettingsList)
+	out := new(AtlasOrgSettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasOrgSettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrgSettingsSpec) DeepCopyInto(out *AtlasOrgSettingsSpec) {
+	*out = *in
+	if in.ConnectionSecretRef != nil {
+		in, out := &in.ConnectionSecretRef, &out.ConnectionSecretRef
+		*out = new(api.LocalObjectReference)
+		**out = **in
+	}
+	if in.ApiAccessListRequired != nil {
+		in, out := &in.ApiAccessListRequired, &out.ApiAccessListRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GenAIFeaturesEnabled != nil {
+		in, out := &in.GenAIFeaturesEnabled, &out.GenAIFeaturesEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxServiceAccountSecretValidityInHours != nil {
+		in, out := &in.MaxServiceAccountSecretValidityInHours, &out.MaxServiceAccountSecretValidityInHours
+		*out = new(int)
+		**out = **in
+	}
+	if in.MultiFactorAuthRequired != nil {
+		in, out := &in.MultiFactorAuthRequired, &out.MultiFactorAuthRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestrictEmployeeAccess != nil {
+		in, out := &in.RestrictEmployeeAccess, &out.RestrictEmployeeAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityContact != nil {
+		in, out := &in.SecurityContact, &out.SecurityContact
+		*out = new(string)
+		**out = **in
+	}
+	if in.StreamsCrossGroupEnabled != nil {
+		in, out := &in.StreamsCrossGroupEnabled, &out.StreamsCrossGroupEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PruneUndeclaredUsers != nil {
+		in, out := &in.PruneUndeclaredUsers, &out.PruneUndeclaredUsers
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrgSettingsSpec.
+func (in *AtlasOrgSettingsSpec) DeepCopy() *AtlasOrgSettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrgSettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrgUser) DeepCopyInto(out *AtlasOrgUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrgUser.
+func (in *AtlasOrgUser) DeepCopy() *AtlasOrgUser {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrgUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasOrgUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasOrgUserList) DeepCopyInto(out *AtlasOrgUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasOrgUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrgUserList.
+func (in *AtlasOrgUserList) DeepCopy() *AtlasOrgUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasOrgUserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *AtlasOrgSettingsList) DeepCopyObject() runtime.Object {
+func (in *AtlasOrgUserList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1385,56 +2123,33 @@ func (in *AtlasOrgSettingsList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AtlasOrgSettingsSpec) DeepCopyInto(out *AtlasOrgSettingsSpec) {
+func (in *AtlasOrgUserSpec) DeepCopyInto(out *AtlasOrgUserSpec) {
 	*out = *in
 	if in.ConnectionSecretRef != nil {
 		in, out := &in.ConnectionSecretRef, &out.ConnectionSecretRef
 		*out = new(api.LocalObjectReference)
 		**out = **in
 	}
-	if in.ApiAccessListRequired != nil {
-		in, out := &in.ApiAccessListRequired, &out.ApiAccessListRequired
-		*out = new(bool)
-		**out = **in
-	}
-	if in.GenAIFeaturesEnabled != nil {
-		in, out := &in.GenAIFeaturesEnabled, &out.GenAIFeaturesEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.MaxServiceAccountSecretValidityInHours != nil {
-		in, out := &in.MaxServiceAccountSecretValidityInHours, &out.MaxServiceAccountSecretValidityInHours
-		*out = new(int)
-		**out = **in
-	}
-	if in.MultiFactorAuthRequired != nil {
-		in, out := &in.MultiFactorAuthRequired, &out.MultiFactorAuthRequired
-		*out = new(bool)
-		**out = **in
-	}
-	if in.RestrictEmployeeAccess != nil {
-		in, out := &in.RestrictEmployeeAccess, &out.RestrictEmployeeAccess
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SecurityContact != nil {
-		in, out := &in.SecurityContact, &out.SecurityContact
-		*out = new(string)
-		**out = **in
+	if in.OrgRoles != nil {
+		in, out := &in.OrgRoles, &out.OrgRoles
+		*out = make([]OrgRole, len(*in))
+		copy(*out, *in)
 	}
-	if in.StreamsCrossGroupEnabled != nil {
-		in, out := &in.StreamsCrossGroupEnabled, &out.StreamsCrossGroupEnabled
-		*out = new(bool)
-		**out = **in
+	if in.ProjectRoles != nil {
+		in, out := &in.ProjectRoles, &out.ProjectRoles
+		*out = make([]OrgUserProjectRoles, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrgSettingsSpec.
-func (in *AtlasOrgSettingsSpec) DeepCopy() *AtlasOrgSettingsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasOrgUserSpec.
+func (in *AtlasOrgUserSpec) DeepCopy() *AtlasOrgUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(AtlasOrgSettingsSpec)
+	out := new(AtlasOrgUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1519,6 +2234,11 @@ func (in *AtlasPrivateEndpointSpec) DeepCopyInto(out *AtlasPrivateEndpointSpec)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExportTo != nil {
+		in, out := &in.ExportTo, &out.ExportTo
+		*out = new(PrivateEndpointExport)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasPrivateEndpointSpec.
@@ -1598,6 +2318,11 @@ func (in *AtlasProjectSpec) DeepCopyInto(out *AtlasProjectSpec) {
 		*out = new(common.ResourceRefNamespaced)
 		**out = **in
 	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(CredentialsReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProjectIPAccessList != nil {
 		in, out := &in.ProjectIPAccessList, &out.ProjectIPAccessList
 		*out = make([]project.IPAccessList, len(*in))
@@ -1689,6 +2414,33 @@ func (in *AtlasProjectSpec) DeepCopy() *AtlasProjectSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndex) DeepCopyInto(out *AtlasSearchIndex) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndex.
+func (in *AtlasSearchIndex) DeepCopy() *AtlasSearchIndex {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasSearchIndex)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasSearchIndex) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasSearchIndexAnalyzer) DeepCopyInto(out *AtlasSearchIndexAnalyzer) {
 	*out = *in
@@ -1815,6 +2567,69 @@ func (in *AtlasSearchIndexConfigSpec) DeepCopy() *AtlasSearchIndexConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndexList) DeepCopyInto(out *AtlasSearchIndexList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasSearchIndex, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndexList.
+func (in *AtlasSearchIndexList) DeepCopy() *AtlasSearchIndexList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasSearchIndexList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasSearchIndexList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasSearchIndexSpec) DeepCopyInto(out *AtlasSearchIndexSpec) {
+	*out = *in
+	if in.DeploymentRef != nil {
+		in, out := &in.DeploymentRef, &out.DeploymentRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.ExternalDeploymentRef != nil {
+		in, out := &in.ExternalDeploymentRef, &out.ExternalDeploymentRef
+		*out = new(ExternalDeploymentReference)
+		**out = **in
+	}
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(api.LocalObjectReference)
+		**out = **in
+	}
+	in.SearchIndex.DeepCopyInto(&out.SearchIndex)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasSearchIndexSpec.
+func (in *AtlasSearchIndexSpec) DeepCopy() *AtlasSearchIndexSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasSearchIndexSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AtlasStreamConnection) DeepCopyInto(out *AtlasStreamConnection) {
 	*out = *in
@@ -2230,6 +3045,21 @@ func (in *AwsKms) DeepCopy() *AwsKms {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureCloudProviderAccess) DeepCopyInto(out *AzureCloudProviderAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureCloudProviderAccess.
+func (in *AzureCloudProviderAccess) DeepCopy() *AzureCloudProviderAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureCloudProviderAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureKeyVault) DeepCopyInto(out *AzureKeyVault) {
 	*out = *in
@@ -2321,6 +3151,36 @@ func (in *BiConnectorSpec) DeepCopy() *BiConnectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CIDRPoolReference) DeepCopyInto(out *CIDRPoolReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CIDRPoolReference.
+func (in *CIDRPoolReference) DeepCopy() *CIDRPoolReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CIDRPoolReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CappedCollection) DeepCopyInto(out *CappedCollection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CappedCollection.
+func (in *CappedCollection) DeepCopy() *CappedCollection {
+	if in == nil {
+		return nil
+	}
+	out := new(CappedCollection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CharFilter) DeepCopyInto(out *CharFilter) {
 	*out = *in
@@ -2348,6 +3208,21 @@ func (in *CharFilter) DeepCopy() *CharFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProviderAccessExport) DeepCopyInto(out *CloudProviderAccessExport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProviderAccessExport.
+func (in *CloudProviderAccessExport) DeepCopy() *CloudProviderAccessExport {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProviderAccessExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProviderAccessRole) DeepCopyInto(out *CloudProviderAccessRole) {
 	*out = *in
@@ -2436,6 +3311,71 @@ func (in *Collection) DeepCopy() *Collection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectionIndex) DeepCopyInto(out *CollectionIndex) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]CollectionIndexKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpireAfterSeconds != nil {
+		in, out := &in.ExpireAfterSeconds, &out.ExpireAfterSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PartialFilterExpression != nil {
+		in, out := &in.PartialFilterExpression, &out.PartialFilterExpression
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionIndex.
+func (in *CollectionIndex) DeepCopy() *CollectionIndex {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectionIndex)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectionIndexKey) DeepCopyInto(out *CollectionIndexKey) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionIndexKey.
+func (in *CollectionIndexKey) DeepCopy() *CollectionIndexKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectionIndexKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectionValidator) DeepCopyInto(out *CollectionValidator) {
+	*out = *in
+	if in.JSONSchema != nil {
+		in, out := &in.JSONSchema, &out.JSONSchema
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionValidator.
+func (in *CollectionValidator) DeepCopy() *CollectionValidator {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectionValidator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComputeSpec) DeepCopyInto(out *ComputeSpec) {
 	*out = *in
@@ -2562,6 +3502,31 @@ func (in *CopySetting) DeepCopy() *CopySetting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsReference) DeepCopyInto(out *CredentialsReference) {
+	*out = *in
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileCredentialsSource)
+		**out = **in
+	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultCredentialsSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsReference.
+func (in *CredentialsReference) DeepCopy() *CredentialsReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomRole) DeepCopyInto(out *CustomRole) {
 	*out = *in
@@ -2791,22 +3756,67 @@ func (in *EndpointSpec) DeepCopy() *EndpointSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointSpec)
+	out := new(EndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDeploymentReference) DeepCopyInto(out *ExternalDeploymentReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDeploymentReference.
+func (in *ExternalDeploymentReference) DeepCopy() *ExternalDeploymentReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDeploymentReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalProjectReference) DeepCopyInto(out *ExternalProjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalProjectReference.
+func (in *ExternalProjectReference) DeepCopy() *ExternalProjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalProjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileCredentialsSource) DeepCopyInto(out *FileCredentialsSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileCredentialsSource.
+func (in *FileCredentialsSource) DeepCopy() *FileCredentialsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(FileCredentialsSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalProjectReference) DeepCopyInto(out *ExternalProjectReference) {
+func (in *FinalSnapshot) DeepCopyInto(out *FinalSnapshot) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalProjectReference.
-func (in *ExternalProjectReference) DeepCopy() *ExternalProjectReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FinalSnapshot.
+func (in *FinalSnapshot) DeepCopy() *FinalSnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(ExternalProjectReference)
+	out := new(FinalSnapshot)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2962,6 +3972,36 @@ func (in *GoogleCloudKms) DeepCopy() *GoogleCloudKms {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAccessDynamicSource) DeepCopyInto(out *IPAccessDynamicSource) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(api.LocalObjectReference)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(IPAccessListConfigMapReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAccessDynamicSource.
+func (in *IPAccessDynamicSource) DeepCopy() *IPAccessDynamicSource {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAccessDynamicSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAccessEntry) DeepCopyInto(out *IPAccessEntry) {
 	*out = *in
@@ -2981,6 +4021,36 @@ func (in *IPAccessEntry) DeepCopy() *IPAccessEntry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAccessListConfigMapReference) DeepCopyInto(out *IPAccessListConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAccessListConfigMapReference.
+func (in *IPAccessListConfigMapReference) DeepCopy() *IPAccessListConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAccessListConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MajorVersionUpgrade) DeepCopyInto(out *MajorVersionUpgrade) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MajorVersionUpgrade.
+func (in *MajorVersionUpgrade) DeepCopy() *MajorVersionUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(MajorVersionUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedNamespace) DeepCopyInto(out *ManagedNamespace) {
 	*out = *in
@@ -3154,6 +4224,41 @@ func (in *Notification) DeepCopy() *Notification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCIdentityProviderSettings) DeepCopyInto(out *OIDCIdentityProviderSettings) {
+	*out = *in
+	if in.RequestedScopes != nil {
+		in, out := &in.RequestedScopes, &out.RequestedScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCIdentityProviderSettings.
+func (in *OIDCIdentityProviderSettings) DeepCopy() *OIDCIdentityProviderSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCIdentityProviderSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnDemandSnapshot) DeepCopyInto(out *OnDemandSnapshot) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnDemandSnapshot.
+func (in *OnDemandSnapshot) DeepCopy() *OnDemandSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(OnDemandSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpsGenieIntegration) DeepCopyInto(out *OpsGenieIntegration) {
 	*out = *in
@@ -3170,6 +4275,71 @@ func (in *OpsGenieIntegration) DeepCopy() *OpsGenieIntegration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgUserProjectRoles) DeepCopyInto(out *OrgUserProjectRoles) {
+	*out = *in
+	if in.ProjectRef != nil {
+		in, out := &in.ProjectRef, &out.ProjectRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.ExternalProjectRef != nil {
+		in, out := &in.ExternalProjectRef, &out.ExternalProjectRef
+		*out = new(ExternalProjectReference)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]TeamRole, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgUserProjectRoles.
+func (in *OrgUserProjectRoles) DeepCopy() *OrgUserProjectRoles {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgUserProjectRoles)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutageSimulation) DeepCopyInto(out *OutageSimulation) {
+	*out = *in
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]OutageSimulationRegion, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutageSimulation.
+func (in *OutageSimulation) DeepCopy() *OutageSimulation {
+	if in == nil {
+		return nil
+	}
+	out := new(OutageSimulation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutageSimulationRegion) DeepCopyInto(out *OutageSimulationRegion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutageSimulationRegion.
+func (in *OutageSimulationRegion) DeepCopy() *OutageSimulationRegion {
+	if in == nil {
+		return nil
+	}
+	out := new(OutageSimulationRegion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PagerDutyIntegration) DeepCopyInto(out *PagerDutyIntegration) {
 	*out = *in
@@ -3206,6 +4376,21 @@ func (in *PrivateEndpoint) DeepCopy() *PrivateEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateEndpointExport) DeepCopyInto(out *PrivateEndpointExport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEndpointExport.
+func (in *PrivateEndpointExport) DeepCopy() *PrivateEndpointExport {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateEndpointExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateEndpointSpec) DeepCopyInto(out *PrivateEndpointSpec) {
 	*out = *in
@@ -3462,6 +4647,26 @@ func (in *RoleSpec) DeepCopy() *RoleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLIdentityProviderSettings) DeepCopyInto(out *SAMLIdentityProviderSettings) {
+	*out = *in
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLIdentityProviderSettings.
+func (in *SAMLIdentityProviderSettings) DeepCopy() *SAMLIdentityProviderSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLIdentityProviderSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScopeSpec) DeepCopyInto(out *ScopeSpec) {
 	*out = *in
@@ -3660,6 +4865,33 @@ func (in *SlackIntegration) DeepCopy() *SlackIntegration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotExport) DeepCopyInto(out *SnapshotExport) {
+	*out = *in
+	if in.ExportBucketRef != nil {
+		in, out := &in.ExportBucketRef, &out.ExportBucketRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.CustomData != nil {
+		in, out := &in.CustomData, &out.CustomData
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotExport.
+func (in *SnapshotExport) DeepCopy() *SnapshotExport {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Source) DeepCopyInto(out *Source) {
 	*out = *in
@@ -3872,6 +5104,67 @@ func (in *Team) DeepCopy() *Team {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMembersConfigMapReference) DeepCopyInto(out *TeamMembersConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMembersConfigMapReference.
+func (in *TeamMembersConfigMapReference) DeepCopy() *TeamMembersConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMembersConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMembersHTTPSource) DeepCopyInto(out *TeamMembersHTTPSource) {
+	*out = *in
+	if in.BearerTokenSecretRef != nil {
+		in, out := &in.BearerTokenSecretRef, &out.BearerTokenSecretRef
+		*out = new(api.LocalObjectReference)
+		**out = **in
+	}
+	out.RefreshInterval = in.RefreshInterval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMembersHTTPSource.
+func (in *TeamMembersHTTPSource) DeepCopy() *TeamMembersHTTPSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMembersHTTPSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMembersSource) DeepCopyInto(out *TeamMembersSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(TeamMembersConfigMapReference)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(TeamMembersHTTPSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMembersSource.
+func (in *TeamMembersSource) DeepCopy() *TeamMembersSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMembersSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
 	*out = *in
@@ -3880,6 +5173,11 @@ func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
 		*out = make([]TeamUser, len(*in))
 		copy(*out, *in)
 	}
+	if in.MembersFrom != nil {
+		in, out := &in.MembersFrom, &out.MembersFrom
+		*out = new(TeamMembersSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
@@ -3907,6 +5205,26 @@ func (in *Threshold) DeepCopy() *Threshold {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeSeriesCollection) DeepCopyInto(out *TimeSeriesCollection) {
+	*out = *in
+	if in.ExpireAfterSeconds != nil {
+		in, out := &in.ExpireAfterSeconds, &out.ExpireAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeSeriesCollection.
+func (in *TimeSeriesCollection) DeepCopy() *TimeSeriesCollection {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeSeriesCollection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenFilter) DeepCopyInto(out *TokenFilter) {
 	*out = *in
@@ -3972,6 +5290,21 @@ func (in *Tokenizer) DeepCopy() *Tokenizer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultCredentialsSource) DeepCopyInto(out *VaultCredentialsSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultCredentialsSource.
+func (in *VaultCredentialsSource) DeepCopy() *VaultCredentialsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultCredentialsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VectorSearch) DeepCopyInto(out *VectorSearch) {
 	*out = *in
@@ -4038,3 +5371,38 @@ func (in *WebhookIntegration) DeepCopy() *WebhookIntegration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentityProvider) DeepCopyInto(out *WorkloadIdentityProvider) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentityProvider.
+func (in *WorkloadIdentityProvider) DeepCopy() *WorkloadIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *X509ManagedCertificate) DeepCopyInto(out *X509ManagedCertificate) {
+	*out = *in
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new X509ManagedCertificate.
+func (in *X509ManagedCertificate) DeepCopy() *X509ManagedCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(X509ManagedCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"reflect"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	akov1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+var _ conversion.Convertible = &AtlasDeployment{}
+
+// deploymentV1Fields are the AtlasDeployment v1 fields removed in v2
+type deploymentV1Fields struct {
+	ServerlessSpec *akov1.ServerlessSpec `json:"serverlessSpec,omitempty"`
+	// NumShards holds the numShards of each v1 replication spec, when any is not 1
+	NumShards []int `json:"numShards,omitempty"`
+}
+
+// ConvertTo converts this AtlasDeployment to the v1 hub version
+func (d *AtlasDeployment) ConvertTo(hub conversion.Hub) error {
+	dst := hub.(*akov1.AtlasDeployment)
+	src := d.DeepCopy()
+
+	dst.ObjectMeta = src.ObjectMeta
+	removed := deploymentV1Fields{}
+	if err := popConversionData(&dst.ObjectMeta, &removed); err != nil {
+		return err
+	}
+	dst.Spec = akov1.AtlasDeploymentSpec{
+		ProjectDualReference: src.Spec.ProjectDualReference,
+		UpgradeToDedicated:   src.Spec.UpgradeToDedicated,
+		BackupScheduleRef:    src.Spec.BackupScheduleRef,
+		ServerlessSpec:       removed.ServerlessSpec,
+		ProcessArgs:          src.Spec.ProcessArgs,
+		FlexSpec:             src.Spec.FlexSpec,
+		FinalSnapshot:        src.Spec.FinalSnapshot,
+		MajorVersionUpgrade:  src.Spec.MajorVersionUpgrade,
+	}
+	if spec := src.Spec.DeploymentSpec; spec != nil {
+		dst.Spec.DeploymentSpec = &akov1.AdvancedDeploymentSpec{
+			BackupEnabled:                spec.BackupEnabled,
+			BiConnector:                  spec.BiConnector,
+			ClusterType:                  spec.ClusterType,
+			DiskSizeGB:                   spec.DiskSizeGB,
+			EncryptionAtRestProvider:     spec.EncryptionAtRestProvider,
+			Labels:                       spec.Labels,
+			MongoDBMajorVersion:          spec.MongoDBMajorVersion,
+			MongoDBVersion:               spec.MongoDBVersion,
+			Name:                         spec.Name,
+			Paused:                       spec.Paused,
+			PitEnabled:                   spec.PitEnabled,
+			ReplicationSpecs:             collapseShards(spec.ReplicationSpecs, removed.NumShards),
+			RootCertType:                 spec.RootCertType,
+			Tags:                         spec.Tags,
+			VersionReleaseSystem:         spec.VersionReleaseSystem,
+			CustomZoneMapping:            spec.CustomZoneMapping,
+			ManagedNamespaces:            spec.ManagedNamespaces,
+			TerminationProtectionEnabled: spec.TerminationProtectionEnabled,
+			SearchNodes:                  spec.SearchNodes,
+			SearchIndexes:                spec.SearchIndexes,
+		}
+	}
+	dst.Status = src.Status
+	return nil
+}
+
+// ConvertFrom converts the v1 hub version to this AtlasDeployment
+func (d *AtlasDeployment) ConvertFrom(hub conversion.Hub) error {
+	src := hub.(*akov1.AtlasDeployment).DeepCopy()
+
+	d.ObjectMeta = src.ObjectMeta
+	removed := deploymentV1Fields{ServerlessSpec: src.Spec.ServerlessSpec}
+	d.Spec = AtlasDeploymentSpec{
+		ProjectDualReference: src.Spec.ProjectDualReference,
+		UpgradeToDedicated:   src.Spec.UpgradeToDedicated,
+		BackupScheduleRef:    src.Spec.BackupScheduleRef,
+		ProcessArgs:          src.Spec.ProcessArgs,
+		FlexSpec:             src.Spec.FlexSpec,
+		FinalSnapshot:        src.Spec.FinalSnapshot,
+		MajorVersionUpgrade:  src.Spec.MajorVersionUpgrade,
+	}
+	if spec := src.Spec.DeploymentSpec; spec != nil {
+		var replicationSpecs []*ReplicationSpec
+		replicationSpecs, removed.NumShards = expandShards(spec.ReplicationSpecs)
+		d.Spec.DeploymentSpec = &AdvancedDeploymentSpec{
+			BackupEnabled:                spec.BackupEnabled,
+			BiConnector:                  spec.BiConnector,
+			ClusterType:                  spec.ClusterType,
+			DiskSizeGB:                   spec.DiskSizeGB,
+			EncryptionAtRestProvider:     spec.EncryptionAtRestProvider,
+			Labels:                       spec.Labels,
+			MongoDBMajorVersion:          spec.MongoDBMajorVersion,
+			MongoDBVersion:               spec.MongoDBVersion,
+			Name:                         spec.Name,
+			Paused:                       spec.Paused,
+			PitEnabled:                   spec.PitEnabled,
+			ReplicationSpecs:             replicationSpecs,
+			RootCertType:                 spec.RootCertType,
+			Tags:                         spec.Tags,
+			VersionReleaseSystem:         spec.VersionReleaseSystem,
+			CustomZoneMapping:            spec.CustomZoneMapping,
+			ManagedNamespaces:            spec.ManagedNamespaces,
+			TerminationProtectionEnabled: spec.TerminationProtectionEnabled,
+			SearchNodes:                  spec.SearchNodes,
+			SearchIndexes:                spec.SearchIndexes,
+		}
+	}
+	if err := setConversionData(&d.ObjectMeta, removed, reflect.ValueOf(removed).IsZero()); err != nil {
+		return err
+	}
+	d.Status = src.Status
+	return nil
+}
+
+// expandShards lists one replication spec per shard, along with the original
+// numShards values when they cannot be inferred back
+func expandShards(specs []*akov1.AdvancedReplicationSpec) ([]*ReplicationSpec, []int) {
+	if specs == nil {
+		return nil, nil
+	}
+	expanded := make([]*ReplicationSpec, 0, len(specs))
+	numShards := make([]int, 0, len(specs))
+	keepNumShards := false
+	for _, spec := range specs {
+		if spec == nil {
+			expanded = append(expanded, nil)
+			numShards = append(numShards, 1)
+			continue
+		}
+		numShards = append(numShards, spec.NumShards)
+		keepNumShards = keepNumShards || spec.NumShards != 1
+		for range max(spec.NumShards, 1) {
+			expanded = append(expanded, &ReplicationSpec{
+				ZoneName:      spec.ZoneName,
+				RegionConfigs: deepCopyRegionConfigs(spec.RegionConfigs),
+			})
+		}
+	}
+	if !keepNumShards {
+		return expanded, nil
+	}
+	return expanded, numShards
+}
+
+// collapseShards restores the v1 numShards layout when the replication specs still match it,
+// otherwise each replication spec becomes a single shard
+func collapseShards(specs []*ReplicationSpec, numShards []int) []*akov1.AdvancedReplicationSpec {
+	if specs == nil {
+		return nil
+	}
+	if !matchesShards(specs, numShards) {
+		numShards = make([]int, len(specs))
+		for i := range numShards {
+			numShards[i] = 1
+		}
+	}
+	collapsed := make([]*akov1.AdvancedReplicationSpec, 0, len(numShards))
+	next := 0
+	for _, n := range numShards {
+		spec := specs[next]
+		next += max(n, 1)
+		if spec == nil {
+			collapsed = append(collapsed, nil)
+			continue
+		}
+		collapsed = append(collapsed, &akov1.AdvancedReplicationSpec{
+			NumShards:     n,
+			ZoneName:      spec.ZoneName,
+			RegionConfigs: spec.RegionConfigs,
+		})
+	}
+	return collapsed
+}
+
+func matchesShards(specs []*ReplicationSpec, numShards []int) bool {
+	if len(numShards) == 0 {
+		return false
+	}
+	next := 0
+	for _, n := range numShards {
+		count := max(n, 1)
+		if next+count > len(specs) {
+			return false
+		}
+		for _, spec := range specs[next+1 : next+count] {
+			if !reflect.DeepEqual(spec, specs[next]) {
+				return false
+			}
+		}
+		next += count
+	}
+	return next == len(specs)
+}
+
+func deepCopyRegionConfigs(configs []*akov1.AdvancedRegionConfig) []*akov1.AdvancedRegionConfig {
+	if configs == nil {
+		return nil
+	}
+	copied := make([]*akov1.AdvancedRegionConfig, len(configs))
+	for i, config := range configs {
+		copied[i] = config.DeepCopy()
+	}
+	return copied
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	akov1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasDeployment{}, &AtlasDeploymentList{})
+}
+
+// AtlasDeploymentSpec defines the desired state of AtlasDeployment
+// Only one of DeploymentSpec and FlexSpec should be defined.
+// Unlike v1, serverless instances are not supported.
+// +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && !has(self.projectRef)) || (!has(self.externalProjectRef) && has(self.projectRef))",message="must define only one project reference through externalProjectRef or projectRef"
+// +kubebuilder:validation:XValidation:rule="(has(self.externalProjectRef) && has(self.connectionSecret)) || !has(self.externalProjectRef)",message="must define a local connection secret when referencing an external project"
+// +kubebuilder:validation:XValidation:rule="!has(self.finalSnapshot) || (has(self.deploymentSpec) && has(self.deploymentSpec.backupEnabled) && self.deploymentSpec.backupEnabled)",message="finalSnapshot requires a dedicated deployment with backups enabled"
+type AtlasDeploymentSpec struct {
+	// ProjectReference is the dual external or kubernetes reference with access credentials
+	akov1.ProjectDualReference `json:",inline"`
+
+	//  upgradeToDedicated, when set to true, triggers the migration from a Flex to a
+	//  Dedicated cluster. The user MUST provide the new dedicated cluster configuration.
+	//  This flag is ignored if the cluster is already dedicated.
+	// +optional
+	UpgradeToDedicated bool `json:"upgradeToDedicated,omitempty"`
+
+	// Configuration for the advanced deployment API https://www.mongodb.com/docs/atlas/reference/api/clusters/
+	// +optional
+	DeploymentSpec *AdvancedDeploymentSpec `json:"deploymentSpec,omitempty"`
+
+	// Backup schedule for the AtlasDeployment
+	// +optional
+	BackupScheduleRef common.ResourceRefNamespaced `json:"backupRef"`
+
+	// ProcessArgs allows to modify Advanced Configuration Options
+	// +optional
+	ProcessArgs *akov1.ProcessArgs `json:"processArgs,omitempty"`
+
+	// Configuration for the Flex cluster API. https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Flex-Clusters
+	// +optional
+	FlexSpec *akov1.FlexSpec `json:"flexSpec,omitempty"`
+
+	// FinalSnapshot takes an on-demand snapshot of the deployment before removing it from Atlas,
+	// so that an accidental deletion can be recovered
+	// +optional
+	FinalSnapshot *akov1.FinalSnapshot `json:"finalSnapshot,omitempty"`
+
+	// MajorVersionUpgrade configures how changes to the MongoDB major version are rolled out
+	// +optional
+	MajorVersionUpgrade *akov1.MajorVersionUpgrade `json:"majorVersionUpgrade,omitempty"`
+}
+
+type AdvancedDeploymentSpec struct {
+	// Applicable only for M10+ deployments.
+	// Flag that indicates if the deployment uses Cloud Backups for backups.
+	// +optional
+	BackupEnabled *bool `json:"backupEnabled,omitempty"`
+	// Configuration of BI Connector for Atlas on this deployment.
+	// The MongoDB Connector for Business Intelligence for Atlas (BI Connector) is only available for M10 and larger deployments.
+	// +optional
+	BiConnector *akov1.BiConnectorSpec `json:"biConnector,omitempty"`
+	// Type of the deployment that you want to create.
+	// The parameter is required if replicationSpecs are set or if Global Deployments are deployed.
+	// +kubebuilder:validation:Enum=REPLICASET;SHARDED;GEOSHARDED
+	// +optional
+	ClusterType string `json:"clusterType,omitempty"`
+	// Capacity, in gigabytes, of the host's root volume.
+	// Increase this number to add capacity, up to a maximum possible value of 4096 (i.e., 4 TB).
+	// This value must be a positive integer.
+	// The parameter is required if replicationSpecs are configured.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4096
+	// +optional
+	DiskSizeGB *int `json:"diskSizeGB,omitempty"`
+	// Cloud service provider that offers Encryption at Rest.
+	// +kubebuilder:validation:Enum=AWS;GCP;AZURE;NONE
+	// +optional
+	EncryptionAtRestProvider string `json:"encryptionAtRestProvider,omitempty"`
+	// Collection of key-value pairs that tag and categorize the deployment.
+	// Each key and value has a maximum length of 255 characters.
+	// +optional
+	Labels []common.LabelSpec `json:"labels,omitempty"`
+	// Version of the deployment to deploy.
+	MongoDBMajorVersion string `json:"mongoDBMajorVersion,omitempty"`
+	MongoDBVersion      string `json:"mongoDBVersion,omitempty"`
+	// Name of the advanced deployment as it appears in Atlas.
+	// After Atlas creates the deployment, you can't change its name.
+	// Can only contain ASCII letters, numbers, and hyphens.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=^[a-zA-Z0-9][a-zA-Z0-9-]*$
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Name cannot be modified after deployment creation"
+	Name string `json:"name,omitempty"`
+	// Flag that indicates whether the deployment should be paused.
+	Paused *bool `json:"paused,omitempty"`
+	// Flag that indicates the deployment uses continuous cloud backups.
+	// +optional
+	PitEnabled *bool `json:"pitEnabled,omitempty"`
+	// Configuration for deployment regions, one entry per shard.
+	// +optional
+	ReplicationSpecs []*ReplicationSpec `json:"replicationSpecs,omitempty"`
+	RootCertType     string             `json:"rootCertType,omitempty"`
+	// Key-value pairs for resource tagging.
+	// +kubebuilder:validation:MaxItems=50
+	// +optional
+	Tags                 []*akov1.TagSpec `json:"tags,omitempty"`
+	VersionReleaseSystem string           `json:"versionReleaseSystem,omitempty"`
+	// +optional
+	CustomZoneMapping []akov1.CustomZoneMapping `json:"customZoneMapping,omitempty"`
+	// +optional
+	ManagedNamespaces []akov1.ManagedNamespace `json:"managedNamespaces,omitempty"`
+	// Flag that indicates whether termination protection is enabled on the cluster. If set to true, MongoDB Cloud won't delete the cluster. If set to false, MongoDB Cloud will delete the cluster.
+	// +kubebuilder:default:=false
+	TerminationProtectionEnabled bool `json:"terminationProtectionEnabled,omitempty"`
+	// Settings for Search Nodes for the cluster. Currently, at most one search node configuration may be defined.
+	// +kubebuilder:validation:MaxItems=1
+	// +optional
+	SearchNodes []akov1.SearchNode `json:"searchNodes,omitempty"`
+	// A list of atlas search indexes configuration for the current deployment
+	// +optional
+	SearchIndexes []akov1.SearchIndex `json:"searchIndexes,omitempty"`
+}
+
+// ReplicationSpec describes a single shard, or the replica set, of a deployment.
+// It replaces the v1 numShards field: a deployment with several shards in a zone lists one replication spec per shard.
+type ReplicationSpec struct {
+	// Human-readable label that identifies the zone in a Global Cluster.
+	ZoneName string `json:"zoneName,omitempty"`
+	// Hardware specifications for nodes set for a given region.
+	// Each regionConfigs object describes the region's priority in elections and the number and type of MongoDB nodes that MongoDB Cloud deploys to the region.
+	// Each regionConfigs object must have either an analyticsSpecs object, electableSpecs object, or readOnlySpecs object.
+	// Tenant clusters only require electableSpecs. Dedicated clusters can specify any of these specifications, but must have at least one electableSpecs object within a replicationSpec.
+	// Every hardware specification must use the same instanceSize.
+	RegionConfigs []*akov1.AdvancedRegionConfig `json:"regionConfigs,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:unservedversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Atlas State",type=string,JSONPath=`.status.stateName`
+// +kubebuilder:printcolumn:name="MongoDB Version",type=string,JSONPath=`.status.mongoDBVersion`
+// +kubebuilder:resource:categories=atlas,shortName=ad
+
+// AtlasDeployment is the Schema for the atlasdeployments API
+type AtlasDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasDeploymentSpec          `json:"spec,omitempty"`
+	Status status.AtlasDeploymentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AtlasDeploymentList contains a list of AtlasDeployment
+type AtlasDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasDeployment `json:"items"`
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"reflect"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	akov1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/project"
+)
+
+var _ conversion.Convertible = &AtlasProject{}
+
+// projectV1Fields are the AtlasProject v1 fields removed in v2
+type projectV1Fields struct {
+	ProjectIPAccessList       []project.IPAccessList           `json:"projectIpAccessList,omitempty"`
+	PrivateEndpoints          []akov1.PrivateEndpoint          `json:"privateEndpoints,omitempty"`
+	CloudProviderAccessRoles  []akov1.CloudProviderAccessRole  `json:"cloudProviderAccessRoles,omitempty"`
+	CloudProviderIntegrations []akov1.CloudProviderIntegration `json:"cloudProviderIntegrations,omitempty"`
+	NetworkPeers              []akov1.NetworkPeer              `json:"networkPeers,omitempty"`
+	Integrations              []project.Integration            `json:"integrations,omitempty"`
+	CustomRoles               []akov1.CustomRole               `json:"customRoles,omitempty"`
+}
+
+// ConvertTo converts this AtlasProject to the v1 hub version
+func (p *AtlasProject) ConvertTo(hub conversion.Hub) error {
+	dst := hub.(*akov1.AtlasProject)
+	src := p.DeepCopy()
+
+	dst.ObjectMeta = src.ObjectMeta
+	removed := projectV1Fields{}
+	if err := popConversionData(&dst.ObjectMeta, &removed); err != nil {
+		return err
+	}
+	dst.Spec = akov1.AtlasProjectSpec{
+		Name:                          src.Spec.Name,
+		RegionUsageRestrictions:       src.Spec.RegionUsageRestrictions,
+		ConnectionSecret:              src.Spec.ConnectionSecret,
+		CredentialsRef:                src.Spec.CredentialsRef,
+		ProjectIPAccessList:           removed.ProjectIPAccessList,
+		MaintenanceWindow:             src.Spec.MaintenanceWindow,
+		PrivateEndpoints:              removed.PrivateEndpoints,
+		CloudProviderAccessRoles:      removed.CloudProviderAccessRoles,
+		CloudProviderIntegrations:     removed.CloudProviderIntegrations,
+		AlertConfigurations:           src.Spec.AlertConfigurations,
+		AlertConfigurationSyncEnabled: src.Spec.AlertConfigurationSyncEnabled,
+		NetworkPeers:                  removed.NetworkPeers,
+		WithDefaultAlertsSettings:     src.Spec.WithDefaultAlertsSettings,
+		X509CertRef:                   src.Spec.X509CertRef,
+		Integrations:                  removed.Integrations,
+		EncryptionAtRest:              src.Spec.EncryptionAtRest,
+		Auditing:                      src.Spec.Auditing,
+		Settings:                      src.Spec.Settings,
+		CustomRoles:                   removed.CustomRoles,
+		Teams:                         src.Spec.Teams,
+		BackupCompliancePolicyRef:     src.Spec.BackupCompliancePolicyRef,
+	}
+	dst.Status = src.Status
+	return nil
+}
+
+// ConvertFrom converts the v1 hub version to this AtlasProject
+func (p *AtlasProject) ConvertFrom(hub conversion.Hub) error {
+	src := hub.(*akov1.AtlasProject).DeepCopy()
+
+	p.ObjectMeta = src.ObjectMeta
+	removed := projectV1Fields{
+		ProjectIPAccessList:       src.Spec.ProjectIPAccessList,
+		PrivateEndpoints:          src.Spec.PrivateEndpoints,
+		CloudProviderAccessRoles:  src.Spec.CloudProviderAccessRoles,
+		CloudProviderIntegrations: src.Spec.CloudProviderIntegrations,
+		NetworkPeers:              src.Spec.NetworkPeers,
+		Integrations:              src.Spec.Integrations,
+		CustomRoles:               src.Spec.CustomRoles,
+	}
+	if err := setConversionData(&p.ObjectMeta, removed, reflect.ValueOf(removed).IsZero()); err != nil {
+		return err
+	}
+	p.Spec = AtlasProjectSpec{
+		Name:                          src.Spec.Name,
+		RegionUsageRestrictions:       src.Spec.RegionUsageRestrictions,
+		ConnectionSecret:              src.Spec.ConnectionSecret,
+		CredentialsRef:                src.Spec.CredentialsRef,
+		MaintenanceWindow:             src.Spec.MaintenanceWindow,
+		AlertConfigurations:           src.Spec.AlertConfigurations,
+		AlertConfigurationSyncEnabled: src.Spec.AlertConfigurationSyncEnabled,
+		WithDefaultAlertsSettings:     src.Spec.WithDefaultAlertsSettings,
+		X509CertRef:                   src.Spec.X509CertRef,
+		EncryptionAtRest:              src.Spec.EncryptionAtRest,
+		Auditing:                      src.Spec.Auditing,
+		Settings:                      src.Spec.Settings,
+		Teams:                         src.Spec.Teams,
+		BackupCompliancePolicyRef:     src.Spec.BackupCompliancePolicyRef,
+	}
+	p.Status = src.Status
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	akov1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/project"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func init() {
+	SchemeBuilder.Register(&AtlasProject{}, &AtlasProjectList{})
+}
+
+// AtlasProjectSpec defines the desired state of Project in Atlas.
+// Unlike v1, it does not embed the IP access list, private endpoints, network peers, cloud provider integrations,
+// custom roles and third party integrations of the project, they are managed by their own custom resources.
+// +kubebuilder:validation:XValidation:rule="!(has(self.connectionSecretRef) && has(self.credentialsRef))",message="connectionSecretRef and credentialsRef are mutually exclusive"
+type AtlasProjectSpec struct {
+	// Name is the name of the Project that is created in Atlas by the Operator if it doesn't exist yet.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Name cannot be modified after project creation"
+	Name string `json:"name"`
+
+	// RegionUsageRestrictions designate the project's AWS region when using Atlas for Government.
+	// This parameter should not be used with commercial Atlas.
+	// In Atlas for Government, not setting this field (defaulting to NONE) means the project is restricted to COMMERCIAL_FEDRAMP_REGIONS_ONLY
+	// +kubebuilder:validation:Enum=NONE;GOV_REGIONS_ONLY;COMMERCIAL_FEDRAMP_REGIONS_ONLY
+	// +kubebuilder:default:=NONE
+	// +optional
+	RegionUsageRestrictions string `json:"regionUsageRestrictions,omitempty"`
+
+	// ConnectionSecret is the name of the Kubernetes Secret which contains the information about the way to connect to
+	// Atlas (organization ID, API keys). The default Operator connection configuration will be used if not provided.
+	// +optional
+	ConnectionSecret *common.ResourceRefNamespaced `json:"connectionSecretRef,omitempty"`
+
+	// CredentialsRef reads the Atlas API credentials of the project from a source other than a Kubernetes Secret,
+	// such as files mounted in the Operator or HashiCorp Vault. It cannot be set together with the ConnectionSecret.
+	// +optional
+	CredentialsRef *akov1.CredentialsReference `json:"credentialsRef,omitempty"`
+
+	// MaintenanceWindow allows to specify a preferred time in the week to run maintenance operations. See more
+	// information at https://www.mongodb.com/docs/atlas/reference/api/maintenance-windows/
+	// +optional
+	MaintenanceWindow project.MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// AlertConfiguration is a list of Alert Configurations configured for the current Project.
+	// +optional
+	AlertConfigurations []akov1.AlertConfiguration `json:"alertConfigurations,omitempty"`
+
+	// AlertConfigurationSyncEnabled is a flag that enables/disables Alert Configurations sync for the current Project.
+	// If true - project alert configurations will be synced according to AlertConfigurations.
+	// If not - alert configurations will not be modified by the operator. They can be managed through API, cli, UI.
+	// +optional
+	AlertConfigurationSyncEnabled bool `json:"alertConfigurationSyncEnabled,omitempty"`
+
+	// Flag that indicates whether to create the new project with the default alert settings enabled. This parameter defaults to true
+	// +kubebuilder:default:=true
+	// +optional
+	WithDefaultAlertsSettings bool `json:"withDefaultAlertsSettings,omitempty"`
+
+	// X509CertRef is the name of the Kubernetes Secret which contains PEM-encoded CA certificate
+	// +optional
+	X509CertRef *common.ResourceRefNamespaced `json:"x509CertRef,omitempty"`
+
+	// EncryptionAtRest allows to set encryption for AWS, Azure and GCP providers
+	// +optional
+	EncryptionAtRest *akov1.EncryptionAtRest `json:"encryptionAtRest,omitempty"`
+
+	// Auditing represents MongoDB Maintenance Windows
+	// +optional
+	Auditing *akov1.Auditing `json:"auditing,omitempty"`
+
+	// Settings allow to set Project Settings for the project
+	// +optional
+	Settings *akov1.ProjectSettings `json:"settings,omitempty"`
+
+	// Teams enable you to grant project access roles to multiple users.
+	// +optional
+	Teams []akov1.Team `json:"teams,omitempty"`
+
+	// BackupCompliancePolicyRef is a reference to the backup compliance CR.
+	// +optional
+	BackupCompliancePolicyRef *common.ResourceRefNamespaced `json:"backupCompliancePolicyRef,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Atlas Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Atlas ID",type=string,JSONPath=`.status.id`
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=atlas,shortName=ap
+
+// AtlasProject is the Schema for the atlasprojects API
+type AtlasProject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AtlasProjectSpec          `json:"spec,omitempty"`
+	Status status.AtlasProjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AtlasProjectList contains a list of AtlasProject
+type AtlasProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AtlasProject `json:"items"`
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConversionDataAnnotation keeps the v1 fields v2 does not have, so that
+// converting a v1 object to v2 and back does not lose them
+const ConversionDataAnnotation = "atlas.mongodb.com/v1-conversion-data"
+
+func setConversionData(meta *metav1.ObjectMeta, data any, empty bool) error {
+	if empty {
+		delete(meta.Annotations, ConversionDataAnnotation)
+		if len(meta.Annotations) == 0 {
+			meta.Annotations = nil
+		}
+		return nil
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode conversion data: %w", err)
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = map[string]string{}
+	}
+	meta.Annotations[ConversionDataAnnotation] = string(encoded)
+	return nil
+}
+
+// popConversionData decodes and removes the conversion data of a converted object
+func popConversionData(meta *metav1.ObjectMeta, into any) error {
+	encoded, ok := meta.Annotations[ConversionDataAnnotation]
+	if !ok {
+		return nil
+	}
+	delete(meta.Annotations, ConversionDataAnnotation)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+	if err := json.Unmarshal([]byte(encoded), into); err != nil {
+		return fmt.Errorf("invalid %s annotation: %w", ConversionDataAnnotation, err)
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	gofuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	akov1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/project"
+	internalcmp "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/cmp"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+const fuzzIterations = 100
+
+func newFuzzer(data []byte) *gofuzz.Fuzzer {
+	return gofuzz.NewFromGoFuzz(data).
+		NilChance(0.2).
+		NumElements(1, 3).
+		Funcs(func(spec *akov1.AdvancedReplicationSpec, c gofuzz.Continue) {
+			c.FuzzNoCustom(spec)
+			spec.NumShards = c.Intn(4)
+		}, func(j *apiextensionsv1.JSON, c gofuzz.Continue) {
+			j.Raw = []byte(strconv.Quote(c.RandString()))
+		})
+}
+
+func FuzzProjectRoundTrip(f *testing.F) {
+	for i := uint(0); i < fuzzIterations; i++ {
+		f.Add(([]byte)(fmt.Sprintf("seed sample %x", i)), i)
+	}
+	f.Fuzz(func(t *testing.T, data []byte, index uint) {
+		original := &akov1.AtlasProject{}
+		newFuzzer(data).Fuzz(&original.ObjectMeta)
+		newFuzzer(data).Fuzz(&original.Spec)
+
+		converted := &AtlasProject{}
+		require.NoError(t, converted.ConvertFrom(original))
+		roundTrip := &akov1.AtlasProject{}
+		require.NoError(t, converted.ConvertTo(roundTrip))
+
+		require.NoError(t, internalcmp.Normalize(original))
+		require.NoError(t, internalcmp.Normalize(roundTrip))
+		assert.Equal(t, original, roundTrip, "failed round trip for index=%d", index)
+	})
+}
+
+func FuzzDeploymentRoundTrip(f *testing.F) {
+	for i := uint(0); i < fuzzIterations; i++ {
+		f.Add(([]byte)(fmt.Sprintf("seed sample %x", i)), i)
+	}
+	f.Fuzz(func(t *testing.T, data []byte, index uint) {
+		original := &akov1.AtlasDeployment{}
+		newFuzzer(data).Fuzz(&original.ObjectMeta)
+		newFuzzer(data).Fuzz(&original.Spec)
+
+		converted := &AtlasDeployment{}
+		require.NoError(t, converted.ConvertFrom(original))
+		roundTrip := &akov1.AtlasDeployment{}
+		require.NoError(t, converted.ConvertTo(roundTrip))
+
+		require.NoError(t, internalcmp.Normalize(original))
+		require.NoError(t, internalcmp.Normalize(roundTrip))
+		assert.Equal(t, original, roundTrip, "failed round trip for index=%d", index)
+	})
+}
+
+func TestProjectConversion(t *testing.T) {
+	v1Project := &akov1.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-project",
+			Namespace:   "default",
+			Annotations: map[string]string{"keep": "me"},
+		},
+		Spec: akov1.AtlasProjectSpec{
+			Name:                "Test Project",
+			ConnectionSecret:    &common.ResourceRefNamespaced{Name: "my-secret"},
+			ProjectIPAccessList: []project.IPAccessList{{CIDRBlock: "10.0.0.0/8"}},
+			CustomRoles:         []akov1.CustomRole{{Name: "role"}},
+		},
+	}
+
+	v2Project := &AtlasProject{}
+	require.NoError(t, v2Project.ConvertFrom(v1Project))
+	assert.Equal(t, "Test Project", v2Project.Spec.Name)
+	assert.Equal(t, "me", v2Project.Annotations["keep"])
+	assert.JSONEq(t,
+		`{"projectIpAccessList":[{"cidrBlock":"10.0.0.0/8"}],"customRoles":[{"name":"role"}]}`,
+		v2Project.Annotations[ConversionDataAnnotation],
+	)
+
+	back := &akov1.AtlasProject{}
+	require.NoError(t, v2Project.ConvertTo(back))
+	assert.Equal(t, v1Project, back)
+
+	v1Project.Spec.ProjectIPAccessList = nil
+	v1Project.Spec.CustomRoles = nil
+	require.NoError(t, v2Project.ConvertFrom(v1Project))
+	assert.Equal(t, map[string]string{"keep": "me"}, v2Project.Annotations)
+}
+
+func TestProjectConversionInvalidAnnotation(t *testing.T) {
+	v2Project := &AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{ConversionDataAnnotation: "{"},
+		},
+	}
+	assert.ErrorContains(t, v2Project.ConvertTo(&akov1.AtlasProject{}), "invalid "+ConversionDataAnnotation+" annotation")
+}
+
+func TestDeploymentConversion(t *testing.T) {
+	zone := func(name string, numShards int) *akov1.AdvancedReplicationSpec {
+		return &akov1.AdvancedReplicationSpec{
+			NumShards: numShards,
+			ZoneName:  name,
+			RegionConfigs: []*akov1.AdvancedRegionConfig{
+				{ProviderName: "AWS", RegionName: "US_EAST_1", Priority: pointer.MakePtr(7)},
+			},
+		}
+	}
+	shard := func(name string) *ReplicationSpec {
+		return &ReplicationSpec{
+			ZoneName: name,
+			RegionConfigs: []*akov1.AdvancedRegionConfig{
+				{ProviderName: "AWS", RegionName: "US_EAST_1", Priority: pointer.MakePtr(7)},
+			},
+		}
+	}
+	v1Deployment := func(specs ...*akov1.AdvancedReplicationSpec) *akov1.AtlasDeployment {
+		return &akov1.AtlasDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-deployment"},
+			Spec: akov1.AtlasDeploymentSpec{
+				ProjectDualReference: akov1.ProjectDualReference{
+					ProjectRef: &common.ResourceRefNamespaced{Name: "my-project"},
+				},
+				DeploymentSpec: &akov1.AdvancedDeploymentSpec{
+					Name:             "cluster0",
+					ClusterType:      "GEOSHARDED",
+					ReplicationSpecs: specs,
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		title          string
+		v1             *akov1.AtlasDeployment
+		edit           func(d *AtlasDeployment)
+		wantShards     []*ReplicationSpec
+		wantAnnotation string
+		wantV1         *akov1.AtlasDeployment
+	}{
+		{
+			title:      "single shard zones need no annotation",
+			v1:         v1Deployment(zone("a", 1), zone("b", 1)),
+			wantShards: []*ReplicationSpec{shard("a"), shard("b")},
+			wantV1:     v1Deployment(zone("a", 1), zone("b", 1)),
+		},
+		{
+			title:          "zones are expanded to one spec per shard",
+			v1:             v1Deployment(zone("a", 2), zone("b", 1)),
+			wantShards:     []*ReplicationSpec{shard("a"), shard("a"), shard("b")},
+			wantAnnotation: `{"numShards":[2,1]}`,
+			wantV1:         v1Deployment(zone("a", 2), zone("b", 1)),
+		},
+		{
+			title:          "unset numShards is kept",
+			v1:             v1Deployment(zone("a", 0)),
+			wantShards:     []*ReplicationSpec{shard("a")},
+			wantAnnotation: `{"numShards":[0]}`,
+			wantV1:         v1Deployment(zone("a", 0)),
+		},
+		{
+			title: "edited shards fall back to one shard per spec",
+			v1:    v1Deployment(zone("a", 2)),
+			edit: func(d *AtlasDeployment) {
+				d.Spec.DeploymentSpec.ReplicationSpecs[1].ZoneName = "b"
+			},
+			wantShards:     []*ReplicationSpec{shard("a"), shard("b")},
+			wantAnnotation: `{"numShards":[2]}`,
+			wantV1:         v1Deployment(zone("a", 1), zone("b", 1)),
+		},
+		{
+			title: "serverless spec is kept",
+			v1: func() *akov1.AtlasDeployment {
+				d := v1Deployment()
+				d.Spec.DeploymentSpec = nil
+				d.Spec.ServerlessSpec = &akov1.ServerlessSpec{Name: "serverless"}
+				return d
+			}(),
+			wantAnnotation: `{"serverlessSpec":{"name":"serverless","providerSettings":null,"backupOptions":{}}}`,
+			wantV1: func() *akov1.AtlasDeployment {
+				d := v1Deployment()
+				d.Spec.DeploymentSpec = nil
+				d.Spec.ServerlessSpec = &akov1.ServerlessSpec{Name: "serverless"}
+				return d
+			}(),
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			v2Deployment := &AtlasDeployment{}
+			require.NoError(t, v2Deployment.ConvertFrom(tc.v1))
+			if tc.edit != nil {
+				tc.edit(v2Deployment)
+			}
+			if tc.wantShards != nil {
+				assert.Equal(t, tc.wantShards, v2Deployment.Spec.DeploymentSpec.ReplicationSpecs)
+			}
+			if tc.wantAnnotation == "" {
+				assert.NotContains(t, v2Deployment.Annotations, ConversionDataAnnotation)
+			} else {
+				assert.JSONEq(t, tc.wantAnnotation, v2Deployment.Annotations[ConversionDataAnnotation])
+			}
+
+			v1Deployment := &akov1.AtlasDeployment{}
+			require.NoError(t, v2Deployment.ConvertTo(v1Deployment))
+			assert.Equal(t, tc.wantV1, v1Deployment)
+		})
+	}
+}
+
+func TestDeploymentV2RoundTrip(t *testing.T) {
+	for i := uint(0); i < fuzzIterations; i++ {
+		original := &AtlasDeployment{}
+		newFuzzer([]byte(fmt.Sprintf("seed sample %x", i))).Fuzz(&original.Spec)
+		original.Name = "my-deployment"
+
+		hub := &akov1.AtlasDeployment{}
+		require.NoError(t, original.ConvertTo(hub))
+		roundTrip := &AtlasDeployment{}
+		require.NoError(t, roundTrip.ConvertFrom(hub))
+
+		require.NoError(t, internalcmp.Normalize(original))
+		require.NoError(t, internalcmp.Normalize(roundTrip))
+		assert.Equal(t, original, roundTrip, "failed round trip for index=%d", i)
+	}
+}
@@ -0,0 +1,368 @@
+//go:build !ignore_autogenerated
+
+//Copyright 2025 MongoDB Inc
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvancedDeploymentSpec) DeepCopyInto(out *AdvancedDeploymentSpec) {
+	*out = *in
+	if in.BackupEnabled != nil {
+		in, out := &in.BackupEnabled, &out.BackupEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BiConnector != nil {
+		in, out := &in.BiConnector, &out.BiConnector
+		*out = new(v1.BiConnectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DiskSizeGB != nil {
+		in, out := &in.DiskSizeGB, &out.DiskSizeGB
+		*out = new(int)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]common.LabelSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paused != nil {
+		in, out := &in.Paused, &out.Paused
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PitEnabled != nil {
+		in, out := &in.PitEnabled, &out.PitEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplicationSpecs != nil {
+		in, out := &in.ReplicationSpecs, &out.ReplicationSpecs
+		*out = make([]*ReplicationSpec, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ReplicationSpec)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*v1.TagSpec, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1.TagSpec)
+				**out = **in
+			}
+		}
+	}
+	if in.CustomZoneMapping != nil {
+		in, out := &in.CustomZoneMapping, &out.CustomZoneMapping
+		*out = make([]v1.CustomZoneMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedNamespaces != nil {
+		in, out := &in.ManagedNamespaces, &out.ManagedNamespaces
+		*out = make([]v1.ManagedNamespace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SearchNodes != nil {
+		in, out := &in.SearchNodes, &out.SearchNodes
+		*out = make([]v1.SearchNode, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchIndexes != nil {
+		in, out := &in.SearchIndexes, &out.SearchIndexes
+		*out = make([]v1.SearchIndex, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedDeploymentSpec.
+func (in *AdvancedDeploymentSpec) DeepCopy() *AdvancedDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvancedDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeployment) DeepCopyInto(out *AtlasDeployment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeployment.
+func (in *AtlasDeployment) DeepCopy() *AtlasDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasDeployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeploymentList) DeepCopyInto(out *AtlasDeploymentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasDeployment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentList.
+func (in *AtlasDeploymentList) DeepCopy() *AtlasDeploymentList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasDeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasDeploymentSpec) DeepCopyInto(out *AtlasDeploymentSpec) {
+	*out = *in
+	in.ProjectDualReference.DeepCopyInto(&out.ProjectDualReference)
+	if in.DeploymentSpec != nil {
+		in, out := &in.DeploymentSpec, &out.DeploymentSpec
+		*out = new(AdvancedDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	out.BackupScheduleRef = in.BackupScheduleRef
+	if in.ProcessArgs != nil {
+		in, out := &in.ProcessArgs, &out.ProcessArgs
+		*out = new(v1.ProcessArgs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlexSpec != nil {
+		in, out := &in.FlexSpec, &out.FlexSpec
+		*out = new(v1.FlexSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FinalSnapshot != nil {
+		in, out := &in.FinalSnapshot, &out.FinalSnapshot
+		*out = new(v1.FinalSnapshot)
+		**out = **in
+	}
+	if in.MajorVersionUpgrade != nil {
+		in, out := &in.MajorVersionUpgrade, &out.MajorVersionUpgrade
+		*out = new(v1.MajorVersionUpgrade)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasDeploymentSpec.
+func (in *AtlasDeploymentSpec) DeepCopy() *AtlasDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasProject) DeepCopyInto(out *AtlasProject) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProject.
+func (in *AtlasProject) DeepCopy() *AtlasProject {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasProject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasProject) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasProjectList) DeepCopyInto(out *AtlasProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AtlasProject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectList.
+func (in *AtlasProjectList) DeepCopy() *AtlasProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AtlasProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AtlasProjectSpec) DeepCopyInto(out *AtlasProjectSpec) {
+	*out = *in
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(v1.CredentialsReference)
+		(*in).DeepCopyInto(*out)
+	}
+	out.MaintenanceWindow = in.MaintenanceWindow
+	if in.AlertConfigurations != nil {
+		in, out := &in.AlertConfigurations, &out.AlertConfigurations
+		*out = make([]v1.AlertConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.X509CertRef != nil {
+		in, out := &in.X509CertRef, &out.X509CertRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+	if in.EncryptionAtRest != nil {
+		in, out := &in.EncryptionAtRest, &out.EncryptionAtRest
+		*out = new(v1.EncryptionAtRest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auditing != nil {
+		in, out := &in.Auditing, &out.Auditing
+		*out = new(v1.Auditing)
+		**out = **in
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(v1.ProjectSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]v1.Team, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BackupCompliancePolicyRef != nil {
+		in, out := &in.BackupCompliancePolicyRef, &out.BackupCompliancePolicyRef
+		*out = new(common.ResourceRefNamespaced)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AtlasProjectSpec.
+func (in *AtlasProjectSpec) DeepCopy() *AtlasProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AtlasProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSpec) DeepCopyInto(out *ReplicationSpec) {
+	*out = *in
+	if in.RegionConfigs != nil {
+		in, out := &in.RegionConfigs, &out.RegionConfigs
+		*out = make([]*v1.AdvancedRegionConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(v1.AdvancedRegionConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSpec.
+func (in *ReplicationSpec) DeepCopy() *ReplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -21,6 +21,8 @@ import (
 	"net/url"
 	"runtime"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/mongodb-forks/digest"
 	v20250312002 "go.mongodb.org/atlas-sdk/v20250312002/admin"
@@ -36,12 +38,21 @@ import (
 
 const (
 	govAtlasDomain = "mongodbgov.com"
+
+	// commercialAtlasHost is the host of commercial Atlas, credentials may always point to it
+	commercialAtlasHost = "cloud.mongodb.com"
+
+	// transportIdleTimeout is how long the transport of some credentials is kept unused,
+	// such as after they were rotated, before it is dropped
+	transportIdleTimeout = time.Hour
 )
 
+// Provider builds Atlas clients and tells which features the Atlas domain of some credentials supports.
+// Nil credentials stand for the Atlas domain of the operator.
 type Provider interface {
 	SdkClientSet(ctx context.Context, creds *Credentials, log *zap.SugaredLogger) (*ClientSet, error)
-	IsCloudGov() bool
-	IsResourceSupported(resource api.AtlasCustomResource) bool
+	IsCloudGov(creds *Credentials) bool
+	IsResourceSupported(resource api.AtlasCustomResource, creds *Credentials) bool
 }
 
 type ClientSet struct {
@@ -53,6 +64,48 @@ type ProductionProvider struct {
 	domain       string
 	dryRun       bool
 	isLogInDebug bool
+	cassetteMode httputil.CassetteMode
+	cassette     *httputil.Cassette
+	cache        *httputil.ResponseCache
+	transport    http.RoundTripper
+	allowedHosts map[string]bool
+
+	transportsMu sync.Mutex
+	transports   map[transportKey]*idleTransport
+	lastSweep    time.Time
+	now          func() time.Time
+}
+
+// idleTransport is an authenticated transport along with the last time a client was built with it
+type idleTransport struct {
+	transport http.RoundTripper
+	lastUsed  time.Time
+}
+
+// transportKey identifies the authenticated transport of a set of credentials on an Atlas domain
+type transportKey struct {
+	domain     string
+	publicKey  string
+	privateKey string
+}
+
+type ProductionProviderOption func(*ProductionProvider)
+
+// WithCassette records Atlas API traffic to, or replays it from, the given cassette
+func WithCassette(mode httputil.CassetteMode, cassette *httputil.Cassette) ProductionProviderOption {
+	return func(p *ProductionProvider) {
+		p.cassetteMode = mode
+		p.cassette = cassette
+	}
+}
+
+// WithAllowedAtlasHosts lets credentials point to the given hosts, on top of the Atlas ones and the host of the operator domain
+func WithAllowedAtlasHosts(hosts ...string) ProductionProviderOption {
+	return func(p *ProductionProvider) {
+		for _, host := range hosts {
+			p.allowedHosts[strings.ToLower(host)] = true
+		}
+	}
 }
 
 // ConnectionConfig is the type that contains connection configuration to Atlas, including credentials.
@@ -66,6 +119,8 @@ type ConnectionConfig struct {
 // see https://www.mongodb.com/docs/atlas/configure-api-access/.
 type Credentials struct {
 	APIKeys *APIKeys
+	// AtlasDomain is the Atlas URL these credentials are used against, instead of the operator one when set
+	AtlasDomain string
 }
 
 // APIKeys is the type that holds Public/Private API keys to authenticate against the Atlas API.
@@ -74,16 +129,38 @@ type APIKeys struct {
 	PrivateKey string
 }
 
-func NewProductionProvider(atlasDomain string, dryRun, isLogInDebug bool) *ProductionProvider {
-	return &ProductionProvider{
+// WithResponseCache caches Atlas GET responses for the given TTL, separately for each credential
+func WithResponseCache(ttl time.Duration) ProductionProviderOption {
+	return func(p *ProductionProvider) {
+		if ttl > 0 {
+			p.cache = httputil.NewResponseCache(ttl)
+		}
+	}
+}
+
+// WithHTTPTransport sends Atlas API requests through the given transport instead of http.DefaultTransport
+func WithHTTPTransport(transport http.RoundTripper) ProductionProviderOption {
+	return func(p *ProductionProvider) {
+		p.transport = transport
+	}
+}
+
+func NewProductionProvider(atlasDomain string, dryRun, isLogInDebug bool, opts ...ProductionProviderOption) *ProductionProvider {
+	p := &ProductionProvider{
 		domain:       atlasDomain,
 		dryRun:       dryRun,
 		isLogInDebug: isLogInDebug,
+		allowedHosts: map[string]bool{},
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(p)
 	}
+	return p
 }
 
-func (p *ProductionProvider) IsCloudGov() bool {
-	domainURL, err := url.Parse(p.domain)
+func (p *ProductionProvider) IsCloudGov(creds *Credentials) bool {
+	domainURL, err := url.Parse(p.atlasDomain(creds))
 	if err != nil {
 		return false
 	}
@@ -91,8 +168,8 @@ func (p *ProductionProvider) IsCloudGov() bool {
 	return strings.HasSuffix(domainURL.Hostname(), govAtlasDomain)
 }
 
-func (p *ProductionProvider) IsResourceSupported(resource api.AtlasCustomResource) bool {
-	if !p.IsCloudGov() {
+func (p *ProductionProvider) IsResourceSupported(resource api.AtlasCustomResource, creds *Credentials) bool {
+	if !p.IsCloudGov(creds) {
 		return true
 	}
 
@@ -107,6 +184,8 @@ func (p *ProductionProvider) IsResourceSupported(resource api.AtlasCustomResourc
 		*akov2.AtlasFederatedAuth,
 		*akov2.AtlasPrivateEndpoint,
 		*akov2.AtlasNetworkContainer,
+		*akov2.AtlasCloudProviderAccess,
+		*akov2.AtlasBackupExportBucket,
 		*akov2.AtlasNetworkPeering:
 		return true
 	case *akov2.AtlasDataFederation,
@@ -123,9 +202,11 @@ func (p *ProductionProvider) IsResourceSupported(resource api.AtlasCustomResourc
 }
 
 func (p *ProductionProvider) SdkClientSet(ctx context.Context, creds *Credentials, log *zap.SugaredLogger) (*ClientSet, error) {
-	var transport http.RoundTripper = digest.NewTransport(creds.APIKeys.PublicKey, creds.APIKeys.PrivateKey)
-	transport = p.newDryRunTransport(transport)
-	transport = httputil.NewLoggingTransport(log, false, transport)
+	if err := p.checkAtlasDomain(creds); err != nil {
+		return nil, err
+	}
+	domain := p.atlasDomain(creds)
+	transport := httputil.NewLoggingTransport(log, false, p.authenticatedTransport(domain, creds))
 	if p.isLogInDebug {
 		log.Debug("JSON payload diff is enabled for Atlas API requests (PATCH & PUT)")
 		transport = httputil.NewTransportWithDiff(transport, log.Named("payload_diff"))
@@ -134,7 +215,7 @@ func (p *ProductionProvider) SdkClientSet(ctx context.Context, creds *Credential
 	httpClient := &http.Client{Transport: transport}
 
 	clientv20250312002, err := v20250312002.NewClient(
-		v20250312002.UseBaseURL(p.domain),
+		v20250312002.UseBaseURL(domain),
 		v20250312002.UseHTTPClient(httpClient),
 		v20250312002.UseUserAgent(operatorUserAgent()))
 	if err != nil {
@@ -142,7 +223,7 @@ func (p *ProductionProvider) SdkClientSet(ctx context.Context, creds *Credential
 	}
 
 	clientv20250312006, err := v20250312006.NewClient(
-		v20250312006.UseBaseURL(p.domain),
+		v20250312006.UseBaseURL(domain),
 		v20250312006.UseHTTPClient(httpClient),
 		v20250312006.UseUserAgent(operatorUserAgent()))
 	if err != nil {
@@ -155,6 +236,102 @@ func (p *ProductionProvider) SdkClientSet(ctx context.Context, creds *Credential
 	}, nil
 }
 
+func (p *ProductionProvider) atlasDomain(creds *Credentials) string {
+	if creds != nil && creds.AtlasDomain != "" {
+		return creds.AtlasDomain
+	}
+
+	return p.domain
+}
+
+// checkAtlasDomain makes sure the Atlas domain of the credentials is an allowed host,
+// so that API keys are never signed for a host the operator administrator did not trust
+func (p *ProductionProvider) checkAtlasDomain(creds *Credentials) error {
+	if creds == nil || creds.AtlasDomain == "" {
+		return nil
+	}
+
+	domainURL, err := url.Parse(creds.AtlasDomain)
+	if err != nil {
+		return fmt.Errorf("invalid Atlas domain %q: %w", creds.AtlasDomain, err)
+	}
+	host := strings.ToLower(domainURL.Host)
+	if host == commercialAtlasHost || host == govAtlasDomain || strings.HasSuffix(host, "."+govAtlasDomain) || p.allowedHosts[host] {
+		return nil
+	}
+	if operatorURL, err := url.Parse(p.domain); err == nil && strings.EqualFold(operatorURL.Host, host) {
+		return nil
+	}
+	return fmt.Errorf("the Atlas domain %q is not allowed, its host must be an Atlas one or be allowed by the operator", creds.AtlasDomain)
+}
+
+// authenticatedTransport returns the transport of the given credentials on the given domain,
+// built once and reused by all the clients of these credentials
+func (p *ProductionProvider) authenticatedTransport(domain string, creds *Credentials) http.RoundTripper {
+	key := transportKey{domain: domain, publicKey: creds.APIKeys.PublicKey, privateKey: creds.APIKeys.PrivateKey}
+
+	p.transportsMu.Lock()
+	defer p.transportsMu.Unlock()
+	now := p.now()
+	p.sweepTransports(now)
+	if cached, ok := p.transports[key]; ok {
+		cached.lastUsed = now
+		return cached.transport
+	}
+
+	transport := p.newCassetteTransport(creds)
+	transport = p.newCachingTransport(domain, creds, transport)
+	transport = p.newDryRunTransport(transport)
+	if p.transports == nil {
+		p.transports = map[transportKey]*idleTransport{}
+	}
+	p.transports[key] = &idleTransport{transport: transport, lastUsed: now}
+	return transport
+}
+
+// sweepTransports drops the transports no client was built with for more than the idle timeout,
+// such as the ones of rotated credentials. It runs at most once per idle timeout and must be
+// called with the lock held.
+func (p *ProductionProvider) sweepTransports(now time.Time) {
+	if now.Sub(p.lastSweep) < transportIdleTimeout {
+		return
+	}
+	p.lastSweep = now
+	for key, cached := range p.transports {
+		if now.Sub(cached.lastUsed) > transportIdleTimeout {
+			delete(p.transports, key)
+		}
+	}
+}
+
+func (p *ProductionProvider) newCassetteTransport(creds *Credentials) http.RoundTripper {
+	switch p.cassetteMode {
+	case httputil.CassetteModeReplay:
+		return httputil.NewReplayTransport(p.cassette)
+	case httputil.CassetteModeRecord:
+		return httputil.NewRecordingTransport(p.cassette, p.newDigestTransport(creds))
+	default:
+		return p.newDigestTransport(creds)
+	}
+}
+
+func (p *ProductionProvider) newDigestTransport(creds *Credentials) http.RoundTripper {
+	if p.transport != nil {
+		return digest.NewTransportWithHTTPRoundTripper(creds.APIKeys.PublicKey, creds.APIKeys.PrivateKey, p.transport)
+	}
+
+	return digest.NewTransport(creds.APIKeys.PublicKey, creds.APIKeys.PrivateKey)
+}
+
+func (p *ProductionProvider) newCachingTransport(domain string, creds *Credentials, delegate http.RoundTripper) http.RoundTripper {
+	if p.cache != nil {
+		scope := httputil.CacheScope(domain, creds.APIKeys.PublicKey, creds.APIKeys.PrivateKey)
+		return httputil.NewCachingTransport(p.cache, scope, delegate)
+	}
+
+	return delegate
+}
+
 func (p *ProductionProvider) newDryRunTransport(delegate http.RoundTripper) http.RoundTripper {
 	if p.dryRun {
 		return dryrun.NewDryRunTransport(delegate)
@@ -15,30 +15,46 @@
 package atlas
 
 import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/version"
 )
 
 func TestProvider_IsCloudGov(t *testing.T) {
 	t.Run("should return false for invalid domain", func(t *testing.T) {
 		p := NewProductionProvider("http://x:namedport", false, false)
-		assert.False(t, p.IsCloudGov())
+		assert.False(t, p.IsCloudGov(nil))
 	})
 
 	t.Run("should return false for commercial Atlas domain", func(t *testing.T) {
 		p := NewProductionProvider("https://cloud.mongodb.com/", false, false)
-		assert.False(t, p.IsCloudGov())
+		assert.False(t, p.IsCloudGov(nil))
 	})
 
 	t.Run("should return true for Atlas for government domain", func(t *testing.T) {
 		p := NewProductionProvider("https://cloud.mongodbgov.com/", false, false)
-		assert.True(t, p.IsCloudGov())
+		assert.True(t, p.IsCloudGov(nil))
+	})
+
+	t.Run("should use the Atlas domain of the credentials", func(t *testing.T) {
+		p := NewProductionProvider("https://cloud.mongodb.com/", false, false)
+		assert.True(t, p.IsCloudGov(&Credentials{AtlasDomain: "https://cloud.mongodbgov.com/"}))
+		assert.False(t, p.IsCloudGov(&Credentials{}))
 	})
 }
 
@@ -138,9 +154,16 @@ func TestProvider_IsResourceSupported(t *testing.T) {
 	for desc, data := range dataProvider {
 		t.Run(desc, func(t *testing.T) {
 			p := NewProductionProvider(data.domain, false, false)
-			assert.Equal(t, data.expectation, p.IsResourceSupported(data.resource))
+			assert.Equal(t, data.expectation, p.IsResourceSupported(data.resource, nil))
 		})
 	}
+
+	t.Run("should evaluate the Atlas domain of the credentials", func(t *testing.T) {
+		p := NewProductionProvider("https://cloud.mongodb.com/", false, false)
+		stream := &akov2.AtlasStreamInstance{}
+		assert.True(t, p.IsResourceSupported(stream, &Credentials{}))
+		assert.False(t, p.IsResourceSupported(stream, &Credentials{AtlasDomain: "https://cloud.mongodbgov.com/"}))
+	})
 }
 
 func TestOperatorUserAgent(t *testing.T) {
@@ -149,3 +172,190 @@ func TestOperatorUserAgent(t *testing.T) {
 	require.Contains(t, userAgent, "MongoDBAtlasKubernetesOperator")
 	require.Contains(t, userAgent, version.Version)
 }
+
+func TestProvider_SdkClientSetReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"request": {"method": "GET", "url": "/api/atlas/v2/groups/byName/my-project"}, `+
+		`"response": {"statusCode": 200, "headers": {"Content-Type": ["application/vnd.atlas.2025-03-12+json"]}, "body": "{\"id\":\"123\",\"name\":\"my-project\"}"}}`+"\n"), 0o600))
+	cassette, err := httputil.LoadCassette(path)
+	require.NoError(t, err)
+
+	p := NewProductionProvider("https://unreachable.invalid/", false, false, WithCassette(httputil.CassetteModeReplay, cassette))
+	clientSet, err := p.SdkClientSet(context.Background(), &Credentials{APIKeys: &APIKeys{PublicKey: "public", PrivateKey: "private"}}, zaptest.NewLogger(t).Sugar())
+	require.NoError(t, err)
+
+	group, _, err := clientSet.SdkClient20250312002.ProjectsApi.GetProjectByName(context.Background(), "my-project").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "123", group.GetId())
+}
+
+func TestProvider_SdkClientSetResponseCache(t *testing.T) {
+	gets := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.Header().Set("WWW-Authenticate", `Digest realm="MMS Public API", nonce="abc", qop="auth", algorithm=MD5`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		gets++
+		w.Header().Set("Content-Type", "application/vnd.atlas.2025-03-12+json")
+		_, _ = w.Write([]byte(`{"id":"123","name":"my-project"}`))
+	}))
+	defer srv.Close()
+
+	for _, tc := range []struct {
+		ttl      time.Duration
+		wantGets int
+	}{
+		{ttl: 0, wantGets: 2},
+		{ttl: time.Minute, wantGets: 1},
+	} {
+		gets = 0
+		p := NewProductionProvider(srv.URL+"/", false, false, WithResponseCache(tc.ttl))
+		for range 2 {
+			clientSet, err := p.SdkClientSet(context.Background(), &Credentials{APIKeys: &APIKeys{PublicKey: "public", PrivateKey: "private"}}, zaptest.NewLogger(t).Sugar())
+			require.NoError(t, err)
+			_, _, err = clientSet.SdkClient20250312002.ProjectsApi.GetProject(context.Background(), "123").Execute()
+			require.NoError(t, err)
+		}
+		assert.Equal(t, tc.wantGets, gets)
+	}
+}
+
+func TestProvider_SdkClientSetHTTPTransport(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.Header().Set("WWW-Authenticate", `Digest realm="MMS Public API", nonce="abc", qop="auth", algorithm=MD5`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/vnd.atlas.2025-03-12+json")
+		_, _ = w.Write([]byte(`{"id":"123","name":"my-project"}`))
+	}))
+	defer srv.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	trusting, err := httputil.NewTransport(httputil.TransportConfig{CABundle: caBundle})
+	require.NoError(t, err)
+	for _, tc := range []struct {
+		title   string
+		opts    []ProductionProviderOption
+		wantErr string
+	}{
+		{
+			title:   "default transport does not trust the server",
+			wantErr: "certificate signed by unknown authority",
+		},
+		{
+			title: "transport with the server CA bundle",
+			opts:  []ProductionProviderOption{WithHTTPTransport(trusting)},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			p := NewProductionProvider(srv.URL+"/", false, false, tc.opts...)
+			clientSet, err := p.SdkClientSet(context.Background(), &Credentials{APIKeys: &APIKeys{PublicKey: "public", PrivateKey: "private"}}, zaptest.NewLogger(t).Sugar())
+			require.NoError(t, err)
+
+			group, _, err := clientSet.SdkClient20250312002.ProjectsApi.GetProject(context.Background(), "123").Execute()
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "my-project", group.GetName())
+		})
+	}
+}
+
+func TestProvider_SdkClientSetAtlasDomain(t *testing.T) {
+	var commercialGets, govGets int
+	newServer := func(gets *int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				w.Header().Set("WWW-Authenticate", `Digest realm="MMS Public API", nonce="abc", qop="auth", algorithm=MD5`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			*gets++
+			w.Header().Set("Content-Type", "application/vnd.atlas.2025-03-12+json")
+			_, _ = w.Write([]byte(`{"id":"123","name":"my-project"}`))
+		}))
+	}
+	commercial := newServer(&commercialGets)
+	defer commercial.Close()
+	gov := newServer(&govGets)
+	defer gov.Close()
+
+	p := NewProductionProvider(commercial.URL+"/", false, false, WithAllowedAtlasHosts(strings.TrimPrefix(gov.URL, "http://")))
+	apiKeys := &APIKeys{PublicKey: "public", PrivateKey: "private"}
+	for _, creds := range []*Credentials{
+		{APIKeys: apiKeys},
+		{APIKeys: apiKeys, AtlasDomain: gov.URL + "/"},
+		{APIKeys: apiKeys, AtlasDomain: gov.URL + "/"},
+	} {
+		clientSet, err := p.SdkClientSet(context.Background(), creds, zaptest.NewLogger(t).Sugar())
+		require.NoError(t, err)
+		_, _, err = clientSet.SdkClient20250312002.ProjectsApi.GetProject(context.Background(), "123").Execute()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, commercialGets)
+	assert.Equal(t, 2, govGets)
+
+	assert.Len(t, p.transports, 2, "expected one transport per Atlas domain and credentials")
+	otherKeys := &Credentials{APIKeys: &APIKeys{PublicKey: "other", PrivateKey: "private"}}
+	_, err := p.SdkClientSet(context.Background(), otherKeys, zaptest.NewLogger(t).Sugar())
+	require.NoError(t, err)
+	assert.Len(t, p.transports, 3)
+}
+
+func TestProvider_SdkClientSetAllowedAtlasHosts(t *testing.T) {
+	p := NewProductionProvider("https://cloud-qa.mongodb.com/", false, false, WithAllowedAtlasHosts("atlas.proxy.internal:8443"))
+	for domain, wantErr := range map[string]bool{
+		"https://cloud.mongodb.com/":          false,
+		"https://cloud.mongodbgov.com/":       false,
+		"https://cloud-qa.mongodbgov.com/":    false,
+		"https://cloud-qa.mongodb.com/":       false,
+		"https://atlas.proxy.internal:8443/":  false,
+		"https://atlas.proxy.internal/":       true,
+		"https://metadata.google.internal/":   true,
+		"https://10.0.0.1/":                   true,
+		"https://evilmongodbgov.com/":         true,
+		"https://cloud.mongodb.com.evil.com/": true,
+		"https://cloud.mongodb.com:8443/":     true,
+		"https://kubernetes.default.svc:443/": true,
+	} {
+		t.Run(domain, func(t *testing.T) {
+			creds := &Credentials{APIKeys: &APIKeys{PublicKey: "public", PrivateKey: "private"}, AtlasDomain: domain}
+			_, err := p.SdkClientSet(context.Background(), creds, zaptest.NewLogger(t).Sugar())
+			if wantErr {
+				assert.ErrorContains(t, err, "is not allowed")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProvider_SdkClientSetEvictsIdleTransports(t *testing.T) {
+	now := time.Now()
+	p := NewProductionProvider("https://cloud.mongodb.com/", false, false)
+	p.now = func() time.Time { return now }
+	newClientSet := func(publicKey string) {
+		creds := &Credentials{APIKeys: &APIKeys{PublicKey: publicKey, PrivateKey: "private"}}
+		_, err := p.SdkClientSet(context.Background(), creds, zaptest.NewLogger(t).Sugar())
+		require.NoError(t, err)
+	}
+
+	newClientSet("rotated")
+	newClientSet("current")
+	assert.Len(t, p.transports, 2)
+
+	now = now.Add(transportIdleTimeout / 2)
+	newClientSet("current")
+	assert.Len(t, p.transports, 2, "expected transports to be kept within the idle timeout")
+
+	now = now.Add(transportIdleTimeout/2 + time.Minute)
+	newClientSet("current")
+	assert.Len(t, p.transports, 1, "expected the transport of the rotated credentials to be dropped")
+	assert.Contains(t, p.transports, transportKey{domain: "https://cloud.mongodb.com/", publicKey: "current", privateKey: "private"})
+}
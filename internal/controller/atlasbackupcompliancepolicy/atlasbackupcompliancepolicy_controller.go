@@ -36,11 +36,10 @@ import (
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 type AtlasBackupCompliancePolicyReconciler struct {
@@ -52,6 +51,8 @@ type AtlasBackupCompliancePolicyReconciler struct {
 	Log                         *zap.SugaredLogger
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
+	GlobalSecretRef             client.ObjectKey
+	CredentialProviders         reconciler.CredentialProviders
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasbackupcompliancepolicies,verbs=get;list;watch;create;update;patch;delete
@@ -84,10 +85,6 @@ func (r *AtlasBackupCompliancePolicyReconciler) Reconcile(ctx context.Context, r
 		return r.invalidate(isValid)
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(bcp) {
-		return r.unsupport(workflowCtx)
-	}
-
 	return r.ensureAtlasBackupCompliancePolicy(workflowCtx, bcp)
 }
 
@@ -104,6 +101,18 @@ func (r *AtlasBackupCompliancePolicyReconciler) ensureAtlasBackupCompliancePolic
 		return r.terminate(workflowCtx, workflow.Internal, err)
 	}
 
+	referrers := make([]*atlas.ConnectionConfig, 0, len(projects.Items))
+	for i := range projects.Items {
+		connectionConfig, err := reconciler.GetProjectConnectionConfig(workflowCtx.Context, r.Client, r.CredentialProviders, &projects.Items[i], &r.GlobalSecretRef)
+		if err != nil {
+			return r.terminate(workflowCtx, workflow.AtlasAPIAccessNotConfigured, err)
+		}
+		referrers = append(referrers, connectionConfig)
+	}
+	if !reconciler.IsSupportedByReferrers(r.AtlasProvider, bcp, referrers) {
+		return r.unsupport(workflowCtx)
+	}
+
 	if len(projects.Items) > 0 {
 		return r.lock(workflowCtx, bcp)
 	}
@@ -115,7 +124,7 @@ func (r *AtlasBackupCompliancePolicyReconciler) For() (client.Object, builder.Pr
 	return &akov2.AtlasBackupCompliancePolicy{}, builder.WithPredicates(r.GlobalPredicates...)
 }
 
-func (r *AtlasBackupCompliancePolicyReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+func (r *AtlasBackupCompliancePolicyReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasBackupCompliancePolicy").
 		For(r.For()).
@@ -124,9 +133,7 @@ func (r *AtlasBackupCompliancePolicyReconciler) SetupWithManager(mgr ctrl.Manage
 			handler.EnqueueRequestsFromMapFunc(r.findBCPForProjects),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
+		WithOptions(options).
 		Complete(r)
 }
 
@@ -136,6 +143,8 @@ func NewAtlasBackupCompliancePolicyReconciler(
 	atlasProvider atlas.Provider,
 	deletionProtection bool,
 	logger *zap.Logger,
+	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
 ) *AtlasBackupCompliancePolicyReconciler {
 	return &AtlasBackupCompliancePolicyReconciler{
 		Scheme:                   c.GetScheme(),
@@ -145,6 +154,8 @@ func NewAtlasBackupCompliancePolicyReconciler(
 		Log:                      logger.Named("controllers").Named("AtlasBackupCompliancePolicy").Sugar(),
 		AtlasProvider:            atlasProvider,
 		ObjectDeletionProtection: deletionProtection,
+		GlobalSecretRef:          globalSecretRef,
+		CredentialProviders:      credentialProviders,
 	}
 }
 
@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -102,6 +103,9 @@ func TestReconcile(t *testing.T) {
 						Namespace: "default",
 					},
 				},
+				akov2.DefaultProject("default", "connection-secret").
+					WithBackupCompliancePolicyNamespaced("bcp", "default"),
+				connectionSecret(),
 			},
 			isSupported: false,
 			wantStatusConditions: []api.Condition{
@@ -149,6 +153,7 @@ func TestReconcile(t *testing.T) {
 				},
 				akov2.DefaultProject("default", "connection-secret").
 					WithBackupCompliancePolicyNamespaced("bcp", "default"),
+				connectionSecret(),
 			},
 			isSupported: true,
 			wantStatusConditions: []api.Condition{
@@ -210,6 +215,7 @@ func TestReconcile(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			testScheme := runtime.NewScheme()
 			assert.NoError(t, akov2.AddToScheme(testScheme))
+			assert.NoError(t, corev1.AddToScheme(testScheme))
 			bcpIndexer := indexer.NewAtlasProjectByBackupCompliancePolicyIndexer(zaptest.NewLogger(t))
 			k8sClient := fake.NewClientBuilder().
 				WithScheme(testScheme).
@@ -312,3 +318,17 @@ func TestFindBCPForProjects(t *testing.T) {
 		})
 	}
 }
+
+func connectionSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "connection-secret",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"orgId":         []byte("orgId"),
+			"publicApiKey":  []byte("publicApiKey"),
+			"privateApiKey": []byte("privateApiKey"),
+		},
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackupexportbucket
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+)
+
+// AtlasBackupExportBucketReconciler reconciles a AtlasBackupExportBucket object
+type AtlasBackupExportBucketReconciler struct {
+	reconciler.AtlasReconciler
+	Scheme                   *runtime.Scheme
+	EventRecorder            record.EventRecorder
+	GlobalPredicates         []predicate.Predicate
+	ObjectDeletionProtection bool
+	independentSyncPeriod    time.Duration
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasbackupexportbuckets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasbackupexportbuckets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasbackupexportbuckets/finalizers,verbs=update
+
+// Reconcile Atlas Backup Export Bucket resources
+func (r *AtlasBackupExportBucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.Log.Infow("-> Starting AtlasBackupExportBucket reconciliation")
+
+	exportBucket := akov2.AtlasBackupExportBucket{}
+	result := customresource.PrepareResource(ctx, r.Client, req, &exportBucket, r.Log)
+	if !result.IsOk() {
+		return result.ReconcileResult()
+	}
+	return r.handleCustomResource(ctx, &exportBucket)
+}
+
+// For prepares the controller for its target Custom Resource; Backup Export Buckets
+func (r *AtlasBackupExportBucketReconciler) For() (client.Object, builder.Predicates) {
+	return &akov2.AtlasBackupExportBucket{}, builder.WithPredicates(r.GlobalPredicates...)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AtlasBackupExportBucketReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.For()).
+		Watches(
+			&akov2.AtlasProject{},
+			handler.EnqueueRequestsFromMapFunc(r.exportBucketForProjectMapFunc()),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.exportBucketForCredentialMapFunc()),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Watches(
+			&akov2.AtlasCloudProviderAccess{},
+			handler.EnqueueRequestsFromMapFunc(r.exportBucketForCloudProviderAccessMapFunc),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		WithOptions(options).
+		Complete(r)
+}
+
+func (r *AtlasBackupExportBucketReconciler) exportBucketForProjectMapFunc() handler.MapFunc {
+	return indexer.ProjectsIndexMapperFunc(
+		indexer.AtlasBackupExportBucketByProjectIndex,
+		func() *akov2.AtlasBackupExportBucketList { return &akov2.AtlasBackupExportBucketList{} },
+		indexer.BackupExportBucketRequests,
+		r.Client,
+		r.Log,
+	)
+}
+
+func (r *AtlasBackupExportBucketReconciler) exportBucketForCredentialMapFunc() handler.MapFunc {
+	return indexer.CredentialsIndexMapperFunc(
+		indexer.AtlasBackupExportBucketCredentialsIndex,
+		func() *akov2.AtlasBackupExportBucketList { return &akov2.AtlasBackupExportBucketList{} },
+		indexer.BackupExportBucketRequests,
+		r.Client,
+		r.Log,
+	)
+}
+
+func (r *AtlasBackupExportBucketReconciler) exportBucketForCloudProviderAccessMapFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	cloudProviderAccess, ok := obj.(*akov2.AtlasCloudProviderAccess)
+	if !ok {
+		r.Log.Warnf("watching AtlasCloudProviderAccess but got %T", obj)
+		return nil
+	}
+
+	exportBuckets := &akov2.AtlasBackupExportBucketList{}
+	listOpts := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(
+			indexer.AtlasBackupExportBucketByCloudProviderAccessIndex,
+			kube.ObjectKeyFromObject(cloudProviderAccess).String(),
+		),
+	}
+	if err := r.Client.List(ctx, exportBuckets, listOpts); err != nil {
+		r.Log.Errorf("failed to list AtlasBackupExportBuckets of AtlasCloudProviderAccess %s: %s", cloudProviderAccess.Name, err)
+		return nil
+	}
+	return indexer.BackupExportBucketRequests(exportBuckets)
+}
+
+func NewAtlasBackupExportBucketReconciler(
+	c cluster.Cluster,
+	predicates []predicate.Predicate,
+	atlasProvider atlas.Provider,
+	deletionProtection bool,
+	logger *zap.Logger,
+	independentSyncPeriod time.Duration,
+	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
+) *AtlasBackupExportBucketReconciler {
+	return &AtlasBackupExportBucketReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:              c.GetClient(),
+			Log:                 logger.Named("controllers").Named("AtlasBackupExportBucket").Sugar(),
+			GlobalSecretRef:     globalSecretRef,
+			CredentialProviders: credentialProviders,
+			AtlasProvider:       atlasProvider,
+		},
+		Scheme:                   c.GetScheme(),
+		EventRecorder:            c.GetEventRecorderFor("AtlasBackupExportBucket"),
+		GlobalPredicates:         predicates,
+		ObjectDeletionProtection: deletionProtection,
+		independentSyncPeriod:    independentSyncPeriod,
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackupexportbucket
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+)
+
+// ResolveExportBucketID returns the Atlas ID of the referenced AtlasBackupExportBucket,
+// failing until the bucket was created in Atlas
+func ResolveExportBucketID(ctx context.Context, c client.Client, ref *common.ResourceRefNamespaced, namespace string) (string, error) {
+	key := ref.GetObject(namespace)
+	exportBucket := &akov2.AtlasBackupExportBucket{}
+	if err := c.Get(ctx, *key, exportBucket); err != nil {
+		return "", fmt.Errorf("failed to get AtlasBackupExportBucket %s: %w", key, err)
+	}
+	if exportBucket.Status.ExportBucketID == "" {
+		return "", fmt.Errorf("AtlasBackupExportBucket %s is not created in Atlas yet", key)
+	}
+	return exportBucket.Status.ExportBucketID, nil
+}
+
+// resolveRoleID returns the Atlas ID of the role referenced by the export bucket,
+// or an empty ID while the role is still to be authorized
+func (r *AtlasBackupExportBucketReconciler) resolveRoleID(ctx context.Context, exportBucket *akov2.AtlasBackupExportBucket) (string, error) {
+	key := exportBucket.Spec.CloudProviderAccessRef.GetObject(exportBucket.Namespace)
+	cloudProviderAccess := &akov2.AtlasCloudProviderAccess{}
+	if err := r.Client.Get(ctx, *key, cloudProviderAccess); err != nil {
+		return "", fmt.Errorf("failed to get AtlasCloudProviderAccess %s: %w", key, err)
+	}
+	if cloudProviderAccess.Spec.ProviderName != exportBucket.Spec.CloudProvider {
+		return "", fmt.Errorf("AtlasCloudProviderAccess %s is for provider %s, not %s",
+			key, cloudProviderAccess.Spec.ProviderName, exportBucket.Spec.CloudProvider)
+	}
+	if !cloudProviderAccess.Status.Authorized {
+		return "", nil
+	}
+	return cloudProviderAccess.Status.RoleID, nil
+}
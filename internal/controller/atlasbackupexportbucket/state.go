@@ -0,0 +1,114 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackupexportbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/exportbucket"
+)
+
+const (
+	typeName = "AtlasBackupExportBucket"
+)
+
+type reconcileRequest struct {
+	projectID    string
+	exportBucket *akov2.AtlasBackupExportBucket
+	service      exportbucket.ExportBucketService
+}
+
+func (r *AtlasBackupExportBucketReconciler) handleCustomResource(ctx context.Context, exportBucket *akov2.AtlasBackupExportBucket) (ctrl.Result, error) {
+	if customresource.ReconciliationShouldBeSkipped(exportBucket) {
+		return r.Skip(ctx, typeName, exportBucket, exportBucket.Spec)
+	}
+
+	conditions := api.InitCondition(exportBucket, api.FalseCondition(api.ReadyType))
+	workflowCtx := workflow.NewContext(r.Log, conditions, ctx, exportBucket)
+	defer statushandler.Update(workflowCtx, r.Client, r.EventRecorder, exportBucket)
+
+	isValid := customresource.ValidateResourceVersion(workflowCtx, exportBucket, r.Log)
+	if !isValid.IsOk() {
+		return r.Invalidate(typeName, isValid)
+	}
+
+	connectionConfig, err := r.ResolveConnectionConfig(ctx, exportBucket)
+	if err != nil {
+		return r.release(workflowCtx, exportBucket, err)
+	}
+
+	if !r.AtlasProvider.IsResourceSupported(exportBucket, connectionConfig.Credentials) {
+		return r.Unsupport(workflowCtx, typeName)
+	}
+
+	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
+	if err != nil {
+		return r.terminate(workflowCtx, exportBucket, workflow.BackupExportBucketNotConfigured, err)
+	}
+	project, err := r.ResolveProject(ctx, sdkClientSet.SdkClient20250312002, exportBucket)
+	if err != nil {
+		return r.release(workflowCtx, exportBucket, err)
+	}
+	return r.handle(workflowCtx, &reconcileRequest{
+		projectID:    project.ID,
+		exportBucket: exportBucket,
+		service:      exportbucket.NewExportBucketServiceFromClientSet(sdkClientSet),
+	})
+}
+
+func (r *AtlasBackupExportBucketReconciler) handle(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	atlasBucket, err := discover(workflowCtx.Context, req)
+	if err != nil {
+		return r.terminate(workflowCtx, req.exportBucket, workflow.BackupExportBucketNotConfigured, err)
+	}
+	inAtlas := atlasBucket != nil
+	deleted := req.exportBucket.DeletionTimestamp != nil
+	switch {
+	case !deleted && !inAtlas:
+		return r.create(workflowCtx, req)
+	case !deleted && inAtlas:
+		return r.sync(workflowCtx, req, atlasBucket)
+	case deleted && inAtlas:
+		return r.delete(workflowCtx, req, atlasBucket)
+	default: // deleted && !inAtlas:
+		return r.unmanage(workflowCtx, req.exportBucket)
+	}
+}
+
+// discover finds the export bucket created for this resource by the ID recorded in the status,
+// as Atlas allows several export buckets with the same name
+func discover(ctx context.Context, req *reconcileRequest) (*exportbucket.ExportBucket, error) {
+	id := req.exportBucket.Status.ExportBucketID
+	if id == "" {
+		return nil, nil
+	}
+	bucket, err := req.service.Get(ctx, req.projectID, id)
+	if errors.Is(err, exportbucket.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export bucket %s from project %s: %w", id, req.projectID, err)
+	}
+	return bucket, nil
+}
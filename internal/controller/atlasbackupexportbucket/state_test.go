@@ -0,0 +1,462 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackupexportbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	akomock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/exportbucket"
+)
+
+var (
+	// sample error test
+	ErrTestFail = errors.New("failure")
+)
+
+const (
+	testBucketID = "bucket-id"
+	testRoleID   = "role-id"
+)
+
+func TestHandleCustomResource(t *testing.T) {
+	for _, tc := range []struct {
+		title          string
+		provider       atlas.Provider
+		annotations    map[string]string
+		wantResult     ctrl.Result
+		wantConditions []api.Condition
+	}{
+		{
+			title: "should skip reconciliation",
+			annotations: map[string]string{
+				customresource.ReconciliationPolicyAnnotation: customresource.ReconciliationPolicySkip,
+			},
+		},
+		{
+			title: "should fail when not supported",
+			provider: &atlasmock.TestProvider{
+				IsSupportedFunc: func() bool {
+					return false
+				},
+			},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).
+					WithReason(string(workflow.AtlasGovUnsupported)).
+					WithMessageRegexp("the AtlasBackupExportBucket is not supported by Atlas for government"),
+				api.TrueCondition(api.ResourceVersionStatus),
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			bucket := testBucket("AWS", func(bucket *akov2.AtlasBackupExportBucket) {
+				bucket.Annotations = tc.annotations
+				bucket.Spec.ConnectionSecret = &api.LocalObjectReference{Name: "my-secret"}
+			})
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-secret",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"orgId":         []byte("orgId"),
+					"publicApiKey":  []byte("publicApiKey"),
+					"privateApiKey": []byte("privateApiKey"),
+				},
+			}
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme(t)).
+				WithObjects(bucket, secret).
+				WithStatusSubresource(bucket).
+				Build()
+			ctx := context.Background()
+			r := testReconciler(k8sClient, tc.provider, zaptest.NewLogger(t))
+			result, err := r.handleCustomResource(ctx, bucket)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+			got := getExportBucket(t, ctx, k8sClient, client.ObjectKeyFromObject(bucket))
+			assert.Equal(t, cleanConditions(tc.wantConditions), cleanConditions(got.Status.GetConditions()))
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	deletionTime := metav1.Now()
+	logger := zaptest.NewLogger(t)
+	readyConditions := []api.Condition{
+		api.TrueCondition(api.BackupExportBucketReady).
+			WithMessageRegexp(fmt.Sprintf("Export bucket %s is ready", testBucketID)),
+		api.TrueCondition(api.ReadyType),
+	}
+	for _, tc := range []struct {
+		title          string
+		bucket         *akov2.AtlasBackupExportBucket
+		role           *akov2.AtlasCloudProviderAccess
+		service        func(t *testing.T) exportbucket.ExportBucketService
+		wantResult     ctrl.Result
+		wantErr        error
+		wantFinalizers []string
+		wantConditions []api.Condition
+		wantBucketID   string
+	}{
+		{
+			title:  "create waits for the role to be authorized",
+			bucket: testBucket("AWS", nil),
+			role:   testRole("AWS", ""),
+			service: func(t *testing.T) exportbucket.ExportBucketService {
+				return akomock.NewExportBucketServiceMock(t)
+			},
+			wantResult: ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.BackupExportBucketReady).
+					WithReason(string(workflow.BackupExportBucketPendingCloudProviderAccess)).
+					WithMessageRegexp("waiting for AtlasCloudProviderAccess default/bucket-role to be authorized"),
+			},
+		},
+		{
+			title:  "create uses the authorized role",
+			bucket: testBucket("AWS", nil),
+			role:   testRole("AWS", testRoleID),
+			service: func(t *testing.T) exportbucket.ExportBucketService {
+				s := akomock.NewExportBucketServiceMock(t)
+				s.EXPECT().Create(mock.Anything, testProjectID, &exportbucket.ExportBucket{CloudProvider: "AWS", BucketName: "snapshots", RoleID: testRoleID}).
+					Return(awsBucket(testRoleID), nil)
+				return s
+			},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: readyConditions,
+			wantBucketID:   testBucketID,
+		},
+		{
+			title:  "create fails when the role is for another provider",
+			bucket: testBucket("AWS", nil),
+			role:   testRole("GCP", testRoleID),
+			service: func(t *testing.T) exportbucket.ExportBucketService {
+				return akomock.NewExportBucketServiceMock(t)
+			},
+			wantErr: errors.New("AtlasCloudProviderAccess default/bucket-role is for provider GCP, not AWS"),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.BackupExportBucketNotConfigured)).
+					WithMessageRegexp("AtlasCloudProviderAccess default/bucket-role is for provider GCP, not AWS"),
+			},
+		},
+		{
+			title:  "create fails when the role is missing",
+			bucket: testBucket("AWS", nil),
+			service: func(t *testing.T) exportbucket.ExportBucketService {
+				return akomock.NewExportBucketServiceMock(t)
+			},
+			wantErr: errors.New("failed to get AtlasCloudProviderAccess default/bucket-role: atlascloudprovideraccesses.atlas.mongodb.com \"bucket-role\" not found"),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.BackupExportBucketNotConfigured)).
+					WithMessageRegexp("failed to get AtlasCloudProviderAccess default/bucket-role: atlascloudprovideraccesses.atlas.mongodb.com \"bucket-role\" not found"),
+			},
+		},
+		{
+			title:  "create fails",
+			bucket: testBucket("AWS", nil),
+			role:   testRole("AWS", testRoleID),
+			service: func(t *testing.T) exportbucket.ExportBucketService {
+				s := akomock.NewExportBucketServiceMock(t)
+				s.EXPECT().Create(mock.Anything, testProjectID, mock.Anything).Return(nil, ErrTestFail)
+				return s
+			},
+			wantErr: fmt.Errorf("failed to create export bucket: %w", ErrTestFail),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.BackupExportBucketNotConfigured)).
+					WithMessageRegexp("failed to create export bucket: failure"),
+			},
+		},
+		{
+			title: "existing bucket is ready",
+			bucket: testBucket("AWS", func(bucket *akov2.AtlasBackupExportBucket) {
+				bucket.Status.ExportBucketID = testBucketID
+			}),
+			role: testRole("AWS", testRoleID),
+			service: func(t *testing.T) exportbucket.ExportBucketService {
+				s := akomock.NewExportBucketServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testBucketID).Return(awsBucket(testRoleID), nil)
+				return s
+			},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: readyConditions,
+			wantBucketID:   testBucketID,
+		},
+		{
+			title: "existing bucket cannot change its role",
+			bucket: testBucket("AWS", func(bucket *akov2.AtlasBackupExportBucket) {
+				bucket.Status.ExportBucketID = testBucketID
+			}),
+			role: testRole("AWS", "other-role-id"),
+			service: func(t *testing.T) exportbucket.ExportBucketService {
+				s := akomock.NewExportBucketServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testBucketID).Return(awsBucket(testRoleID), nil)
+				return s
+			},
+			wantErr: fmt.Errorf("export bucket %s uses role %s in Atlas, which cannot be changed to other-role-id: recreate the resource to use another role", testBucketID, testRoleID),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.BackupExportBucketRoleChanged)).
+					WithMessageRegexp(fmt.Sprintf("export bucket %s uses role %s in Atlas, which cannot be changed to other-role-id: recreate the resource to use another role", testBucketID, testRoleID)),
+			},
+			wantBucketID: testBucketID,
+		},
+		{
+			title: "bucket missing in Atlas is created again",
+			bucket: testBucket("AWS", func(bucket *akov2.AtlasBackupExportBucket) {
+				bucket.Status.ExportBucketID = "gone"
+			}),
+			role: testRole("AWS", testRoleID),
+			service: func(t *testing.T) exportbucket.ExportBucketService {
+				s := akomock.NewExportBucketServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, "gone").Return(nil, exportbucket.ErrNotFound)
+				s.EXPECT().Create(mock.Anything, testProjectID, mock.Anything).Return(awsBucket(testRoleID), nil)
+				return s
+			},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: readyConditions,
+			wantBucketID:   testBucketID,
+		},
+		{
+			title: "delete removes the bucket",
+			bucket: testBucket("AWS", func(bucket *akov2.AtlasBackupExportBucket) {
+				bucket.Finalizers = []string{customresource.FinalizerLabel}
+				bucket.DeletionTimestamp = &deletionTime
+				bucket.Status.ExportBucketID = testBucketID
+			}),
+			service: func(t *testing.T) exportbucket.ExportBucketService {
+				s := akomock.NewExportBucketServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testBucketID).Return(awsBucket(testRoleID), nil)
+				s.EXPECT().Delete(mock.Anything, testProjectID, testBucketID).Return(nil)
+				return s
+			},
+			wantBucketID: testBucketID,
+		},
+		{
+			title: "delete keeps the bucket when protected",
+			bucket: testBucket("AWS", func(bucket *akov2.AtlasBackupExportBucket) {
+				bucket.Finalizers = []string{customresource.FinalizerLabel}
+				bucket.DeletionTimestamp = &deletionTime
+				bucket.Annotations = map[string]string{customresource.ResourcePolicyAnnotation: customresource.ResourcePolicyKeep}
+				bucket.Status.ExportBucketID = testBucketID
+			}),
+			service: func(t *testing.T) exportbucket.ExportBucketService {
+				s := akomock.NewExportBucketServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testBucketID).Return(awsBucket(testRoleID), nil)
+				return s
+			},
+			wantBucketID: testBucketID,
+		},
+		{
+			title: "delete fails",
+			bucket: testBucket("AWS", func(bucket *akov2.AtlasBackupExportBucket) {
+				bucket.Finalizers = []string{customresource.FinalizerLabel}
+				bucket.DeletionTimestamp = &deletionTime
+				bucket.Status.ExportBucketID = testBucketID
+			}),
+			service: func(t *testing.T) exportbucket.ExportBucketService {
+				s := akomock.NewExportBucketServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testBucketID).Return(awsBucket(testRoleID), nil)
+				s.EXPECT().Delete(mock.Anything, testProjectID, testBucketID).Return(ErrTestFail)
+				return s
+			},
+			wantErr:        fmt.Errorf("failed to delete export bucket: %w", ErrTestFail),
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.BackupExportBucketNotDeleted)).
+					WithMessageRegexp("failed to delete export bucket: failure"),
+			},
+			wantBucketID: testBucketID,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			workflowCtx := &workflow.Context{
+				Context: context.Background(),
+			}
+			objects := []client.Object{tc.bucket}
+			if tc.role != nil {
+				objects = append(objects, tc.role)
+			}
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme(t)).
+				WithObjects(objects...).
+				Build()
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, logger)
+			result, err := r.handle(workflowCtx, &reconcileRequest{
+				projectID:    testProjectID,
+				exportBucket: tc.bucket,
+				service:      tc.service(t),
+			})
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantResult, result)
+			bucket := getExportBucket(t, workflowCtx.Context, k8sClient, client.ObjectKeyFromObject(tc.bucket))
+			assert.Equal(t, tc.wantFinalizers, bucket.GetFinalizers())
+			assert.Equal(t, cleanConditions(tc.wantConditions), cleanConditions(workflowCtx.Conditions()))
+
+			tc.bucket.UpdateStatus(nil, workflowCtx.StatusOptions()...)
+			assert.Equal(t, tc.wantBucketID, tc.bucket.Status.ExportBucketID)
+		})
+	}
+}
+
+func TestResolveExportBucketID(t *testing.T) {
+	for _, tc := range []struct {
+		title   string
+		bucket  *akov2.AtlasBackupExportBucket
+		wantID  string
+		wantErr string
+	}{
+		{
+			title: "created buckets resolve to their Atlas ID",
+			bucket: testBucket("AWS", func(bucket *akov2.AtlasBackupExportBucket) {
+				bucket.Status.ExportBucketID = testBucketID
+			}),
+			wantID: testBucketID,
+		},
+		{
+			title:   "buckets yet to be created fail",
+			bucket:  testBucket("AWS", nil),
+			wantErr: "AtlasBackupExportBucket default/export-bucket is not created in Atlas yet",
+		},
+		{
+			title:   "missing buckets fail",
+			wantErr: "failed to get AtlasBackupExportBucket default/export-bucket",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(testScheme(t))
+			if tc.bucket != nil {
+				builder = builder.WithObjects(tc.bucket)
+			}
+			id, err := ResolveExportBucketID(context.Background(), builder.Build(), &common.ResourceRefNamespaced{Name: "export-bucket"}, "default")
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantID, id)
+		})
+	}
+}
+
+func testBucket(cloudProvider string, modify func(*akov2.AtlasBackupExportBucket)) *akov2.AtlasBackupExportBucket {
+	bucket := &akov2.AtlasBackupExportBucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "export-bucket",
+			Namespace: "default",
+		},
+		Spec: akov2.AtlasBackupExportBucketSpec{
+			CloudProvider:          cloudProvider,
+			BucketName:             "snapshots",
+			CloudProviderAccessRef: common.ResourceRefNamespaced{Name: "bucket-role"},
+		},
+	}
+	if modify != nil {
+		modify(bucket)
+	}
+	return bucket
+}
+
+func testRole(providerName, authorizedRoleID string) *akov2.AtlasCloudProviderAccess {
+	return &akov2.AtlasCloudProviderAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bucket-role",
+			Namespace: "default",
+		},
+		Spec: akov2.AtlasCloudProviderAccessSpec{
+			ProviderName: providerName,
+		},
+		Status: status.AtlasCloudProviderAccessStatus{
+			RoleID:     authorizedRoleID,
+			Authorized: authorizedRoleID != "",
+		},
+	}
+}
+
+func awsBucket(roleID string) *exportbucket.ExportBucket {
+	return &exportbucket.ExportBucket{
+		ID:            testBucketID,
+		CloudProvider: "AWS",
+		BucketName:    "snapshots",
+		RoleID:        roleID,
+	}
+}
+
+func getExportBucket(t *testing.T, ctx context.Context, k8sClient client.Client, key client.ObjectKey) *akov2.AtlasBackupExportBucket {
+	bucket := &akov2.AtlasBackupExportBucket{}
+	if err := k8sClient.Get(ctx, key, bucket); err != nil && !k8serrors.IsNotFound(err) {
+		require.NoError(t, err)
+	}
+	return bucket
+}
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	return scheme
+}
+
+func testReconciler(k8sClient client.Client, provider atlas.Provider, logger *zap.Logger) *AtlasBackupExportBucketReconciler {
+	return &AtlasBackupExportBucketReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:        k8sClient,
+			Log:           logger.Sugar(),
+			AtlasProvider: provider,
+		},
+		EventRecorder: record.NewFakeRecorder(10),
+	}
+}
+
+func cleanConditions(inputs []api.Condition) []api.Condition {
+	outputs := make([]api.Condition, 0, len(inputs))
+	for _, condition := range inputs {
+		clean := condition
+		clean.LastTransitionTime = metav1.Time{}
+		outputs = append(outputs, clean)
+	}
+	return outputs
+}
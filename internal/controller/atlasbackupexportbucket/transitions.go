@@ -0,0 +1,139 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasbackupexportbucket
+
+import (
+	"errors"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/exportbucket"
+)
+
+func (r *AtlasBackupExportBucketReconciler) create(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	roleID, err := r.resolveRoleID(workflowCtx.Context, req.exportBucket)
+	if err != nil {
+		return r.terminate(workflowCtx, req.exportBucket, workflow.BackupExportBucketNotConfigured, err)
+	}
+	if roleID == "" {
+		return r.pendingCloudProviderAccess(workflowCtx, req.exportBucket)
+	}
+
+	createdBucket, err := req.service.Create(workflowCtx.Context, req.projectID, exportbucket.NewExportBucket(&req.exportBucket.Spec, roleID))
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to create export bucket: %w", err)
+		return r.terminate(workflowCtx, req.exportBucket, workflow.BackupExportBucketNotConfigured, wrappedErr)
+	}
+	return r.ready(workflowCtx, req.exportBucket, createdBucket)
+}
+
+func (r *AtlasBackupExportBucketReconciler) sync(workflowCtx *workflow.Context, req *reconcileRequest, atlasBucket *exportbucket.ExportBucket) (ctrl.Result, error) {
+	roleID, err := r.resolveRoleID(workflowCtx.Context, req.exportBucket)
+	if err != nil {
+		return r.terminate(workflowCtx, req.exportBucket, workflow.BackupExportBucketNotConfigured, err)
+	}
+	if roleID == "" {
+		return r.pendingCloudProviderAccess(workflowCtx, req.exportBucket)
+	}
+	if roleID != atlasBucket.RoleID {
+		// Atlas has no way to update an export bucket
+		err := fmt.Errorf("export bucket %s uses role %s in Atlas, which cannot be changed to %s: recreate the resource to use another role",
+			atlasBucket.ID, atlasBucket.RoleID, roleID)
+		return r.terminate(workflowCtx, req.exportBucket, workflow.BackupExportBucketRoleChanged, err)
+	}
+	return r.ready(workflowCtx, req.exportBucket, atlasBucket)
+}
+
+func (r *AtlasBackupExportBucketReconciler) delete(workflowCtx *workflow.Context, req *reconcileRequest, atlasBucket *exportbucket.ExportBucket) (ctrl.Result, error) {
+	if customresource.IsResourcePolicyKeepOrDefault(req.exportBucket, r.ObjectDeletionProtection) {
+		return r.unmanage(workflowCtx, req.exportBucket)
+	}
+	err := req.service.Delete(workflowCtx.Context, req.projectID, atlasBucket.ID)
+	if err != nil && !errors.Is(err, exportbucket.ErrNotFound) {
+		wrappedErr := fmt.Errorf("failed to delete export bucket: %w", err)
+		return r.terminate(workflowCtx, req.exportBucket, workflow.BackupExportBucketNotDeleted, wrappedErr)
+	}
+	return r.unmanage(workflowCtx, req.exportBucket)
+}
+
+func (r *AtlasBackupExportBucketReconciler) pendingCloudProviderAccess(workflowCtx *workflow.Context, exportBucket *akov2.AtlasBackupExportBucket) (ctrl.Result, error) {
+	result := workflow.InProgress(workflow.BackupExportBucketPendingCloudProviderAccess,
+		fmt.Sprintf("waiting for AtlasCloudProviderAccess %s to be authorized", exportBucket.Spec.CloudProviderAccessRef.GetObject(exportBucket.Namespace)))
+	workflowCtx.SetConditionFalse(api.ReadyType).
+		SetConditionFromResult(api.BackupExportBucketReady, result)
+
+	return result.ReconcileResult()
+}
+
+func (r *AtlasBackupExportBucketReconciler) ready(workflowCtx *workflow.Context, exportBucket *akov2.AtlasBackupExportBucket, atlasBucket *exportbucket.ExportBucket) (ctrl.Result, error) {
+	// record the export bucket ID first, it is the only way to find the bucket again
+	workflowCtx.EnsureStatusOption(updateExportBucketStatusOption(atlasBucket))
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, exportBucket, customresource.SetFinalizer); err != nil {
+		return r.terminate(workflowCtx, exportBucket, workflow.AtlasFinalizerNotSet, err)
+	}
+
+	workflowCtx.SetConditionTrueMsg(api.BackupExportBucketReady, fmt.Sprintf("Export bucket %s is ready", atlasBucket.ID)).
+		SetConditionTrue(api.ReadyType)
+
+	if exportBucket.Spec.ExternalProjectRef != nil {
+		return workflow.Requeue(r.independentSyncPeriod).ReconcileResult()
+	}
+
+	return workflow.OK().ReconcileResult()
+}
+
+func (r *AtlasBackupExportBucketReconciler) unmanage(workflowCtx *workflow.Context, exportBucket *akov2.AtlasBackupExportBucket) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, exportBucket, customresource.UnsetFinalizer); err != nil {
+		return r.terminate(workflowCtx, exportBucket, workflow.AtlasFinalizerNotRemoved, err)
+	}
+	return workflow.Deleted().ReconcileResult()
+}
+
+func (r *AtlasBackupExportBucketReconciler) release(workflowCtx *workflow.Context, exportBucket *akov2.AtlasBackupExportBucket, err error) (ctrl.Result, error) {
+	if errors.Is(err, reconciler.ErrMissingKubeProject) {
+		if finalizerErr := customresource.ManageFinalizer(workflowCtx.Context, r.Client, exportBucket, customresource.UnsetFinalizer); finalizerErr != nil {
+			err = errors.Join(err, finalizerErr)
+		}
+	}
+	return r.terminate(workflowCtx, exportBucket, workflow.BackupExportBucketNotConfigured, err)
+}
+
+func (r *AtlasBackupExportBucketReconciler) terminate(
+	ctx *workflow.Context,
+	resource api.AtlasCustomResource,
+	reason workflow.ConditionReason,
+	err error,
+) (ctrl.Result, error) {
+	condition := api.ReadyType
+	r.Log.Errorf("resource %T(%s/%s) failed on condition %s: %s",
+		resource, resource.GetNamespace(), resource.GetName(), condition, err)
+	result := workflow.Terminate(reason, err)
+	ctx.SetConditionFalse(api.ReadyType).SetConditionFromResult(condition, result)
+
+	return result.ReconcileResult()
+}
+
+func updateExportBucketStatusOption(bucket *exportbucket.ExportBucket) status.AtlasBackupExportBucketStatusOption {
+	return func(bucketStatus *status.AtlasBackupExportBucketStatus) {
+		exportbucket.ApplyStatus(bucketStatus, bucket)
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlascidrpool
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+// ErrPoolExhausted fails an allocation when the pool has no free block of the requested size
+var ErrPoolExhausted = errors.New("no free CIDR block left in the pool")
+
+// Allocator allocates the CIDR blocks of network containers from AtlasCIDRPools.
+// Pools are read from the API server rather than the cache, as they are shared by all operator shards.
+type Allocator struct {
+	Reader client.Reader
+	Client client.Client
+}
+
+func NewAllocator(c cluster.Cluster) *Allocator {
+	return &Allocator{
+		Reader: c.GetAPIReader(),
+		Client: c.GetClient(),
+	}
+}
+
+// Allocate returns the CIDR block allocated to the owner from the pool, allocating one when there is none yet.
+// A block retained for the owner is allocated again, so that a container taking over a kept one keeps its block.
+// The preferred block is allocated when it is free, so that a container keeps its block when its allocation was lost.
+// Blocks overlapping inUse, such as those of the existing Atlas containers, or being released are never allocated.
+// Allocations are persisted in the pool status, conflicting updates are retried.
+func (a *Allocator) Allocate(ctx context.Context, ref *akov2.CIDRPoolReference, owner client.ObjectKey, preferred string, inUse []string) (string, error) {
+	var allocated string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pool := &akov2.AtlasCIDRPool{}
+		if err := a.Reader.Get(ctx, client.ObjectKey{Name: ref.Name}, pool); err != nil {
+			return fmt.Errorf("failed to get AtlasCIDRPool %s: %w", ref.Name, err)
+		}
+		if allocation := find(pool.Status.Allocations, owner); allocation != nil {
+			allocated = allocation.CIDRBlock
+			return nil
+		}
+		if !pool.DeletionTimestamp.IsZero() {
+			return fmt.Errorf("AtlasCIDRPool %s is being deleted", ref.Name)
+		}
+		if retained := find(pool.Status.Retained, owner); retained != nil {
+			allocated = retained.CIDRBlock
+			status.WithCIDRPoolAllocations(append(slices.Clone(pool.Status.Allocations), *retained))(&pool.Status)
+			status.WithCIDRPoolRetained(without(pool.Status.Retained, owner))(&pool.Status)
+			return a.Client.Status().Update(ctx, pool)
+		}
+
+		ranges, err := parseCIDRs(pool.Spec.CIDRBlocks)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR blocks in AtlasCIDRPool %s: %w", ref.Name, err)
+		}
+		taken, err := parseCIDRs(pool.Spec.Reserved)
+		if err != nil {
+			return fmt.Errorf("invalid reserved CIDR blocks in AtlasCIDRPool %s: %w", ref.Name, err)
+		}
+		released, err := parseCIDRs(pool.Spec.Release)
+		if err != nil {
+			return fmt.Errorf("invalid released CIDR blocks in AtlasCIDRPool %s: %w", ref.Name, err)
+		}
+		taken = append(taken, released...)
+		for _, allocation := range slices.Concat(pool.Status.Allocations, pool.Status.Retained) {
+			if _, network, err := net.ParseCIDR(allocation.CIDRBlock); err == nil {
+				taken = append(taken, network)
+			}
+		}
+		for _, cidr := range inUse {
+			if _, network, err := net.ParseCIDR(cidr); err == nil {
+				taken = append(taken, network)
+			}
+		}
+
+		block := preferredBlock(ranges, ref.PrefixLength, taken, preferred)
+		if block == nil {
+			block = nextFreeBlock(ranges, ref.PrefixLength, taken)
+		}
+		if block == nil {
+			return fmt.Errorf("failed to allocate a /%d block from AtlasCIDRPool %s: %w", ref.PrefixLength, ref.Name, ErrPoolExhausted)
+		}
+
+		allocations := append(slices.Clone(pool.Status.Allocations), status.CIDRAllocation{
+			CIDRBlock: block.String(),
+			Namespace: owner.Namespace,
+			Name:      owner.Name,
+		})
+		status.WithCIDRPoolAllocations(allocations)(&pool.Status)
+		if err := a.Client.Status().Update(ctx, pool); err != nil {
+			return err
+		}
+		allocated = block.String()
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return allocated, nil
+}
+
+// Allocated returns the CIDR block allocated to the owner from the pool, empty when there is none
+func (a *Allocator) Allocated(ctx context.Context, ref *akov2.CIDRPoolReference, owner client.ObjectKey) (string, error) {
+	pool := &akov2.AtlasCIDRPool{}
+	if err := a.Reader.Get(ctx, client.ObjectKey{Name: ref.Name}, pool); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get AtlasCIDRPool %s: %w", ref.Name, err)
+	}
+	if allocation := find(pool.Status.Allocations, owner); allocation != nil {
+		return allocation.CIDRBlock, nil
+	}
+	return "", nil
+}
+
+// Release frees the CIDR block allocated to the owner from the pool, if any.
+// It must only be called once the container of the owner is gone from Atlas.
+func (a *Allocator) Release(ctx context.Context, ref *akov2.CIDRPoolReference, owner client.ObjectKey) error {
+	return a.updateAllocation(ctx, ref, owner, func(pool *akov2.AtlasCIDRPool, _ status.CIDRAllocation) {
+		status.WithCIDRPoolAllocations(without(pool.Status.Allocations, owner))(&pool.Status)
+	})
+}
+
+// Retain moves the CIDR block allocated to the owner to the retained blocks of the pool, if any,
+// so that it stays taken while the container of the owner is kept in Atlas
+func (a *Allocator) Retain(ctx context.Context, ref *akov2.CIDRPoolReference, owner client.ObjectKey) error {
+	return a.updateAllocation(ctx, ref, owner, func(pool *akov2.AtlasCIDRPool, allocation status.CIDRAllocation) {
+		status.WithCIDRPoolAllocations(without(pool.Status.Allocations, owner))(&pool.Status)
+		status.WithCIDRPoolRetained(append(without(pool.Status.Retained, owner), allocation))(&pool.Status)
+	})
+}
+
+// updateAllocation updates the pool status with the allocation of the owner, if any, retrying on conflicts
+func (a *Allocator) updateAllocation(ctx context.Context, ref *akov2.CIDRPoolReference, owner client.ObjectKey, update func(*akov2.AtlasCIDRPool, status.CIDRAllocation)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pool := &akov2.AtlasCIDRPool{}
+		if err := a.Reader.Get(ctx, client.ObjectKey{Name: ref.Name}, pool); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to get AtlasCIDRPool %s: %w", ref.Name, err)
+		}
+		allocation := find(pool.Status.Allocations, owner)
+		if allocation == nil {
+			return nil
+		}
+		update(pool, *allocation)
+		return a.Client.Status().Update(ctx, pool)
+	})
+}
+
+// without returns a copy of the allocations without the one of the owner
+func without(allocations []status.CIDRAllocation, owner client.ObjectKey) []status.CIDRAllocation {
+	return slices.DeleteFunc(slices.Clone(allocations), func(allocation status.CIDRAllocation) bool {
+		return allocation.Namespace == owner.Namespace && allocation.Name == owner.Name
+	})
+}
+
+func find(allocations []status.CIDRAllocation, owner client.ObjectKey) *status.CIDRAllocation {
+	for i := range allocations {
+		if allocations[i].Namespace == owner.Namespace && allocations[i].Name == owner.Name {
+			return &allocations[i]
+		}
+	}
+	return nil
+}
+
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		ip, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		if ip.To4() == nil {
+			return nil, fmt.Errorf("%s is not an IPv4 CIDR block", cidr)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// preferredBlock returns the preferred block when it is a free block of the given size within the ranges
+func preferredBlock(ranges []*net.IPNet, prefixLength int, taken []*net.IPNet, preferred string) *net.IPNet {
+	if preferred == "" {
+		return nil
+	}
+	ip, block, err := net.ParseCIDR(preferred)
+	if err != nil || !ip.Equal(block.IP) {
+		return nil
+	}
+	if ones, bits := block.Mask.Size(); ones != prefixLength || bits != 32 {
+		return nil
+	}
+	if !slices.ContainsFunc(ranges, func(r *net.IPNet) bool { return contains(r, block) }) || overlapsAny(block, taken) {
+		return nil
+	}
+	return block
+}
+
+// nextFreeBlock returns the first block of the given size within the ranges not overlapping any taken block
+func nextFreeBlock(ranges []*net.IPNet, prefixLength int, taken []*net.IPNet) *net.IPNet {
+	size := uint64(1) << (32 - prefixLength)
+	for _, r := range ranges {
+		ones, _ := r.Mask.Size()
+		if prefixLength < ones {
+			continue
+		}
+		start := uint64(binary.BigEndian.Uint32(r.IP.To4()))
+		end := start + uint64(1)<<(32-ones)
+		for candidate := start; candidate < end; candidate += size {
+			block := &net.IPNet{IP: make(net.IP, net.IPv4len), Mask: net.CIDRMask(prefixLength, 32)}
+			binary.BigEndian.PutUint32(block.IP, uint32(candidate))
+			if !overlapsAny(block, taken) {
+				return block
+			}
+		}
+	}
+	return nil
+}
+
+func contains(outer, inner *net.IPNet) bool {
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+func overlapsAny(block *net.IPNet, taken []*net.IPNet) bool {
+	return slices.ContainsFunc(taken, func(t *net.IPNet) bool {
+		return t.Contains(block.IP) || block.Contains(t.IP)
+	})
+}
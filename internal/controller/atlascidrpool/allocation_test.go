@@ -0,0 +1,252 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlascidrpool
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+)
+
+func TestNextFreeBlock(t *testing.T) {
+	for _, tc := range []struct {
+		title        string
+		ranges       []string
+		prefixLength int
+		taken        []string
+		want         string
+	}{
+		{
+			title:        "first block of an empty pool",
+			ranges:       []string{"10.64.0.0/12"},
+			prefixLength: 21,
+			want:         "10.64.0.0/21",
+		},
+		{
+			title:        "skips taken and overlapping blocks",
+			ranges:       []string{"10.64.0.0/12"},
+			prefixLength: 21,
+			taken:        []string{"10.64.0.0/21", "10.64.12.0/24"},
+			want:         "10.64.16.0/21",
+		},
+		{
+			title:        "skips blocks within a larger taken block",
+			ranges:       []string{"10.64.0.0/12"},
+			prefixLength: 24,
+			taken:        []string{"10.64.0.0/16"},
+			want:         "10.65.0.0/24",
+		},
+		{
+			title:        "moves to the next range when one is full",
+			ranges:       []string{"10.64.0.0/23", "172.16.0.0/16"},
+			prefixLength: 23,
+			taken:        []string{"10.64.0.0/23"},
+			want:         "172.16.0.0/23",
+		},
+		{
+			title:        "skips ranges smaller than the requested block",
+			ranges:       []string{"10.64.0.0/24", "172.16.0.0/16"},
+			prefixLength: 21,
+			want:         "172.16.0.0/21",
+		},
+		{
+			title:        "nothing when the pool is exhausted",
+			ranges:       []string{"10.64.0.0/22"},
+			prefixLength: 23,
+			taken:        []string{"10.64.0.0/23", "10.64.2.0/24"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			ranges, err := parseCIDRs(tc.ranges)
+			require.NoError(t, err)
+			taken, err := parseCIDRs(tc.taken)
+			require.NoError(t, err)
+			block := nextFreeBlock(ranges, tc.prefixLength, taken)
+			if tc.want == "" {
+				assert.Nil(t, block)
+				return
+			}
+			require.NotNil(t, block)
+			assert.Equal(t, tc.want, block.String())
+		})
+	}
+}
+
+func TestPreferredBlock(t *testing.T) {
+	ranges, err := parseCIDRs([]string{"10.64.0.0/12"})
+	require.NoError(t, err)
+	taken, err := parseCIDRs([]string{"10.64.0.0/21"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		title     string
+		preferred string
+		want      string
+	}{
+		{title: "no preference"},
+		{title: "free block", preferred: "10.64.8.0/21", want: "10.64.8.0/21"},
+		{title: "taken block", preferred: "10.64.0.0/21"},
+		{title: "block out of the pool", preferred: "10.128.0.0/21"},
+		{title: "block of another size", preferred: "10.64.8.0/22"},
+		{title: "misaligned block", preferred: "10.64.9.0/21"},
+		{title: "invalid block", preferred: "not-a-cidr"},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			block := preferredBlock(ranges, 21, taken, tc.preferred)
+			if tc.want == "" {
+				assert.Nil(t, block)
+				return
+			}
+			require.NotNil(t, block)
+			assert.Equal(t, tc.want, block.String())
+		})
+	}
+}
+
+func TestParseCIDRs(t *testing.T) {
+	networks, err := parseCIDRs([]string{"10.64.1.0/16"})
+	require.NoError(t, err)
+	assert.Equal(t, []*net.IPNet{{IP: net.IP{10, 64, 0, 0}, Mask: net.CIDRMask(16, 32)}}, networks)
+
+	_, err = parseCIDRs([]string{"fd00::/8"})
+	assert.ErrorContains(t, err, "fd00::/8 is not an IPv4 CIDR block")
+
+	_, err = parseCIDRs([]string{"10.64.0.0"})
+	assert.Error(t, err)
+}
+
+func TestAllocator(t *testing.T) {
+	ctx := context.Background()
+	pool := &akov2.AtlasCIDRPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "corporate"},
+		Spec: akov2.AtlasCIDRPoolSpec{
+			CIDRBlocks: []string{"10.64.0.0/12"},
+			Reserved:   []string{"10.64.0.0/16"},
+		},
+		Status: status.AtlasCIDRPoolStatus{
+			Allocations: []status.CIDRAllocation{
+				{CIDRBlock: "10.65.0.0/21", Namespace: "ns", Name: "existing"},
+			},
+			AllocatedCount: 1,
+		},
+	}
+	k8sClient := newFakeClient(t, pool)
+	allocator := &Allocator{Reader: k8sClient, Client: k8sClient}
+	ref := &akov2.CIDRPoolReference{Name: "corporate", PrefixLength: 21}
+	owner := client.ObjectKey{Namespace: "ns", Name: "container"}
+
+	allocated, err := allocator.Allocated(ctx, ref, owner)
+	require.NoError(t, err)
+	assert.Empty(t, allocated)
+
+	allocated, err = allocator.Allocate(ctx, ref, owner, "", []string{"10.65.8.0/24"})
+	require.NoError(t, err)
+	assert.Equal(t, "10.65.16.0/21", allocated)
+
+	again, err := allocator.Allocate(ctx, ref, owner, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, allocated, again, "allocations must be stable")
+
+	other, err := allocator.Allocate(ctx, ref, client.ObjectKey{Namespace: "ns", Name: "other"}, "10.72.0.0/21", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "10.72.0.0/21", other, "a free preferred block must be allocated")
+
+	stored := &akov2.AtlasCIDRPool{}
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Name: "corporate"}, stored))
+	assert.Equal(t, []status.CIDRAllocation{
+		{CIDRBlock: "10.65.0.0/21", Namespace: "ns", Name: "existing"},
+		{CIDRBlock: "10.65.16.0/21", Namespace: "ns", Name: "container"},
+		{CIDRBlock: "10.72.0.0/21", Namespace: "ns", Name: "other"},
+	}, stored.Status.Allocations)
+	assert.Equal(t, 3, stored.Status.AllocatedCount)
+
+	require.NoError(t, allocator.Release(ctx, ref, owner))
+	require.NoError(t, allocator.Release(ctx, ref, owner), "releasing twice must be a no-op")
+	allocated, err = allocator.Allocated(ctx, ref, owner)
+	require.NoError(t, err)
+	assert.Empty(t, allocated)
+
+	kept := client.ObjectKey{Namespace: "ns", Name: "other"}
+	require.NoError(t, allocator.Retain(ctx, ref, kept))
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Name: "corporate"}, stored))
+	assert.Equal(t, []status.CIDRAllocation{{CIDRBlock: "10.72.0.0/21", Namespace: "ns", Name: "other"}}, stored.Status.Retained)
+	allocated, err = allocator.Allocated(ctx, ref, kept)
+	require.NoError(t, err)
+	assert.Empty(t, allocated, "a retained block must not be allocated")
+
+	next, err := allocator.Allocate(ctx, ref, owner, "10.72.0.0/21", nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, "10.72.0.0/21", next, "a retained block must never be allocated to another container")
+	require.NoError(t, allocator.Release(ctx, ref, owner))
+
+	again, err = allocator.Allocate(ctx, ref, kept, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "10.72.0.0/21", again, "a container of the same name must take its retained block back")
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKey{Name: "corporate"}, stored))
+	assert.Empty(t, stored.Status.Retained)
+
+	require.NoError(t, allocator.Release(ctx, &akov2.CIDRPoolReference{Name: "missing"}, owner))
+	_, err = allocator.Allocate(ctx, &akov2.CIDRPoolReference{Name: "missing", PrefixLength: 21}, owner, "", nil)
+	assert.ErrorContains(t, err, "failed to get AtlasCIDRPool missing")
+}
+
+func TestAllocatorExhausted(t *testing.T) {
+	pool := &akov2.AtlasCIDRPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "small"},
+		Spec:       akov2.AtlasCIDRPoolSpec{CIDRBlocks: []string{"10.64.0.0/21"}},
+	}
+	k8sClient := newFakeClient(t, pool)
+	allocator := &Allocator{Reader: k8sClient, Client: k8sClient}
+
+	_, err := allocator.Allocate(context.Background(), &akov2.CIDRPoolReference{Name: "small", PrefixLength: 21}, client.ObjectKey{Namespace: "ns", Name: "container"}, "", []string{"10.64.4.0/24"})
+	assert.ErrorIs(t, err, ErrPoolExhausted)
+}
+
+func TestAllocatorSkipsReleasedBlocks(t *testing.T) {
+	pool := &akov2.AtlasCIDRPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "corporate"},
+		Spec: akov2.AtlasCIDRPoolSpec{
+			CIDRBlocks: []string{"10.64.0.0/20"},
+			Release:    []string{"10.64.0.0/21"},
+		},
+	}
+	k8sClient := newFakeClient(t, pool)
+	allocator := &Allocator{Reader: k8sClient, Client: k8sClient}
+
+	allocated, err := allocator.Allocate(context.Background(), &akov2.CIDRPoolReference{Name: "corporate", PrefixLength: 21}, client.ObjectKey{Namespace: "ns", Name: "container"}, "10.64.0.0/21", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "10.64.8.0/21", allocated, "a block being released must not be allocated")
+}
+
+func newFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+	testScheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(testScheme))
+	return fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&akov2.AtlasCIDRPool{}).
+		Build()
+}
@@ -0,0 +1,257 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlascidrpool
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+)
+
+// AtlasCIDRPoolReconciler keeps the allocations of an AtlasCIDRPool in line with the AtlasNetworkContainers using it.
+// The allocations themselves are made and released by the AtlasNetworkContainer controller.
+type AtlasCIDRPoolReconciler struct {
+	Client           client.Client
+	APIReader        client.Reader
+	Scheme           *runtime.Scheme
+	GlobalPredicates []predicate.Predicate
+	Log              *zap.SugaredLogger
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlascidrpools,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlascidrpools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlascidrpools/finalizers,verbs=update
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasnetworkcontainers,verbs=get;list;watch
+
+func (r *AtlasCIDRPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.With("atlascidrpool", req.Name)
+	log.Infow("-> Starting AtlasCIDRPool reconciliation")
+
+	pool := &akov2.AtlasCIDRPool{}
+	result := customresource.PrepareResource(ctx, r.Client, req, pool, log)
+	if !result.IsOk() {
+		return result.ReconcileResult()
+	}
+
+	if customresource.ReconciliationShouldBeSkipped(pool) {
+		log.Infow(fmt.Sprintf("-> Skipping AtlasCIDRPool reconciliation as annotation %s=%s", customresource.ReconciliationPolicyAnnotation, customresource.ReconciliationPolicySkip), "spec", pool.Spec)
+		return workflow.OK().ReconcileResult()
+	}
+
+	conditions := api.InitCondition(pool, api.FalseCondition(api.ReadyType))
+	workflowCtx := workflow.NewContext(log, conditions, ctx, pool)
+
+	res, err := r.handle(workflowCtx, pool)
+	// allocations are updated concurrently by the network containers, the status is never patched over them
+	if statusErr := r.updateStatus(workflowCtx, pool); statusErr != nil {
+		if apierrors.IsConflict(statusErr) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		log.Errorf("Failed to update status: %s", statusErr)
+	}
+	return res, err
+}
+
+func (r *AtlasCIDRPoolReconciler) handle(workflowCtx *workflow.Context, pool *akov2.AtlasCIDRPool) (ctrl.Result, error) {
+	isValid := customresource.ValidateResourceVersion(workflowCtx, pool, r.Log)
+	if !isValid.IsOk() {
+		return isValid.ReconcileResult()
+	}
+
+	if _, err := parseCIDRs(pool.Spec.CIDRBlocks); err != nil {
+		return r.invalidate(workflowCtx, fmt.Errorf("invalid CIDR blocks: %w", err))
+	}
+	if _, err := parseCIDRs(pool.Spec.Reserved); err != nil {
+		return r.invalidate(workflowCtx, fmt.Errorf("invalid reserved CIDR blocks: %w", err))
+	}
+	if _, err := parseCIDRs(pool.Spec.Release); err != nil {
+		return r.invalidate(workflowCtx, fmt.Errorf("invalid released CIDR blocks: %w", err))
+	}
+
+	// the cache of a shard only holds its own containers, the pool is shared by all of them
+	containers := &akov2.AtlasNetworkContainerList{}
+	if err := r.APIReader.List(workflowCtx.Context, containers); err != nil {
+		return r.terminate(workflowCtx, workflow.Internal, err)
+	}
+	owners := map[client.ObjectKey]struct{}{}
+	for _, container := range containers.Items {
+		if container.Spec.CIDRPoolRef != nil && container.Spec.CIDRPoolRef.Name == pool.Name {
+			owners[client.ObjectKeyFromObject(&container)] = struct{}{}
+		}
+	}
+	// the containers of removed owners may still be in Atlas, as the operator did not delete them
+	var stale []status.CIDRAllocation
+	for _, allocation := range pool.Status.Allocations {
+		if _, ok := owners[client.ObjectKey{Namespace: allocation.Namespace, Name: allocation.Name}]; !ok {
+			r.Log.Infof("retaining %s allocated to the removed AtlasNetworkContainer %s/%s", allocation.CIDRBlock, allocation.Namespace, allocation.Name)
+			stale = append(stale, allocation)
+		}
+	}
+	workflowCtx.EnsureStatusOption(retainAllocations(stale))
+	for _, retained := range slices.Concat(pool.Status.Retained, stale) {
+		if slices.Contains(pool.Spec.Release, retained.CIDRBlock) {
+			r.Log.Infof("releasing %s retained for the removed AtlasNetworkContainer %s/%s", retained.CIDRBlock, retained.Namespace, retained.Name)
+		}
+	}
+	workflowCtx.EnsureStatusOption(releaseRetained(pool.Spec.Release))
+
+	if allocated := len(pool.Status.Allocations) - len(stale); allocated > 0 {
+		return r.lock(workflowCtx, pool, allocated)
+	}
+	return r.release(workflowCtx, pool)
+}
+
+// updateStatus fails on conflicts, so that allocations made since the pool was read are never dropped
+func (r *AtlasCIDRPoolReconciler) updateStatus(workflowCtx *workflow.Context, pool *akov2.AtlasCIDRPool) error {
+	poolCopy := pool.DeepCopy()
+	poolCopy.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+	if reflect.DeepEqual(pool.Status, poolCopy.Status) {
+		return nil
+	}
+	if err := r.Client.Status().Update(workflowCtx.Context, poolCopy); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// retainAllocations moves the given allocations only to the retained blocks, the status may hold newer ones
+func retainAllocations(stale []status.CIDRAllocation) status.AtlasCIDRPoolStatusOption {
+	return func(s *status.AtlasCIDRPoolStatus) {
+		allocations := slices.DeleteFunc(slices.Clone(s.Allocations), func(allocation status.CIDRAllocation) bool {
+			return slices.Contains(stale, allocation)
+		})
+		status.WithCIDRPoolAllocations(allocations)(s)
+		status.WithCIDRPoolRetained(append(slices.Clone(s.Retained), stale...))(s)
+	}
+}
+
+// releaseRetained frees the given blocks from the retained blocks, their containers are gone from Atlas
+func releaseRetained(release []string) status.AtlasCIDRPoolStatusOption {
+	return func(s *status.AtlasCIDRPoolStatus) {
+		retained := slices.DeleteFunc(slices.Clone(s.Retained), func(allocation status.CIDRAllocation) bool {
+			return slices.Contains(release, allocation.CIDRBlock)
+		})
+		status.WithCIDRPoolRetained(retained)(s)
+	}
+}
+
+// lock keeps the pool from being removed while blocks are allocated from it
+func (r *AtlasCIDRPoolReconciler) lock(ctx *workflow.Context, pool *akov2.AtlasCIDRPool, allocated int) (ctrl.Result, error) {
+	if !customresource.HaveFinalizer(pool, customresource.FinalizerLabel) {
+		if err := customresource.ManageFinalizer(ctx.Context, r.Client, pool, customresource.SetFinalizer); err != nil {
+			return r.terminate(ctx, workflow.AtlasFinalizerNotSet, err)
+		}
+	}
+	if !pool.DeletionTimestamp.IsZero() {
+		return r.terminate(ctx, workflow.CIDRPoolInUse, fmt.Errorf("%d CIDR blocks are still allocated to network containers", allocated))
+	}
+	return r.ready(ctx)
+}
+
+func (r *AtlasCIDRPoolReconciler) release(ctx *workflow.Context, pool *akov2.AtlasCIDRPool) (ctrl.Result, error) {
+	if customresource.HaveFinalizer(pool, customresource.FinalizerLabel) {
+		if err := customresource.ManageFinalizer(ctx.Context, r.Client, pool, customresource.UnsetFinalizer); err != nil {
+			return r.terminate(ctx, workflow.AtlasFinalizerNotRemoved, err)
+		}
+	}
+	if !pool.DeletionTimestamp.IsZero() {
+		return workflow.Deleted().ReconcileResult()
+	}
+	return r.ready(ctx)
+}
+
+func (r *AtlasCIDRPoolReconciler) ready(ctx *workflow.Context) (ctrl.Result, error) {
+	result := workflow.OK()
+	ctx.SetConditionFromResult(api.ReadyType, result)
+	return result.ReconcileResult()
+}
+
+func (r *AtlasCIDRPoolReconciler) invalidate(ctx *workflow.Context, err error) (ctrl.Result, error) {
+	r.Log.Error(err)
+	invalid := workflow.Terminate(workflow.CIDRPoolInvalid, err).WithoutRetry()
+	ctx.SetConditionFromResult(api.ReadyType, invalid)
+	return invalid.ReconcileResult()
+}
+
+func (r *AtlasCIDRPoolReconciler) terminate(ctx *workflow.Context, reason workflow.ConditionReason, err error) (ctrl.Result, error) {
+	r.Log.Error(err)
+	terminated := workflow.Terminate(reason, err)
+	ctx.SetConditionFromResult(api.ReadyType, terminated)
+	return terminated.ReconcileResult()
+}
+
+func (r *AtlasCIDRPoolReconciler) For() (client.Object, builder.Predicates) {
+	return &akov2.AtlasCIDRPool{}, builder.WithPredicates(r.GlobalPredicates...)
+}
+
+func (r *AtlasCIDRPoolReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("AtlasCIDRPool").
+		For(r.For()).
+		Watches(
+			&akov2.AtlasNetworkContainer{},
+			handler.EnqueueRequestsFromMapFunc(r.poolForContainer),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		WithOptions(options).
+		Complete(r)
+}
+
+func (r *AtlasCIDRPoolReconciler) poolForContainer(_ context.Context, obj client.Object) []reconcile.Request {
+	container, ok := obj.(*akov2.AtlasNetworkContainer)
+	if !ok {
+		r.Log.Warnf("watching AtlasNetworkContainer but got %T", obj)
+		return nil
+	}
+
+	if container.Spec.CIDRPoolRef == nil {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: container.Spec.CIDRPoolRef.Name}}}
+}
+
+func NewAtlasCIDRPoolReconciler(
+	c cluster.Cluster,
+	predicates []predicate.Predicate,
+	logger *zap.Logger,
+) *AtlasCIDRPoolReconciler {
+	return &AtlasCIDRPoolReconciler{
+		Scheme:           c.GetScheme(),
+		Client:           c.GetClient(),
+		APIReader:        c.GetAPIReader(),
+		GlobalPredicates: predicates,
+		Log:              logger.Named("controllers").Named("AtlasCIDRPool").Sugar(),
+	}
+}
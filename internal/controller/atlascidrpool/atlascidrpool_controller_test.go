@@ -0,0 +1,210 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlascidrpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+)
+
+func TestReconcile(t *testing.T) {
+	container := &akov2.AtlasNetworkContainer{
+		ObjectMeta: metav1.ObjectMeta{Name: "container", Namespace: "ns"},
+		Spec: akov2.AtlasNetworkContainerSpec{
+			CIDRPoolRef: &akov2.CIDRPoolReference{Name: "corporate", PrefixLength: 21},
+		},
+	}
+	otherPoolContainer := &akov2.AtlasNetworkContainer{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns"},
+		Spec: akov2.AtlasNetworkContainerSpec{
+			CIDRPoolRef: &akov2.CIDRPoolReference{Name: "other", PrefixLength: 21},
+		},
+	}
+	allocation := status.CIDRAllocation{CIDRBlock: "10.64.0.0/21", Namespace: "ns", Name: "container"}
+	staleAllocation := status.CIDRAllocation{CIDRBlock: "10.64.8.0/21", Namespace: "ns", Name: "other"}
+	deletionTimestamp := metav1.NewTime(time.Now())
+
+	for _, tc := range []struct {
+		name    string
+		pool    *akov2.AtlasCIDRPool
+		objects []client.Object
+
+		wantErr         string
+		wantCondition   api.Condition
+		wantAllocations []status.CIDRAllocation
+		wantRetained    []status.CIDRAllocation
+		wantFinalizers  []string
+		wantDeleted     bool
+	}{
+		{
+			name: "should be ready without allocations",
+			pool: &akov2.AtlasCIDRPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "corporate"},
+				Spec:       akov2.AtlasCIDRPoolSpec{CIDRBlocks: []string{"10.64.0.0/12"}},
+			},
+			wantCondition: api.TrueCondition(api.ReadyType),
+		},
+		{
+			name: "should keep allocations of existing containers and set the finalizer",
+			pool: &akov2.AtlasCIDRPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "corporate"},
+				Spec:       akov2.AtlasCIDRPoolSpec{CIDRBlocks: []string{"10.64.0.0/12"}},
+				Status: status.AtlasCIDRPoolStatus{
+					Allocations: []status.CIDRAllocation{allocation, staleAllocation},
+				},
+			},
+			objects:         []client.Object{container, otherPoolContainer},
+			wantCondition:   api.TrueCondition(api.ReadyType),
+			wantAllocations: []status.CIDRAllocation{allocation},
+			wantRetained:    []status.CIDRAllocation{staleAllocation},
+			wantFinalizers:  []string{customresource.FinalizerLabel},
+		},
+		{
+			name: "should retain allocations of removed containers and release the finalizer",
+			pool: &akov2.AtlasCIDRPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "corporate", Finalizers: []string{customresource.FinalizerLabel}},
+				Spec:       akov2.AtlasCIDRPoolSpec{CIDRBlocks: []string{"10.64.0.0/12"}},
+				Status: status.AtlasCIDRPoolStatus{
+					Allocations: []status.CIDRAllocation{allocation},
+					Retained:    []status.CIDRAllocation{staleAllocation},
+				},
+			},
+			wantCondition: api.TrueCondition(api.ReadyType),
+			wantRetained:  []status.CIDRAllocation{staleAllocation, allocation},
+		},
+		{
+			name: "should free the retained blocks listed for release",
+			pool: &akov2.AtlasCIDRPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "corporate", Finalizers: []string{customresource.FinalizerLabel}},
+				Spec: akov2.AtlasCIDRPoolSpec{
+					CIDRBlocks: []string{"10.64.0.0/12"},
+					Release:    []string{staleAllocation.CIDRBlock, "10.72.0.0/21"},
+				},
+				Status: status.AtlasCIDRPoolStatus{
+					Allocations: []status.CIDRAllocation{allocation},
+					Retained:    []status.CIDRAllocation{staleAllocation},
+				},
+			},
+			objects:         []client.Object{container},
+			wantCondition:   api.TrueCondition(api.ReadyType),
+			wantAllocations: []status.CIDRAllocation{allocation},
+			wantFinalizers:  []string{customresource.FinalizerLabel},
+		},
+		{
+			name: "should not be deleted while blocks are allocated",
+			pool: &akov2.AtlasCIDRPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "corporate",
+					Finalizers:        []string{customresource.FinalizerLabel},
+					DeletionTimestamp: &deletionTimestamp,
+				},
+				Spec: akov2.AtlasCIDRPoolSpec{CIDRBlocks: []string{"10.64.0.0/12"}},
+				Status: status.AtlasCIDRPoolStatus{
+					Allocations: []status.CIDRAllocation{allocation},
+				},
+			},
+			objects:         []client.Object{container},
+			wantErr:         "1 CIDR blocks are still allocated to network containers",
+			wantCondition:   api.FalseCondition(api.ReadyType).WithReason("CIDRPoolInUse").WithMessageRegexp("1 CIDR blocks are still allocated to network containers"),
+			wantAllocations: []status.CIDRAllocation{allocation},
+			wantFinalizers:  []string{customresource.FinalizerLabel},
+		},
+		{
+			name: "should be deleted once no block is allocated",
+			pool: &akov2.AtlasCIDRPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "corporate",
+					Finalizers:        []string{customresource.FinalizerLabel},
+					DeletionTimestamp: &deletionTimestamp,
+				},
+				Spec: akov2.AtlasCIDRPoolSpec{CIDRBlocks: []string{"10.64.0.0/12"}},
+			},
+			wantDeleted: true,
+		},
+		{
+			name: "should fail on invalid CIDR blocks",
+			pool: &akov2.AtlasCIDRPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "corporate"},
+				Spec:       akov2.AtlasCIDRPoolSpec{CIDRBlocks: []string{"10.64.0.0/12"}, Reserved: []string{"fd00::/8"}},
+			},
+			wantCondition: api.FalseCondition(api.ReadyType).WithReason("CIDRPoolInvalid").WithMessageRegexp("invalid reserved CIDR blocks: fd00::/8 is not an IPv4 CIDR block"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := newFakeClient(t, append(tc.objects, tc.pool)...)
+			r := &AtlasCIDRPoolReconciler{
+				Client:    k8sClient,
+				APIReader: k8sClient,
+				Log:       zaptest.NewLogger(t).Sugar(),
+			}
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "corporate"}})
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			pool := &akov2.AtlasCIDRPool{}
+			getErr := k8sClient.Get(context.Background(), client.ObjectKey{Name: "corporate"}, pool)
+			if tc.wantDeleted {
+				assert.True(t, apierrors.IsNotFound(getErr), "the pool must be removed")
+				return
+			}
+			require.NoError(t, getErr)
+			var ready *api.Condition
+			for i := range pool.Status.Conditions {
+				if pool.Status.Conditions[i].Type == api.ReadyType {
+					ready = &pool.Status.Conditions[i]
+				}
+			}
+			require.NotNil(t, ready)
+			assert.Equal(t, tc.wantCondition.Status, ready.Status)
+			assert.Equal(t, tc.wantCondition.Reason, ready.Reason)
+			assert.Regexp(t, tc.wantCondition.Message, ready.Message)
+			assert.Equal(t, tc.wantAllocations, pool.Status.Allocations)
+			assert.Equal(t, len(tc.wantAllocations), pool.Status.AllocatedCount)
+			assert.Equal(t, tc.wantRetained, pool.Status.Retained)
+			assert.Equal(t, tc.wantFinalizers, pool.Finalizers)
+		})
+	}
+}
+
+func TestPoolForContainer(t *testing.T) {
+	r := &AtlasCIDRPoolReconciler{Log: zaptest.NewLogger(t).Sugar()}
+
+	assert.Nil(t, r.poolForContainer(context.Background(), &akov2.AtlasNetworkContainer{}))
+	assert.Nil(t, r.poolForContainer(context.Background(), &akov2.AtlasProject{}))
+	assert.Equal(t,
+		[]ctrl.Request{{NamespacedName: client.ObjectKey{Name: "corporate"}}},
+		r.poolForContainer(context.Background(), &akov2.AtlasNetworkContainer{
+			Spec: akov2.AtlasNetworkContainerSpec{CIDRPoolRef: &akov2.CIDRPoolReference{Name: "corporate"}},
+		}),
+	)
+}
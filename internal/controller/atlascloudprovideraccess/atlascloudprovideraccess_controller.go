@@ -0,0 +1,137 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlascloudprovideraccess
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+)
+
+// AtlasCloudProviderAccessReconciler reconciles a AtlasCloudProviderAccess object
+type AtlasCloudProviderAccessReconciler struct {
+	reconciler.AtlasReconciler
+	Scheme                   *runtime.Scheme
+	EventRecorder            record.EventRecorder
+	GlobalPredicates         []predicate.Predicate
+	ObjectDeletionProtection bool
+	independentSyncPeriod    time.Duration
+}
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlascloudprovideraccesses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlascloudprovideraccesses/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlascloudprovideraccesses/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile Atlas Cloud Provider Access resources
+func (r *AtlasCloudProviderAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.Log.Infow("-> Starting AtlasCloudProviderAccess reconciliation")
+
+	cloudProviderAccess := akov2.AtlasCloudProviderAccess{}
+	result := customresource.PrepareResource(ctx, r.Client, req, &cloudProviderAccess, r.Log)
+	if !result.IsOk() {
+		return result.ReconcileResult()
+	}
+	return r.handleCustomResource(ctx, &cloudProviderAccess)
+}
+
+// For prepares the controller for its target Custom Resource; Cloud Provider Access roles
+func (r *AtlasCloudProviderAccessReconciler) For() (client.Object, builder.Predicates) {
+	return &akov2.AtlasCloudProviderAccess{}, builder.WithPredicates(r.GlobalPredicates...)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AtlasCloudProviderAccessReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.For()).
+		Owns(&corev1.ConfigMap{}).
+		Owns(&corev1.Secret{}).
+		Watches(
+			&akov2.AtlasProject{},
+			handler.EnqueueRequestsFromMapFunc(r.cloudProviderAccessForProjectMapFunc()),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.cloudProviderAccessForCredentialMapFunc()),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		WithOptions(options).
+		Complete(r)
+}
+
+func (r *AtlasCloudProviderAccessReconciler) cloudProviderAccessForProjectMapFunc() handler.MapFunc {
+	return indexer.ProjectsIndexMapperFunc(
+		indexer.AtlasCloudProviderAccessByProjectIndex,
+		func() *akov2.AtlasCloudProviderAccessList { return &akov2.AtlasCloudProviderAccessList{} },
+		indexer.CloudProviderAccessRequests,
+		r.Client,
+		r.Log,
+	)
+}
+
+func (r *AtlasCloudProviderAccessReconciler) cloudProviderAccessForCredentialMapFunc() handler.MapFunc {
+	return indexer.CredentialsIndexMapperFunc(
+		indexer.AtlasCloudProviderAccessCredentialsIndex,
+		func() *akov2.AtlasCloudProviderAccessList { return &akov2.AtlasCloudProviderAccessList{} },
+		indexer.CloudProviderAccessRequests,
+		r.Client,
+		r.Log,
+	)
+}
+
+func NewAtlasCloudProviderAccessReconciler(
+	c cluster.Cluster,
+	predicates []predicate.Predicate,
+	atlasProvider atlas.Provider,
+	deletionProtection bool,
+	logger *zap.Logger,
+	independentSyncPeriod time.Duration,
+	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
+) *AtlasCloudProviderAccessReconciler {
+	return &AtlasCloudProviderAccessReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:              c.GetClient(),
+			Log:                 logger.Named("controllers").Named("AtlasCloudProviderAccess").Sugar(),
+			GlobalSecretRef:     globalSecretRef,
+			CredentialProviders: credentialProviders,
+			AtlasProvider:       atlasProvider,
+		},
+		Scheme:                   c.GetScheme(),
+		EventRecorder:            c.GetEventRecorderFor("AtlasCloudProviderAccess"),
+		GlobalPredicates:         predicates,
+		ObjectDeletionProtection: deletionProtection,
+		independentSyncPeriod:    independentSyncPeriod,
+	}
+}
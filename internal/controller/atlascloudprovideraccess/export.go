@@ -0,0 +1,78 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlascloudprovideraccess
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/cloudprovideraccess"
+)
+
+// export writes the Atlas side identifiers of the role into the ConfigMap or Secret
+// requested by the resource, which owns the exported object
+func (r *AtlasCloudProviderAccessReconciler) export(ctx context.Context, cloudProviderAccess *akov2.AtlasCloudProviderAccess, atlasRole *cloudprovideraccess.Role) error {
+	exportTo := cloudProviderAccess.Spec.ExportTo
+	if exportTo == nil {
+		return nil
+	}
+
+	meta := metav1.ObjectMeta{Name: exportTo.Name, Namespace: cloudProviderAccess.Namespace}
+	exports := atlasRole.Exports()
+	var obj client.Object
+	var mutate func()
+	switch exportTo.Kind {
+	case akov2.CloudProviderAccessExportSecret:
+		secret := &corev1.Secret{ObjectMeta: meta}
+		obj = secret
+		mutate = func() {
+			// only labelled Secrets are visible to the operator when watching all namespaces
+			if secret.Labels == nil {
+				secret.Labels = map[string]string{}
+			}
+			secret.Labels[connectionsecret.TypeLabelKey] = connectionsecret.ExportLabelVal
+			secret.Data = make(map[string][]byte, len(exports))
+			for key, value := range exports {
+				secret.Data[key] = []byte(value)
+			}
+		}
+	default:
+		configMap := &corev1.ConfigMap{ObjectMeta: meta}
+		obj = configMap
+		mutate = func() {
+			configMap.Data = exports
+		}
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+		// objects the resource did not create are never overwritten
+		if obj.GetResourceVersion() != "" && !metav1.IsControlledBy(obj, cloudProviderAccess) {
+			return fmt.Errorf("it already exists and is not controlled by AtlasCloudProviderAccess %s", cloudProviderAccess.Name)
+		}
+		mutate()
+		return controllerutil.SetControllerReference(cloudProviderAccess, obj, r.Client.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write %s %s: %w", exportTo.Kind, client.ObjectKeyFromObject(obj), err)
+	}
+	return nil
+}
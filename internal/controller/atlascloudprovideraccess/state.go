@@ -0,0 +1,114 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlascloudprovideraccess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/cloudprovideraccess"
+)
+
+const (
+	typeName = "AtlasCloudProviderAccess"
+)
+
+type reconcileRequest struct {
+	projectID           string
+	cloudProviderAccess *akov2.AtlasCloudProviderAccess
+	service             cloudprovideraccess.CloudProviderAccessService
+}
+
+func (r *AtlasCloudProviderAccessReconciler) handleCustomResource(ctx context.Context, cloudProviderAccess *akov2.AtlasCloudProviderAccess) (ctrl.Result, error) {
+	if customresource.ReconciliationShouldBeSkipped(cloudProviderAccess) {
+		return r.Skip(ctx, typeName, cloudProviderAccess, cloudProviderAccess.Spec)
+	}
+
+	conditions := api.InitCondition(cloudProviderAccess, api.FalseCondition(api.ReadyType))
+	workflowCtx := workflow.NewContext(r.Log, conditions, ctx, cloudProviderAccess)
+	defer statushandler.Update(workflowCtx, r.Client, r.EventRecorder, cloudProviderAccess)
+
+	isValid := customresource.ValidateResourceVersion(workflowCtx, cloudProviderAccess, r.Log)
+	if !isValid.IsOk() {
+		return r.Invalidate(typeName, isValid)
+	}
+
+	connectionConfig, err := r.ResolveConnectionConfig(ctx, cloudProviderAccess)
+	if err != nil {
+		return r.release(workflowCtx, cloudProviderAccess, err)
+	}
+
+	if !r.AtlasProvider.IsResourceSupported(cloudProviderAccess, connectionConfig.Credentials) {
+		return r.Unsupport(workflowCtx, typeName)
+	}
+
+	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
+	if err != nil {
+		return r.terminate(workflowCtx, cloudProviderAccess, workflow.CloudProviderAccessNotConfigured, err)
+	}
+	project, err := r.ResolveProject(ctx, sdkClientSet.SdkClient20250312002, cloudProviderAccess)
+	if err != nil {
+		return r.release(workflowCtx, cloudProviderAccess, err)
+	}
+	return r.handle(workflowCtx, &reconcileRequest{
+		projectID:           project.ID,
+		cloudProviderAccess: cloudProviderAccess,
+		service:             cloudprovideraccess.NewCloudProviderAccessServiceFromClientSet(sdkClientSet),
+	})
+}
+
+func (r *AtlasCloudProviderAccessReconciler) handle(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	atlasRole, err := discover(workflowCtx.Context, req)
+	if err != nil {
+		return r.terminate(workflowCtx, req.cloudProviderAccess, workflow.CloudProviderAccessNotConfigured, err)
+	}
+	inAtlas := atlasRole != nil
+	deleted := req.cloudProviderAccess.DeletionTimestamp != nil
+	switch {
+	case !deleted && !inAtlas:
+		return r.create(workflowCtx, req)
+	case !deleted && inAtlas:
+		return r.sync(workflowCtx, req, atlasRole)
+	case deleted && inAtlas:
+		return r.delete(workflowCtx, req, atlasRole)
+	default: // deleted && !inAtlas:
+		return r.unmanage(workflowCtx, req.cloudProviderAccess)
+	}
+}
+
+// discover finds the role created for this resource, roles have no name in Atlas so
+// they can only be tracked by the ID recorded in the status
+func discover(ctx context.Context, req *reconcileRequest) (*cloudprovideraccess.Role, error) {
+	id := req.cloudProviderAccess.Status.RoleID
+	if id == "" {
+		return nil, nil
+	}
+	role, err := req.service.Get(ctx, req.projectID, id)
+	if errors.Is(err, cloudprovideraccess.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cloud provider access role %s from project %s: %w", id, req.projectID, err)
+	}
+	return role, nil
+}
@@ -0,0 +1,531 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlascloudprovideraccess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	akomock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/cloudprovideraccess"
+)
+
+var (
+	// sample error test
+	ErrTestFail = errors.New("failure")
+)
+
+const (
+	testRoleID  = "role-id"
+	testRoleArn = "arn:aws:iam::123456789012:role/atlas"
+)
+
+func TestHandleCustomResource(t *testing.T) {
+	for _, tc := range []struct {
+		title          string
+		provider       atlas.Provider
+		annotations    map[string]string
+		wantResult     ctrl.Result
+		wantConditions []api.Condition
+	}{
+		{
+			title: "should skip reconciliation",
+			annotations: map[string]string{
+				customresource.ReconciliationPolicyAnnotation: customresource.ReconciliationPolicySkip,
+			},
+		},
+		{
+			title: "should fail when not supported",
+			provider: &atlasmock.TestProvider{
+				IsSupportedFunc: func() bool {
+					return false
+				},
+			},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).
+					WithReason(string(workflow.AtlasGovUnsupported)).
+					WithMessageRegexp("the AtlasCloudProviderAccess is not supported by Atlas for government"),
+				api.TrueCondition(api.ResourceVersionStatus),
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			cpa := &akov2.AtlasCloudProviderAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cloud-provider-access",
+					Namespace:   "default",
+					Annotations: tc.annotations,
+				},
+				Spec: akov2.AtlasCloudProviderAccessSpec{
+					ProjectDualReference: akov2.ProjectDualReference{
+						ConnectionSecret: &api.LocalObjectReference{Name: "my-secret"},
+					},
+					ProviderName: "GCP",
+				},
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-secret",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"orgId":         []byte("orgId"),
+					"publicApiKey":  []byte("publicApiKey"),
+					"privateApiKey": []byte("privateApiKey"),
+				},
+			}
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme(t)).
+				WithObjects(cpa, secret).
+				WithStatusSubresource(cpa).
+				Build()
+			ctx := context.Background()
+			r := testReconciler(k8sClient, tc.provider, zaptest.NewLogger(t))
+			result, err := r.handleCustomResource(ctx, cpa)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, result)
+			got := getCloudProviderAccess(t, ctx, k8sClient, client.ObjectKeyFromObject(cpa))
+			assert.Equal(t, cleanConditions(tc.wantConditions), cleanConditions(got.Status.GetConditions()))
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	deletionTime := metav1.Now()
+	authorizedDate := time.Now()
+	logger := zaptest.NewLogger(t)
+	for _, tc := range []struct {
+		title          string
+		cpa            *akov2.AtlasCloudProviderAccess
+		service        func(t *testing.T) cloudprovideraccess.CloudProviderAccessService
+		wantResult     ctrl.Result
+		wantErr        error
+		wantFinalizers []string
+		wantConditions []api.Condition
+		wantConfigMap  map[string]string
+		wantSecret     map[string][]byte
+	}{
+		{
+			title: "AWS create without a role ARN exports and waits for authorization",
+			cpa: testCPA("AWS", func(cpa *akov2.AtlasCloudProviderAccess) {
+				cpa.Spec.ExportTo = &akov2.CloudProviderAccessExport{Kind: akov2.CloudProviderAccessExportConfigMap, Name: "aws-role"}
+			}),
+			service: func(t *testing.T) cloudprovideraccess.CloudProviderAccessService {
+				s := akomock.NewCloudProviderAccessServiceMock(t)
+				s.EXPECT().Create(mock.Anything, testProjectID, &cloudprovideraccess.Role{ProviderName: "AWS"}).
+					Return(awsRole(nil), nil)
+				return s
+			},
+			wantResult:     ctrl.Result{RequeueAfter: workflow.DefaultRetry},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType),
+				api.FalseCondition(api.CloudProviderAccessReady).
+					WithReason(string(workflow.CloudProviderAccessPendingAuthorization)).
+					WithMessageRegexp("waiting for the IAM role ARN to authorize the role"),
+			},
+			wantConfigMap: map[string]string{
+				"providerName":               "AWS",
+				"roleId":                     testRoleID,
+				"atlasAWSAccountArn":         "arn:aws:iam::000000000000:root",
+				"atlasAssumedRoleExternalId": "external-id",
+			},
+		},
+		{
+			title: "AWS role is authorized once the ARN is set",
+			cpa: testCPA("AWS", func(cpa *akov2.AtlasCloudProviderAccess) {
+				cpa.Spec.AWS = &akov2.AWSCloudProviderAccess{IamAssumedRoleArn: testRoleArn}
+				cpa.Status.RoleID = testRoleID
+			}),
+			service: func(t *testing.T) cloudprovideraccess.CloudProviderAccessService {
+				s := akomock.NewCloudProviderAccessServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testRoleID).Return(awsRole(nil), nil)
+				s.EXPECT().Authorize(mock.Anything, testProjectID, testRoleID, &cloudprovideraccess.Role{ProviderName: "AWS", IamAssumedRoleArn: testRoleArn}).
+					Return(awsRole(&authorizedDate), nil)
+				return s
+			},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.CloudProviderAccessReady).
+					WithMessageRegexp(fmt.Sprintf("Cloud Provider Access role %s is authorized", testRoleID)),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title: "AWS role already authorized is ready",
+			cpa: testCPA("AWS", func(cpa *akov2.AtlasCloudProviderAccess) {
+				cpa.Spec.AWS = &akov2.AWSCloudProviderAccess{IamAssumedRoleArn: testRoleArn}
+				cpa.Status.RoleID = testRoleID
+			}),
+			service: func(t *testing.T) cloudprovideraccess.CloudProviderAccessService {
+				s := akomock.NewCloudProviderAccessServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testRoleID).Return(awsRole(&authorizedDate), nil)
+				return s
+			},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.CloudProviderAccessReady).
+					WithMessageRegexp(fmt.Sprintf("Cloud Provider Access role %s is authorized", testRoleID)),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title: "AWS authorization failure",
+			cpa: testCPA("AWS", func(cpa *akov2.AtlasCloudProviderAccess) {
+				cpa.Spec.AWS = &akov2.AWSCloudProviderAccess{IamAssumedRoleArn: testRoleArn}
+				cpa.Status.RoleID = testRoleID
+			}),
+			service: func(t *testing.T) cloudprovideraccess.CloudProviderAccessService {
+				s := akomock.NewCloudProviderAccessServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testRoleID).Return(awsRole(nil), nil)
+				s.EXPECT().Authorize(mock.Anything, testProjectID, testRoleID, mock.Anything).Return(nil, ErrTestFail)
+				return s
+			},
+			wantErr: fmt.Errorf("failed to authorize cloud provider access role: %w", ErrTestFail),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.CloudProviderAccessNotAuthorized)).
+					WithMessageRegexp("failed to authorize cloud provider access role: failure"),
+			},
+		},
+		{
+			title: "GCP create is authorized right away and exported to a Secret",
+			cpa: testCPA("GCP", func(cpa *akov2.AtlasCloudProviderAccess) {
+				cpa.Spec.ExportTo = &akov2.CloudProviderAccessExport{Kind: akov2.CloudProviderAccessExportSecret, Name: "gcp-role"}
+			}),
+			service: func(t *testing.T) cloudprovideraccess.CloudProviderAccessService {
+				s := akomock.NewCloudProviderAccessServiceMock(t)
+				created := &cloudprovideraccess.Role{ProviderName: "GCP", RoleID: testRoleID, GCPServiceAccountForAtlas: "atlas@gcp"}
+				s.EXPECT().Create(mock.Anything, testProjectID, &cloudprovideraccess.Role{ProviderName: "GCP"}).Return(created, nil)
+				authorized := *created
+				authorized.AuthorizedDate = &authorizedDate
+				s.EXPECT().Authorize(mock.Anything, testProjectID, testRoleID, &cloudprovideraccess.Role{ProviderName: "GCP"}).
+					Return(&authorized, nil)
+				return s
+			},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.CloudProviderAccessReady).
+					WithMessageRegexp(fmt.Sprintf("Cloud Provider Access role %s is authorized", testRoleID)),
+				api.TrueCondition(api.ReadyType),
+			},
+			wantSecret: map[string][]byte{
+				"providerName":              []byte("GCP"),
+				"roleId":                    []byte(testRoleID),
+				"gcpServiceAccountForAtlas": []byte("atlas@gcp"),
+			},
+		},
+		{
+			title: "Azure role is authorized again when the service principal changes",
+			cpa: testCPA("AZURE", func(cpa *akov2.AtlasCloudProviderAccess) {
+				cpa.Spec.Azure = &akov2.AzureCloudProviderAccess{AtlasAzureAppID: "app", ServicePrincipalID: "new-principal", TenantID: "tenant"}
+				cpa.Status.RoleID = testRoleID
+			}),
+			service: func(t *testing.T) cloudprovideraccess.CloudProviderAccessService {
+				s := akomock.NewCloudProviderAccessServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testRoleID).Return(&cloudprovideraccess.Role{
+					ProviderName: "AZURE", RoleID: testRoleID, AtlasAzureAppID: "app", ServicePrincipalID: "old-principal", TenantID: "tenant", AuthorizedDate: &authorizedDate,
+				}, nil)
+				s.EXPECT().Authorize(mock.Anything, testProjectID, testRoleID, mock.Anything).Return(&cloudprovideraccess.Role{
+					ProviderName: "AZURE", RoleID: testRoleID, AtlasAzureAppID: "app", ServicePrincipalID: "new-principal", TenantID: "tenant", AuthorizedDate: &authorizedDate,
+				}, nil)
+				return s
+			},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.CloudProviderAccessReady).
+					WithMessageRegexp(fmt.Sprintf("Cloud Provider Access role %s is authorized", testRoleID)),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title: "create fails",
+			cpa:   testCPA("GCP", nil),
+			service: func(t *testing.T) cloudprovideraccess.CloudProviderAccessService {
+				s := akomock.NewCloudProviderAccessServiceMock(t)
+				s.EXPECT().Create(mock.Anything, testProjectID, mock.Anything).Return(nil, ErrTestFail)
+				return s
+			},
+			wantErr: fmt.Errorf("failed to create cloud provider access role: %w", ErrTestFail),
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.CloudProviderAccessNotConfigured)).
+					WithMessageRegexp("failed to create cloud provider access role: failure"),
+			},
+		},
+		{
+			title: "role missing in Atlas is created again",
+			cpa: testCPA("GCP", func(cpa *akov2.AtlasCloudProviderAccess) {
+				cpa.Status.RoleID = "gone"
+			}),
+			service: func(t *testing.T) cloudprovideraccess.CloudProviderAccessService {
+				s := akomock.NewCloudProviderAccessServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, "gone").Return(nil, cloudprovideraccess.ErrNotFound)
+				s.EXPECT().Create(mock.Anything, testProjectID, mock.Anything).Return(
+					&cloudprovideraccess.Role{ProviderName: "GCP", RoleID: testRoleID, AuthorizedDate: &authorizedDate}, nil)
+				return s
+			},
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.TrueCondition(api.CloudProviderAccessReady).
+					WithMessageRegexp(fmt.Sprintf("Cloud Provider Access role %s is authorized", testRoleID)),
+				api.TrueCondition(api.ReadyType),
+			},
+		},
+		{
+			title: "delete deauthorizes the role",
+			cpa: testCPA("AWS", func(cpa *akov2.AtlasCloudProviderAccess) {
+				cpa.Finalizers = []string{customresource.FinalizerLabel}
+				cpa.DeletionTimestamp = &deletionTime
+				cpa.Status.RoleID = testRoleID
+			}),
+			service: func(t *testing.T) cloudprovideraccess.CloudProviderAccessService {
+				s := akomock.NewCloudProviderAccessServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testRoleID).Return(awsRole(&authorizedDate), nil)
+				s.EXPECT().Deauthorize(mock.Anything, testProjectID, "AWS", testRoleID).Return(nil)
+				return s
+			},
+		},
+		{
+			title: "delete keeps the role when protected",
+			cpa: testCPA("AWS", func(cpa *akov2.AtlasCloudProviderAccess) {
+				cpa.Finalizers = []string{customresource.FinalizerLabel}
+				cpa.DeletionTimestamp = &deletionTime
+				cpa.Annotations = map[string]string{customresource.ResourcePolicyAnnotation: customresource.ResourcePolicyKeep}
+				cpa.Status.RoleID = testRoleID
+			}),
+			service: func(t *testing.T) cloudprovideraccess.CloudProviderAccessService {
+				s := akomock.NewCloudProviderAccessServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testRoleID).Return(awsRole(&authorizedDate), nil)
+				return s
+			},
+		},
+		{
+			title: "delete fails",
+			cpa: testCPA("AWS", func(cpa *akov2.AtlasCloudProviderAccess) {
+				cpa.Finalizers = []string{customresource.FinalizerLabel}
+				cpa.DeletionTimestamp = &deletionTime
+				cpa.Status.RoleID = testRoleID
+			}),
+			service: func(t *testing.T) cloudprovideraccess.CloudProviderAccessService {
+				s := akomock.NewCloudProviderAccessServiceMock(t)
+				s.EXPECT().Get(mock.Anything, testProjectID, testRoleID).Return(awsRole(&authorizedDate), nil)
+				s.EXPECT().Deauthorize(mock.Anything, testProjectID, "AWS", testRoleID).Return(ErrTestFail)
+				return s
+			},
+			wantErr:        fmt.Errorf("failed to deauthorize cloud provider access role: %w", ErrTestFail),
+			wantFinalizers: []string{customresource.FinalizerLabel},
+			wantConditions: []api.Condition{
+				api.FalseCondition(api.ReadyType).WithReason(string(workflow.CloudProviderAccessNotDeleted)).
+					WithMessageRegexp("failed to deauthorize cloud provider access role: failure"),
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			workflowCtx := &workflow.Context{
+				Context: context.Background(),
+			}
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme(t)).
+				WithObjects(tc.cpa).
+				Build()
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, logger)
+			result, err := r.handle(workflowCtx, &reconcileRequest{
+				projectID:           testProjectID,
+				cloudProviderAccess: tc.cpa,
+				service:             tc.service(t),
+			})
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tc.wantErr.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantResult, result)
+			cpa := getCloudProviderAccess(t, workflowCtx.Context, k8sClient, client.ObjectKeyFromObject(tc.cpa))
+			assert.Equal(t, tc.wantFinalizers, cpa.GetFinalizers())
+			assert.Equal(t, cleanConditions(tc.wantConditions), cleanConditions(workflowCtx.Conditions()))
+
+			if tc.wantConfigMap != nil {
+				configMap := &corev1.ConfigMap{}
+				require.NoError(t, k8sClient.Get(workflowCtx.Context, client.ObjectKey{Name: tc.cpa.Spec.ExportTo.Name, Namespace: "default"}, configMap))
+				assert.Equal(t, tc.wantConfigMap, configMap.Data)
+				assertOwnedBy(t, tc.cpa, configMap)
+			}
+			if tc.wantSecret != nil {
+				secret := &corev1.Secret{}
+				require.NoError(t, k8sClient.Get(workflowCtx.Context, client.ObjectKey{Name: tc.cpa.Spec.ExportTo.Name, Namespace: "default"}, secret))
+				assert.Equal(t, tc.wantSecret, secret.Data)
+				assert.Equal(t, connectionsecret.ExportLabelVal, secret.Labels[connectionsecret.TypeLabelKey])
+				assertOwnedBy(t, tc.cpa, secret)
+			}
+		})
+	}
+}
+
+func TestExportConflict(t *testing.T) {
+	for _, tc := range []struct {
+		title   string
+		kind    string
+		taken   client.Object
+		wantErr string
+	}{
+		{
+			title: "objects controlled by another owner are left untouched",
+			kind:  akov2.CloudProviderAccessExportConfigMap,
+			taken: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "taken",
+					Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{
+						{APIVersion: "v1", Kind: "Pod", Name: "other", UID: "other-uid", Controller: pointer.MakePtr(true)},
+					},
+				},
+				Data: map[string]string{"user": "data"},
+			},
+			wantErr: "failed to write ConfigMap default/taken",
+		},
+		{
+			title: "unowned objects are left untouched",
+			kind:  akov2.CloudProviderAccessExportSecret,
+			taken: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "taken", Namespace: "default"},
+				Data:       map[string][]byte{"user": []byte("data")},
+			},
+			wantErr: "failed to write Secret default/taken: it already exists and is not controlled by AtlasCloudProviderAccess cloud-provider-access",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			cpa := testCPA("GCP", func(cpa *akov2.AtlasCloudProviderAccess) {
+				cpa.Spec.ExportTo = &akov2.CloudProviderAccessExport{Kind: tc.kind, Name: "taken"}
+			})
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme(t)).
+				WithObjects(cpa, tc.taken).
+				Build()
+			before := tc.taken.DeepCopyObject().(client.Object)
+			require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tc.taken), before))
+
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			err := r.export(context.Background(), cpa, &cloudprovideraccess.Role{ProviderName: "GCP", RoleID: testRoleID})
+			assert.ErrorContains(t, err, tc.wantErr)
+
+			after := tc.taken.DeepCopyObject().(client.Object)
+			require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tc.taken), after))
+			assert.Equal(t, before, after)
+		})
+	}
+}
+
+func testCPA(providerName string, modify func(*akov2.AtlasCloudProviderAccess)) *akov2.AtlasCloudProviderAccess {
+	cpa := &akov2.AtlasCloudProviderAccess{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cloud-provider-access",
+			Namespace: "default",
+			UID:       "cpa-uid",
+		},
+		Spec: akov2.AtlasCloudProviderAccessSpec{
+			ProviderName: providerName,
+		},
+		Status: status.AtlasCloudProviderAccessStatus{},
+	}
+	if modify != nil {
+		modify(cpa)
+	}
+	return cpa
+}
+
+func awsRole(authorizedDate *time.Time) *cloudprovideraccess.Role {
+	role := &cloudprovideraccess.Role{
+		ProviderName:               "AWS",
+		RoleID:                     testRoleID,
+		AtlasAWSAccountArn:         "arn:aws:iam::000000000000:root",
+		AtlasAssumedRoleExternalID: "external-id",
+		AuthorizedDate:             authorizedDate,
+	}
+	if authorizedDate != nil {
+		role.IamAssumedRoleArn = testRoleArn
+	}
+	return role
+}
+
+func assertOwnedBy(t *testing.T, cpa *akov2.AtlasCloudProviderAccess, obj client.Object) {
+	require.Len(t, obj.GetOwnerReferences(), 1)
+	owner := obj.GetOwnerReferences()[0]
+	assert.Equal(t, "AtlasCloudProviderAccess", owner.Kind)
+	assert.Equal(t, cpa.Name, owner.Name)
+	assert.True(t, *owner.Controller)
+}
+
+func getCloudProviderAccess(t *testing.T, ctx context.Context, k8sClient client.Client, key client.ObjectKey) *akov2.AtlasCloudProviderAccess {
+	cpa := &akov2.AtlasCloudProviderAccess{}
+	if err := k8sClient.Get(ctx, key, cpa); err != nil && !k8serrors.IsNotFound(err) {
+		require.NoError(t, err)
+	}
+	return cpa
+}
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	return scheme
+}
+
+func testReconciler(k8sClient client.Client, provider atlas.Provider, logger *zap.Logger) *AtlasCloudProviderAccessReconciler {
+	return &AtlasCloudProviderAccessReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:        k8sClient,
+			Log:           logger.Sugar(),
+			AtlasProvider: provider,
+		},
+		EventRecorder: record.NewFakeRecorder(10),
+	}
+}
+
+func cleanConditions(inputs []api.Condition) []api.Condition {
+	outputs := make([]api.Condition, 0, len(inputs))
+	for _, condition := range inputs {
+		clean := condition
+		clean.LastTransitionTime = metav1.Time{}
+		outputs = append(outputs, clean)
+	}
+	return outputs
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlascloudprovideraccess
+
+import (
+	"errors"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/cloudprovideraccess"
+)
+
+func (r *AtlasCloudProviderAccessReconciler) create(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	createdRole, err := req.service.Create(workflowCtx.Context, req.projectID, cloudprovideraccess.NewRole(&req.cloudProviderAccess.Spec))
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to create cloud provider access role: %w", err)
+		return r.terminate(workflowCtx, req.cloudProviderAccess, workflow.CloudProviderAccessNotConfigured, wrappedErr)
+	}
+	// record the role ID right away, it is the only way to find the role again
+	workflowCtx.EnsureStatusOption(updateCloudProviderAccessStatusOption(createdRole))
+	return r.sync(workflowCtx, req, createdRole)
+}
+
+func (r *AtlasCloudProviderAccessReconciler) sync(workflowCtx *workflow.Context, req *reconcileRequest, atlasRole *cloudprovideraccess.Role) (ctrl.Result, error) {
+	if err := r.export(workflowCtx.Context, req.cloudProviderAccess, atlasRole); err != nil {
+		wrappedErr := fmt.Errorf("failed to export cloud provider access role: %w", err)
+		return r.terminate(workflowCtx, req.cloudProviderAccess, workflow.CloudProviderAccessNotExported, wrappedErr)
+	}
+
+	desiredRole := cloudprovideraccess.NewRole(&req.cloudProviderAccess.Spec)
+	if desiredRole.ProviderName == string(provider.ProviderAWS) && desiredRole.IamAssumedRoleArn == "" {
+		return r.pendingAuthorization(workflowCtx, req.cloudProviderAccess, atlasRole)
+	}
+	if needsAuthorization(desiredRole, atlasRole) {
+		return r.authorize(workflowCtx, req, atlasRole.RoleID, desiredRole)
+	}
+	return r.ready(workflowCtx, req.cloudProviderAccess, atlasRole)
+}
+
+func (r *AtlasCloudProviderAccessReconciler) authorize(workflowCtx *workflow.Context, req *reconcileRequest, roleID string, desiredRole *cloudprovideraccess.Role) (ctrl.Result, error) {
+	authorizedRole, err := req.service.Authorize(workflowCtx.Context, req.projectID, roleID, desiredRole)
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to authorize cloud provider access role: %w", err)
+		return r.terminate(workflowCtx, req.cloudProviderAccess, workflow.CloudProviderAccessNotAuthorized, wrappedErr)
+	}
+	return r.ready(workflowCtx, req.cloudProviderAccess, authorizedRole)
+}
+
+func (r *AtlasCloudProviderAccessReconciler) delete(workflowCtx *workflow.Context, req *reconcileRequest, atlasRole *cloudprovideraccess.Role) (ctrl.Result, error) {
+	if customresource.IsResourcePolicyKeepOrDefault(req.cloudProviderAccess, r.ObjectDeletionProtection) {
+		return r.unmanage(workflowCtx, req.cloudProviderAccess)
+	}
+	err := req.service.Deauthorize(workflowCtx.Context, req.projectID, atlasRole.ProviderName, atlasRole.RoleID)
+	if err != nil && !errors.Is(err, cloudprovideraccess.ErrNotFound) {
+		wrappedErr := fmt.Errorf("failed to deauthorize cloud provider access role: %w", err)
+		return r.terminate(workflowCtx, req.cloudProviderAccess, workflow.CloudProviderAccessNotDeleted, wrappedErr)
+	}
+	return r.unmanage(workflowCtx, req.cloudProviderAccess)
+}
+
+func (r *AtlasCloudProviderAccessReconciler) pendingAuthorization(workflowCtx *workflow.Context, cloudProviderAccess *akov2.AtlasCloudProviderAccess, atlasRole *cloudprovideraccess.Role) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, cloudProviderAccess, customresource.SetFinalizer); err != nil {
+		return r.terminate(workflowCtx, cloudProviderAccess, workflow.AtlasFinalizerNotSet, err)
+	}
+
+	result := workflow.InProgress(workflow.CloudProviderAccessPendingAuthorization, "waiting for the IAM role ARN to authorize the role")
+	workflowCtx.SetConditionFalse(api.ReadyType).
+		SetConditionFromResult(api.CloudProviderAccessReady, result).
+		EnsureStatusOption(updateCloudProviderAccessStatusOption(atlasRole))
+
+	return result.ReconcileResult()
+}
+
+func (r *AtlasCloudProviderAccessReconciler) ready(workflowCtx *workflow.Context, cloudProviderAccess *akov2.AtlasCloudProviderAccess, atlasRole *cloudprovideraccess.Role) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, cloudProviderAccess, customresource.SetFinalizer); err != nil {
+		return r.terminate(workflowCtx, cloudProviderAccess, workflow.AtlasFinalizerNotSet, err)
+	}
+
+	workflowCtx.SetConditionTrueMsg(api.CloudProviderAccessReady, fmt.Sprintf("Cloud Provider Access role %s is authorized", atlasRole.RoleID)).
+		SetConditionTrue(api.ReadyType).EnsureStatusOption(updateCloudProviderAccessStatusOption(atlasRole))
+
+	if cloudProviderAccess.Spec.ExternalProjectRef != nil {
+		return workflow.Requeue(r.independentSyncPeriod).ReconcileResult()
+	}
+
+	return workflow.OK().ReconcileResult()
+}
+
+func (r *AtlasCloudProviderAccessReconciler) unmanage(workflowCtx *workflow.Context, cloudProviderAccess *akov2.AtlasCloudProviderAccess) (ctrl.Result, error) {
+	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, cloudProviderAccess, customresource.UnsetFinalizer); err != nil {
+		return r.terminate(workflowCtx, cloudProviderAccess, workflow.AtlasFinalizerNotRemoved, err)
+	}
+	return workflow.Deleted().ReconcileResult()
+}
+
+func (r *AtlasCloudProviderAccessReconciler) release(workflowCtx *workflow.Context, cloudProviderAccess *akov2.AtlasCloudProviderAccess, err error) (ctrl.Result, error) {
+	if errors.Is(err, reconciler.ErrMissingKubeProject) {
+		if finalizerErr := customresource.ManageFinalizer(workflowCtx.Context, r.Client, cloudProviderAccess, customresource.UnsetFinalizer); finalizerErr != nil {
+			err = errors.Join(err, finalizerErr)
+		}
+	}
+	return r.terminate(workflowCtx, cloudProviderAccess, workflow.CloudProviderAccessNotConfigured, err)
+}
+
+func (r *AtlasCloudProviderAccessReconciler) terminate(
+	ctx *workflow.Context,
+	resource api.AtlasCustomResource,
+	reason workflow.ConditionReason,
+	err error,
+) (ctrl.Result, error) {
+	condition := api.ReadyType
+	r.Log.Errorf("resource %T(%s/%s) failed on condition %s: %s",
+		resource, resource.GetNamespace(), resource.GetName(), condition, err)
+	result := workflow.Terminate(reason, err)
+	ctx.SetConditionFalse(api.ReadyType).SetConditionFromResult(condition, result)
+
+	return result.ReconcileResult()
+}
+
+func needsAuthorization(desired, atlasRole *cloudprovideraccess.Role) bool {
+	if !atlasRole.Authorized() {
+		return true
+	}
+	switch provider.ProviderName(desired.ProviderName) {
+	case provider.ProviderAWS:
+		return desired.IamAssumedRoleArn != atlasRole.IamAssumedRoleArn
+	case provider.ProviderAzure:
+		return desired.AtlasAzureAppID != atlasRole.AtlasAzureAppID ||
+			desired.ServicePrincipalID != atlasRole.ServicePrincipalID ||
+			desired.TenantID != atlasRole.TenantID
+	}
+	return false
+}
+
+func updateCloudProviderAccessStatusOption(role *cloudprovideraccess.Role) status.AtlasCloudProviderAccessStatusOption {
+	return func(cpaStatus *status.AtlasCloudProviderAccessStatus) {
+		cloudprovideraccess.ApplyStatus(cpaStatus, role)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlascollection
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mongodb"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
+)
+
+func (h *AtlasCollectionHandler) HandleInitial(ctx context.Context, collection *akov2.AtlasCollection) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateInitial, state.StateCreated, collection)
+}
+
+func (h *AtlasCollectionHandler) HandleCreated(ctx context.Context, collection *akov2.AtlasCollection) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateCreated, state.StateUpdated, collection)
+}
+
+func (h *AtlasCollectionHandler) HandleUpdated(ctx context.Context, collection *akov2.AtlasCollection) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateUpdated, state.StateUpdated, collection)
+}
+
+// HandleDeletionRequested releases the collection, its data is never dropped by the operator
+func (h *AtlasCollectionHandler) HandleDeletionRequested(_ context.Context, collection *akov2.AtlasCollection) (ctrlstate.Result, error) {
+	return result.NextState(state.StateDeleted,
+		fmt.Sprintf("Released collection %s.%s, its data is kept in the deployment", collection.Spec.Database, collection.Spec.Name))
+}
+
+func (h *AtlasCollectionHandler) upsert(ctx context.Context, currentState, nextState state.ResourceState, collection *akov2.AtlasCollection) (ctrlstate.Result, error) {
+	desired, err := mongodb.NewCollection(&collection.Spec)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+	indexes, err := mongodb.NewIndexes(collection.Spec.Indexes)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+
+	connURL, err := h.connectionURL(ctx, collection)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+	service, err := h.clients.Get(ctx, connURL)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+
+	created, err := service.EnsureCollection(ctx, desired)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+	changes, err := service.EnsureIndexes(ctx, desired.Database, desired.Name, indexes, collection.Status.Indexes)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+	if err := h.recordStatus(ctx, collection, desired, indexes); err != nil {
+		return result.Error(currentState, err)
+	}
+
+	action := "Synced"
+	if created {
+		action = "Created"
+	}
+	return result.NextState(nextState, fmt.Sprintf("%s collection %s.%s, created %d and dropped %d indexes",
+		action, desired.Database, desired.Name, len(changes.Created), len(changes.Dropped)))
+}
+
+func (h *AtlasCollectionHandler) recordStatus(ctx context.Context, collection *akov2.AtlasCollection, desired *mongodb.Collection, indexes []mongodb.Index) error {
+	observed := status.AtlasCollectionStatus{
+		UnifiedStatus: collection.Status.UnifiedStatus,
+		Type:          desired.Type(),
+	}
+	for _, index := range indexes {
+		observed.Indexes = append(observed.Indexes, index.Name)
+	}
+	slices.Sort(observed.Indexes)
+	if equality.Semantic.DeepEqual(collection.Status, observed) {
+		return nil
+	}
+	// a merge patch computed from the previous status also clears the indexes once all are removed
+	original := collection.DeepCopy()
+	collection.Status = observed
+	if err := h.Client.Status().Patch(ctx, collection, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to patch status: %w", err)
+	}
+	return nil
+}
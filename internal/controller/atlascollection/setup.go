@@ -0,0 +1,139 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlascollection
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlrtbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mongodb"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	mckpredicate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/predicate"
+)
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlascollections,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlascollections/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlascollections/finalizers,verbs=update
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlascollections,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlascollections/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlascollections/finalizers,verbs=update
+
+type AtlasCollectionHandler struct {
+	ctrlstate.StateHandler[akov2.AtlasCollection]
+	reconciler.AtlasReconciler
+	clients *mongodb.Clients
+}
+
+func NewAtlasCollectionReconciler(
+	c cluster.Cluster,
+	logger *zap.Logger,
+	reapplySupport bool,
+) *ctrlstate.Reconciler[akov2.AtlasCollection] {
+	collectionHandler := &AtlasCollectionHandler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client: c.GetClient(),
+			Log:    logger.Named("controllers").Named("AtlasCollection").Sugar(),
+		},
+		clients: mongodb.NewClients(mongodb.Connect, mongodb.DefaultClientIdleTimeout),
+	}
+	return ctrlstate.NewStateReconciler(
+		collectionHandler,
+		ctrlstate.WithCluster[akov2.AtlasCollection](c),
+		ctrlstate.WithReapplySupport[akov2.AtlasCollection](reapplySupport),
+	)
+}
+
+// For prepares the controller for its target Custom Resource; AtlasCollection
+func (h *AtlasCollectionHandler) For() (client.Object, builder.Predicates) {
+	obj := &akov2.AtlasCollection{}
+	return obj, ctrlrtbuilder.WithPredicates(
+		predicate.Or(
+			mckpredicate.AnnotationChanged("mongodb.com/reapply-period"),
+			predicate.GenerationChangedPredicate{},
+		),
+		mckpredicate.IgnoreDeletedPredicate[client.Object](),
+	)
+}
+
+func (h *AtlasCollectionHandler) SetupWithManager(mgr ctrl.Manager, rec reconcile.Reconciler, defaultOptions controller.Options) error {
+	h.Client = mgr.GetClient()
+	// the connections to the deployments are closed when the manager stops
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return h.clients.Close(context.Background())
+	})); err != nil {
+		return err
+	}
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named("AtlasCollection").
+		For(h.For()).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(h.collectionForSecretMapFunc()),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		WithOptions(defaultOptions).Complete(rec)
+}
+
+func (h *AtlasCollectionHandler) collectionForSecretMapFunc() handler.MapFunc {
+	return indexer.CredentialsIndexMapperFunc(
+		indexer.AtlasCollectionBySecretIndex,
+		func() *akov2.AtlasCollectionList { return &akov2.AtlasCollectionList{} },
+		indexer.AtlasCollectionRequests,
+		h.Client,
+		h.Log,
+	)
+}
+
+// connectionURL returns the connection string of the referenced connection Secret,
+// making sure it belongs to the referenced deployment
+func (h *AtlasCollectionHandler) connectionURL(ctx context.Context, collection *akov2.AtlasCollection) (string, error) {
+	deployment := &akov2.AtlasDeployment{}
+	key := *collection.Spec.DeploymentRef.GetObject(collection.GetNamespace())
+	if err := h.Client.Get(ctx, key, deployment); err != nil {
+		return "", fmt.Errorf("failed to get deployment %s: %w", key, err)
+	}
+
+	secret := &corev1.Secret{}
+	secretKey := client.ObjectKey{Namespace: collection.GetNamespace(), Name: collection.Spec.ConnectionSecretRef.Name}
+	if err := h.Client.Get(ctx, secretKey, secret); err != nil {
+		return "", fmt.Errorf("failed to get connection secret %s: %w", secretKey, err)
+	}
+	if clusterName, ok := secret.Labels[connectionsecret.ClusterLabelKey]; ok &&
+		clusterName != kube.NormalizeLabelValue(deployment.GetDeploymentName()) {
+		return "", fmt.Errorf("connection secret %s belongs to deployment %q, not %q",
+			secretKey, clusterName, deployment.GetDeploymentName())
+	}
+	return connectionsecret.ConnectionURL(secret)
+}
@@ -41,10 +41,8 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/customroles"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/version"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 type AtlasCustomRoleReconciler struct {
@@ -65,13 +63,15 @@ func NewAtlasCustomRoleReconciler(
 	independentSyncPeriod time.Duration,
 	logger *zap.Logger,
 	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
 ) *AtlasCustomRoleReconciler {
 	return &AtlasCustomRoleReconciler{
 		AtlasReconciler: reconciler.AtlasReconciler{
-			Client:          c.GetClient(),
-			Log:             logger.Named("controllers").Named("AtlasCustomRoles").Sugar(),
-			GlobalSecretRef: globalSecretRef,
-			AtlasProvider:   atlasProvider,
+			Client:              c.GetClient(),
+			Log:                 logger.Named("controllers").Named("AtlasCustomRoles").Sugar(),
+			GlobalSecretRef:     globalSecretRef,
+			CredentialProviders: credentialProviders,
+			AtlasProvider:       atlasProvider,
 		},
 		Scheme:                   c.GetScheme(),
 		EventRecorder:            c.GetEventRecorderFor("AtlasCustomRoles"),
@@ -134,17 +134,18 @@ func (r *AtlasCustomRoleReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 	workflowCtx.SetConditionTrue(api.ResourceVersionStatus).SetConditionTrue(api.ValidationSucceeded)
 
-	if !r.AtlasProvider.IsResourceSupported(atlasCustomRole) {
+	connectionConfig, err := r.ResolveConnectionConfig(ctx, atlasCustomRole)
+	if err != nil {
+		return r.fail(req, err)
+	}
+
+	if !r.AtlasProvider.IsResourceSupported(atlasCustomRole, connectionConfig.Credentials) {
 		return r.terminate(workflowCtx, atlasCustomRole,
 			api.ProjectCustomRolesReadyType, workflow.AtlasGovUnsupported,
 			false,
 			fmt.Errorf("the %T is not supported by Atlas for government", atlasCustomRole))
 	}
 
-	connectionConfig, err := r.ResolveConnectionConfig(ctx, atlasCustomRole)
-	if err != nil {
-		return r.fail(req, err)
-	}
 	atlasSdkClientSet, err := r.AtlasProvider.SdkClientSet(workflowCtx.Context, connectionConfig.Credentials, workflowCtx.Log)
 	if err != nil {
 		return r.terminate(workflowCtx, atlasCustomRole, api.ProjectCustomRolesReadyType, workflow.AtlasAPIAccessNotConfigured, true, err)
@@ -209,7 +210,7 @@ func (r *AtlasCustomRoleReconciler) For() (client.Object, builder.Predicates) {
 	return &akov2.AtlasCustomRole{}, builder.WithPredicates(r.GlobalPredicates...)
 }
 
-func (r *AtlasCustomRoleReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+func (r *AtlasCustomRoleReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasCustomRole").
 		For(r.For()).
@@ -217,9 +218,7 @@ func (r *AtlasCustomRoleReconciler) SetupWithManager(mgr ctrl.Manager, skipNameV
 			&corev1.Secret{},
 			handler.EnqueueRequestsFromMapFunc(r.customRolesCredentials()),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
+		WithOptions(options).
 		Complete(r)
 }
 
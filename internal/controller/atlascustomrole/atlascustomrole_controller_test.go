@@ -210,6 +210,7 @@ func TestAtlasCustomRoleReconciler_Reconcile(t *testing.T) {
 						},
 					},
 					ProjectDualReference: akov2.ProjectDualReference{
+						ConnectionSecret: &api.LocalObjectReference{Name: "test"},
 						ExternalProjectRef: &akov2.ExternalProjectReference{
 							ID: "testProjectID",
 						},
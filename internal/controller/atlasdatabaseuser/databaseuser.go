@@ -16,6 +16,7 @@ package atlasdatabaseuser
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"time"
@@ -25,9 +26,11 @@ import (
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/connectionsecret"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/dbuser"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
@@ -35,6 +38,11 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/version"
 )
 
+const (
+	defaultX509MonthsUntilExpiration = 3
+	defaultX509RenewBefore           = 30 * 24 * time.Hour
+)
+
 func (r *AtlasDatabaseUserReconciler) handleDatabaseUser(ctx *workflow.Context, atlasDatabaseUser *akov2.AtlasDatabaseUser) (ctrl.Result, error) {
 	valid, err := customresource.ResourceVersionIsValid(atlasDatabaseUser)
 	switch {
@@ -54,31 +62,33 @@ func (r *AtlasDatabaseUserReconciler) handleDatabaseUser(ctx *workflow.Context,
 			SetConditionTrue(api.ValidationSucceeded)
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(atlasDatabaseUser) {
-		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.AtlasGovUnsupported, false, fmt.Errorf("the %T is not supported by Atlas for government", atlasDatabaseUser))
-	}
-
 	connectionConfig, err := r.ResolveConnectionConfig(ctx.Context, atlasDatabaseUser)
 	if err != nil {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.AtlasAPIAccessNotConfigured, true, err)
 	}
+
+	if !r.AtlasProvider.IsResourceSupported(atlasDatabaseUser, connectionConfig.Credentials) {
+		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.AtlasGovUnsupported, false, fmt.Errorf("the %T is not supported by Atlas for government", atlasDatabaseUser))
+	}
+
 	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx.Context, connectionConfig.Credentials, r.Log)
 	if err != nil {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.AtlasAPIAccessNotConfigured, true, err)
 	}
 	dbUserService := dbuser.NewAtlasUsers(sdkClientSet.SdkClient20250312002.DatabaseUsersApi)
-	deploymentService := deployment.NewAtlasDeployments(sdkClientSet.SdkClient20250312002.ClustersApi, sdkClientSet.SdkClient20250312002.ServerlessInstancesApi, sdkClientSet.SdkClient20250312002.GlobalClustersApi, sdkClientSet.SdkClient20250312002.FlexClustersApi, r.AtlasProvider.IsCloudGov())
+	certService := dbuser.NewAtlasCertificates(sdkClientSet.SdkClient20250312002.X509AuthenticationApi)
+	deploymentService := deployment.NewAtlasDeployments(sdkClientSet.SdkClient20250312002.ClustersApi, sdkClientSet.SdkClient20250312002.ServerlessInstancesApi, sdkClientSet.SdkClient20250312002.GlobalClustersApi, sdkClientSet.SdkClient20250312002.FlexClustersApi, r.AtlasProvider.IsCloudGov(connectionConfig.Credentials))
 	atlasProject, err := r.ResolveProject(ctx.Context, sdkClientSet.SdkClient20250312002, atlasDatabaseUser)
 	if err != nil {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.AtlasAPIAccessNotConfigured, true, err)
 	}
 
-	return r.dbuLifeCycle(ctx, dbUserService, deploymentService, atlasDatabaseUser, atlasProject)
+	return r.dbuLifeCycle(ctx, dbUserService, certService, deploymentService, atlasDatabaseUser, atlasProject)
 }
 
 func (r *AtlasDatabaseUserReconciler) dbuLifeCycle(ctx *workflow.Context, dbUserService dbuser.AtlasUsersService,
-	deploymentService deployment.AtlasDeploymentsService, atlasDatabaseUser *akov2.AtlasDatabaseUser,
-	atlasProject *project.Project) (ctrl.Result, error) {
+	certService dbuser.AtlasCertificatesService, deploymentService deployment.AtlasDeploymentsService,
+	atlasDatabaseUser *akov2.AtlasDatabaseUser, atlasProject *project.Project) (ctrl.Result, error) {
 	databaseUserInAtlas, err := dbUserService.Get(ctx.Context, atlasDatabaseUser.Spec.DatabaseName, atlasProject.ID, atlasDatabaseUser.Spec.Username)
 	if err != nil && !errors.Is(err, dbuser.ErrorNotFound) {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.Internal, true, err)
@@ -113,7 +123,7 @@ func (r *AtlasDatabaseUserReconciler) dbuLifeCycle(ctx *workflow.Context, dbUser
 	case !dbUserExists && !wasDeleted:
 		return r.create(ctx, dbUserService, atlasProject.ID, atlasDatabaseUser)
 	case dbUserExists && !wasDeleted:
-		return r.update(ctx, dbUserService, deploymentService, atlasProject, atlasDatabaseUser, databaseUserInAtlas)
+		return r.update(ctx, dbUserService, certService, deploymentService, atlasProject, atlasDatabaseUser, databaseUserInAtlas)
 	case dbUserExists && wasDeleted:
 		return r.delete(ctx, dbUserService, atlasProject.ID, atlasDatabaseUser)
 	default:
@@ -154,7 +164,7 @@ func (r *AtlasDatabaseUserReconciler) create(ctx *workflow.Context, dbUserServic
 }
 
 func (r *AtlasDatabaseUserReconciler) update(ctx *workflow.Context, dbUserService dbuser.AtlasUsersService,
-	deploymentService deployment.AtlasDeploymentsService, atlasProject *project.Project,
+	certService dbuser.AtlasCertificatesService, deploymentService deployment.AtlasDeploymentsService, atlasProject *project.Project,
 	atlasDatabaseUser *akov2.AtlasDatabaseUser, databaseUserInAtlas *dbuser.User) (ctrl.Result, error) {
 	userPassword, passwordVersion, err := r.readPassword(ctx.Context, atlasDatabaseUser)
 	if err != nil {
@@ -167,7 +177,7 @@ func (r *AtlasDatabaseUserReconciler) update(ctx *workflow.Context, dbUserServic
 	}
 
 	if !hasChanged(databaseUserInAKO, databaseUserInAtlas, atlasDatabaseUser.Status.PasswordVersion, passwordVersion) {
-		return r.readiness(ctx, deploymentService, atlasProject, atlasDatabaseUser, passwordVersion)
+		return r.readiness(ctx, certService, deploymentService, atlasProject, atlasDatabaseUser, passwordVersion)
 	}
 
 	r.Log.Debug(dbuser.DiffSpecs(databaseUserInAKO, databaseUserInAtlas))
@@ -198,8 +208,9 @@ func (r *AtlasDatabaseUserReconciler) delete(ctx *workflow.Context, dbUserServic
 	return r.unmanage(ctx, projectID, atlasDatabaseUser)
 }
 
-func (r *AtlasDatabaseUserReconciler) readiness(ctx *workflow.Context, deploymentService deployment.AtlasDeploymentsService,
-	atlasProject *project.Project, atlasDatabaseUser *akov2.AtlasDatabaseUser, passwordVersion string) (ctrl.Result, error) {
+func (r *AtlasDatabaseUserReconciler) readiness(ctx *workflow.Context, certService dbuser.AtlasCertificatesService,
+	deploymentService deployment.AtlasDeploymentsService, atlasProject *project.Project,
+	atlasDatabaseUser *akov2.AtlasDatabaseUser, passwordVersion string) (ctrl.Result, error) {
 	allDeploymentNames, err := deploymentService.ListDeploymentNames(ctx.Context, atlasProject.ID)
 	if err != nil {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.Internal, true, err)
@@ -243,13 +254,82 @@ func (r *AtlasDatabaseUserReconciler) readiness(ctx *workflow.Context, deploymen
 		)
 	}
 
+	if err := r.checkServiceAccount(ctx, atlasDatabaseUser); err != nil {
+		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.DatabaseUserServiceAccountNotFound, true, err)
+	}
+
+	renewAt, err := r.ensureX509Certificate(ctx, certService, atlasProject, atlasDatabaseUser)
+	if err != nil {
+		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.DatabaseUserX509CertificateNotCreated, true, err)
+	}
+
 	// TODO refactor connectionsecret package to follow state machine approach
 	result := connectionsecret.CreateOrUpdateConnectionSecrets(ctx, r.Client, deploymentService, r.EventRecorder, atlasProject, *atlasDatabaseUser)
 	if !result.IsOk() {
 		return r.terminate(ctx, atlasDatabaseUser, api.DatabaseUserReadyType, workflow.DatabaseUserConnectionSecretsNotCreated, true, errors.New(result.GetMessage()))
 	}
 
-	return r.ready(ctx, atlasDatabaseUser, passwordVersion)
+	readyResult, err := r.ready(ctx, atlasDatabaseUser, passwordVersion)
+	if err != nil || renewAt.IsZero() {
+		return readyResult, err
+	}
+	// come back in time to renew the certificate
+	untilRenewal := max(time.Until(renewAt), time.Second)
+	if readyResult.RequeueAfter == 0 || untilRenewal < readyResult.RequeueAfter {
+		readyResult.RequeueAfter = untilRenewal
+	}
+	return readyResult, nil
+}
+
+// checkServiceAccount verifies the ServiceAccount bound to an OIDC Workload user exists
+func (r *AtlasDatabaseUserReconciler) checkServiceAccount(ctx *workflow.Context, atlasDatabaseUser *akov2.AtlasDatabaseUser) error {
+	ref := atlasDatabaseUser.Spec.ServiceAccountRef
+	if ref == nil {
+		return nil
+	}
+	serviceAccount := &corev1.ServiceAccount{}
+	if err := r.Client.Get(ctx.Context, kube.ObjectKey(atlasDatabaseUser.Namespace, ref.Name), serviceAccount); err != nil {
+		return fmt.Errorf("failed to get ServiceAccount %s bound to the database user: %w", ref.Name, err)
+	}
+	return nil
+}
+
+// ensureX509Certificate keeps a valid Atlas managed certificate in a Secret for users with the MANAGED x509Type,
+// it returns when the certificate is due for renewal, or the zero time for any other user
+func (r *AtlasDatabaseUserReconciler) ensureX509Certificate(ctx *workflow.Context, certService dbuser.AtlasCertificatesService,
+	atlasProject *project.Project, atlasDatabaseUser *akov2.AtlasDatabaseUser) (time.Time, error) {
+	if atlasDatabaseUser.Spec.X509Type != "MANAGED" {
+		if atlasDatabaseUser.Status.X509CertificateSecret == "" {
+			return time.Time{}, nil
+		}
+		err := connectionsecret.RemoveX509Secret(ctx.Context, r.Client, atlasDatabaseUser, atlasDatabaseUser.Status.X509CertificateSecret)
+		if err != nil {
+			return time.Time{}, err
+		}
+		ctx.EnsureStatusOption(status.AtlasDatabaseUserX509CertificateOption("", ""))
+		return time.Time{}, nil
+	}
+
+	monthsUntilExpiration, renewBefore := x509Settings(atlasDatabaseUser)
+	secretName := connectionsecret.X509SecretName(atlasProject.Name, atlasDatabaseUser.Name)
+	cert, err := connectionsecret.ReadX509Certificate(ctx.Context, r.Client, atlasDatabaseUser.Namespace, secretName)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if cert == nil || cert.Subject.CommonName != atlasDatabaseUser.Spec.Username || !time.Now().Before(x509RenewalTime(cert, renewBefore)) {
+		issued, err := certService.Create(ctx.Context, atlasProject.ID, atlasDatabaseUser.Spec.Username, monthsUntilExpiration)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if err := connectionsecret.EnsureX509Secret(ctx.Context, r.Client, atlasDatabaseUser, atlasProject.ID, secretName, issued); err != nil {
+			return time.Time{}, err
+		}
+		ctx.Log.Infow("Issued a new X.509 certificate", "secret", secretName, "notAfter", issued.Certificate.NotAfter)
+		cert = issued.Certificate
+	}
+
+	ctx.EnsureStatusOption(status.AtlasDatabaseUserX509CertificateOption(secretName, timeutil.FormatISO8601(cert.NotAfter)))
+	return x509RenewalTime(cert, renewBefore), nil
 }
 
 func (r *AtlasDatabaseUserReconciler) readPassword(ctx context.Context, atlasDatabaseUser *akov2.AtlasDatabaseUser) (string, string, error) {
@@ -345,3 +425,27 @@ func filterScopeDeployments(user *akov2.AtlasDatabaseUser, allDeploymentsInProje
 	}
 	return deploymentsToCheck
 }
+
+func x509Settings(atlasDatabaseUser *akov2.AtlasDatabaseUser) (int, time.Duration) {
+	monthsUntilExpiration, renewBefore := defaultX509MonthsUntilExpiration, defaultX509RenewBefore
+	if x509 := atlasDatabaseUser.Spec.X509; x509 != nil {
+		if x509.MonthsUntilExpiration > 0 {
+			monthsUntilExpiration = x509.MonthsUntilExpiration
+		}
+		if x509.RenewBefore != nil {
+			renewBefore = x509.RenewBefore.Duration
+		}
+	}
+	return monthsUntilExpiration, renewBefore
+}
+
+// x509RenewalTime never renews a certificate before half of its validity, so that short-lived
+// certificates are not issued again on every reconciliation
+func x509RenewalTime(cert *x509.Certificate, renewBefore time.Duration) time.Time {
+	halfway := cert.NotBefore.Add(cert.NotAfter.Sub(cert.NotBefore) / 2)
+	renewAt := cert.NotAfter.Add(-renewBefore)
+	if renewAt.Before(halfway) {
+		return halfway
+	}
+	return renewAt
+}
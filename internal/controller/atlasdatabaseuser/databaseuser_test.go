@@ -16,7 +16,14 @@ package atlasdatabaseuser
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
@@ -24,6 +31,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/atlas-sdk/v20250312002/admin"
 	"go.mongodb.org/atlas-sdk/v20250312002/mockadmin"
 	"go.uber.org/zap"
@@ -35,6 +43,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
@@ -47,6 +56,7 @@ import (
 	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/dbuser"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/project"
@@ -829,7 +839,7 @@ func TestDbuLifeCycle(t *testing.T) {
 				Log:     logger,
 			}
 
-			result, err := r.dbuLifeCycle(ctx, tt.dbUserService(), tt.dService(), tt.dbUserInAKO, &project.Project{})
+			result, err := r.dbuLifeCycle(ctx, tt.dbUserService(), nil, tt.dService(), tt.dbUserInAKO, &project.Project{})
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -1360,7 +1370,7 @@ func TestUpdate(t *testing.T) {
 				Log:     logger,
 			}
 
-			result, err := r.update(ctx, tt.dbUserService(), tt.dService(), &project.Project{}, tt.dbUserInAKO, tt.dbUserInAtlas)
+			result, err := r.update(ctx, tt.dbUserService(), nil, tt.dService(), &project.Project{}, tt.dbUserInAKO, tt.dbUserInAtlas)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -1756,7 +1766,7 @@ func TestReadiness(t *testing.T) {
 				Log:     logger,
 			}
 
-			result, err := r.readiness(ctx, tt.dService(), &project.Project{}, tt.dbUser, "999")
+			result, err := r.readiness(ctx, nil, tt.dService(), &project.Project{}, tt.dbUser, "999")
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -2325,3 +2335,278 @@ func DefaultTestProvider(t *testing.T) *atlasmock.TestProvider {
 		},
 	}
 }
+
+func testX509Certificate(t *testing.T, username string, notBefore, notAfter time.Time) *dbuser.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: username},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	cert, err := dbuser.ParseCertificate(append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})...,
+	))
+	require.NoError(t, err)
+	return cert
+}
+
+func TestEnsureX509Certificate(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	atlasProject := &project.Project{ID: "project-id", Name: "my-project"}
+	secretName := "my-project-user1-x509"
+	managedUser := func() *akov2.AtlasDatabaseUser {
+		return &akov2.AtlasDatabaseUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "user1", Namespace: "default", UID: "user1-uid"},
+			Spec: akov2.AtlasDatabaseUserSpec{
+				Username:     "user1",
+				DatabaseName: "$external",
+				X509Type:     "MANAGED",
+			},
+		}
+	}
+	fresh := testX509Certificate(t, "user1", now.Add(-time.Hour), now.Add(90*24*time.Hour))
+	expiring := testX509Certificate(t, "user1", now.Add(-80*24*time.Hour), now.Add(10*24*time.Hour))
+
+	tests := map[string]struct {
+		dbUser            *akov2.AtlasDatabaseUser
+		existing          *dbuser.Certificate
+		existingUnowned   bool
+		certService       func() dbuser.AtlasCertificatesService
+		expectedRenewAt   time.Time
+		expectedSecret    string
+		expectedNotAfter  string
+		expectedSerial    *big.Int
+		expectedErr       string
+		expectNoSecret    bool
+		expectedStatusSet bool
+	}{
+		"users without managed certificates are skipped": {
+			dbUser: &akov2.AtlasDatabaseUser{
+				ObjectMeta: metav1.ObjectMeta{Name: "user1", Namespace: "default"},
+				Spec:       akov2.AtlasDatabaseUserSpec{Username: "user1"},
+			},
+			certService: func() dbuser.AtlasCertificatesService {
+				return translation.NewAtlasCertificatesServiceMock(t)
+			},
+			expectNoSecret: true,
+		},
+		"the certificate of users no longer using managed certificates is removed": {
+			dbUser: &akov2.AtlasDatabaseUser{
+				ObjectMeta: metav1.ObjectMeta{Name: "user1", Namespace: "default", UID: "user1-uid"},
+				Spec:       akov2.AtlasDatabaseUserSpec{Username: "user1"},
+				Status:     status.AtlasDatabaseUserStatus{X509CertificateSecret: secretName},
+			},
+			existing: fresh,
+			certService: func() dbuser.AtlasCertificatesService {
+				return translation.NewAtlasCertificatesServiceMock(t)
+			},
+			expectNoSecret:    true,
+			expectedStatusSet: true,
+		},
+		"a Secret the user does not control is not removed": {
+			dbUser: &akov2.AtlasDatabaseUser{
+				ObjectMeta: metav1.ObjectMeta{Name: "user1", Namespace: "default", UID: "user1-uid"},
+				Spec:       akov2.AtlasDatabaseUserSpec{Username: "user1"},
+				Status:     status.AtlasDatabaseUserStatus{X509CertificateSecret: secretName},
+			},
+			existing:        fresh,
+			existingUnowned: true,
+			certService: func() dbuser.AtlasCertificatesService {
+				return translation.NewAtlasCertificatesServiceMock(t)
+			},
+			expectedSerial:    fresh.Certificate.SerialNumber,
+			expectedStatusSet: true,
+		},
+		"a certificate is issued for a new managed user": {
+			dbUser: managedUser(),
+			certService: func() dbuser.AtlasCertificatesService {
+				service := translation.NewAtlasCertificatesServiceMock(t)
+				service.EXPECT().Create(context.Background(), "project-id", "user1", 3).Return(fresh, nil)
+				return service
+			},
+			expectedRenewAt:   fresh.Certificate.NotAfter.Add(-30 * 24 * time.Hour),
+			expectedSecret:    secretName,
+			expectedNotAfter:  timeutil.FormatISO8601(fresh.Certificate.NotAfter),
+			expectedSerial:    fresh.Certificate.SerialNumber,
+			expectedStatusSet: true,
+		},
+		"a valid certificate is kept": {
+			dbUser:   managedUser(),
+			existing: fresh,
+			certService: func() dbuser.AtlasCertificatesService {
+				return translation.NewAtlasCertificatesServiceMock(t)
+			},
+			expectedRenewAt:   fresh.Certificate.NotAfter.Add(-30 * 24 * time.Hour),
+			expectedSecret:    secretName,
+			expectedNotAfter:  timeutil.FormatISO8601(fresh.Certificate.NotAfter),
+			expectedSerial:    fresh.Certificate.SerialNumber,
+			expectedStatusSet: true,
+		},
+		"an expiring certificate is renewed": {
+			dbUser: func() *akov2.AtlasDatabaseUser {
+				dbUser := managedUser()
+				dbUser.Spec.X509 = &akov2.X509ManagedCertificate{MonthsUntilExpiration: 6}
+				return dbUser
+			}(),
+			existing: expiring,
+			certService: func() dbuser.AtlasCertificatesService {
+				service := translation.NewAtlasCertificatesServiceMock(t)
+				service.EXPECT().Create(context.Background(), "project-id", "user1", 6).Return(fresh, nil)
+				return service
+			},
+			expectedRenewAt:   fresh.Certificate.NotAfter.Add(-30 * 24 * time.Hour),
+			expectedSecret:    secretName,
+			expectedNotAfter:  timeutil.FormatISO8601(fresh.Certificate.NotAfter),
+			expectedSerial:    fresh.Certificate.SerialNumber,
+			expectedStatusSet: true,
+		},
+		"a certificate for another username is replaced": {
+			dbUser: func() *akov2.AtlasDatabaseUser {
+				dbUser := managedUser()
+				dbUser.Spec.Username = "user2"
+				return dbUser
+			}(),
+			existing: fresh,
+			certService: func() dbuser.AtlasCertificatesService {
+				service := translation.NewAtlasCertificatesServiceMock(t)
+				service.EXPECT().Create(context.Background(), "project-id", "user2", 3).Return(expiring, nil)
+				return service
+			},
+			expectedRenewAt:   expiring.Certificate.NotAfter.Add(-30 * 24 * time.Hour),
+			expectedSecret:    secretName,
+			expectedNotAfter:  timeutil.FormatISO8601(expiring.Certificate.NotAfter),
+			expectedSerial:    expiring.Certificate.SerialNumber,
+			expectedStatusSet: true,
+		},
+		"failing to issue a certificate is an error": {
+			dbUser: managedUser(),
+			certService: func() dbuser.AtlasCertificatesService {
+				service := translation.NewAtlasCertificatesServiceMock(t)
+				service.EXPECT().Create(context.Background(), "project-id", "user1", 3).Return(nil, errors.New("unavailable"))
+				return service
+			},
+			expectedErr:    "unavailable",
+			expectNoSecret: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testScheme := runtime.NewScheme()
+			require.NoError(t, akov2.AddToScheme(testScheme))
+			require.NoError(t, corev1.AddToScheme(testScheme))
+			objects := []client.Object{tt.dbUser}
+			if tt.existing != nil {
+				secret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: "default"},
+					Type:       corev1.SecretTypeTLS,
+					Data: map[string][]byte{
+						corev1.TLSCertKey:       tt.existing.CertificatePEM,
+						corev1.TLSPrivateKeyKey: tt.existing.PrivateKeyPEM,
+					},
+				}
+				if !tt.existingUnowned {
+					require.NoError(t, controllerutil.SetControllerReference(tt.dbUser, secret, testScheme))
+				}
+				objects = append(objects, secret)
+			}
+			k8sClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objects...).Build()
+			logger := zaptest.NewLogger(t).Sugar()
+			r := AtlasDatabaseUserReconciler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Client: k8sClient,
+					Log:    logger,
+				},
+			}
+			ctx := &workflow.Context{
+				Context: context.Background(),
+				Log:     logger,
+			}
+
+			renewAt, err := r.ensureX509Certificate(ctx, tt.certService(), atlasProject, tt.dbUser)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.True(t, tt.expectedRenewAt.Equal(renewAt), "expected renewal at %v, got %v", tt.expectedRenewAt, renewAt)
+
+			tt.dbUser.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+			assert.Equal(t, tt.expectedStatusSet, len(ctx.StatusOptions()) > 0)
+			assert.Equal(t, tt.expectedSecret, tt.dbUser.Status.X509CertificateSecret)
+			assert.Equal(t, tt.expectedNotAfter, tt.dbUser.Status.X509CertificateNotAfter)
+
+			secret := &corev1.Secret{}
+			err = k8sClient.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: secretName}, secret)
+			if tt.expectNoSecret {
+				assert.True(t, k8serrors.IsNotFound(err))
+				return
+			}
+			require.NoError(t, err)
+			block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+			require.NotNil(t, block)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSerial, cert.SerialNumber)
+		})
+	}
+}
+
+func TestX509RenewalTime(t *testing.T) {
+	notBefore := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert := &x509.Certificate{NotBefore: notBefore, NotAfter: notBefore.Add(90 * 24 * time.Hour)}
+
+	assert.Equal(t, notBefore.Add(60*24*time.Hour), x509RenewalTime(cert, 30*24*time.Hour))
+	assert.Equal(t, notBefore.Add(45*24*time.Hour), x509RenewalTime(cert, 60*24*time.Hour), "renewal never happens before half of the validity")
+}
+
+func TestCheckServiceAccount(t *testing.T) {
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	tests := map[string]struct {
+		serviceAccountRef *common.ResourceRef
+		expectedErr       string
+	}{
+		"users without a service account are skipped": {},
+		"the bound service account exists": {
+			serviceAccountRef: &common.ResourceRef{Name: "app"},
+		},
+		"a missing service account fails": {
+			serviceAccountRef: &common.ResourceRef{Name: "missing"},
+			expectedErr:       "failed to get ServiceAccount missing bound to the database user",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testScheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(testScheme))
+			k8sClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(serviceAccount).Build()
+			r := AtlasDatabaseUserReconciler{
+				AtlasReconciler: reconciler.AtlasReconciler{Client: k8sClient},
+			}
+			ctx := &workflow.Context{Context: context.Background()}
+			dbUser := &akov2.AtlasDatabaseUser{
+				ObjectMeta: metav1.ObjectMeta{Name: "user1", Namespace: "default"},
+				Spec: akov2.AtlasDatabaseUserSpec{
+					Username:          "idp-id/system:serviceaccount:default:app",
+					OIDCAuthType:      "USER",
+					ServiceAccountRef: tt.serviceAccountRef,
+				},
+			}
+
+			err := r.checkServiceAccount(ctx, dbUser)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
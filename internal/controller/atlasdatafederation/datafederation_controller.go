@@ -44,9 +44,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/datafederation"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 // AtlasDataFederationReconciler reconciles an DataFederation object
@@ -60,6 +58,7 @@ type AtlasDataFederationReconciler struct {
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
 	GlobalSecretRef             client.ObjectKey
+	CredentialProviders         reconciler.CredentialProviders
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdatafederations,verbs=get;list;watch;create;update;patch;delete
@@ -101,25 +100,26 @@ func (r *AtlasDataFederationReconciler) Reconcile(context context.Context, req c
 		return resourceVersionIsValid.ReconcileResult()
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(dataFederation) {
-		result := workflow.Terminate(workflow.AtlasGovUnsupported, errors.New("the AtlasDataFederation is not supported by Atlas for government")).
-			WithoutRetry()
-		ctx.SetConditionFromResult(api.DataFederationReadyType, result)
-		return result.ReconcileResult()
-	}
-
 	project := &akov2.AtlasProject{}
 	if result := r.readProjectResource(context, dataFederation, project); !result.IsOk() {
 		ctx.SetConditionFromResult(api.DataFederationReadyType, result)
 		return result.ReconcileResult()
 	}
 
-	connectionConfig, err := reconciler.GetConnectionConfig(ctx.Context, r.Client, project.ConnectionSecretObjectKey(), &r.GlobalSecretRef)
+	connectionConfig, err := reconciler.GetProjectConnectionConfig(ctx.Context, r.Client, r.CredentialProviders, project, &r.GlobalSecretRef)
 	if err != nil {
 		result = workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err)
 		ctx.SetConditionFromResult(api.DatabaseUserReadyType, result)
 		return result.ReconcileResult()
 	}
+
+	if !r.AtlasProvider.IsResourceSupported(dataFederation, connectionConfig.Credentials) {
+		result := workflow.Terminate(workflow.AtlasGovUnsupported, errors.New("the AtlasDataFederation is not supported by Atlas for government")).
+			WithoutRetry()
+		ctx.SetConditionFromResult(api.DataFederationReadyType, result)
+		return result.ReconcileResult()
+	}
+
 	clientSet, err := r.AtlasProvider.SdkClientSet(ctx.Context, connectionConfig.Credentials, log)
 	if err != nil {
 		result = workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err)
@@ -234,7 +234,7 @@ func (r *AtlasDataFederationReconciler) For() (client.Object, builder.Predicates
 	return &akov2.AtlasDataFederation{}, builder.WithPredicates(r.GlobalPredicates...)
 }
 
-func (r *AtlasDataFederationReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+func (r *AtlasDataFederationReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasDataFederation").
 		For(r.For()).
@@ -243,9 +243,7 @@ func (r *AtlasDataFederationReconciler) SetupWithManager(mgr ctrl.Manager, skipN
 			handler.EnqueueRequestsFromMapFunc(r.findAtlasDataFederationForProjects),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
+		WithOptions(options).
 		Complete(r)
 }
 
@@ -283,6 +281,7 @@ func NewAtlasDataFederationReconciler(
 	deletionProtection bool,
 	logger *zap.Logger,
 	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
 ) *AtlasDataFederationReconciler {
 	return &AtlasDataFederationReconciler{
 		Scheme:                   c.GetScheme(),
@@ -293,6 +292,7 @@ func NewAtlasDataFederationReconciler(
 		AtlasProvider:            atlasProvider,
 		ObjectDeletionProtection: deletionProtection,
 		GlobalSecretRef:          globalSecretRef,
+		CredentialProviders:      credentialProviders,
 	}
 }
 
@@ -77,6 +77,10 @@ func (r *AtlasDeploymentReconciler) handleAdvancedDeployment(ctx *workflow.Conte
 
 	switch atlasCluster.GetState() {
 	case status.StateIDLE:
+		if transition := r.ensureMajorVersion(ctx, deploymentService, akoCluster, atlasCluster); transition != nil {
+			return transition(workflow.MajorVersionUpgradeNotAllowed)
+		}
+
 		if changes, occurred := deployment.ComputeChanges(akoCluster, atlasCluster); occurred {
 			updatedDeployment, err := deploymentService.UpdateDeployment(ctx.Context, changes)
 			if err != nil {
@@ -102,7 +106,7 @@ func (r *AtlasDeploymentReconciler) handleAdvancedDeployment(ctx *workflow.Conte
 		}
 
 		var results []workflow.DeprecatedResult
-		if !r.AtlasProvider.IsCloudGov() {
+		if !r.AtlasProvider.IsCloudGov(ctx.Credentials) {
 			searchNodeResult := handleSearchNodes(ctx, akoCluster.GetCustomResource(), akoCluster.GetProjectID())
 			results = append(results, searchNodeResult)
 		}
@@ -49,10 +49,8 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/project"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 // AtlasDeploymentReconciler reconciles an AtlasDeployment object
@@ -123,24 +121,26 @@ func (r *AtlasDeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return resourceVersionIsValid.ReconcileResult()
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(atlasDeployment) {
+	connectionConfig, err := r.ResolveConnectionConfig(workflowCtx.Context, atlasDeployment)
+	if err != nil {
+		return r.terminate(workflowCtx, workflow.AtlasAPIAccessNotConfigured, err)
+	}
+
+	if !r.AtlasProvider.IsResourceSupported(atlasDeployment, connectionConfig.Credentials) {
 		result = workflow.Terminate(workflow.AtlasGovUnsupported, errors.New("the AtlasDeployment is not supported by Atlas for government")).
 			WithoutRetry()
 		workflowCtx.SetConditionFromResult(api.DeploymentReadyType, result)
 		return result.ReconcileResult()
 	}
 
-	connectionConfig, err := r.ResolveConnectionConfig(workflowCtx.Context, atlasDeployment)
-	if err != nil {
-		return r.terminate(workflowCtx, workflow.AtlasAPIAccessNotConfigured, err)
-	}
 	sdkClientSet, err := r.AtlasProvider.SdkClientSet(workflowCtx.Context, connectionConfig.Credentials, r.Log)
 	if err != nil {
 		return r.terminate(workflowCtx, workflow.AtlasAPIAccessNotConfigured, err)
 	}
 	workflowCtx.SdkClientSet = sdkClientSet
+	workflowCtx.Credentials = connectionConfig.Credentials
 	projectService := project.NewProjectAPIService(sdkClientSet.SdkClient20250312002.ProjectsApi)
-	deploymentService := deployment.NewAtlasDeployments(sdkClientSet.SdkClient20250312002.ClustersApi, sdkClientSet.SdkClient20250312002.ServerlessInstancesApi, sdkClientSet.SdkClient20250312002.GlobalClustersApi, sdkClientSet.SdkClient20250312002.FlexClustersApi, r.AtlasProvider.IsCloudGov())
+	deploymentService := deployment.NewAtlasDeployments(sdkClientSet.SdkClient20250312002.ClustersApi, sdkClientSet.SdkClient20250312002.ServerlessInstancesApi, sdkClientSet.SdkClient20250312002.GlobalClustersApi, sdkClientSet.SdkClient20250312002.FlexClustersApi, r.AtlasProvider.IsCloudGov(connectionConfig.Credentials))
 	atlasProject, err := r.ResolveProject(workflowCtx.Context, sdkClientSet.SdkClient20250312002, atlasDeployment)
 	if err != nil {
 		return r.terminate(workflowCtx, workflow.AtlasAPIAccessNotConfigured, err)
@@ -208,6 +208,12 @@ func (r *AtlasDeploymentReconciler) delete(
 		ctx.Log.Info(msg)
 		r.EventRecorder.Event(deploymentInAKO.GetCustomResource(), "Warning", "AtlasDeploymentTermination", msg)
 	default:
+		if atlasDeployment := deploymentInAKO.GetCustomResource(); atlasDeployment.Spec.FinalSnapshot != nil && atlasDeployment.IsAdvancedDeployment() {
+			if result := r.ensureFinalSnapshot(ctx, deploymentInAKO.GetProjectID(), atlasDeployment); !result.IsOk() {
+				ctx.SetConditionFromResult(api.DeploymentReadyType, result)
+				return result.ReconcileResult()
+			}
+		}
 		if err := r.deleteDeploymentFromAtlas(ctx, deploymentService, deploymentInAKO, deploymentInAtlas); err != nil {
 			return r.terminate(ctx, workflow.Internal, fmt.Errorf("failed to remove deployment from Atlas: %w", err))
 		}
@@ -380,7 +386,7 @@ func (r *AtlasDeploymentReconciler) For() (client.Object, builder.Predicates) {
 	return &akov2.AtlasDeployment{}, builder.WithPredicates(r.GlobalPredicates...)
 }
 
-func (r *AtlasDeploymentReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+func (r *AtlasDeploymentReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasDeployment").
 		For(r.For()).
@@ -404,9 +410,7 @@ func (r *AtlasDeploymentReconciler) SetupWithManager(mgr ctrl.Manager, skipNameV
 			handler.EnqueueRequestsFromMapFunc(r.deploymentsForCredentialMapFunc()),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
 		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
+		WithOptions(options).
 		Complete(r)
 }
 
@@ -418,15 +422,17 @@ func NewAtlasDeploymentReconciler(
 	independentSyncPeriod time.Duration,
 	logger *zap.Logger,
 	globalSecretref client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
 ) *AtlasDeploymentReconciler {
 	suggaredLogger := logger.Named("controllers").Named("AtlasDeployment").Sugar()
 
 	return &AtlasDeploymentReconciler{
 		AtlasReconciler: reconciler.AtlasReconciler{
-			Client:          c.GetClient(),
-			Log:             suggaredLogger,
-			GlobalSecretRef: globalSecretref,
-			AtlasProvider:   atlasProvider,
+			Client:              c.GetClient(),
+			Log:                 suggaredLogger,
+			GlobalSecretRef:     globalSecretref,
+			CredentialProviders: credentialProviders,
+			AtlasProvider:       atlasProvider,
 		},
 		Scheme:                   c.GetScheme(),
 		EventRecorder:            c.GetEventRecorderFor("AtlasDeployment"),
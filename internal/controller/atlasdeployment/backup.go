@@ -29,6 +29,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasbackupexportbucket"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/validate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
@@ -86,7 +87,7 @@ func (r *AtlasDeploymentReconciler) ensureBackupSchedule(
 		return nil, err
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(bSchedule) {
+	if !r.AtlasProvider.IsResourceSupported(bSchedule, service.Credentials) {
 		return nil, errors.New("the AtlasBackupSchedule is not supported by Atlas for government")
 	}
 
@@ -134,7 +135,7 @@ func (r *AtlasDeploymentReconciler) ensureBackupPolicy(service *workflow.Context
 		return nil, errors.New(errText)
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(bPolicy) {
+	if !r.AtlasProvider.IsResourceSupported(bPolicy, service.Credentials) {
 		return nil, errors.New("the AtlasBackupPolicy is not supported by Atlas for government")
 	}
 
@@ -187,6 +188,14 @@ func (r *AtlasDeploymentReconciler) updateBackupScheduleAndPolicy(ctx context.Co
 
 	apiScheduleReq := bSchedule.ToAtlas(currentSchedule.GetClusterId(), clusterName, zoneID, bPolicy)
 
+	if export := bSchedule.Spec.Export; export != nil && export.ExportBucketRef != nil {
+		exportBucketID, err := atlasbackupexportbucket.ResolveExportBucketID(ctx, r.Client, export.ExportBucketRef, bSchedule.Namespace)
+		if err != nil {
+			return r.transitionFromLegacy(service, deploymentService, projectID, deployment, fmt.Errorf("unable to resolve the export bucket of backup schedule %s: %w", client.ObjectKeyFromObject(bSchedule).String(), err))
+		}
+		apiScheduleReq.Export.SetExportBucketId(exportBucketID)
+	}
+
 	// There is only one policy, always
 	apiScheduleReq.GetPolicies()[0].SetId(currentSchedule.GetPolicies()[0].GetId())
 
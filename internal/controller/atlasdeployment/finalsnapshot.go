@@ -0,0 +1,110 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	corev1 "k8s.io/api/core/v1"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+const (
+	snapshotStatusCompleted = "completed"
+	snapshotStatusFailed    = "failed"
+)
+
+// ensureFinalSnapshot takes an on-demand snapshot of the deployment and waits for it to complete.
+// The snapshot ID is kept in the status, so that a single snapshot is taken across reconciliations.
+// Atlas cannot snapshot a deployment without backups, the snapshot is skipped then so that the deletion is not blocked.
+func (r *AtlasDeploymentReconciler) ensureFinalSnapshot(ctx *workflow.Context, projectID string, atlasDeployment *akov2.AtlasDeployment) workflow.DeprecatedResult {
+	backupsAPI := ctx.SdkClientSet.SdkClient20250312002.CloudBackupsApi
+	clusterName := atlasDeployment.GetDeploymentName()
+
+	if !pointer.GetOrDefault(atlasDeployment.Spec.DeploymentSpec.BackupEnabled, false) {
+		r.EventRecorder.Event(atlasDeployment, corev1.EventTypeWarning, "FinalSnapshotSkipped", "Backups are disabled, removing the deployment without a final snapshot")
+		return workflow.OK()
+	}
+
+	snapshotID := atlasDeployment.Status.FinalSnapshotID
+	if snapshotID == "" {
+		request := &admin.DiskBackupOnDemandSnapshotRequest{
+			Description:     pointer.MakePtr(fmt.Sprintf("Final snapshot of %s taken before its deletion", clusterName)),
+			RetentionInDays: pointer.MakePtr(atlasDeployment.Spec.FinalSnapshot.RetentionInDays),
+		}
+		snapshot, _, err := backupsAPI.TakeSnapshot(ctx.Context, projectID, clusterName, request).Execute()
+		if err != nil {
+			return workflow.Terminate(workflow.DeploymentFinalSnapshotFailed, fmt.Errorf("failed to take the final snapshot: %w", err))
+		}
+		r.EventRecorder.Eventf(atlasDeployment, corev1.EventTypeNormal, "FinalSnapshotTaken", "Taking final snapshot %s before removing the deployment", snapshot.GetId())
+		ctx.EnsureStatusOption(status.AtlasDeploymentFinalSnapshotIDOption(snapshot.GetId()))
+		return workflow.InProgress(workflow.DeploymentFinalSnapshotInProgress, fmt.Sprintf("final snapshot %s is being taken", snapshot.GetId()))
+	}
+
+	snapshotStatus, err := r.finalSnapshotStatus(ctx, backupsAPI, projectID, atlasDeployment)
+	if err != nil {
+		return workflow.Terminate(workflow.DeploymentFinalSnapshotFailed, fmt.Errorf("failed to get the final snapshot %s: %w", snapshotID, err))
+	}
+	switch snapshotStatus {
+	case "":
+		// the snapshot is gone, a new one is taken on the next reconciliation
+		ctx.EnsureStatusOption(status.AtlasDeploymentFinalSnapshotIDOption(""))
+		return workflow.InProgress(workflow.DeploymentFinalSnapshotInProgress, fmt.Sprintf("final snapshot %s was not found", snapshotID))
+	case snapshotStatusFailed:
+		ctx.EnsureStatusOption(status.AtlasDeploymentFinalSnapshotIDOption(""))
+		return workflow.Terminate(workflow.DeploymentFinalSnapshotFailed, fmt.Errorf("final snapshot %s failed", snapshotID))
+	case snapshotStatusCompleted:
+		r.EventRecorder.Eventf(atlasDeployment, corev1.EventTypeNormal, "FinalSnapshotCompleted", "Final snapshot %s completed, removing the deployment", snapshotID)
+		return workflow.OK()
+	}
+	return workflow.InProgress(workflow.DeploymentFinalSnapshotInProgress, fmt.Sprintf("final snapshot %s is %s", snapshotID, snapshotStatus))
+}
+
+// finalSnapshotStatus returns the status of the final snapshot, empty when it does not exist
+func (r *AtlasDeploymentReconciler) finalSnapshotStatus(ctx *workflow.Context, backupsAPI admin.CloudBackupsApi, projectID string, atlasDeployment *akov2.AtlasDeployment) (string, error) {
+	clusterName := atlasDeployment.GetDeploymentName()
+	snapshotID := atlasDeployment.Status.FinalSnapshotID
+
+	var snapshotStatus string
+	var httpResp *http.Response
+	var err error
+	switch atlasDeployment.Spec.DeploymentSpec.ClusterType {
+	case "SHARDED", "GEOSHARDED":
+		var snapshot *admin.DiskBackupShardedClusterSnapshot
+		snapshot, httpResp, err = backupsAPI.GetShardedClusterBackup(ctx.Context, projectID, clusterName, snapshotID).Execute()
+		snapshotStatus = snapshot.GetStatus()
+	default:
+		var snapshot *admin.DiskBackupReplicaSet
+		snapshot, httpResp, err = backupsAPI.GetReplicaSetBackup(ctx.Context, projectID, clusterName, snapshotID).Execute()
+		snapshotStatus = snapshot.GetStatus()
+	}
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	if snapshotStatus == "" {
+		return "", errors.New("snapshot status is unknown")
+	}
+	return snapshotStatus, nil
+}
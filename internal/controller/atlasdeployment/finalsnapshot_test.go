@@ -0,0 +1,190 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	"go.mongodb.org/atlas-sdk/v20250312002/mockadmin"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+func TestEnsureFinalSnapshot(t *testing.T) {
+	for name, tc := range map[string]struct {
+		clusterType      string
+		backupDisabled   bool
+		snapshotID       string
+		backupsAPI       func() admin.CloudBackupsApi
+		wantOK           bool
+		wantReason       workflow.ConditionReason
+		wantSnapshotID   string
+		wantStatusOption bool
+		wantEvent        string
+	}{
+		"takes the snapshot": {
+			backupsAPI: func() admin.CloudBackupsApi {
+				backups := mockadmin.NewCloudBackupsApi(t)
+				backups.EXPECT().TakeSnapshot(mock.Anything, "project-id", "cluster0", &admin.DiskBackupOnDemandSnapshotRequest{
+					Description:     pointer.MakePtr("Final snapshot of cluster0 taken before its deletion"),
+					RetentionInDays: pointer.MakePtr(3),
+				}).Return(admin.TakeSnapshotApiRequest{ApiService: backups})
+				backups.EXPECT().TakeSnapshotExecute(mock.Anything).Return(&admin.DiskBackupSnapshot{Id: pointer.MakePtr("snapshot-id")}, nil, nil)
+				return backups
+			},
+			wantReason:       workflow.DeploymentFinalSnapshotInProgress,
+			wantSnapshotID:   "snapshot-id",
+			wantStatusOption: true,
+			wantEvent:        "Normal FinalSnapshotTaken Taking final snapshot snapshot-id before removing the deployment",
+		},
+		"fails to take the snapshot": {
+			backupsAPI: func() admin.CloudBackupsApi {
+				backups := mockadmin.NewCloudBackupsApi(t)
+				backups.EXPECT().TakeSnapshot(mock.Anything, "project-id", "cluster0", mock.Anything).Return(admin.TakeSnapshotApiRequest{ApiService: backups})
+				backups.EXPECT().TakeSnapshotExecute(mock.Anything).Return(nil, nil, errors.New("backups are not enabled"))
+				return backups
+			},
+			wantReason: workflow.DeploymentFinalSnapshotFailed,
+		},
+		"waits for the snapshot to complete": {
+			snapshotID: "snapshot-id",
+			backupsAPI: func() admin.CloudBackupsApi {
+				backups := mockadmin.NewCloudBackupsApi(t)
+				backups.EXPECT().GetReplicaSetBackup(mock.Anything, "project-id", "cluster0", "snapshot-id").Return(admin.GetReplicaSetBackupApiRequest{ApiService: backups})
+				backups.EXPECT().GetReplicaSetBackupExecute(mock.Anything).Return(&admin.DiskBackupReplicaSet{Status: pointer.MakePtr("inProgress")}, nil, nil)
+				return backups
+			},
+			wantReason: workflow.DeploymentFinalSnapshotInProgress,
+		},
+		"proceeds once the snapshot completed": {
+			snapshotID: "snapshot-id",
+			backupsAPI: func() admin.CloudBackupsApi {
+				backups := mockadmin.NewCloudBackupsApi(t)
+				backups.EXPECT().GetReplicaSetBackup(mock.Anything, "project-id", "cluster0", "snapshot-id").Return(admin.GetReplicaSetBackupApiRequest{ApiService: backups})
+				backups.EXPECT().GetReplicaSetBackupExecute(mock.Anything).Return(&admin.DiskBackupReplicaSet{Status: pointer.MakePtr("completed")}, nil, nil)
+				return backups
+			},
+			wantOK:    true,
+			wantEvent: "Normal FinalSnapshotCompleted Final snapshot snapshot-id completed, removing the deployment",
+		},
+		"reads the snapshot of a sharded cluster": {
+			clusterType: "SHARDED",
+			snapshotID:  "snapshot-id",
+			backupsAPI: func() admin.CloudBackupsApi {
+				backups := mockadmin.NewCloudBackupsApi(t)
+				backups.EXPECT().GetShardedClusterBackup(mock.Anything, "project-id", "cluster0", "snapshot-id").Return(admin.GetShardedClusterBackupApiRequest{ApiService: backups})
+				backups.EXPECT().GetShardedClusterBackupExecute(mock.Anything).Return(&admin.DiskBackupShardedClusterSnapshot{Status: pointer.MakePtr("completed")}, nil, nil)
+				return backups
+			},
+			wantOK:    true,
+			wantEvent: "Normal FinalSnapshotCompleted Final snapshot snapshot-id completed, removing the deployment",
+		},
+		"retakes a failed snapshot": {
+			snapshotID: "snapshot-id",
+			backupsAPI: func() admin.CloudBackupsApi {
+				backups := mockadmin.NewCloudBackupsApi(t)
+				backups.EXPECT().GetReplicaSetBackup(mock.Anything, "project-id", "cluster0", "snapshot-id").Return(admin.GetReplicaSetBackupApiRequest{ApiService: backups})
+				backups.EXPECT().GetReplicaSetBackupExecute(mock.Anything).Return(&admin.DiskBackupReplicaSet{Status: pointer.MakePtr("failed")}, nil, nil)
+				return backups
+			},
+			wantReason:       workflow.DeploymentFinalSnapshotFailed,
+			wantStatusOption: true,
+		},
+		"retakes a snapshot which is gone": {
+			snapshotID: "snapshot-id",
+			backupsAPI: func() admin.CloudBackupsApi {
+				backups := mockadmin.NewCloudBackupsApi(t)
+				backups.EXPECT().GetReplicaSetBackup(mock.Anything, "project-id", "cluster0", "snapshot-id").Return(admin.GetReplicaSetBackupApiRequest{ApiService: backups})
+				backups.EXPECT().GetReplicaSetBackupExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found"))
+				return backups
+			},
+			wantReason:       workflow.DeploymentFinalSnapshotInProgress,
+			wantStatusOption: true,
+		},
+		"skips the snapshot when backups are disabled": {
+			backupDisabled: true,
+			backupsAPI: func() admin.CloudBackupsApi {
+				return mockadmin.NewCloudBackupsApi(t)
+			},
+			wantOK:    true,
+			wantEvent: "Warning FinalSnapshotSkipped Backups are disabled, removing the deployment without a final snapshot",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			atlasDeployment := &akov2.AtlasDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster0", Namespace: "default"},
+				Spec: akov2.AtlasDeploymentSpec{
+					DeploymentSpec: &akov2.AdvancedDeploymentSpec{
+						Name:          "cluster0",
+						ClusterType:   tc.clusterType,
+						BackupEnabled: pointer.MakePtr(!tc.backupDisabled),
+					},
+					FinalSnapshot: &akov2.FinalSnapshot{RetentionInDays: 3},
+				},
+				Status: status.AtlasDeploymentStatus{FinalSnapshotID: tc.snapshotID},
+			}
+			recorder := record.NewFakeRecorder(10)
+			logger := zaptest.NewLogger(t).Sugar()
+			r := &AtlasDeploymentReconciler{
+				AtlasReconciler: reconciler.AtlasReconciler{Log: logger},
+				EventRecorder:   recorder,
+			}
+			ctx := &workflow.Context{
+				Context: context.Background(),
+				Log:     logger,
+				SdkClientSet: &atlas.ClientSet{
+					SdkClient20250312002: &admin.APIClient{CloudBackupsApi: tc.backupsAPI()},
+				},
+			}
+
+			result := r.ensureFinalSnapshot(ctx, "project-id", atlasDeployment)
+			assert.Equal(t, tc.wantOK, result.IsOk())
+			if !tc.wantOK {
+				ctx.SetConditionFromResult(api.DeploymentReadyType, result)
+				assert.True(t, ctx.HasReason(tc.wantReason))
+			}
+
+			if tc.wantStatusOption {
+				statusCopy := atlasDeployment.Status
+				for _, option := range ctx.StatusOptions() {
+					option.(status.AtlasDeploymentStatusOption)(&statusCopy)
+				}
+				assert.Equal(t, tc.wantSnapshotID, statusCopy.FinalSnapshotID)
+			} else {
+				assert.Empty(t, ctx.StatusOptions())
+			}
+
+			if tc.wantEvent != "" {
+				assert.Equal(t, tc.wantEvent, <-recorder.Events)
+			}
+			assert.Empty(t, recorder.Events)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
+)
+
+// legacyMajorVersions lists the releases before MongoDB switched to one major version per year
+var legacyMajorVersions = []string{"3.6", "4.0", "4.2", "4.4", "5.0"}
+
+// ensureMajorVersion validates a change of mongoDBMajorVersion before it is sent to Atlas.
+// Upgrades must go one major version at a time, and a rollback to the previous major version is
+// only possible while the feature compatibility version is still pinned to it.
+func (r *AtlasDeploymentReconciler) ensureMajorVersion(ctx *workflow.Context, deploymentService deployment.AtlasDeploymentsService, deploymentInAKO, deploymentInAtlas *deployment.Cluster) transitionFn {
+	desired := deploymentInAKO.MongoDBMajorVersion
+	current := deploymentInAtlas.MongoDBMajorVersion
+
+	if desired == "" || current == "" || desired == current || deploymentInAKO.VersionReleaseSystem == "CONTINUOUS" {
+		if api.HasConditionType(api.MajorVersionUpgradeReadyType, ctx.Conditions()) {
+			ctx.SetConditionTrueMsg(api.MajorVersionUpgradeReadyType, fmt.Sprintf("deployment is running MongoDB %s", current))
+		}
+		setFCVPinnedCondition(ctx, deploymentInAtlas)
+
+		return nil
+	}
+
+	switch {
+	case nextMajorVersion(current) == desired:
+		if pin := deploymentInAKO.GetCustomResource().Spec.MajorVersionUpgrade; pin != nil && pin.PinFCVDays > 0 && deploymentInAtlas.FCVPinnedUntil == nil {
+			until := time.Now().UTC().AddDate(0, 0, pin.PinFCVDays)
+			err := deploymentService.PinFeatureCompatibilityVersion(ctx.Context, deploymentInAKO.GetProjectID(), deploymentInAKO.GetName(), until)
+			if err != nil {
+				return r.majorVersionNotAllowed(ctx, workflow.FeatureCompatibilityVersionNotPinned, err)
+			}
+			deploymentInAtlas.FCVPinnedUntil = &until
+		}
+
+		ctx.SetConditionFromResult(
+			api.MajorVersionUpgradeReadyType,
+			workflow.InProgress(workflow.MajorVersionUpgradeInProgress, fmt.Sprintf("upgrading MongoDB from %s to %s", current, desired)),
+		)
+	case nextMajorVersion(desired) == current:
+		if !fcvPinnedTo(deploymentInAtlas, desired) {
+			return r.majorVersionNotAllowed(
+				ctx,
+				workflow.MajorVersionUpgradeNotAllowed,
+				fmt.Errorf("cannot roll back MongoDB from %s to %s: feature compatibility version is not pinned to %s", current, desired, desired),
+			)
+		}
+
+		ctx.SetConditionFromResult(
+			api.MajorVersionUpgradeReadyType,
+			workflow.InProgress(workflow.MajorVersionRollbackInProgress, fmt.Sprintf("rolling back MongoDB from %s to %s", current, desired)),
+		)
+	default:
+		return r.majorVersionNotAllowed(
+			ctx,
+			workflow.MajorVersionUpgradeNotAllowed,
+			fmt.Errorf("cannot change MongoDB from %s to %s: major versions must be upgraded one at a time", current, desired),
+		)
+	}
+	setFCVPinnedCondition(ctx, deploymentInAtlas)
+
+	return nil
+}
+
+func (r *AtlasDeploymentReconciler) majorVersionNotAllowed(ctx *workflow.Context, reason workflow.ConditionReason, err error) transitionFn {
+	return func(workflow.ConditionReason) (ctrl.Result, error) {
+		ctx.SetConditionFromResult(api.MajorVersionUpgradeReadyType, workflow.Terminate(reason, err))
+
+		return r.terminate(ctx, reason, err)
+	}
+}
+
+func setFCVPinnedCondition(ctx *workflow.Context, deploymentInAtlas *deployment.Cluster) {
+	if deploymentInAtlas.FCVPinnedUntil == nil {
+		ctx.UnsetCondition(api.FeatureCompatibilityVersionPinnedType)
+
+		return
+	}
+
+	ctx.SetConditionTrueMsg(
+		api.FeatureCompatibilityVersionPinnedType,
+		fmt.Sprintf("feature compatibility version %s is pinned until %s", deploymentInAtlas.FeatureCompatibilityVersion, deploymentInAtlas.FCVPinnedUntil.Format(time.RFC3339)),
+	)
+}
+
+func fcvPinnedTo(deploymentInAtlas *deployment.Cluster, version string) bool {
+	return deploymentInAtlas.FCVPinnedUntil != nil &&
+		deploymentInAtlas.FCVPinnedUntil.After(time.Now()) &&
+		deploymentInAtlas.FeatureCompatibilityVersion == version
+}
+
+// nextMajorVersion returns the only major version a deployment can be upgraded to from the given one
+func nextMajorVersion(version string) string {
+	if i := slices.Index(legacyMajorVersions, version); i >= 0 && i < len(legacyMajorVersions)-1 {
+		return legacyMajorVersions[i+1]
+	}
+
+	major, minor, ok := strings.Cut(version, ".")
+	if !ok || minor != "0" {
+		return ""
+	}
+
+	n, err := strconv.Atoi(major)
+	if err != nil || n < 5 {
+		return ""
+	}
+
+	return fmt.Sprintf("%d.0", n+1)
+}
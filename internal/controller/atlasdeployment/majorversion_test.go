@@ -0,0 +1,247 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeployment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deployment"
+)
+
+func TestEnsureMajorVersion(t *testing.T) {
+	pinnedUntil := time.Now().Add(72 * time.Hour)
+	expiredPin := time.Now().Add(-time.Hour)
+
+	for name, tc := range map[string]struct {
+		desired             string
+		current             string
+		releaseSystem       string
+		upgrade             *akov2.MajorVersionUpgrade
+		fcv                 string
+		fcvPinnedUntil      *time.Time
+		conditions          []api.Condition
+		deploymentService   func() deployment.AtlasDeploymentsService
+		wantTransition      bool
+		wantReason          workflow.ConditionReason
+		wantUpgradeStatus   corev1.ConditionStatus
+		wantPinnedCondition bool
+	}{
+		"does nothing when the version is unchanged": {
+			desired: "7.0",
+			current: "7.0",
+		},
+		"marks a finished upgrade as ready": {
+			desired: "8.0",
+			current: "8.0",
+			conditions: []api.Condition{
+				api.FalseCondition(api.MajorVersionUpgradeReadyType).WithReason(string(workflow.MajorVersionUpgradeInProgress)),
+			},
+			wantUpgradeStatus: corev1.ConditionTrue,
+		},
+		"reports a pinned feature compatibility version": {
+			desired:             "8.0",
+			current:             "8.0",
+			fcv:                 "7.0",
+			fcvPinnedUntil:      &pinnedUntil,
+			wantPinnedCondition: true,
+		},
+		"skips versions managed by Atlas": {
+			desired:       "6.0",
+			current:       "8.0",
+			releaseSystem: "CONTINUOUS",
+		},
+		"upgrades to the next major version": {
+			desired:           "8.0",
+			current:           "7.0",
+			wantReason:        workflow.MajorVersionUpgradeInProgress,
+			wantUpgradeStatus: corev1.ConditionFalse,
+		},
+		"upgrades from a legacy major version": {
+			desired:           "4.4",
+			current:           "4.2",
+			wantReason:        workflow.MajorVersionUpgradeInProgress,
+			wantUpgradeStatus: corev1.ConditionFalse,
+		},
+		"pins the feature compatibility version before upgrading": {
+			desired: "8.0",
+			current: "7.0",
+			fcv:     "7.0",
+			upgrade: &akov2.MajorVersionUpgrade{PinFCVDays: 7},
+			deploymentService: func() deployment.AtlasDeploymentsService {
+				service := translation.NewAtlasDeploymentsServiceMock(t)
+				service.EXPECT().PinFeatureCompatibilityVersion(mock.Anything, "project-id", "cluster0", mock.AnythingOfType("time.Time")).
+					Run(func(_ context.Context, _, _ string, until time.Time) {
+						assert.WithinDuration(t, time.Now().AddDate(0, 0, 7), until, time.Minute)
+					}).
+					Return(nil)
+				return service
+			},
+			wantReason:          workflow.MajorVersionUpgradeInProgress,
+			wantUpgradeStatus:   corev1.ConditionFalse,
+			wantPinnedCondition: true,
+		},
+		"keeps an existing pin": {
+			desired:             "8.0",
+			current:             "7.0",
+			fcv:                 "7.0",
+			fcvPinnedUntil:      &pinnedUntil,
+			upgrade:             &akov2.MajorVersionUpgrade{PinFCVDays: 7},
+			wantReason:          workflow.MajorVersionUpgradeInProgress,
+			wantUpgradeStatus:   corev1.ConditionFalse,
+			wantPinnedCondition: true,
+		},
+		"fails to pin the feature compatibility version": {
+			desired: "8.0",
+			current: "7.0",
+			upgrade: &akov2.MajorVersionUpgrade{PinFCVDays: 7},
+			deploymentService: func() deployment.AtlasDeploymentsService {
+				service := translation.NewAtlasDeploymentsServiceMock(t)
+				service.EXPECT().PinFeatureCompatibilityVersion(mock.Anything, "project-id", "cluster0", mock.Anything).
+					Return(errors.New("failed to pin"))
+				return service
+			},
+			wantTransition:    true,
+			wantReason:        workflow.FeatureCompatibilityVersionNotPinned,
+			wantUpgradeStatus: corev1.ConditionFalse,
+		},
+		"refuses to skip a major version": {
+			desired:           "8.0",
+			current:           "6.0",
+			wantTransition:    true,
+			wantReason:        workflow.MajorVersionUpgradeNotAllowed,
+			wantUpgradeStatus: corev1.ConditionFalse,
+		},
+		"refuses an unknown major version": {
+			desired:           "7.1",
+			current:           "7.0",
+			wantTransition:    true,
+			wantReason:        workflow.MajorVersionUpgradeNotAllowed,
+			wantUpgradeStatus: corev1.ConditionFalse,
+		},
+		"rolls back while the feature compatibility version is pinned": {
+			desired:             "7.0",
+			current:             "8.0",
+			fcv:                 "7.0",
+			fcvPinnedUntil:      &pinnedUntil,
+			wantReason:          workflow.MajorVersionRollbackInProgress,
+			wantUpgradeStatus:   corev1.ConditionFalse,
+			wantPinnedCondition: true,
+		},
+		"refuses to roll back without a pinned feature compatibility version": {
+			desired:           "7.0",
+			current:           "8.0",
+			fcv:               "8.0",
+			wantTransition:    true,
+			wantReason:        workflow.MajorVersionUpgradeNotAllowed,
+			wantUpgradeStatus: corev1.ConditionFalse,
+		},
+		"refuses to roll back once the pin expired": {
+			desired:           "7.0",
+			current:           "8.0",
+			fcv:               "7.0",
+			fcvPinnedUntil:    &expiredPin,
+			wantTransition:    true,
+			wantReason:        workflow.MajorVersionUpgradeNotAllowed,
+			wantUpgradeStatus: corev1.ConditionFalse,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			atlasDeployment := &akov2.AtlasDeployment{
+				Spec: akov2.AtlasDeploymentSpec{
+					DeploymentSpec: &akov2.AdvancedDeploymentSpec{
+						Name:                 "cluster0",
+						MongoDBMajorVersion:  tc.desired,
+						VersionReleaseSystem: tc.releaseSystem,
+					},
+					MajorVersionUpgrade: tc.upgrade,
+				},
+			}
+			akoCluster := deployment.NewDeployment("project-id", atlasDeployment).(*deployment.Cluster)
+			atlasCluster := &deployment.Cluster{
+				AdvancedDeploymentSpec: &akov2.AdvancedDeploymentSpec{
+					Name:                "cluster0",
+					MongoDBMajorVersion: tc.current,
+				},
+				ProjectID:                   "project-id",
+				FeatureCompatibilityVersion: tc.fcv,
+				FCVPinnedUntil:              tc.fcvPinnedUntil,
+			}
+
+			var deploymentService deployment.AtlasDeploymentsService = translation.NewAtlasDeploymentsServiceMock(t)
+			if tc.deploymentService != nil {
+				deploymentService = tc.deploymentService()
+			}
+
+			logger := zaptest.NewLogger(t).Sugar()
+			r := &AtlasDeploymentReconciler{AtlasReconciler: reconciler.AtlasReconciler{Log: logger}}
+			ctx := workflow.NewContext(logger, tc.conditions, context.Background(), atlasDeployment)
+
+			transition := r.ensureMajorVersion(ctx, deploymentService, akoCluster, atlasCluster)
+			require.Equal(t, tc.wantTransition, transition != nil)
+			if transition != nil {
+				_, err := transition(workflow.MajorVersionUpgradeNotAllowed)
+				require.Error(t, err)
+				condition, ok := ctx.GetCondition(api.DeploymentReadyType)
+				require.True(t, ok)
+				assert.Equal(t, string(tc.wantReason), condition.Reason)
+			}
+
+			condition, ok := ctx.GetCondition(api.MajorVersionUpgradeReadyType)
+			assert.Equal(t, tc.wantUpgradeStatus != "", ok)
+			if ok {
+				assert.Equal(t, tc.wantUpgradeStatus, condition.Status)
+				if tc.wantReason != "" {
+					assert.Equal(t, string(tc.wantReason), condition.Reason)
+				}
+			}
+
+			_, ok = ctx.GetCondition(api.FeatureCompatibilityVersionPinnedType)
+			assert.Equal(t, tc.wantPinnedCondition, ok)
+		})
+	}
+}
+
+func TestNextMajorVersion(t *testing.T) {
+	for version, next := range map[string]string{
+		"3.6": "4.0",
+		"4.0": "4.2",
+		"4.2": "4.4",
+		"4.4": "5.0",
+		"5.0": "6.0",
+		"6.0": "7.0",
+		"7.0": "8.0",
+		"8.0": "9.0",
+		"3.4": "",
+		"7.1": "",
+		"7":   "",
+		"":    "",
+	} {
+		assert.Equal(t, next, nextMajorVersion(version), version)
+	}
+}
@@ -0,0 +1,412 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeploymentaction
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasbackupexportbucket"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deploymentaction"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
+)
+
+func (h *AtlasDeploymentActionHandler) HandleInitial(ctx context.Context, action *akov2.AtlasDeploymentAction) (ctrlstate.Result, error) {
+	return h.handle(ctx, state.StateInitial, action)
+}
+
+func (h *AtlasDeploymentActionHandler) HandleCreating(ctx context.Context, action *akov2.AtlasDeploymentAction) (ctrlstate.Result, error) {
+	return h.handle(ctx, state.StateCreating, action)
+}
+
+func (h *AtlasDeploymentActionHandler) HandleCreated(ctx context.Context, action *akov2.AtlasDeploymentAction) (ctrlstate.Result, error) {
+	return h.handle(ctx, state.StateCreated, action)
+}
+
+func (h *AtlasDeploymentActionHandler) HandleUpdating(ctx context.Context, action *akov2.AtlasDeploymentAction) (ctrlstate.Result, error) {
+	return h.handle(ctx, state.StateUpdating, action)
+}
+
+func (h *AtlasDeploymentActionHandler) HandleUpdated(ctx context.Context, action *akov2.AtlasDeploymentAction) (ctrlstate.Result, error) {
+	return h.handle(ctx, state.StateUpdated, action)
+}
+
+// HandleDeletionRequested leaves Atlas untouched, the action already ran and removing
+// its record does not undo it
+func (h *AtlasDeploymentActionHandler) HandleDeletionRequested(_ context.Context, action *akov2.AtlasDeploymentAction) (ctrlstate.Result, error) {
+	return result.NextState(state.StateDeleted, fmt.Sprintf("Removed %s action", action.Spec.Action))
+}
+
+func (h *AtlasDeploymentActionHandler) HandleDeleting(_ context.Context, action *akov2.AtlasDeploymentAction) (ctrlstate.Result, error) {
+	return result.NextState(state.StateDeleted, fmt.Sprintf("Removed %s action", action.Spec.Action))
+}
+
+// handle runs the action exactly once and then follows it in Atlas until it completes.
+// A finished action is never run again, whatever happens to the resource afterwards.
+func (h *AtlasDeploymentActionHandler) handle(ctx context.Context, currentState state.ResourceState, action *akov2.AtlasDeploymentAction) (ctrlstate.Result, error) {
+	switch action.Status.Phase {
+	case status.DeploymentActionSucceeded:
+		return result.NextState(settledState(currentState), fmt.Sprintf("%s action succeeded", action.Spec.Action))
+	case status.DeploymentActionFailed:
+		return result.Error(currentState, actionFailed(action))
+	}
+
+	req, err := h.newReconcileRequest(ctx, action)
+	if err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to build reconcile request: %w", err))
+	}
+
+	switch {
+	case action.Status.Phase == "":
+		return h.run(ctx, currentState, req)
+	case !hasAtlasID(&action.Status):
+		return h.recover(ctx, currentState, req)
+	}
+	return h.follow(ctx, currentState, req)
+}
+
+// run records the action as running before sending it to Atlas, so that a reconciliation
+// which stops in between finds the action running and looks it up instead of repeating it
+func (h *AtlasDeploymentActionHandler) run(ctx context.Context, currentState state.ResourceState, req *reconcileRequest) (ctrlstate.Result, error) {
+	exportBucketID, err := h.prepare(ctx, req)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+
+	observed := req.action.Status.DeepCopy()
+	observed.ProjectID = req.projectID
+	observed.ClusterName = req.clusterName
+	observed.Phase = status.DeploymentActionRunning
+	observed.StartTime = nowPtr()
+	if err := h.claim(ctx, req.action, observed); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to record the start of the action: %w", err))
+	}
+
+	res, err := h.start(ctx, currentState, req, exportBucketID)
+	if err != nil && req.action.Spec.Action == akov2.DeploymentActionTestFailover {
+		// a failover test cannot be looked up later on, release it so that it is sent again
+		if releaseErr := h.release(ctx, req.action); releaseErr != nil {
+			return result.Error(currentState, errors.Join(err, releaseErr))
+		}
+	}
+	return res, err
+}
+
+// prepare checks the action can be sent to Atlas and resolves the export bucket of a snapshot export
+func (h *AtlasDeploymentActionHandler) prepare(ctx context.Context, req *reconcileRequest) (string, error) {
+	spec := &req.action.Spec
+	switch spec.Action {
+	case akov2.DeploymentActionStartOutageSimulation:
+		if spec.OutageSimulation == nil {
+			return "", errors.New("outageSimulation is required to start an outage simulation")
+		}
+	case akov2.DeploymentActionExportSnapshot:
+		if spec.SnapshotExport == nil {
+			return "", errors.New("snapshotExport is required to export a snapshot")
+		}
+		if spec.SnapshotExport.ExportBucketRef == nil {
+			return spec.SnapshotExport.ExportBucketID, nil
+		}
+		exportBucketID, err := atlasbackupexportbucket.ResolveExportBucketID(ctx, h.Client, spec.SnapshotExport.ExportBucketRef, req.action.Namespace)
+		if err != nil {
+			return "", fmt.Errorf("unable to resolve the export bucket: %w", err)
+		}
+		return exportBucketID, nil
+	case akov2.DeploymentActionTestFailover, akov2.DeploymentActionEndOutageSimulation, akov2.DeploymentActionTakeSnapshot:
+	default:
+		return "", fmt.Errorf("unsupported action %q", spec.Action)
+	}
+	return "", nil
+}
+
+// start sends the action, already recorded as running, to Atlas
+func (h *AtlasDeploymentActionHandler) start(ctx context.Context, currentState state.ResourceState, req *reconcileRequest, exportBucketID string) (ctrlstate.Result, error) {
+	spec := &req.action.Spec
+	observed := req.action.Status.DeepCopy()
+
+	switch spec.Action {
+	case akov2.DeploymentActionTestFailover:
+		if err := req.service.TestFailover(ctx, req.projectID, req.clusterName); err != nil {
+			return result.Error(currentState, err)
+		}
+		// Atlas does not track a failover test, accepting the request is all there is to follow
+		observed.Phase = status.DeploymentActionSucceeded
+		observed.CompletionTime = observed.StartTime
+	case akov2.DeploymentActionStartOutageSimulation:
+		simulation, err := req.service.StartOutageSimulation(ctx, req.projectID, req.clusterName, spec.OutageSimulation)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		observed.OutageSimulationID = simulation.ID
+		observed.AtlasState = simulation.State
+	case akov2.DeploymentActionEndOutageSimulation:
+		simulation, err := req.service.EndOutageSimulation(ctx, req.projectID, req.clusterName)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		observed.OutageSimulationID = simulation.ID
+		observed.AtlasState = simulation.State
+	case akov2.DeploymentActionTakeSnapshot:
+		snapshot, err := req.service.TakeSnapshot(ctx, req.projectID, req.clusterName, spec.Snapshot)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		observed.SnapshotID = snapshot.ID
+		observed.AtlasState = snapshot.Status
+	case akov2.DeploymentActionExportSnapshot:
+		job, err := req.service.ExportSnapshot(ctx, req.projectID, req.clusterName, exportBucketID, spec.SnapshotExport)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		observed.ExportJobID = job.ID
+		observed.AtlasState = job.State
+	default:
+		return result.Error(currentState, fmt.Errorf("unsupported action %q", spec.Action))
+	}
+
+	return h.recordStatus(ctx, currentState, req.action, observed)
+}
+
+// recover finds in Atlas the operation of an action recorded as running without its ID,
+// the reconciliation which started it stopped before recording it.
+// The action is sent again only when Atlas shows it never received it.
+func (h *AtlasDeploymentActionHandler) recover(ctx context.Context, currentState state.ResourceState, req *reconcileRequest) (ctrlstate.Result, error) {
+	exportBucketID, err := h.prepare(ctx, req)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+
+	spec := &req.action.Spec
+	observed := req.action.Status.DeepCopy()
+	var since time.Time
+	if observed.StartTime != nil {
+		since = observed.StartTime.Time
+	}
+
+	switch spec.Action {
+	case akov2.DeploymentActionTestFailover:
+		// Atlas keeps no record of a failover test, running it again could fail over twice
+		observed.Phase = status.DeploymentActionFailed
+		observed.CompletionTime = nowPtr()
+	case akov2.DeploymentActionStartOutageSimulation:
+		simulation, err := req.service.GetOutageSimulation(ctx, req.projectID, req.clusterName)
+		switch {
+		case errors.Is(err, deploymentaction.ErrNotFound):
+			return h.start(ctx, currentState, req, exportBucketID)
+		case err != nil:
+			return result.Error(currentState, err)
+		}
+		observed.OutageSimulationID = simulation.ID
+		observed.AtlasState = simulation.State
+	case akov2.DeploymentActionEndOutageSimulation:
+		simulation, err := req.service.GetOutageSimulation(ctx, req.projectID, req.clusterName)
+		switch {
+		case errors.Is(err, deploymentaction.ErrNotFound):
+			// the simulation already ended
+			observed.AtlasState = deploymentaction.OutageSimulationStateComplete
+			observed.Phase = status.DeploymentActionSucceeded
+			observed.CompletionTime = nowPtr()
+		case err != nil:
+			return result.Error(currentState, err)
+		case simulation.State == deploymentaction.OutageSimulationStateSimulating:
+			return h.start(ctx, currentState, req, exportBucketID)
+		default:
+			observed.OutageSimulationID = simulation.ID
+			observed.AtlasState = simulation.State
+		}
+	case akov2.DeploymentActionTakeSnapshot:
+		snapshot, err := req.service.FindSnapshot(ctx, req.projectID, req.clusterName, spec.Snapshot, since)
+		switch {
+		case errors.Is(err, deploymentaction.ErrNotFound):
+			return h.start(ctx, currentState, req, exportBucketID)
+		case err != nil:
+			return result.Error(currentState, err)
+		}
+		observed.SnapshotID = snapshot.ID
+		observed.AtlasState = snapshot.Status
+	case akov2.DeploymentActionExportSnapshot:
+		job, err := req.service.FindExportJob(ctx, req.projectID, req.clusterName, exportBucketID, spec.SnapshotExport.SnapshotID, since)
+		switch {
+		case errors.Is(err, deploymentaction.ErrNotFound):
+			return h.start(ctx, currentState, req, exportBucketID)
+		case err != nil:
+			return result.Error(currentState, err)
+		}
+		observed.ExportJobID = job.ID
+		observed.AtlasState = job.State
+	}
+
+	return h.recordStatus(ctx, currentState, req.action, observed)
+}
+
+// hasAtlasID tells whether the status records the Atlas operation of a running action
+func hasAtlasID(actionStatus *status.AtlasDeploymentActionStatus) bool {
+	return actionStatus.SnapshotID != "" || actionStatus.OutageSimulationID != "" || actionStatus.ExportJobID != ""
+}
+
+func (h *AtlasDeploymentActionHandler) follow(ctx context.Context, currentState state.ResourceState, req *reconcileRequest) (ctrlstate.Result, error) {
+	action := req.action
+	observed := action.Status.DeepCopy()
+
+	switch action.Spec.Action {
+	case akov2.DeploymentActionStartOutageSimulation, akov2.DeploymentActionEndOutageSimulation:
+		simulation, err := req.service.GetOutageSimulation(ctx, req.projectID, req.clusterName)
+		switch {
+		case errors.Is(err, deploymentaction.ErrNotFound):
+			// Atlas removes the simulation once the deployment recovered from it
+			observed.AtlasState = deploymentaction.OutageSimulationStateComplete
+		case err != nil:
+			return result.Error(currentState, err)
+		default:
+			observed.AtlasState = simulation.State
+		}
+		switch {
+		case outageSimulationDone(action.Spec.Action, observed.AtlasState):
+			observed.Phase = status.DeploymentActionSucceeded
+		case observed.AtlasState == deploymentaction.OutageSimulationStateComplete:
+			// the simulation ended before it ever started simulating
+			observed.Phase = status.DeploymentActionFailed
+		}
+	case akov2.DeploymentActionTakeSnapshot:
+		snapshot, err := req.service.GetSnapshot(ctx, req.projectID, req.clusterName, observed.SnapshotID)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		observed.AtlasState = snapshot.Status
+		switch snapshot.Status {
+		case deploymentaction.SnapshotStatusCompleted:
+			observed.Phase = status.DeploymentActionSucceeded
+		case deploymentaction.SnapshotStatusFailed:
+			observed.Phase = status.DeploymentActionFailed
+		}
+	case akov2.DeploymentActionExportSnapshot:
+		job, err := req.service.GetExportJob(ctx, req.projectID, req.clusterName, observed.ExportJobID)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		observed.AtlasState = job.State
+		switch job.State {
+		case deploymentaction.ExportJobStateSuccessful:
+			observed.Phase = status.DeploymentActionSucceeded
+		case deploymentaction.ExportJobStateFailed, deploymentaction.ExportJobStateCancelled:
+			observed.Phase = status.DeploymentActionFailed
+		}
+	default:
+		return result.Error(currentState, fmt.Errorf("unsupported action %q", action.Spec.Action))
+	}
+
+	if observed.Phase != status.DeploymentActionRunning {
+		observed.CompletionTime = nowPtr()
+	}
+	return h.recordStatus(ctx, currentState, action, observed)
+}
+
+// outageSimulationDone tells whether Atlas reached the end state of a start or end outage simulation action
+func outageSimulationDone(action, atlasState string) bool {
+	if action == akov2.DeploymentActionStartOutageSimulation {
+		return atlasState == deploymentaction.OutageSimulationStateSimulating
+	}
+	return atlasState == deploymentaction.OutageSimulationStateComplete
+}
+
+func (h *AtlasDeploymentActionHandler) recordStatus(ctx context.Context, currentState state.ResourceState, action *akov2.AtlasDeploymentAction, observed *status.AtlasDeploymentActionStatus) (ctrlstate.Result, error) {
+	action.Status = *observed
+	if err := h.patchNonConditionStatus(ctx, action); err != nil {
+		return result.Error(currentState, err)
+	}
+
+	switch observed.Phase {
+	case status.DeploymentActionSucceeded:
+		return result.NextState(settledState(currentState), fmt.Sprintf("%s action succeeded", action.Spec.Action))
+	case status.DeploymentActionFailed:
+		return result.Error(currentState, actionFailed(action))
+	default:
+		return result.NextState(transitionalState(currentState), fmt.Sprintf("%s action is running in Atlas: %s", action.Spec.Action, observed.AtlasState))
+	}
+}
+
+func actionFailed(action *akov2.AtlasDeploymentAction) error {
+	if action.Status.AtlasState == "" {
+		return fmt.Errorf("%s action failed: its outcome in Atlas is unknown", action.Spec.Action)
+	}
+	return fmt.Errorf("%s action failed: Atlas reported %s", action.Spec.Action, action.Status.AtlasState)
+}
+
+func transitionalState(currentState state.ResourceState) state.ResourceState {
+	switch currentState {
+	case state.StateInitial, state.StateCreating:
+		return state.StateCreating
+	default:
+		return state.StateUpdating
+	}
+}
+
+func settledState(currentState state.ResourceState) state.ResourceState {
+	switch currentState {
+	case state.StateInitial, state.StateCreating, state.StateCreated:
+		return state.StateCreated
+	default:
+		return state.StateUpdated
+	}
+}
+
+func nowPtr() *metav1.Time {
+	now := metav1.Now()
+	return &now
+}
+
+// claim records the action as running, failing when the resource changed since it was read
+// so that concurrent reconciliations never both send the action to Atlas
+func (h *AtlasDeploymentActionHandler) claim(ctx context.Context, action *akov2.AtlasDeploymentAction, observed *status.AtlasDeploymentActionStatus) error {
+	original := action.DeepCopy()
+	action.Status = *observed
+	if err := h.Client.Status().Patch(ctx, action, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+		action.Status = original.Status
+		return fmt.Errorf("failed to patch: %w", err)
+	}
+	return nil
+}
+
+// release drops the record of an action Atlas refused
+func (h *AtlasDeploymentActionHandler) release(ctx context.Context, action *akov2.AtlasDeploymentAction) error {
+	claimed := action.DeepCopy()
+	action.Status = status.AtlasDeploymentActionStatus{UnifiedStatus: claimed.Status.UnifiedStatus}
+	if err := h.Client.Status().Patch(ctx, action, client.MergeFrom(claimed)); err != nil {
+		return fmt.Errorf("failed to release the action: %w", err)
+	}
+	return nil
+}
+
+func (h *AtlasDeploymentActionHandler) patchNonConditionStatus(ctx context.Context, action *akov2.AtlasDeploymentAction) error {
+	statusJSON, err := json.Marshal(action)
+	if err != nil {
+		return fmt.Errorf("failed to marshal status: %w", err)
+	}
+	if err := h.Client.Status().Patch(ctx, action, client.RawPatch(types.MergePatchType, statusJSON)); err != nil {
+		return fmt.Errorf("failed to patch: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,624 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeploymentaction
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mocks "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deploymentaction"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
+)
+
+//nolint:gosec
+const (
+	fakeOrgID        = "fake-org-id"
+	fakeProjectID    = "fake-project-id"
+	fakeClusterName  = "my-cluster"
+	fakeSnapshotID   = "fake-snapshot-id"
+	fakeSimulationID = "fake-simulation-id"
+	fakeBucketID     = "fake-bucket-id"
+	fakeExportJobID  = "fake-export-job-id"
+)
+
+var fakeStartTime = metav1.NewTime(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+
+var fakeAtlasSecret = corev1.Secret{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "atlas-credentials",
+		Namespace: "default",
+	},
+	Data: map[string][]byte{
+		"orgId":         []byte(fakeOrgID),
+		"publicApiKey":  []byte("fake-api-key"),
+		"privateApiKey": []byte("fake-api-secret"),
+	},
+}
+
+var fakeProject = akov2.AtlasProject{
+	ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "default"},
+	Status:     status.AtlasProjectStatus{ID: fakeProjectID},
+}
+
+var fakeDeployment = akov2.AtlasDeployment{
+	ObjectMeta: metav1.ObjectMeta{Name: "my-deployment", Namespace: "default"},
+	Spec: akov2.AtlasDeploymentSpec{
+		ProjectDualReference: akov2.ProjectDualReference{
+			ProjectRef: &common.ResourceRefNamespaced{Name: "my-project"},
+		},
+		DeploymentSpec: &akov2.AdvancedDeploymentSpec{Name: fakeClusterName},
+	},
+}
+
+var fakeExportBucket = akov2.AtlasBackupExportBucket{
+	ObjectMeta: metav1.ObjectMeta{Name: "my-bucket", Namespace: "default"},
+	Status:     status.AtlasBackupExportBucketStatus{ExportBucketID: fakeBucketID},
+}
+
+var fakeProvider = &atlasmock.TestProvider{
+	SdkClientSetFunc: func(ctx context.Context, creds *atlas.Credentials, log *zap.SugaredLogger) (*atlas.ClientSet, error) {
+		return &atlas.ClientSet{}, nil
+	},
+}
+
+var creating = ctrlstate.Result{
+	Result:    reconcile.Result{RequeueAfter: result.DefaultRequeueTIme},
+	NextState: state.StateCreating,
+}
+
+func deploymentAction(action string) *akov2.AtlasDeploymentAction {
+	return &akov2.AtlasDeploymentAction{
+		ObjectMeta: metav1.ObjectMeta{Name: "drill", Namespace: "default"},
+		Spec: akov2.AtlasDeploymentActionSpec{
+			DeploymentRef: &common.ResourceRefNamespaced{Name: "my-deployment"},
+			Action:        action,
+		},
+	}
+}
+
+func outageSimulation() *akov2.AtlasDeploymentAction {
+	action := deploymentAction(akov2.DeploymentActionStartOutageSimulation)
+	action.Spec.DeploymentRef = nil
+	action.Spec.ExternalDeploymentRef = &akov2.ExternalDeploymentReference{ProjectID: fakeProjectID, ClusterName: fakeClusterName}
+	action.Spec.ConnectionSecret = &api.LocalObjectReference{Name: "atlas-credentials"}
+	action.Spec.OutageSimulation = &akov2.OutageSimulation{
+		Regions: []akov2.OutageSimulationRegion{{CloudProvider: "AWS", RegionName: "US_EAST_1"}},
+	}
+	return action
+}
+
+func snapshotExport(bucketName string) *akov2.AtlasDeploymentAction {
+	action := deploymentAction(akov2.DeploymentActionExportSnapshot)
+	action.Spec.SnapshotExport = &akov2.SnapshotExport{
+		SnapshotID:      fakeSnapshotID,
+		ExportBucketRef: &common.ResourceRefNamespaced{Name: bucketName},
+	}
+	return action
+}
+
+func withStatus(action *akov2.AtlasDeploymentAction, phase, atlasState string) *akov2.AtlasDeploymentAction {
+	action.Status = status.AtlasDeploymentActionStatus{
+		ProjectID:   fakeProjectID,
+		ClusterName: fakeClusterName,
+		Phase:       phase,
+		AtlasState:  atlasState,
+	}
+	switch action.Spec.Action {
+	case akov2.DeploymentActionTakeSnapshot:
+		action.Status.SnapshotID = fakeSnapshotID
+	case akov2.DeploymentActionStartOutageSimulation, akov2.DeploymentActionEndOutageSimulation:
+		action.Status.OutageSimulationID = fakeSimulationID
+	case akov2.DeploymentActionExportSnapshot:
+		action.Status.ExportJobID = fakeExportJobID
+	}
+	return action
+}
+
+// claimed records the action as running without the ID of its Atlas operation,
+// as left by a reconciliation which stopped right after sending it to Atlas
+func claimed(action *akov2.AtlasDeploymentAction) *akov2.AtlasDeploymentAction {
+	action.Status = status.AtlasDeploymentActionStatus{
+		ProjectID:   fakeProjectID,
+		ClusterName: fakeClusterName,
+		Phase:       status.DeploymentActionRunning,
+		StartTime:   &fakeStartTime,
+	}
+	return action
+}
+
+func TestHandle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name           string
+		state          state.ResourceState
+		input          *akov2.AtlasDeploymentAction
+		service        func(t *testing.T) deploymentaction.DeploymentActionService
+		want           ctrlstate.Result
+		wantErr        string
+		wantStatus     status.AtlasDeploymentActionStatus
+		wantStarted    bool
+		wantCompletion bool
+	}{
+		{
+			name:  "test failover completes at once",
+			state: state.StateInitial,
+			input: deploymentAction(akov2.DeploymentActionTestFailover),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().TestFailover(mock.Anything, fakeProjectID, fakeClusterName).Return(nil)
+				return svc
+			},
+			want: ctrlstate.Result{NextState: state.StateCreated, StateMsg: "TestFailover action succeeded."},
+			wantStatus: status.AtlasDeploymentActionStatus{
+				ProjectID: fakeProjectID, ClusterName: fakeClusterName, Phase: status.DeploymentActionSucceeded,
+			},
+			wantStarted:    true,
+			wantCompletion: true,
+		},
+		{
+			name:  "snapshots are taken",
+			state: state.StateInitial,
+			input: deploymentAction(akov2.DeploymentActionTakeSnapshot),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().TakeSnapshot(mock.Anything, fakeProjectID, fakeClusterName, (*akov2.OnDemandSnapshot)(nil)).
+					Return(&deploymentaction.Snapshot{ID: fakeSnapshotID, Status: "queued"}, nil)
+				return svc
+			},
+			want:        withMsg(creating, "TakeSnapshot action is running in Atlas: queued."),
+			wantStatus:  withStatus(deploymentAction(akov2.DeploymentActionTakeSnapshot), status.DeploymentActionRunning, "queued").Status,
+			wantStarted: true,
+		},
+		{
+			name:  "outage simulations are started on external deployments",
+			state: state.StateInitial,
+			input: outageSimulation(),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().StartOutageSimulation(mock.Anything, fakeProjectID, fakeClusterName, outageSimulation().Spec.OutageSimulation).
+					Return(&deploymentaction.OutageSimulation{ID: fakeSimulationID, State: "START_REQUESTED"}, nil)
+				return svc
+			},
+			want:        withMsg(creating, "StartOutageSimulation action is running in Atlas: START_REQUESTED."),
+			wantStatus:  withStatus(outageSimulation(), status.DeploymentActionRunning, "START_REQUESTED").Status,
+			wantStarted: true,
+		},
+		{
+			name:  "failures to run the action are retried",
+			state: state.StateInitial,
+			input: deploymentAction(akov2.DeploymentActionTestFailover),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().TestFailover(mock.Anything, fakeProjectID, fakeClusterName).Return(fmt.Errorf("fake-failure"))
+				return svc
+			},
+			want:    ctrlstate.Result{NextState: state.StateInitial},
+			wantErr: "fake-failure",
+		},
+		{
+			name:  "failures to take snapshots keep the action running",
+			state: state.StateInitial,
+			input: deploymentAction(akov2.DeploymentActionTakeSnapshot),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().TakeSnapshot(mock.Anything, fakeProjectID, fakeClusterName, (*akov2.OnDemandSnapshot)(nil)).
+					Return(nil, fmt.Errorf("fake-failure"))
+				return svc
+			},
+			want:        ctrlstate.Result{NextState: state.StateInitial},
+			wantErr:     "fake-failure",
+			wantStatus:  status.AtlasDeploymentActionStatus{ProjectID: fakeProjectID, ClusterName: fakeClusterName, Phase: status.DeploymentActionRunning},
+			wantStarted: true,
+		},
+		{
+			name:  "running snapshots without an ID are looked up",
+			state: state.StateCreating,
+			input: claimed(deploymentAction(akov2.DeploymentActionTakeSnapshot)),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().FindSnapshot(mock.Anything, fakeProjectID, fakeClusterName, (*akov2.OnDemandSnapshot)(nil), fakeStartTime.Time).
+					Return(&deploymentaction.Snapshot{ID: fakeSnapshotID, Status: "inProgress"}, nil)
+				return svc
+			},
+			want:        withMsg(creating, "TakeSnapshot action is running in Atlas: inProgress."),
+			wantStatus:  withStatus(deploymentAction(akov2.DeploymentActionTakeSnapshot), status.DeploymentActionRunning, "inProgress").Status,
+			wantStarted: true,
+		},
+		{
+			name:  "running snapshots Atlas never received are taken",
+			state: state.StateCreating,
+			input: claimed(deploymentAction(akov2.DeploymentActionTakeSnapshot)),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().FindSnapshot(mock.Anything, fakeProjectID, fakeClusterName, (*akov2.OnDemandSnapshot)(nil), fakeStartTime.Time).
+					Return(nil, deploymentaction.ErrNotFound)
+				svc.EXPECT().TakeSnapshot(mock.Anything, fakeProjectID, fakeClusterName, (*akov2.OnDemandSnapshot)(nil)).
+					Return(&deploymentaction.Snapshot{ID: fakeSnapshotID, Status: "queued"}, nil)
+				return svc
+			},
+			want:        withMsg(creating, "TakeSnapshot action is running in Atlas: queued."),
+			wantStatus:  withStatus(deploymentAction(akov2.DeploymentActionTakeSnapshot), status.DeploymentActionRunning, "queued").Status,
+			wantStarted: true,
+		},
+		{
+			name:  "running outage simulations without an ID are looked up",
+			state: state.StateCreating,
+			input: claimed(outageSimulation()),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().GetOutageSimulation(mock.Anything, fakeProjectID, fakeClusterName).
+					Return(&deploymentaction.OutageSimulation{ID: fakeSimulationID, State: "STARTING"}, nil)
+				return svc
+			},
+			want:        withMsg(creating, "StartOutageSimulation action is running in Atlas: STARTING."),
+			wantStatus:  withStatus(outageSimulation(), status.DeploymentActionRunning, "STARTING").Status,
+			wantStarted: true,
+		},
+		{
+			name:  "running outage simulation ends Atlas never received are sent",
+			state: state.StateCreating,
+			input: claimed(deploymentAction(akov2.DeploymentActionEndOutageSimulation)),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().GetOutageSimulation(mock.Anything, fakeProjectID, fakeClusterName).
+					Return(&deploymentaction.OutageSimulation{ID: fakeSimulationID, State: deploymentaction.OutageSimulationStateSimulating}, nil)
+				svc.EXPECT().EndOutageSimulation(mock.Anything, fakeProjectID, fakeClusterName).
+					Return(&deploymentaction.OutageSimulation{ID: fakeSimulationID, State: "RECOVERY_REQUESTED"}, nil)
+				return svc
+			},
+			want:        withMsg(creating, "EndOutageSimulation action is running in Atlas: RECOVERY_REQUESTED."),
+			wantStatus:  withStatus(deploymentAction(akov2.DeploymentActionEndOutageSimulation), status.DeploymentActionRunning, "RECOVERY_REQUESTED").Status,
+			wantStarted: true,
+		},
+		{
+			name:  "running export jobs without an ID are looked up",
+			state: state.StateCreating,
+			input: claimed(snapshotExport("my-bucket")),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().FindExportJob(mock.Anything, fakeProjectID, fakeClusterName, fakeBucketID, fakeSnapshotID, fakeStartTime.Time).
+					Return(&deploymentaction.ExportJob{ID: fakeExportJobID, State: "InProgress"}, nil)
+				return svc
+			},
+			want:        withMsg(creating, "ExportSnapshot action is running in Atlas: InProgress."),
+			wantStatus:  withStatus(snapshotExport("my-bucket"), status.DeploymentActionRunning, "InProgress").Status,
+			wantStarted: true,
+		},
+		{
+			name:  "running failover tests are never repeated",
+			state: state.StateCreating,
+			input: claimed(deploymentAction(akov2.DeploymentActionTestFailover)),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				return mocks.NewDeploymentActionServiceMock(t)
+			},
+			want:    ctrlstate.Result{NextState: state.StateCreating},
+			wantErr: "TestFailover action failed: its outcome in Atlas is unknown",
+			wantStatus: status.AtlasDeploymentActionStatus{
+				ProjectID: fakeProjectID, ClusterName: fakeClusterName, Phase: status.DeploymentActionFailed,
+			},
+			wantStarted:    true,
+			wantCompletion: true,
+		},
+		{
+			name:  "running snapshots are followed",
+			state: state.StateCreating,
+			input: withStatus(deploymentAction(akov2.DeploymentActionTakeSnapshot), status.DeploymentActionRunning, "queued"),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().GetSnapshot(mock.Anything, fakeProjectID, fakeClusterName, fakeSnapshotID).
+					Return(&deploymentaction.Snapshot{ID: fakeSnapshotID, Status: "inProgress"}, nil)
+				return svc
+			},
+			want:       withMsg(creating, "TakeSnapshot action is running in Atlas: inProgress."),
+			wantStatus: withStatus(deploymentAction(akov2.DeploymentActionTakeSnapshot), status.DeploymentActionRunning, "inProgress").Status,
+		},
+		{
+			name:  "completed snapshots succeed",
+			state: state.StateCreating,
+			input: withStatus(deploymentAction(akov2.DeploymentActionTakeSnapshot), status.DeploymentActionRunning, "inProgress"),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().GetSnapshot(mock.Anything, fakeProjectID, fakeClusterName, fakeSnapshotID).
+					Return(&deploymentaction.Snapshot{ID: fakeSnapshotID, Status: deploymentaction.SnapshotStatusCompleted}, nil)
+				return svc
+			},
+			want:           ctrlstate.Result{NextState: state.StateCreated, StateMsg: "TakeSnapshot action succeeded."},
+			wantStatus:     withStatus(deploymentAction(akov2.DeploymentActionTakeSnapshot), status.DeploymentActionSucceeded, "completed").Status,
+			wantCompletion: true,
+		},
+		{
+			name:  "failed snapshots fail the action",
+			state: state.StateCreating,
+			input: withStatus(deploymentAction(akov2.DeploymentActionTakeSnapshot), status.DeploymentActionRunning, "inProgress"),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().GetSnapshot(mock.Anything, fakeProjectID, fakeClusterName, fakeSnapshotID).
+					Return(&deploymentaction.Snapshot{ID: fakeSnapshotID, Status: deploymentaction.SnapshotStatusFailed}, nil)
+				return svc
+			},
+			want:           ctrlstate.Result{NextState: state.StateCreating},
+			wantErr:        "TakeSnapshot action failed: Atlas reported failed",
+			wantStatus:     withStatus(deploymentAction(akov2.DeploymentActionTakeSnapshot), status.DeploymentActionFailed, "failed").Status,
+			wantCompletion: true,
+		},
+		{
+			name:  "outage simulations succeed once simulating",
+			state: state.StateCreating,
+			input: withStatus(outageSimulation(), status.DeploymentActionRunning, "STARTING"),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().GetOutageSimulation(mock.Anything, fakeProjectID, fakeClusterName).
+					Return(&deploymentaction.OutageSimulation{ID: fakeSimulationID, State: deploymentaction.OutageSimulationStateSimulating}, nil)
+				return svc
+			},
+			want:           ctrlstate.Result{NextState: state.StateCreated, StateMsg: "StartOutageSimulation action succeeded."},
+			wantStatus:     withStatus(outageSimulation(), status.DeploymentActionSucceeded, "SIMULATING").Status,
+			wantCompletion: true,
+		},
+		{
+			name:  "outage simulations gone before simulating fail",
+			state: state.StateCreating,
+			input: withStatus(outageSimulation(), status.DeploymentActionRunning, "STARTING"),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().GetOutageSimulation(mock.Anything, fakeProjectID, fakeClusterName).
+					Return(nil, deploymentaction.ErrNotFound)
+				return svc
+			},
+			want:           ctrlstate.Result{NextState: state.StateCreating},
+			wantErr:        "StartOutageSimulation action failed: Atlas reported COMPLETE",
+			wantStatus:     withStatus(outageSimulation(), status.DeploymentActionFailed, "COMPLETE").Status,
+			wantCompletion: true,
+		},
+		{
+			name:  "ended outage simulations succeed once Atlas removed them",
+			state: state.StateCreating,
+			input: withStatus(deploymentAction(akov2.DeploymentActionEndOutageSimulation), status.DeploymentActionRunning, "RECOVERING"),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().GetOutageSimulation(mock.Anything, fakeProjectID, fakeClusterName).
+					Return(nil, deploymentaction.ErrNotFound)
+				return svc
+			},
+			want:           ctrlstate.Result{NextState: state.StateCreated, StateMsg: "EndOutageSimulation action succeeded."},
+			wantStatus:     withStatus(deploymentAction(akov2.DeploymentActionEndOutageSimulation), status.DeploymentActionSucceeded, "COMPLETE").Status,
+			wantCompletion: true,
+		},
+		{
+			name:  "snapshots are exported to referenced buckets",
+			state: state.StateInitial,
+			input: snapshotExport("my-bucket"),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().ExportSnapshot(mock.Anything, fakeProjectID, fakeClusterName, fakeBucketID, snapshotExport("my-bucket").Spec.SnapshotExport).
+					Return(&deploymentaction.ExportJob{ID: fakeExportJobID, State: "Queued"}, nil)
+				return svc
+			},
+			want:        withMsg(creating, "ExportSnapshot action is running in Atlas: Queued."),
+			wantStatus:  withStatus(snapshotExport("my-bucket"), status.DeploymentActionRunning, "Queued").Status,
+			wantStarted: true,
+		},
+		{
+			name:  "snapshots are not exported to missing buckets",
+			state: state.StateInitial,
+			input: snapshotExport("missing-bucket"),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				return mocks.NewDeploymentActionServiceMock(t)
+			},
+			want:    ctrlstate.Result{NextState: state.StateInitial},
+			wantErr: "unable to resolve the export bucket",
+		},
+		{
+			name:  "successful export jobs succeed",
+			state: state.StateCreating,
+			input: withStatus(snapshotExport("my-bucket"), status.DeploymentActionRunning, "InProgress"),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().GetExportJob(mock.Anything, fakeProjectID, fakeClusterName, fakeExportJobID).
+					Return(&deploymentaction.ExportJob{ID: fakeExportJobID, State: deploymentaction.ExportJobStateSuccessful}, nil)
+				return svc
+			},
+			want:           ctrlstate.Result{NextState: state.StateCreated, StateMsg: "ExportSnapshot action succeeded."},
+			wantStatus:     withStatus(snapshotExport("my-bucket"), status.DeploymentActionSucceeded, "Successful").Status,
+			wantCompletion: true,
+		},
+		{
+			name:  "cancelled export jobs fail the action",
+			state: state.StateCreating,
+			input: withStatus(snapshotExport("my-bucket"), status.DeploymentActionRunning, "InProgress"),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				svc := mocks.NewDeploymentActionServiceMock(t)
+				svc.EXPECT().GetExportJob(mock.Anything, fakeProjectID, fakeClusterName, fakeExportJobID).
+					Return(&deploymentaction.ExportJob{ID: fakeExportJobID, State: deploymentaction.ExportJobStateCancelled}, nil)
+				return svc
+			},
+			want:           ctrlstate.Result{NextState: state.StateCreating},
+			wantErr:        "ExportSnapshot action failed: Atlas reported Cancelled",
+			wantStatus:     withStatus(snapshotExport("my-bucket"), status.DeploymentActionFailed, "Cancelled").Status,
+			wantCompletion: true,
+		},
+		{
+			name:  "succeeded actions never run again",
+			state: state.StateUpdated,
+			input: withStatus(deploymentAction(akov2.DeploymentActionTestFailover), status.DeploymentActionSucceeded, ""),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				return mocks.NewDeploymentActionServiceMock(t)
+			},
+			want:       ctrlstate.Result{NextState: state.StateUpdated, StateMsg: "TestFailover action succeeded."},
+			wantStatus: withStatus(deploymentAction(akov2.DeploymentActionTestFailover), status.DeploymentActionSucceeded, "").Status,
+		},
+		{
+			name:  "failed actions never run again",
+			state: state.StateCreated,
+			input: withStatus(deploymentAction(akov2.DeploymentActionTakeSnapshot), status.DeploymentActionFailed, "failed"),
+			service: func(t *testing.T) deploymentaction.DeploymentActionService {
+				return mocks.NewDeploymentActionServiceMock(t)
+			},
+			want:       ctrlstate.Result{NextState: state.StateCreated},
+			wantErr:    "TakeSnapshot action failed: Atlas reported failed",
+			wantStatus: withStatus(deploymentAction(akov2.DeploymentActionTakeSnapshot), status.DeploymentActionFailed, "failed").Status,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(&fakeAtlasSecret, &fakeProject, &fakeDeployment, &fakeExportBucket, tc.input).
+				WithStatusSubresource(tc.input).Build()
+			svc := tc.service(t)
+			h := AtlasDeploymentActionHandler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Client:          k8sClient,
+					AtlasProvider:   fakeProvider,
+					GlobalSecretRef: client.ObjectKeyFromObject(&fakeAtlasSecret),
+				},
+				serviceBuilder: func(*atlas.ClientSet) deploymentaction.DeploymentActionService { return svc },
+			}
+			var handle func(context.Context, *akov2.AtlasDeploymentAction) (ctrlstate.Result, error)
+			switch tc.state {
+			case state.StateInitial:
+				handle = h.HandleInitial
+			case state.StateCreating:
+				handle = h.HandleCreating
+			case state.StateCreated:
+				handle = h.HandleCreated
+			case state.StateUpdated:
+				handle = h.HandleUpdated
+			default:
+				panic(fmt.Errorf("unsupported state %v for test", tc.state))
+			}
+			got, err := handle(ctx, tc.input)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+			assert.Equal(t, tc.want, got)
+
+			action := &akov2.AtlasDeploymentAction{}
+			require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(tc.input), action))
+			assert.Equal(t, tc.wantStarted, action.Status.StartTime != nil)
+			assert.Equal(t, tc.wantCompletion, action.Status.CompletionTime != nil)
+			action.Status.StartTime = nil
+			action.Status.CompletionTime = nil
+			assert.Equal(t, tc.wantStatus, action.Status)
+		})
+	}
+}
+
+func TestRunRecordsTheActionFirst(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name    string
+		stale   bool
+		wantErr string
+	}{
+		{
+			name: "the action is running before it is sent to Atlas",
+		},
+		{
+			name:    "stale actions are not sent to Atlas",
+			stale:   true,
+			wantErr: "failed to record the start of the action",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			input := deploymentAction(akov2.DeploymentActionTakeSnapshot)
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(&fakeAtlasSecret, &fakeProject, &fakeDeployment, input).
+				WithStatusSubresource(input).Build()
+			if tc.stale {
+				updated := input.DeepCopy()
+				updated.Annotations = map[string]string{"updated": "true"}
+				require.NoError(t, k8sClient.Update(ctx, updated))
+			}
+
+			svc := mocks.NewDeploymentActionServiceMock(t)
+			if !tc.stale {
+				svc.EXPECT().TakeSnapshot(mock.Anything, fakeProjectID, fakeClusterName, (*akov2.OnDemandSnapshot)(nil)).
+					Run(func(ctx context.Context, _, _ string, _ *akov2.OnDemandSnapshot) {
+						action := &akov2.AtlasDeploymentAction{}
+						require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(input), action))
+						assert.Equal(t, status.DeploymentActionRunning, action.Status.Phase)
+						assert.NotNil(t, action.Status.StartTime)
+					}).
+					Return(&deploymentaction.Snapshot{ID: fakeSnapshotID, Status: "queued"}, nil)
+			}
+			h := AtlasDeploymentActionHandler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Client:          k8sClient,
+					AtlasProvider:   fakeProvider,
+					GlobalSecretRef: client.ObjectKeyFromObject(&fakeAtlasSecret),
+				},
+				serviceBuilder: func(*atlas.ClientSet) deploymentaction.DeploymentActionService { return svc },
+			}
+
+			_, err := h.HandleInitial(ctx, input)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+				action := &akov2.AtlasDeploymentAction{}
+				require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(input), action))
+				assert.Empty(t, action.Status.Phase)
+			}
+		})
+	}
+}
+
+func TestHandleDeletion(t *testing.T) {
+	h := AtlasDeploymentActionHandler{}
+	action := withStatus(outageSimulation(), status.DeploymentActionSucceeded, "SIMULATING")
+
+	got, err := h.HandleDeletionRequested(context.Background(), action)
+	require.NoError(t, err)
+	assert.Equal(t, ctrlstate.Result{NextState: state.StateDeleted, StateMsg: "Removed StartOutageSimulation action."}, got)
+}
+
+func withMsg(res ctrlstate.Result, msg string) ctrlstate.Result {
+	res.StateMsg = msg
+	return res
+}
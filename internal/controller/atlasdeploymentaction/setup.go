@@ -0,0 +1,182 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasdeploymentaction
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlrtbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deploymentaction"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	mckpredicate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/predicate"
+)
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdeploymentactions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdeploymentactions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdeploymentactions/finalizers,verbs=update
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdeploymentactions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdeploymentactions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasdeploymentactions/finalizers,verbs=update
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasbackupexportbuckets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasbackupexportbuckets,verbs=get;list;watch
+
+type serviceBuilderFunc func(*atlas.ClientSet) deploymentaction.DeploymentActionService
+
+type AtlasDeploymentActionHandler struct {
+	ctrlstate.StateHandler[akov2.AtlasDeploymentAction]
+	reconciler.AtlasReconciler
+	serviceBuilder serviceBuilderFunc
+}
+
+func NewAtlasDeploymentActionReconciler(
+	c cluster.Cluster,
+	atlasProvider atlas.Provider,
+	logger *zap.Logger,
+	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
+	reapplySupport bool,
+) *ctrlstate.Reconciler[akov2.AtlasDeploymentAction] {
+	actionHandler := &AtlasDeploymentActionHandler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:              c.GetClient(),
+			AtlasProvider:       atlasProvider,
+			Log:                 logger.Named("controllers").Named("AtlasDeploymentAction").Sugar(),
+			GlobalSecretRef:     globalSecretRef,
+			CredentialProviders: credentialProviders,
+		},
+		serviceBuilder: deploymentaction.NewDeploymentActionServiceFromClientSet,
+	}
+	return ctrlstate.NewStateReconciler(
+		actionHandler,
+		ctrlstate.WithCluster[akov2.AtlasDeploymentAction](c),
+		ctrlstate.WithReapplySupport[akov2.AtlasDeploymentAction](reapplySupport),
+	)
+}
+
+// For prepares the controller for its target Custom Resource; AtlasDeploymentAction
+func (h *AtlasDeploymentActionHandler) For() (client.Object, builder.Predicates) {
+	obj := &akov2.AtlasDeploymentAction{}
+	return obj, ctrlrtbuilder.WithPredicates(
+		predicate.Or(
+			mckpredicate.AnnotationChanged("mongodb.com/reapply-period"),
+			predicate.GenerationChangedPredicate{},
+		),
+		mckpredicate.IgnoreDeletedPredicate[client.Object](),
+	)
+}
+
+func (h *AtlasDeploymentActionHandler) SetupWithManager(mgr ctrl.Manager, rec reconcile.Reconciler, defaultOptions controller.Options) error {
+	h.Client = mgr.GetClient()
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named("AtlasDeploymentAction").
+		For(h.For()).
+		WithOptions(defaultOptions).Complete(rec)
+}
+
+type reconcileRequest struct {
+	service     deploymentaction.DeploymentActionService
+	action      *akov2.AtlasDeploymentAction
+	projectID   string
+	clusterName string
+}
+
+func (h *AtlasDeploymentActionHandler) newReconcileRequest(ctx context.Context, action *akov2.AtlasDeploymentAction) (*reconcileRequest, error) {
+	req := &reconcileRequest{action: action}
+	var cfg *atlas.ConnectionConfig
+	var err error
+	if ref := action.Spec.ExternalDeploymentRef; ref != nil {
+		req.projectID = ref.ProjectID
+		req.clusterName = ref.ClusterName
+		cfg, err = h.connectionConfig(ctx, action)
+	} else {
+		cfg, err = h.resolveDeployment(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sdkClientSet, err := h.AtlasProvider.SdkClientSet(ctx, cfg.Credentials, h.Log)
+	if err != nil {
+		return nil, err
+	}
+	req.service = h.serviceBuilder(sdkClientSet)
+	return req, nil
+}
+
+// resolveDeployment fills in the project and cluster of the referenced AtlasDeployment
+// and returns its credentials, unless the action defines its own
+func (h *AtlasDeploymentActionHandler) resolveDeployment(ctx context.Context, req *reconcileRequest) (*atlas.ConnectionConfig, error) {
+	deployment := &akov2.AtlasDeployment{}
+	key := *req.action.Spec.DeploymentRef.GetObject(req.action.GetNamespace())
+	if err := h.Client.Get(ctx, key, deployment); err != nil {
+		return nil, fmt.Errorf("failed to get deployment %s: %w", key, err)
+	}
+	req.clusterName = deployment.GetDeploymentName()
+
+	projectID, err := h.projectID(ctx, deployment)
+	if err != nil {
+		return nil, err
+	}
+	req.projectID = projectID
+
+	if req.action.Spec.ConnectionSecret != nil {
+		return h.connectionConfig(ctx, req.action)
+	}
+	return h.ResolveConnectionConfig(ctx, deployment)
+}
+
+func (h *AtlasDeploymentActionHandler) projectID(ctx context.Context, deployment *akov2.AtlasDeployment) (string, error) {
+	ref := deployment.ProjectDualRef()
+	if ref.ExternalProjectRef != nil {
+		return ref.ExternalProjectRef.ID, nil
+	}
+	if ref.ProjectRef == nil {
+		return "", fmt.Errorf("deployment %s has no project reference", client.ObjectKeyFromObject(deployment))
+	}
+	project := &akov2.AtlasProject{}
+	key := *ref.ProjectRef.GetObject(deployment.GetNamespace())
+	if err := h.Client.Get(ctx, key, project); err != nil {
+		return "", fmt.Errorf("failed to get project %s: %w", key, err)
+	}
+	if project.ID() == "" {
+		return "", fmt.Errorf("project %s is not ready yet", key)
+	}
+	return project.ID(), nil
+}
+
+func (h *AtlasDeploymentActionHandler) connectionConfig(ctx context.Context, action *akov2.AtlasDeploymentAction) (*atlas.ConnectionConfig, error) {
+	var objKey *client.ObjectKey
+	if action.Spec.ConnectionSecret != nil && action.Spec.ConnectionSecret.Name != "" {
+		objKey = &client.ObjectKey{
+			Namespace: action.GetNamespace(),
+			Name:      action.Spec.ConnectionSecret.Name,
+		}
+	}
+	return reconciler.GetConnectionConfig(ctx, h.Client, objKey, &h.GlobalSecretRef)
+}
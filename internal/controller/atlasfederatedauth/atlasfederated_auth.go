@@ -25,6 +25,7 @@ import (
 
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/identityprovider"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/paging"
 )
 
@@ -65,12 +66,19 @@ func (r *AtlasFederatedAuthReconciler) ensureFederatedAuth(service *workflow.Con
 		return workflow.Terminate(workflow.Internal, fmt.Errorf("cannot convert Federated Auth spec to Atlas: %w", err))
 	}
 
+	idpService := identityprovider.NewIdentityProviderServiceFromClientSet(service.SdkClientSet)
+	workloadIDPID, result := r.ensureWorkloadIdentityProvider(service, idpService, atlasFedSettings.GetId(), fedauth)
+	if !result.IsOk() {
+		return result
+	}
+	operatorConf.DataAccessIdentityProviderIds = dataAccessIdentityProviders(fedauth, orgConfig, workloadIDPID)
+
 	if result := r.ensureIDPSettings(service.Context, atlasFedSettings.GetId(), identityProvider, fedauth, service.SdkClientSet.SdkClient20250312002); !result.IsOk() {
 		return result
 	}
 
 	if federatedSettingsAreEqual(operatorConf, orgConfig) {
-		return workflow.OK()
+		return r.removeWorkloadIdentityProvider(service, idpService, atlasFedSettings.GetId(), fedauth)
 	}
 
 	updatedSettings, _, err := service.SdkClientSet.SdkClient20250312002.FederatedAuthenticationApi.
@@ -90,7 +98,7 @@ func (r *AtlasFederatedAuthReconciler) ensureFederatedAuth(service *workflow.Con
 			fmt.Errorf("the following users are in conflict: %v", users))
 	}
 
-	return workflow.OK()
+	return r.removeWorkloadIdentityProvider(service, idpService, atlasFedSettings.GetId(), fedauth)
 }
 
 func prepareProjectList(ctx context.Context, client *admin.APIClient) (map[string]string, error) {
@@ -42,8 +42,6 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 // AtlasFederatedAuthReconciler reconciles an AtlasFederatedAuth object
@@ -57,6 +55,7 @@ type AtlasFederatedAuthReconciler struct {
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
 	GlobalSecretRef             client.ObjectKey
+	IssuerDiscovery             IssuerDiscovery
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasfederatedauths,verbs=get;list;watch;create;update;patch;delete
@@ -99,16 +98,16 @@ func (r *AtlasFederatedAuthReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return resourceVersionIsValid.ReconcileResult()
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(fedauth) {
-		result := workflow.Terminate(workflow.AtlasGovUnsupported, errors.New("the AtlasFederatedAuth is not supported by Atlas for government")).
-			WithoutRetry()
+	connectionConfig, err := reconciler.GetConnectionConfig(ctx, r.Client, fedauth.ConnectionSecretObjectKey(), &r.GlobalSecretRef)
+	if err != nil {
+		result := workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err)
 		setCondition(workflowCtx, api.FederatedAuthReadyType, result)
 		return result.ReconcileResult()
 	}
 
-	connectionConfig, err := reconciler.GetConnectionConfig(ctx, r.Client, fedauth.ConnectionSecretObjectKey(), &r.GlobalSecretRef)
-	if err != nil {
-		result := workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err)
+	if !r.AtlasProvider.IsResourceSupported(fedauth, connectionConfig.Credentials) {
+		result := workflow.Terminate(workflow.AtlasGovUnsupported, errors.New("the AtlasFederatedAuth is not supported by Atlas for government")).
+			WithoutRetry()
 		setCondition(workflowCtx, api.FederatedAuthReadyType, result)
 		return result.ReconcileResult()
 	}
@@ -134,7 +133,7 @@ func (r *AtlasFederatedAuthReconciler) For() (client.Object, builder.Predicates)
 	return &akov2.AtlasFederatedAuth{}, builder.WithPredicates(r.GlobalPredicates...)
 }
 
-func (r *AtlasFederatedAuthReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+func (r *AtlasFederatedAuthReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasFederatedAuth").
 		For(r.For()).
@@ -142,9 +141,7 @@ func (r *AtlasFederatedAuthReconciler) SetupWithManager(mgr ctrl.Manager, skipNa
 			&corev1.Secret{},
 			handler.EnqueueRequestsFromMapFunc(r.findAtlasFederatedAuthForSecret),
 		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
+		WithOptions(options).
 		Complete(r)
 }
 
@@ -202,6 +199,7 @@ func NewAtlasFederatedAuthReconciler(
 		AtlasProvider:            atlasProvider,
 		ObjectDeletionProtection: deletionProtection,
 		GlobalSecretRef:          globalSecretRef,
+		IssuerDiscovery:          NewKubeIssuerDiscovery(c.GetConfig()),
 	}
 }
 
@@ -0,0 +1,166 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfederatedauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	"k8s.io/client-go/rest"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/identityprovider"
+)
+
+// IssuerDiscovery returns the issuer of the service account tokens of the Kubernetes cluster
+type IssuerDiscovery func(ctx context.Context) (string, error)
+
+// NewKubeIssuerDiscovery reads the issuer from the OIDC discovery document served by the Kubernetes API server
+func NewKubeIssuerDiscovery(cfg *rest.Config) IssuerDiscovery {
+	return func(ctx context.Context) (string, error) {
+		httpClient, err := rest.HTTPClientFor(cfg)
+		if err != nil {
+			return "", fmt.Errorf("failed to build the Kubernetes API client: %w", err)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(cfg.Host, "/")+"/.well-known/openid-configuration", nil)
+		if err != nil {
+			return "", err
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch the service account issuer discovery document: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("failed to fetch the service account issuer discovery document: %s", resp.Status)
+		}
+		discovery := struct {
+			Issuer string `json:"issuer"`
+		}{}
+		if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+			return "", fmt.Errorf("failed to decode the service account issuer discovery document: %w", err)
+		}
+		if discovery.Issuer == "" {
+			return "", errors.New("the service account issuer discovery document has no issuer")
+		}
+		return discovery.Issuer, nil
+	}
+}
+
+// ensureWorkloadIdentityProvider creates or updates the Workload identity provider of the Kubernetes cluster,
+// it returns the Atlas ID of the provider, or an empty ID when none is requested
+func (r *AtlasFederatedAuthReconciler) ensureWorkloadIdentityProvider(ctx *workflow.Context, idpService identityprovider.IdentityProviderService,
+	federationSettingsID string, fedauth *akov2.AtlasFederatedAuth) (string, workflow.DeprecatedResult) {
+	spec := fedauth.Spec.WorkloadIdentityProvider
+	if spec == nil {
+		return "", workflow.OK()
+	}
+
+	issuerURI := spec.IssuerURI
+	if issuerURI == "" {
+		if r.IssuerDiscovery == nil {
+			return "", workflow.Terminate(workflow.FederatedAuthWorkloadIdentityProviderNotReady, errors.New("the service account issuer cannot be discovered, set issuerURI"))
+		}
+		discovered, err := r.IssuerDiscovery(ctx.Context)
+		if err != nil {
+			return "", workflow.Terminate(workflow.FederatedAuthWorkloadIdentityProviderNotReady, err)
+		}
+		issuerURI = discovered
+	}
+
+	desired := identityprovider.NewWorkloadIdentityProvider(spec, issuerURI)
+	var current *identityprovider.IdentityProvider
+	if id := fedauth.Status.WorkloadIdentityProviderID; id != "" {
+		idp, err := idpService.Get(ctx.Context, federationSettingsID, id)
+		if err != nil && !errors.Is(err, identityprovider.ErrNotFound) {
+			return "", workflow.Terminate(workflow.FederatedAuthWorkloadIdentityProviderNotReady, err)
+		}
+		current = idp
+	}
+	if current == nil {
+		// adopt a provider of the same issuer, such as one whose recorded ID got lost
+		idp, err := idpService.FindByIssuer(ctx.Context, federationSettingsID, identityprovider.ProtocolOIDC, issuerURI)
+		if err != nil && !errors.Is(err, identityprovider.ErrNotFound) {
+			return "", workflow.Terminate(workflow.FederatedAuthWorkloadIdentityProviderNotReady, err)
+		}
+		current = idp
+	}
+
+	switch {
+	case current == nil:
+		created, err := idpService.Create(ctx.Context, federationSettingsID, desired)
+		if err != nil {
+			return "", workflow.Terminate(workflow.FederatedAuthWorkloadIdentityProviderNotReady, err)
+		}
+		ctx.Log.Infow("Created Workload identity provider", "id", created.ID, "issuer", issuerURI)
+		current = created
+	case !desired.EqualSettings(current):
+		updated, err := idpService.Update(ctx.Context, federationSettingsID, current.ID, desired)
+		if err != nil {
+			return "", workflow.Terminate(workflow.FederatedAuthWorkloadIdentityProviderNotReady, err)
+		}
+		current = updated
+	}
+
+	ctx.EnsureStatusOption(status.AtlasFederatedAuthWorkloadIdentityProviderOption(current.ID, issuerURI))
+	return current.ID, workflow.OK()
+}
+
+// removeWorkloadIdentityProvider deletes the Workload identity provider no longer requested,
+// it must be disconnected from the organization beforehand
+func (r *AtlasFederatedAuthReconciler) removeWorkloadIdentityProvider(ctx *workflow.Context, idpService identityprovider.IdentityProviderService,
+	federationSettingsID string, fedauth *akov2.AtlasFederatedAuth) workflow.DeprecatedResult {
+	if fedauth.Spec.WorkloadIdentityProvider != nil || fedauth.Status.WorkloadIdentityProviderID == "" {
+		return workflow.OK()
+	}
+	err := idpService.Delete(ctx.Context, federationSettingsID, fedauth.Status.WorkloadIdentityProviderID)
+	if err != nil && !errors.Is(err, identityprovider.ErrNotFound) {
+		return workflow.Terminate(workflow.FederatedAuthWorkloadIdentityProviderNotReady, err)
+	}
+	ctx.EnsureStatusOption(status.AtlasFederatedAuthWorkloadIdentityProviderOption("", ""))
+	return workflow.OK()
+}
+
+// dataAccessIdentityProviders connects the Workload identity provider to the organization for data access, and
+// disconnects a removed one, keeping the providers already connected when the spec does not list them
+func dataAccessIdentityProviders(fedauth *akov2.AtlasFederatedAuth, orgConfig *admin.ConnectedOrgConfig, workloadIDPID string) *[]string {
+	removedIDPID := ""
+	if workloadIDPID == "" {
+		removedIDPID = fedauth.Status.WorkloadIdentityProviderID
+	}
+	if workloadIDPID == "" && removedIDPID == "" {
+		return fedauth.Spec.DataAccessIdentityProviders
+	}
+
+	ids := orgConfig.GetDataAccessIdentityProviderIds()
+	if fedauth.Spec.DataAccessIdentityProviders != nil {
+		ids = *fedauth.Spec.DataAccessIdentityProviders
+	}
+	ids = slices.DeleteFunc(slices.Clone(ids), func(id string) bool {
+		return id == removedIDPID
+	})
+	if workloadIDPID != "" && !slices.Contains(ids, workloadIDPID) {
+		ids = append(ids, workloadIDPID)
+	}
+	return &ids
+}
@@ -0,0 +1,280 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfederatedauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	"go.uber.org/zap/zaptest"
+	"k8s.io/client-go/rest"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/identityprovider"
+)
+
+const (
+	testFederationSettingsID = "fed-settings-id"
+	testIssuer               = "https://oidc.example.com/cluster"
+)
+
+func TestNewKubeIssuerDiscovery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"issuer":"` + testIssuer + `","jwks_uri":"` + testIssuer + `/openid/v1/jwks"}`))
+	}))
+	defer server.Close()
+
+	issuer, err := NewKubeIssuerDiscovery(&rest.Config{Host: server.URL})(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testIssuer, issuer)
+
+	_, err = NewKubeIssuerDiscovery(&rest.Config{Host: server.URL + "/missing"})(context.Background())
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestEnsureWorkloadIdentityProvider(t *testing.T) {
+	spec := &akov2.WorkloadIdentityProvider{DisplayName: "my-cluster", Audience: "atlas"}
+	desired := identityprovider.NewWorkloadIdentityProvider(spec, testIssuer)
+	withID := func(idp *identityprovider.IdentityProvider, id string) *identityprovider.IdentityProvider {
+		clone := *idp
+		clone.ID = id
+		return &clone
+	}
+
+	for _, tc := range []struct {
+		title           string
+		spec            *akov2.WorkloadIdentityProvider
+		statusID        string
+		discovery       IssuerDiscovery
+		idpService      func(*testing.T) identityprovider.IdentityProviderService
+		expectedID      string
+		expectedIssuer  string
+		expectedFailure bool
+	}{
+		{
+			title: "nothing is done without a workload identity provider",
+			idpService: func(t *testing.T) identityprovider.IdentityProviderService {
+				return translation.NewIdentityProviderServiceMock(t)
+			},
+		},
+		{
+			title: "the provider is created with the discovered issuer",
+			spec:  spec,
+			discovery: func(context.Context) (string, error) {
+				return testIssuer, nil
+			},
+			idpService: func(t *testing.T) identityprovider.IdentityProviderService {
+				service := translation.NewIdentityProviderServiceMock(t)
+				service.EXPECT().FindByIssuer(context.Background(), testFederationSettingsID, identityprovider.ProtocolOIDC, testIssuer).
+					Return(nil, identityprovider.ErrNotFound)
+				service.EXPECT().Create(context.Background(), testFederationSettingsID, desired).Return(withID(desired, "new-idp"), nil)
+				return service
+			},
+			expectedID:     "new-idp",
+			expectedIssuer: testIssuer,
+		},
+		{
+			title: "a provider of the same issuer is adopted instead of created",
+			spec:  spec,
+			discovery: func(context.Context) (string, error) {
+				return testIssuer, nil
+			},
+			idpService: func(t *testing.T) identityprovider.IdentityProviderService {
+				service := translation.NewIdentityProviderServiceMock(t)
+				service.EXPECT().FindByIssuer(context.Background(), testFederationSettingsID, identityprovider.ProtocolOIDC, testIssuer).
+					Return(withID(desired, "existing-idp"), nil)
+				return service
+			},
+			expectedID:     "existing-idp",
+			expectedIssuer: testIssuer,
+		},
+		{
+			title: "an explicit issuer skips the discovery",
+			spec: &akov2.WorkloadIdentityProvider{
+				DisplayName: "my-cluster",
+				Audience:    "atlas",
+				IssuerURI:   "https://other.example.com",
+			},
+			idpService: func(t *testing.T) identityprovider.IdentityProviderService {
+				service := translation.NewIdentityProviderServiceMock(t)
+				explicit := withID(desired, "")
+				explicit.IssuerURI = "https://other.example.com"
+				service.EXPECT().FindByIssuer(context.Background(), testFederationSettingsID, identityprovider.ProtocolOIDC, "https://other.example.com").
+					Return(nil, identityprovider.ErrNotFound)
+				service.EXPECT().Create(context.Background(), testFederationSettingsID, explicit).Return(withID(explicit, "new-idp"), nil)
+				return service
+			},
+			expectedID:     "new-idp",
+			expectedIssuer: "https://other.example.com",
+		},
+		{
+			title:    "an up to date provider is left untouched",
+			spec:     spec,
+			statusID: "idp-id",
+			discovery: func(context.Context) (string, error) {
+				return testIssuer, nil
+			},
+			idpService: func(t *testing.T) identityprovider.IdentityProviderService {
+				service := translation.NewIdentityProviderServiceMock(t)
+				service.EXPECT().Get(context.Background(), testFederationSettingsID, "idp-id").Return(withID(desired, "idp-id"), nil)
+				return service
+			},
+			expectedID:     "idp-id",
+			expectedIssuer: testIssuer,
+		},
+		{
+			title:    "a changed provider is updated",
+			spec:     spec,
+			statusID: "idp-id",
+			discovery: func(context.Context) (string, error) {
+				return testIssuer, nil
+			},
+			idpService: func(t *testing.T) identityprovider.IdentityProviderService {
+				service := translation.NewIdentityProviderServiceMock(t)
+				outdated := withID(desired, "idp-id")
+				outdated.Audience = "old-audience"
+				service.EXPECT().Get(context.Background(), testFederationSettingsID, "idp-id").Return(outdated, nil)
+				service.EXPECT().Update(context.Background(), testFederationSettingsID, "idp-id", desired).Return(withID(desired, "idp-id"), nil)
+				return service
+			},
+			expectedID:     "idp-id",
+			expectedIssuer: testIssuer,
+		},
+		{
+			title:    "a provider removed in Atlas is created again",
+			spec:     spec,
+			statusID: "idp-id",
+			discovery: func(context.Context) (string, error) {
+				return testIssuer, nil
+			},
+			idpService: func(t *testing.T) identityprovider.IdentityProviderService {
+				service := translation.NewIdentityProviderServiceMock(t)
+				service.EXPECT().Get(context.Background(), testFederationSettingsID, "idp-id").Return(nil, identityprovider.ErrNotFound)
+				service.EXPECT().FindByIssuer(context.Background(), testFederationSettingsID, identityprovider.ProtocolOIDC, testIssuer).
+					Return(nil, identityprovider.ErrNotFound)
+				service.EXPECT().Create(context.Background(), testFederationSettingsID, desired).Return(withID(desired, "new-idp"), nil)
+				return service
+			},
+			expectedID:     "new-idp",
+			expectedIssuer: testIssuer,
+		},
+		{
+			title: "failing to discover the issuer fails",
+			spec:  spec,
+			discovery: func(context.Context) (string, error) {
+				return "", errors.New("forbidden")
+			},
+			idpService: func(t *testing.T) identityprovider.IdentityProviderService {
+				return translation.NewIdentityProviderServiceMock(t)
+			},
+			expectedFailure: true,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			r := &AtlasFederatedAuthReconciler{IssuerDiscovery: tc.discovery}
+			ctx := &workflow.Context{Context: context.Background(), Log: zaptest.NewLogger(t).Sugar()}
+			fedauth := &akov2.AtlasFederatedAuth{
+				Spec:   akov2.AtlasFederatedAuthSpec{WorkloadIdentityProvider: tc.spec},
+				Status: status.AtlasFederatedAuthStatus{WorkloadIdentityProviderID: tc.statusID},
+			}
+
+			id, result := r.ensureWorkloadIdentityProvider(ctx, tc.idpService(t), testFederationSettingsID, fedauth)
+			assert.Equal(t, tc.expectedFailure, !result.IsOk())
+			assert.Equal(t, tc.expectedID, id)
+
+			fedauth.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+			if tc.expectedID != "" {
+				assert.Equal(t, tc.expectedID, fedauth.Status.WorkloadIdentityProviderID)
+				assert.Equal(t, tc.expectedIssuer, fedauth.Status.WorkloadIdentityProviderIssuerURI)
+			}
+		})
+	}
+}
+
+func TestRemoveWorkloadIdentityProvider(t *testing.T) {
+	ctx := &workflow.Context{Context: context.Background(), Log: zaptest.NewLogger(t).Sugar()}
+	service := translation.NewIdentityProviderServiceMock(t)
+	service.EXPECT().Delete(context.Background(), testFederationSettingsID, "idp-id").Return(identityprovider.ErrNotFound)
+	fedauth := &akov2.AtlasFederatedAuth{
+		Status: status.AtlasFederatedAuthStatus{WorkloadIdentityProviderID: "idp-id", WorkloadIdentityProviderIssuerURI: testIssuer},
+	}
+
+	result := (&AtlasFederatedAuthReconciler{}).removeWorkloadIdentityProvider(ctx, service, testFederationSettingsID, fedauth)
+	require.True(t, result.IsOk())
+	fedauth.UpdateStatus(ctx.Conditions(), ctx.StatusOptions()...)
+	assert.Empty(t, fedauth.Status.WorkloadIdentityProviderID)
+	assert.Empty(t, fedauth.Status.WorkloadIdentityProviderIssuerURI)
+}
+
+func TestDataAccessIdentityProviders(t *testing.T) {
+	orgConfig := &admin.ConnectedOrgConfig{DataAccessIdentityProviderIds: &[]string{"other-idp", "idp-id"}}
+	for _, tc := range []struct {
+		title         string
+		specIDs       *[]string
+		statusID      string
+		workloadIDPID string
+		expected      *[]string
+	}{
+		{
+			title:    "the spec is kept without a workload identity provider",
+			specIDs:  &[]string{"other-idp"},
+			expected: &[]string{"other-idp"},
+		},
+		{
+			title:         "the workload identity provider is added to the connected ones",
+			workloadIDPID: "new-idp",
+			expected:      &[]string{"other-idp", "idp-id", "new-idp"},
+		},
+		{
+			title:         "the workload identity provider is added to the spec ones",
+			specIDs:       &[]string{"spec-idp"},
+			workloadIDPID: "new-idp",
+			expected:      &[]string{"spec-idp", "new-idp"},
+		},
+		{
+			title:         "a connected workload identity provider is kept once",
+			workloadIDPID: "idp-id",
+			expected:      &[]string{"other-idp", "idp-id"},
+		},
+		{
+			title:    "a removed workload identity provider is disconnected",
+			statusID: "idp-id",
+			expected: &[]string{"other-idp"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			fedauth := &akov2.AtlasFederatedAuth{
+				Spec:   akov2.AtlasFederatedAuthSpec{DataAccessIdentityProviders: tc.specIDs},
+				Status: status.AtlasFederatedAuthStatus{WorkloadIdentityProviderID: tc.statusID},
+			}
+			assert.Equal(t, tc.expected, dataAccessIdentityProviders(fedauth, orgConfig, tc.workloadIDPID))
+			assert.Equal(t, pointer.MakePtr([]string{"other-idp", "idp-id"}), orgConfig.DataAccessIdentityProviderIds)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasidentityprovider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/identityprovider"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
+)
+
+func (h *AtlasIdentityProviderHandler) HandleInitial(ctx context.Context, idp *akov2.AtlasIdentityProvider) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateInitial, state.StateCreated, idp)
+}
+
+func (h *AtlasIdentityProviderHandler) HandleCreated(ctx context.Context, idp *akov2.AtlasIdentityProvider) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateCreated, state.StateUpdated, idp)
+}
+
+func (h *AtlasIdentityProviderHandler) HandleUpdated(ctx context.Context, idp *akov2.AtlasIdentityProvider) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateUpdated, state.StateUpdated, idp)
+}
+
+func (h *AtlasIdentityProviderHandler) HandleDeletionRequested(ctx context.Context, idp *akov2.AtlasIdentityProvider) (ctrlstate.Result, error) {
+	if idp.Status.ID == "" || h.deletionProtection {
+		return h.unmanage(idp)
+	}
+	req, err := h.newReconcileRequest(ctx, idp)
+	if err != nil {
+		return h.unmanage(idp)
+	}
+	return h.delete(ctx, req)
+}
+
+func (h *AtlasIdentityProviderHandler) upsert(ctx context.Context, currentState, nextState state.ResourceState,
+	idp *akov2.AtlasIdentityProvider) (ctrlstate.Result, error) {
+	req, err := h.newReconcileRequest(ctx, idp)
+	if err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to build reconcile request: %w", err))
+	}
+	desired, err := identityprovider.NewFromSpec(&idp.Spec)
+	if err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to read identity provider %q: %w", idp.Spec.DisplayName, err))
+	}
+	statusChanged := false
+	if idp.Status.FederationSettingsID == "" {
+		federationSettingsID, err := req.service.FederationSettingsID(ctx, idp.Spec.OrgID)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		idp.Status.FederationSettingsID = federationSettingsID
+		statusChanged = true
+	}
+
+	current, err := h.find(ctx, req)
+	if errors.Is(err, identityprovider.ErrNotFound) {
+		return h.create(ctx, currentState, req, desired)
+	}
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+	if idp.Status.ID != current.ID || statusChanged {
+		idp.Status.ID = current.ID
+		if err := h.patchNonConditionStatus(ctx, idp); err != nil {
+			return result.Error(currentState, fmt.Errorf("failed to record id for identity provider %q: %w", idp.Spec.DisplayName, err))
+		}
+	}
+	if !desired.EqualSettings(current) {
+		return h.update(ctx, currentState, req, current.ID, desired)
+	}
+	if err := h.ensureOrgConnection(ctx, req, current); err != nil {
+		return result.Error(currentState, err)
+	}
+	return result.NextState(nextState, fmt.Sprintf("Synced Atlas Identity Provider %s", current.ID))
+}
+
+// find looks the identity provider up by the recorded ID, falling back to its issuer
+// to adopt identity providers set up out of the operator
+func (h *AtlasIdentityProviderHandler) find(ctx context.Context, req *reconcileRequest) (*identityprovider.IdentityProvider, error) {
+	federationSettingsID := req.idp.Status.FederationSettingsID
+	if req.idp.Status.ID != "" {
+		current, err := req.service.Get(ctx, federationSettingsID, req.idp.Status.ID)
+		if !errors.Is(err, identityprovider.ErrNotFound) {
+			return current, err
+		}
+	}
+	return req.service.FindByIssuer(ctx, federationSettingsID, req.idp.Spec.Protocol, req.idp.Spec.IssuerURI)
+}
+
+func (h *AtlasIdentityProviderHandler) create(ctx context.Context, currentState state.ResourceState, req *reconcileRequest,
+	desired *identityprovider.IdentityProvider) (ctrlstate.Result, error) {
+	if desired.Protocol == identityprovider.ProtocolSAML {
+		return result.Error(currentState, fmt.Errorf("no SAML identity provider with issuer %q found, "+
+			"SAML identity providers must be created in Atlas before being managed", desired.IssuerURI))
+	}
+	created, err := req.service.Create(ctx, req.idp.Status.FederationSettingsID, desired)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+	req.idp.Status.ID = created.ID
+	if err := h.patchNonConditionStatus(ctx, req.idp); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to record id for identity provider %q: %w", desired.DisplayName, err))
+	}
+	if err := h.ensureOrgConnection(ctx, req, created); err != nil {
+		return result.Error(currentState, err)
+	}
+	return result.NextState(state.StateCreated, fmt.Sprintf("Created Atlas Identity Provider %s", created.ID))
+}
+
+func (h *AtlasIdentityProviderHandler) update(ctx context.Context, currentState state.ResourceState, req *reconcileRequest,
+	id string, desired *identityprovider.IdentityProvider) (ctrlstate.Result, error) {
+	updated, err := req.service.Update(ctx, req.idp.Status.FederationSettingsID, id, desired)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+	if err := h.ensureOrgConnection(ctx, req, updated); err != nil {
+		return result.Error(currentState, err)
+	}
+	return result.NextState(state.StateUpdated, fmt.Sprintf("Updated Atlas Identity Provider %s", updated.ID))
+}
+
+func (h *AtlasIdentityProviderHandler) ensureOrgConnection(ctx context.Context, req *reconcileRequest, current *identityprovider.IdentityProvider) error {
+	federationSettingsID := req.idp.Status.FederationSettingsID
+	if req.idp.Spec.ConnectToOrg == nil || *req.idp.Spec.ConnectToOrg {
+		return req.service.ConnectOrg(ctx, federationSettingsID, req.idp.Spec.OrgID, current)
+	}
+	err := req.service.DisconnectOrg(ctx, federationSettingsID, req.idp.Spec.OrgID, current)
+	if errors.Is(err, identityprovider.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// delete removes OIDC identity providers, SAML ones cannot be created back
+// through the Atlas Admin API so they are only released
+func (h *AtlasIdentityProviderHandler) delete(ctx context.Context, req *reconcileRequest) (ctrlstate.Result, error) {
+	if req.idp.Spec.Protocol == identityprovider.ProtocolSAML {
+		return h.unmanage(req.idp)
+	}
+	federationSettingsID := req.idp.Status.FederationSettingsID
+	current := &identityprovider.IdentityProvider{ID: req.idp.Status.ID, Protocol: req.idp.Spec.Protocol}
+	err := req.service.DisconnectOrg(ctx, federationSettingsID, req.idp.Spec.OrgID, current)
+	if err != nil && !errors.Is(err, identityprovider.ErrNotFound) {
+		return result.Error(state.StateDeletionRequested, err)
+	}
+	err = req.service.Delete(ctx, federationSettingsID, req.idp.Status.ID)
+	if err != nil && !errors.Is(err, identityprovider.ErrNotFound) {
+		return result.Error(state.StateDeletionRequested, err)
+	}
+	return h.unmanage(req.idp)
+}
+
+func (h *AtlasIdentityProviderHandler) unmanage(idp *akov2.AtlasIdentityProvider) (ctrlstate.Result, error) {
+	return result.NextState(
+		state.StateDeleted,
+		fmt.Sprintf("Deleted Atlas Identity Provider %q", idp.Spec.DisplayName),
+	)
+}
+
+func (h *AtlasIdentityProviderHandler) patchNonConditionStatus(ctx context.Context, idp *akov2.AtlasIdentityProvider) error {
+	statusJSON, err := json.Marshal(idp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal status: %w", err)
+	}
+	if err := h.Client.Status().Patch(ctx, idp, client.RawPatch(types.MergePatchType, statusJSON)); err != nil {
+		return fmt.Errorf("failed to patch: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,355 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasidentityprovider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mocks "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/identityprovider"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
+)
+
+//nolint:gosec
+const (
+	fakeOrgID                = "fake-org-id"
+	fakeFederationSettingsID = "fake-federation-settings-id"
+	fakeID                   = "fake-idp-id"
+	fakeIssuer               = "https://sso.example.com"
+)
+
+var fakeAtlasSecret = corev1.Secret{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "atlas-credentials",
+		Namespace: "default",
+	},
+	Data: map[string][]byte{
+		"orgId":         []byte(fakeOrgID),
+		"publicApiKey":  []byte("fake-api-key"),
+		"privateApiKey": []byte("fake-api-secret"),
+	},
+}
+
+var fakeProvider = &atlasmock.TestProvider{
+	SdkClientSetFunc: func(ctx context.Context, creds *atlas.Credentials, log *zap.SugaredLogger) (*atlas.ClientSet, error) {
+		return &atlas.ClientSet{}, nil
+	},
+}
+
+func sampleOIDCProvider() *akov2.AtlasIdentityProvider {
+	return &akov2.AtlasIdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "corporate", Namespace: "default"},
+		Spec: akov2.AtlasIdentityProviderSpec{
+			OrgID:               fakeOrgID,
+			ConnectionSecretRef: &api.LocalObjectReference{Name: "atlas-credentials"},
+			Protocol:            "OIDC",
+			DisplayName:         "corporate",
+			IssuerURI:           fakeIssuer,
+			OIDC: &akov2.OIDCIdentityProviderSettings{
+				IdpType:           "WORKFORCE",
+				Audience:          "atlas",
+				ClientID:          "client",
+				AuthorizationType: "GROUP",
+				GroupsClaim:       "groups",
+				UserClaim:         "sub",
+			},
+		},
+	}
+}
+
+func sampleSAMLProvider() *akov2.AtlasIdentityProvider {
+	idp := sampleOIDCProvider()
+	idp.Spec.Protocol = "SAML"
+	idp.Spec.OIDC = nil
+	idp.Spec.SAML = &akov2.SAMLIdentityProviderSettings{SSOURL: "https://sso.example.com/login", Status: "ACTIVE"}
+	return idp
+}
+
+func withStatus(idp *akov2.AtlasIdentityProvider, id string) *akov2.AtlasIdentityProvider {
+	idp.Status = status.AtlasIdentityProviderStatus{ID: id, FederationSettingsID: fakeFederationSettingsID}
+	return idp
+}
+
+func atlasIdentityProvider(t *testing.T, idp *akov2.AtlasIdentityProvider) *identityprovider.IdentityProvider {
+	result, err := identityprovider.NewFromSpec(&idp.Spec)
+	require.NoError(t, err)
+	result.ID = fakeID
+	return result
+}
+
+func TestHandleUpsert(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name           string
+		state          state.ResourceState
+		input          *akov2.AtlasIdentityProvider
+		serviceBuilder func(t *testing.T) serviceBuilderFunc
+		want           ctrlstate.Result
+		wantErr        string
+		wantID         string
+	}{
+		{
+			name:  "initial creates OIDC providers and connects them",
+			state: state.StateInitial,
+			input: sampleOIDCProvider(),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewIdentityProviderServiceMock(t)
+				svc.EXPECT().FederationSettingsID(mock.Anything, fakeOrgID).Return(fakeFederationSettingsID, nil)
+				svc.EXPECT().FindByIssuer(mock.Anything, fakeFederationSettingsID, "OIDC", fakeIssuer).
+					Return(nil, identityprovider.ErrNotFound)
+				svc.EXPECT().Create(mock.Anything, fakeFederationSettingsID, mock.Anything).
+					Return(&identityprovider.IdentityProvider{ID: fakeID, Protocol: "OIDC"}, nil)
+				svc.EXPECT().ConnectOrg(mock.Anything, fakeFederationSettingsID, fakeOrgID,
+					&identityprovider.IdentityProvider{ID: fakeID, Protocol: "OIDC"}).Return(nil)
+				return func(*atlas.ClientSet) identityprovider.IdentityProviderService { return svc }
+			},
+			want:   ctrlstate.Result{NextState: state.StateCreated, StateMsg: "Created Atlas Identity Provider fake-idp-id."},
+			wantID: fakeID,
+		},
+		{
+			name:  "initial adopts existing providers by issuer",
+			state: state.StateInitial,
+			input: sampleSAMLProvider(),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewIdentityProviderServiceMock(t)
+				svc.EXPECT().FederationSettingsID(mock.Anything, fakeOrgID).Return(fakeFederationSettingsID, nil)
+				svc.EXPECT().FindByIssuer(mock.Anything, fakeFederationSettingsID, "SAML", fakeIssuer).
+					Return(atlasIdentityProvider(t, sampleSAMLProvider()), nil)
+				svc.EXPECT().ConnectOrg(mock.Anything, fakeFederationSettingsID, fakeOrgID, mock.Anything).Return(nil)
+				return func(*atlas.ClientSet) identityprovider.IdentityProviderService { return svc }
+			},
+			want:   ctrlstate.Result{NextState: state.StateCreated, StateMsg: "Synced Atlas Identity Provider fake-idp-id."},
+			wantID: fakeID,
+		},
+		{
+			name:  "missing SAML providers cannot be created",
+			state: state.StateInitial,
+			input: sampleSAMLProvider(),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewIdentityProviderServiceMock(t)
+				svc.EXPECT().FederationSettingsID(mock.Anything, fakeOrgID).Return(fakeFederationSettingsID, nil)
+				svc.EXPECT().FindByIssuer(mock.Anything, fakeFederationSettingsID, "SAML", fakeIssuer).
+					Return(nil, identityprovider.ErrNotFound)
+				return func(*atlas.ClientSet) identityprovider.IdentityProviderService { return svc }
+			},
+			want:    ctrlstate.Result{NextState: state.StateInitial},
+			wantErr: "SAML identity providers must be created in Atlas before being managed",
+		},
+		{
+			name:  "drifted providers are updated",
+			state: state.StateCreated,
+			input: withStatus(sampleOIDCProvider(), fakeID),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				current := atlasIdentityProvider(t, sampleOIDCProvider())
+				current.Audience = "other"
+				svc := mocks.NewIdentityProviderServiceMock(t)
+				svc.EXPECT().Get(mock.Anything, fakeFederationSettingsID, fakeID).Return(current, nil)
+				svc.EXPECT().Update(mock.Anything, fakeFederationSettingsID, fakeID, mock.Anything).
+					Return(atlasIdentityProvider(t, sampleOIDCProvider()), nil)
+				svc.EXPECT().ConnectOrg(mock.Anything, fakeFederationSettingsID, fakeOrgID, mock.Anything).Return(nil)
+				return func(*atlas.ClientSet) identityprovider.IdentityProviderService { return svc }
+			},
+			want:   ctrlstate.Result{NextState: state.StateUpdated, StateMsg: "Updated Atlas Identity Provider fake-idp-id."},
+			wantID: fakeID,
+		},
+		{
+			name:  "providers in sync are disconnected when requested",
+			state: state.StateUpdated,
+			input: func() *akov2.AtlasIdentityProvider {
+				idp := withStatus(sampleOIDCProvider(), fakeID)
+				idp.Spec.ConnectToOrg = pointer.MakePtr(false)
+				return idp
+			}(),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewIdentityProviderServiceMock(t)
+				svc.EXPECT().Get(mock.Anything, fakeFederationSettingsID, fakeID).
+					Return(atlasIdentityProvider(t, sampleOIDCProvider()), nil)
+				svc.EXPECT().DisconnectOrg(mock.Anything, fakeFederationSettingsID, fakeOrgID, mock.Anything).Return(nil)
+				return func(*atlas.ClientSet) identityprovider.IdentityProviderService { return svc }
+			},
+			want:   ctrlstate.Result{NextState: state.StateUpdated, StateMsg: "Synced Atlas Identity Provider fake-idp-id."},
+			wantID: fakeID,
+		},
+		{
+			name:  "failures to get the provider are reported",
+			state: state.StateUpdated,
+			input: withStatus(sampleOIDCProvider(), fakeID),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewIdentityProviderServiceMock(t)
+				svc.EXPECT().Get(mock.Anything, fakeFederationSettingsID, fakeID).Return(nil, fmt.Errorf("unexpected error"))
+				return func(*atlas.ClientSet) identityprovider.IdentityProviderService { return svc }
+			},
+			want:    ctrlstate.Result{NextState: state.StateUpdated},
+			wantErr: "unexpected error",
+			wantID:  fakeID,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(&fakeAtlasSecret, tc.input).
+				WithStatusSubresource(tc.input).Build()
+			h := AtlasIdentityProviderHandler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Client:        k8sClient,
+					AtlasProvider: fakeProvider,
+				},
+				serviceBuilder: tc.serviceBuilder(t),
+			}
+
+			handle := h.HandleInitial
+			switch tc.state {
+			case state.StateInitial:
+				handle = h.HandleInitial
+			case state.StateCreated:
+				handle = h.HandleCreated
+			case state.StateUpdated:
+				handle = h.HandleUpdated
+			default:
+				panic(fmt.Errorf("unsupported state %v for test", tc.state))
+			}
+			got, err := handle(ctx, tc.input)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+			assert.Equal(t, tc.want, got)
+
+			idp := &akov2.AtlasIdentityProvider{}
+			require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(tc.input), idp))
+			assert.Equal(t, tc.wantID, idp.Status.ID)
+		})
+	}
+}
+
+func TestHandleDeletion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name               string
+		deletionProtection bool
+		input              *akov2.AtlasIdentityProvider
+		serviceBuilder     func(t *testing.T) serviceBuilderFunc
+		want               ctrlstate.Result
+		wantErr            string
+	}{
+		{
+			name:  "OIDC providers are disconnected and deleted",
+			input: withStatus(sampleOIDCProvider(), fakeID),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewIdentityProviderServiceMock(t)
+				svc.EXPECT().DisconnectOrg(mock.Anything, fakeFederationSettingsID, fakeOrgID,
+					&identityprovider.IdentityProvider{ID: fakeID, Protocol: "OIDC"}).Return(nil)
+				svc.EXPECT().Delete(mock.Anything, fakeFederationSettingsID, fakeID).Return(nil)
+				return func(*atlas.ClientSet) identityprovider.IdentityProviderService { return svc }
+			},
+			want: ctrlstate.Result{NextState: state.StateDeleted, StateMsg: "Deleted Atlas Identity Provider \"corporate\"."},
+		},
+		{
+			name:  "missing OIDC providers are released",
+			input: withStatus(sampleOIDCProvider(), fakeID),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewIdentityProviderServiceMock(t)
+				svc.EXPECT().DisconnectOrg(mock.Anything, fakeFederationSettingsID, fakeOrgID, mock.Anything).
+					Return(identityprovider.ErrNotFound)
+				svc.EXPECT().Delete(mock.Anything, fakeFederationSettingsID, fakeID).Return(identityprovider.ErrNotFound)
+				return func(*atlas.ClientSet) identityprovider.IdentityProviderService { return svc }
+			},
+			want: ctrlstate.Result{NextState: state.StateDeleted, StateMsg: "Deleted Atlas Identity Provider \"corporate\"."},
+		},
+		{
+			name:  "deletion failures are reported",
+			input: withStatus(sampleOIDCProvider(), fakeID),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewIdentityProviderServiceMock(t)
+				svc.EXPECT().DisconnectOrg(mock.Anything, fakeFederationSettingsID, fakeOrgID, mock.Anything).Return(nil)
+				svc.EXPECT().Delete(mock.Anything, fakeFederationSettingsID, fakeID).Return(fmt.Errorf("unexpected error"))
+				return func(*atlas.ClientSet) identityprovider.IdentityProviderService { return svc }
+			},
+			want:    ctrlstate.Result{NextState: state.StateDeletionRequested},
+			wantErr: "unexpected error",
+		},
+		{
+			name:  "SAML providers are released",
+			input: withStatus(sampleSAMLProvider(), fakeID),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewIdentityProviderServiceMock(t)
+				return func(*atlas.ClientSet) identityprovider.IdentityProviderService { return svc }
+			},
+			want: ctrlstate.Result{NextState: state.StateDeleted, StateMsg: "Deleted Atlas Identity Provider \"corporate\"."},
+		},
+		{
+			name:               "deletion protection releases providers",
+			deletionProtection: true,
+			input:              withStatus(sampleOIDCProvider(), fakeID),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewIdentityProviderServiceMock(t)
+				return func(*atlas.ClientSet) identityprovider.IdentityProviderService { return svc }
+			},
+			want: ctrlstate.Result{NextState: state.StateDeleted, StateMsg: "Deleted Atlas Identity Provider \"corporate\"."},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(&fakeAtlasSecret, tc.input).
+				WithStatusSubresource(tc.input).Build()
+			h := AtlasIdentityProviderHandler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Client:        k8sClient,
+					AtlasProvider: fakeProvider,
+				},
+				deletionProtection: tc.deletionProtection,
+				serviceBuilder:     tc.serviceBuilder(t),
+			}
+
+			got, err := h.HandleDeletionRequested(ctx, tc.input)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasidentityprovider
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlrtbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/identityprovider"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	mckpredicate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/predicate"
+)
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasidentityproviders,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasidentityproviders/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasidentityproviders/finalizers,verbs=update
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasidentityproviders,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasidentityproviders/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasidentityproviders/finalizers,verbs=update
+
+type serviceBuilderFunc func(*atlas.ClientSet) identityprovider.IdentityProviderService
+
+type AtlasIdentityProviderHandler struct {
+	ctrlstate.StateHandler[akov2.AtlasIdentityProvider]
+	reconciler.AtlasReconciler
+	deletionProtection bool
+	serviceBuilder     serviceBuilderFunc
+}
+
+func NewAtlasIdentityProviderReconciler(
+	c cluster.Cluster,
+	atlasProvider atlas.Provider,
+	deletionProtection bool,
+	logger *zap.Logger,
+	globalSecretRef client.ObjectKey,
+	reapplySupport bool,
+) *ctrlstate.Reconciler[akov2.AtlasIdentityProvider] {
+	idpHandler := &AtlasIdentityProviderHandler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:          c.GetClient(),
+			AtlasProvider:   atlasProvider,
+			Log:             logger.Named("controllers").Named("AtlasIdentityProvider").Sugar(),
+			GlobalSecretRef: globalSecretRef,
+		},
+		deletionProtection: deletionProtection,
+		serviceBuilder:     identityprovider.NewIdentityProviderServiceFromClientSet,
+	}
+	return ctrlstate.NewStateReconciler(
+		idpHandler,
+		ctrlstate.WithCluster[akov2.AtlasIdentityProvider](c),
+		ctrlstate.WithReapplySupport[akov2.AtlasIdentityProvider](reapplySupport),
+	)
+}
+
+// For prepares the controller for its target Custom Resource; AtlasIdentityProvider
+func (h *AtlasIdentityProviderHandler) For() (client.Object, builder.Predicates) {
+	obj := &akov2.AtlasIdentityProvider{}
+	return obj, ctrlrtbuilder.WithPredicates(
+		predicate.Or(
+			mckpredicate.AnnotationChanged("mongodb.com/reapply-period"),
+			predicate.GenerationChangedPredicate{},
+		),
+		mckpredicate.IgnoreDeletedPredicate[client.Object](),
+	)
+}
+
+func (h *AtlasIdentityProviderHandler) SetupWithManager(mgr ctrl.Manager, rec reconcile.Reconciler, defaultOptions controller.Options) error {
+	h.Client = mgr.GetClient()
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named("AtlasIdentityProvider").
+		For(h.For()).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(h.identityProviderForCredentialMapFunc()),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		WithOptions(defaultOptions).Complete(rec)
+}
+
+func (h *AtlasIdentityProviderHandler) identityProviderForCredentialMapFunc() handler.MapFunc {
+	return indexer.CredentialsIndexMapperFunc(
+		indexer.AtlasIdentityProviderBySecretsIndex,
+		func() *akov2.AtlasIdentityProviderList { return &akov2.AtlasIdentityProviderList{} },
+		indexer.AtlasIdentityProviderRequests,
+		h.Client,
+		h.Log,
+	)
+}
+
+type reconcileRequest struct {
+	service identityprovider.IdentityProviderService
+	idp     *akov2.AtlasIdentityProvider
+}
+
+func (h *AtlasIdentityProviderHandler) newReconcileRequest(ctx context.Context, idp *akov2.AtlasIdentityProvider) (*reconcileRequest, error) {
+	var objKey *client.ObjectKey
+	if idp.Spec.ConnectionSecretRef != nil && idp.Spec.ConnectionSecretRef.Name != "" {
+		objKey = &client.ObjectKey{
+			Namespace: idp.GetNamespace(),
+			Name:      idp.Spec.ConnectionSecretRef.Name,
+		}
+	}
+
+	cfg, err := reconciler.GetConnectionConfig(ctx, h.Client, objKey, &h.GlobalSecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkClientSet, err := h.AtlasProvider.SdkClientSet(ctx, cfg.Credentials, h.Log)
+	if err != nil {
+		return nil, err
+	}
+	return &reconcileRequest{
+		service: h.serviceBuilder(sdkClientSet),
+		idp:     idp,
+	}, nil
+}
@@ -16,6 +16,8 @@ package atlasipaccesslist
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"time"
 
 	"go.uber.org/zap"
@@ -29,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -39,8 +42,6 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 // AtlasIPAccessListReconciler reconciles a AtlasIPAccessList object
@@ -51,6 +52,7 @@ type AtlasIPAccessListReconciler struct {
 	GlobalPredicates         []predicate.Predicate
 	ObjectDeletionProtection bool
 	independentSyncPeriod    time.Duration
+	watchNodes               bool
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasipaccesslists,verbs=get;list;watch;create;update;patch;delete
@@ -59,6 +61,9 @@ type AtlasIPAccessListReconciler struct {
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasipaccesslists,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasipaccesslists/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasipaccesslists/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=default,resources=services,verbs=get;list;watch
 
 func (r *AtlasIPAccessListReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	r.Log.Infow("-> Starting AtlasIPAccessList reconciliation")
@@ -76,8 +81,8 @@ func (r *AtlasIPAccessListReconciler) For() (client.Object, builder.Predicates)
 	return &akov2.AtlasIPAccessList{}, builder.WithPredicates(r.GlobalPredicates...)
 }
 
-func (r *AtlasIPAccessListReconciler) SetupWithManager(mgr manager.Manager, skipNameValidation bool) error {
-	return ctrl.NewControllerManagedBy(mgr).
+func (r *AtlasIPAccessListReconciler) SetupWithManager(mgr manager.Manager, options controller.TypedOptions[reconcile.Request]) error {
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasIPAccessList").
 		For(r.For()).
 		Watches(
@@ -90,11 +95,69 @@ func (r *AtlasIPAccessListReconciler) SetupWithManager(mgr manager.Manager, skip
 			handler.EnqueueRequestsFromMapFunc(r.ipAccessListForCredentialMapFunc()),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
 		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation),
-		}).
-		Complete(r)
+		Watches(
+			&corev1.Service{},
+			handler.EnqueueRequestsFromMapFunc(r.ipAccessListForSourceMapFunc()),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.ipAccessListForSourceMapFunc()),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		)
+	// Nodes are cluster scoped, the operator can only watch them when it is not limited to some namespaces
+	if r.watchNodes {
+		b = b.Watches(
+			&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.ipAccessListForSourceMapFunc()),
+			builder.WithPredicates(nodeAddressesChangedPredicate()),
+		)
+	}
+	return b.WithOptions(options).Complete(r)
+}
+
+// ipAccessListForSourceMapFunc enqueues the AtlasIPAccessLists reading their dynamic entries from the object
+func (r *AtlasIPAccessListReconciler) ipAccessListForSourceMapFunc() handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		var key string
+		switch obj.(type) {
+		case *corev1.Node:
+			key = indexer.IPAccessListNodesSourceKey
+		case *corev1.Service:
+			key = indexer.IPAccessListSourceKey("Service", obj.GetNamespace(), obj.GetName())
+		case *corev1.ConfigMap:
+			key = indexer.IPAccessListSourceKey("ConfigMap", obj.GetNamespace(), obj.GetName())
+		default:
+			r.Log.Warnf("watching dynamic sources but got %T", obj)
+			return nil
+		}
+
+		list := &akov2.AtlasIPAccessListList{}
+		listOpts := &client.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector(indexer.AtlasIPAccessListBySourceIndex, key),
+		}
+		if err := r.Client.List(ctx, list, listOpts); err != nil {
+			r.Log.Errorf("failed to list AtlasIPAccessList: %s", err)
+			return nil
+		}
+
+		return indexer.IPAccessListRequests(list)
+	}
+}
+
+// nodeAddressesChangedPredicate ignores the frequent node status updates that change neither labels nor addresses
+func nodeAddressesChangedPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, okOld := e.ObjectOld.(*corev1.Node)
+			newNode, okNew := e.ObjectNew.(*corev1.Node)
+			if !okOld || !okNew {
+				return false
+			}
+			return !maps.Equal(oldNode.Labels, newNode.Labels) ||
+				!slices.Equal(oldNode.Status.Addresses, newNode.Status.Addresses)
+		},
+	}
 }
 
 func (r *AtlasIPAccessListReconciler) ipAccessListForProjectMapFunc() handler.MapFunc {
@@ -147,18 +210,22 @@ func NewAtlasIPAccessListReconciler(
 	independentSyncPeriod time.Duration,
 	logger *zap.Logger,
 	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
+	watchNodes bool,
 ) *AtlasIPAccessListReconciler {
 	return &AtlasIPAccessListReconciler{
 		AtlasReconciler: reconciler.AtlasReconciler{
-			Client:          c.GetClient(),
-			Log:             logger.Named("controllers").Named("AtlasIPAccessList").Sugar(),
-			GlobalSecretRef: globalSecretRef,
-			AtlasProvider:   atlasProvider,
+			Client:              c.GetClient(),
+			Log:                 logger.Named("controllers").Named("AtlasIPAccessList").Sugar(),
+			GlobalSecretRef:     globalSecretRef,
+			CredentialProviders: credentialProviders,
+			AtlasProvider:       atlasProvider,
 		},
 		Scheme:                   c.GetScheme(),
 		EventRecorder:            c.GetEventRecorderFor("AtlasIPAccessList"),
 		GlobalPredicates:         predicates,
 		ObjectDeletionProtection: deletionProtection,
 		independentSyncPeriod:    independentSyncPeriod,
+		watchNodes:               watchNodes,
 	}
 }
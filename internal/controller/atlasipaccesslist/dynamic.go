@@ -0,0 +1,163 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasipaccesslist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/ipaccesslist"
+)
+
+const DefaultGracePeriod = time.Hour
+
+type dynamicEntries struct {
+	entries  ipaccesslist.IPAccessEntries
+	statuses []status.IPAccessDynamicEntryStatus
+	// expiresIn is how long until the next entry no longer listed by its source is removed, zero when there is none
+	expiresIn time.Duration
+}
+
+// resolveDynamicEntries returns the entries listed by the dynamic sources, along with those no longer listed whose
+// grace period has not elapsed yet
+func (r *AtlasIPAccessListReconciler) resolveDynamicEntries(ctx context.Context, ipAccessList *akov2.AtlasIPAccessList, now time.Time) (*dynamicEntries, error) {
+	result := &dynamicEntries{entries: ipaccesslist.IPAccessEntries{}}
+	for _, source := range ipAccessList.Spec.DynamicSources {
+		addresses, err := r.sourceAddresses(ctx, ipAccessList.Namespace, source)
+		if err != nil {
+			return nil, err
+		}
+		for _, address := range addresses {
+			cidr, ok := ipv4Network(address)
+			if !ok {
+				r.Log.Warnf("skipping %q of a dynamic source, it is not an IPv4 address or CIDR block", address)
+				continue
+			}
+			if _, ok := result.entries[cidr]; !ok {
+				result.entries[cidr] = &ipaccesslist.IPAccessEntry{CIDR: cidr, Comment: source.Comment}
+				result.statuses = append(result.statuses, status.IPAccessDynamicEntryStatus{Entry: cidr, LastSeen: metav1.NewTime(now)})
+			}
+		}
+	}
+
+	gracePeriod := DefaultGracePeriod
+	if ipAccessList.Spec.GracePeriod != nil {
+		gracePeriod = ipAccessList.Spec.GracePeriod.Duration
+	}
+	for _, previous := range ipAccessList.Status.DynamicEntries {
+		if _, ok := result.entries[previous.Entry]; ok {
+			continue
+		}
+		expiresIn := previous.LastSeen.Add(gracePeriod).Sub(now)
+		if expiresIn <= 0 {
+			continue
+		}
+		result.entries[previous.Entry] = &ipaccesslist.IPAccessEntry{CIDR: previous.Entry}
+		result.statuses = append(result.statuses, previous)
+		if result.expiresIn == 0 || expiresIn < result.expiresIn {
+			result.expiresIn = expiresIn
+		}
+	}
+
+	slices.SortFunc(result.statuses, func(a, b status.IPAccessDynamicEntryStatus) int {
+		return strings.Compare(a.Entry, b.Entry)
+	})
+	return result, nil
+}
+
+func (r *AtlasIPAccessListReconciler) sourceAddresses(ctx context.Context, namespace string, source akov2.IPAccessDynamicSource) ([]string, error) {
+	switch {
+	case source.NodeSelector != nil:
+		if !r.watchNodes {
+			return nil, errors.New("node selectors require the operator to watch all namespaces")
+		}
+		selector, err := metav1.LabelSelectorAsSelector(source.NodeSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node selector: %w", err)
+		}
+		nodes := &corev1.NodeList{}
+		if err := r.Client.List(ctx, nodes, &client.ListOptions{LabelSelector: selector}); err != nil {
+			return nil, fmt.Errorf("failed to list nodes: %w", err)
+		}
+		var addresses []string
+		for _, node := range nodes.Items {
+			for _, address := range node.Status.Addresses {
+				if address.Type == corev1.NodeExternalIP {
+					addresses = append(addresses, address.Address)
+				}
+			}
+		}
+		return addresses, nil
+	case source.ServiceRef != nil:
+		service := &corev1.Service{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: source.ServiceRef.Name}, service); err != nil {
+			return nil, fmt.Errorf("failed to get Service %s/%s: %w", namespace, source.ServiceRef.Name, err)
+		}
+		var addresses []string
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			if ingress.IP != "" {
+				addresses = append(addresses, ingress.IP)
+			}
+		}
+		return addresses, nil
+	case source.ConfigMapRef != nil:
+		configMap := &corev1.ConfigMap{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: source.ConfigMapRef.Name}, configMap); err != nil {
+			return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", namespace, source.ConfigMapRef.Name, err)
+		}
+		values := configMap.Data
+		if key := source.ConfigMapRef.Key; key != "" {
+			value, ok := configMap.Data[key]
+			if !ok {
+				return nil, fmt.Errorf("ConfigMap %s/%s has no key %q", namespace, source.ConfigMapRef.Name, key)
+			}
+			values = map[string]string{key: value}
+		}
+		var addresses []string
+		for _, value := range values {
+			addresses = append(addresses, strings.FieldsFunc(value, func(r rune) bool {
+				return r == ',' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+			})...)
+		}
+		return addresses, nil
+	}
+	return nil, nil
+}
+
+// ipv4Network returns the CIDR block of an IPv4 address or network
+func ipv4Network(address string) (string, bool) {
+	if ip := net.ParseIP(address); ip != nil {
+		if ip.To4() == nil {
+			return "", false
+		}
+		return (&net.IPNet{IP: ip.To4(), Mask: net.CIDRMask(32, 32)}).String(), true
+	}
+	ip, network, err := net.ParseCIDR(address)
+	if err != nil || ip.To4() == nil {
+		return "", false
+	}
+	return network.String(), true
+}
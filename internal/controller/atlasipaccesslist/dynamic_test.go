@@ -0,0 +1,232 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasipaccesslist
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/ipaccesslist"
+)
+
+func TestResolveDynamicEntries(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	objects := []client.Object{
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"pool": "egress"}},
+			Status: corev1.NodeStatus{
+				Addresses: []corev1.NodeAddress{
+					{Type: corev1.NodeInternalIP, Address: "10.0.0.1"},
+					{Type: corev1.NodeExternalIP, Address: "34.1.1.1"},
+					{Type: corev1.NodeExternalIP, Address: "2001:db8::1"},
+				},
+			},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-2", Labels: map[string]string{"pool": "default"}},
+			Status: corev1.NodeStatus{
+				Addresses: []corev1.NodeAddress{{Type: corev1.NodeExternalIP, Address: "34.2.2.2"}},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "egress", Namespace: "default"},
+			Status: corev1.ServiceStatus{
+				LoadBalancer: corev1.LoadBalancerStatus{
+					Ingress: []corev1.LoadBalancerIngress{{IP: "35.3.3.3"}, {Hostname: "lb.example.com"}},
+				},
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "offices", Namespace: "default"},
+			Data: map[string]string{
+				"paris":  "203.0.113.0/24, 198.51.100.7",
+				"berlin": "192.0.2.0/28\nnot-an-ip",
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		spec             akov2.AtlasIPAccessListSpec
+		previous         []status.IPAccessDynamicEntryStatus
+		watchNodes       bool
+		expectedEntries  ipaccesslist.IPAccessEntries
+		expectedStatuses []status.IPAccessDynamicEntryStatus
+		expectedExpiry   time.Duration
+		expectedErr      string
+	}{
+		"should resolve external addresses of selected nodes": {
+			spec: akov2.AtlasIPAccessListSpec{
+				DynamicSources: []akov2.IPAccessDynamicSource{
+					{NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "egress"}}, Comment: "egress nodes"},
+				},
+			},
+			watchNodes: true,
+			expectedEntries: ipaccesslist.IPAccessEntries{
+				"34.1.1.1/32": {CIDR: "34.1.1.1/32", Comment: "egress nodes"},
+			},
+			expectedStatuses: []status.IPAccessDynamicEntryStatus{
+				{Entry: "34.1.1.1/32", LastSeen: metav1.NewTime(now)},
+			},
+		},
+		"should fail to resolve nodes when they are not watched": {
+			spec: akov2.AtlasIPAccessListSpec{
+				DynamicSources: []akov2.IPAccessDynamicSource{
+					{NodeSelector: &metav1.LabelSelector{}},
+				},
+			},
+			expectedErr: "node selectors require the operator to watch all namespaces",
+		},
+		"should resolve load balancer addresses of a service": {
+			spec: akov2.AtlasIPAccessListSpec{
+				DynamicSources: []akov2.IPAccessDynamicSource{
+					{ServiceRef: &api.LocalObjectReference{Name: "egress"}},
+				},
+			},
+			expectedEntries: ipaccesslist.IPAccessEntries{
+				"35.3.3.3/32": {CIDR: "35.3.3.3/32"},
+			},
+			expectedStatuses: []status.IPAccessDynamicEntryStatus{
+				{Entry: "35.3.3.3/32", LastSeen: metav1.NewTime(now)},
+			},
+		},
+		"should resolve all values of a config map": {
+			spec: akov2.AtlasIPAccessListSpec{
+				DynamicSources: []akov2.IPAccessDynamicSource{
+					{ConfigMapRef: &akov2.IPAccessListConfigMapReference{Name: "offices"}},
+				},
+			},
+			expectedEntries: ipaccesslist.IPAccessEntries{
+				"192.0.2.0/28":    {CIDR: "192.0.2.0/28"},
+				"198.51.100.7/32": {CIDR: "198.51.100.7/32"},
+				"203.0.113.0/24":  {CIDR: "203.0.113.0/24"},
+			},
+			expectedStatuses: []status.IPAccessDynamicEntryStatus{
+				{Entry: "192.0.2.0/28", LastSeen: metav1.NewTime(now)},
+				{Entry: "198.51.100.7/32", LastSeen: metav1.NewTime(now)},
+				{Entry: "203.0.113.0/24", LastSeen: metav1.NewTime(now)},
+			},
+		},
+		"should resolve a single key of a config map": {
+			spec: akov2.AtlasIPAccessListSpec{
+				DynamicSources: []akov2.IPAccessDynamicSource{
+					{ConfigMapRef: &akov2.IPAccessListConfigMapReference{Name: "offices", Key: "berlin"}},
+				},
+			},
+			expectedEntries: ipaccesslist.IPAccessEntries{
+				"192.0.2.0/28": {CIDR: "192.0.2.0/28"},
+			},
+			expectedStatuses: []status.IPAccessDynamicEntryStatus{
+				{Entry: "192.0.2.0/28", LastSeen: metav1.NewTime(now)},
+			},
+		},
+		"should fail when the config map has no such key": {
+			spec: akov2.AtlasIPAccessListSpec{
+				DynamicSources: []akov2.IPAccessDynamicSource{
+					{ConfigMapRef: &akov2.IPAccessListConfigMapReference{Name: "offices", Key: "london"}},
+				},
+			},
+			expectedErr: `ConfigMap default/offices has no key "london"`,
+		},
+		"should fail when the service does not exist": {
+			spec: akov2.AtlasIPAccessListSpec{
+				DynamicSources: []akov2.IPAccessDynamicSource{
+					{ServiceRef: &api.LocalObjectReference{Name: "missing"}},
+				},
+			},
+			expectedErr: "failed to get Service default/missing",
+		},
+		"should keep entries no longer listed during the grace period": {
+			spec: akov2.AtlasIPAccessListSpec{
+				DynamicSources: []akov2.IPAccessDynamicSource{
+					{ServiceRef: &api.LocalObjectReference{Name: "egress"}},
+				},
+				GracePeriod: &metav1.Duration{Duration: 30 * time.Minute},
+			},
+			previous: []status.IPAccessDynamicEntryStatus{
+				{Entry: "35.3.3.3/32", LastSeen: metav1.NewTime(now.Add(-time.Hour))},
+				{Entry: "35.4.4.4/32", LastSeen: metav1.NewTime(now.Add(-20 * time.Minute))},
+				{Entry: "35.5.5.5/32", LastSeen: metav1.NewTime(now.Add(-40 * time.Minute))},
+			},
+			expectedEntries: ipaccesslist.IPAccessEntries{
+				"35.3.3.3/32": {CIDR: "35.3.3.3/32"},
+				"35.4.4.4/32": {CIDR: "35.4.4.4/32"},
+			},
+			expectedStatuses: []status.IPAccessDynamicEntryStatus{
+				{Entry: "35.3.3.3/32", LastSeen: metav1.NewTime(now)},
+				{Entry: "35.4.4.4/32", LastSeen: metav1.NewTime(now.Add(-20 * time.Minute))},
+			},
+			expectedExpiry: 10 * time.Minute,
+		},
+		"should use the default grace period": {
+			previous: []status.IPAccessDynamicEntryStatus{
+				{Entry: "35.4.4.4/32", LastSeen: metav1.NewTime(now.Add(-20 * time.Minute))},
+			},
+			expectedEntries: ipaccesslist.IPAccessEntries{
+				"35.4.4.4/32": {CIDR: "35.4.4.4/32"},
+			},
+			expectedStatuses: []status.IPAccessDynamicEntryStatus{
+				{Entry: "35.4.4.4/32", LastSeen: metav1.NewTime(now.Add(-20 * time.Minute))},
+			},
+			expectedExpiry: 40 * time.Minute,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			testScheme := runtime.NewScheme()
+			require.NoError(t, akov2.AddToScheme(testScheme))
+			require.NoError(t, corev1.AddToScheme(testScheme))
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(objects...).
+				Build()
+			r := &AtlasIPAccessListReconciler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Client: k8sClient,
+					Log:    zaptest.NewLogger(t).Sugar(),
+				},
+				watchNodes: tt.watchNodes,
+			}
+			ipAccessList := &akov2.AtlasIPAccessList{
+				ObjectMeta: metav1.ObjectMeta{Name: "ip-access-list", Namespace: "default"},
+				Spec:       tt.spec,
+				Status:     status.AtlasIPAccessListStatus{DynamicEntries: tt.previous},
+			}
+
+			result, err := r.resolveDynamicEntries(context.Background(), ipAccessList, now)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedEntries, result.entries)
+			assert.Equal(t, tt.expectedStatuses, result.statuses)
+			assert.Equal(t, tt.expectedExpiry, result.expiresIn)
+		})
+	}
+}
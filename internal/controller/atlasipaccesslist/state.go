@@ -16,6 +16,7 @@ package atlasipaccesslist
 
 import (
 	"context"
+	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
@@ -43,14 +44,15 @@ func (r *AtlasIPAccessListReconciler) handleCustomResource(ctx context.Context,
 		return r.invalidate(isValid)
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(ipAccessList) {
-		return r.unsupport(workflowCtx)
-	}
-
 	connectionConfig, err := r.ResolveConnectionConfig(ctx, ipAccessList)
 	if err != nil {
 		return r.terminate(workflowCtx, ipAccessList, api.ReadyType, workflow.AtlasAPIAccessNotConfigured, err)
 	}
+
+	if !r.AtlasProvider.IsResourceSupported(ipAccessList, connectionConfig.Credentials) {
+		return r.unsupport(workflowCtx)
+	}
+
 	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
 	if err != nil {
 		return r.terminate(workflowCtx, ipAccessList, api.ReadyType, workflow.AtlasAPIAccessNotConfigured, err)
@@ -92,6 +94,17 @@ func (r *AtlasIPAccessListReconciler) handleIPAccessList(
 		return r.unmanage(ctx, ipAccessList)
 	}
 
+	dynamic, err := r.resolveDynamicEntries(ctx.Context, ipAccessList, time.Now())
+	if err != nil {
+		return r.terminate(ctx, ipAccessList, api.IPAccessListReady, workflow.IPAccessListSourceUnavailable, err)
+	}
+	ctx.EnsureStatusOption(status.WithIPAccessListDynamicEntries(dynamic.statuses))
+	for id, entry := range dynamic.entries {
+		if _, ok := akoIPAccessList[id]; !ok {
+			akoIPAccessList[id] = entry
+		}
+	}
+
 	if toAdd := collection.MapDiff(akoIPAccessList, atlasIPAccessList); len(toAdd) > 0 {
 		r.Log.Infof("adding ip access list %v on project %s", toAdd, projectID)
 		return r.create(ctx, ipAccessListService, ipAccessList, projectID, toAdd)
@@ -122,5 +135,5 @@ func (r *AtlasIPAccessListReconciler) handleIPAccessList(
 		return r.inProgress(ctx, ipAccessList, "Atlas has started to add access list entries")
 	}
 
-	return r.ready(ctx, ipAccessList)
+	return r.ready(ctx, ipAccessList, dynamic.expiresIn)
 }
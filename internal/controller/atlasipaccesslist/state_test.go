@@ -120,6 +120,11 @@ func TestHandleCustomResource(t *testing.T) {
 					Namespace: "default",
 				},
 				Spec: akov2.AtlasIPAccessListSpec{
+					ProjectDualReference: akov2.ProjectDualReference{
+						ConnectionSecret: &api.LocalObjectReference{
+							Name: "my-secret",
+						},
+					},
 					Entries: []akov2.IPAccessEntry{
 						{
 							CIDRBlock: "192.168.0.0/24",
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
@@ -144,7 +145,9 @@ func (r *AtlasIPAccessListReconciler) unmanage(ctx *workflow.Context, ipAccessLi
 	return workflow.Deleted().ReconcileResult()
 }
 
-func (r *AtlasIPAccessListReconciler) ready(ctx *workflow.Context, ipAccessList *akov2.AtlasIPAccessList) (ctrl.Result, error) {
+// ready requeues the resource once the next dynamic entry expires, or after the independent sync period for
+// external projects, whichever comes first
+func (r *AtlasIPAccessListReconciler) ready(ctx *workflow.Context, ipAccessList *akov2.AtlasIPAccessList, expiresIn time.Duration) (ctrl.Result, error) {
 	if err := customresource.ManageFinalizer(ctx.Context, r.Client, ipAccessList, customresource.SetFinalizer); err != nil {
 		return r.terminate(ctx, ipAccessList, api.ReadyType, workflow.AtlasFinalizerNotSet, err)
 	}
@@ -152,10 +155,14 @@ func (r *AtlasIPAccessListReconciler) ready(ctx *workflow.Context, ipAccessList
 	ctx.SetConditionTrue(api.ReadyType).
 		SetConditionTrue(api.IPAccessListReady)
 
-	if ipAccessList.Spec.ExternalProjectRef != nil {
+	if ipAccessList.Spec.ExternalProjectRef != nil && (expiresIn == 0 || r.independentSyncPeriod < expiresIn) {
 		return workflow.Requeue(r.independentSyncPeriod).ReconcileResult()
 	}
 
+	if expiresIn > 0 {
+		return workflow.Requeue(expiresIn).ReconcileResult()
+	}
+
 	return workflow.OK().ReconcileResult()
 }
 
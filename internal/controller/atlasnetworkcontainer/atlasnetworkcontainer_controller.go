@@ -20,6 +20,7 @@ import (
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,11 +34,10 @@ import (
 
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlascidrpool"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 // AtlasNetworkContainerReconciler reconciles a AtlasNetworkContainer object
@@ -48,11 +48,15 @@ type AtlasNetworkContainerReconciler struct {
 	GlobalPredicates         []predicate.Predicate
 	ObjectDeletionProtection bool
 	independentSyncPeriod    time.Duration
+	// cidrAllocator is nil when the operator is limited to some namespaces, as the pools are cluster scoped
+	cidrAllocator *atlascidrpool.Allocator
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasnetworkcontainers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasnetworkcontainers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasnetworkcontainers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlascidrpools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlascidrpools/status,verbs=get;update;patch
 
 // Reconcile Atlas Network Container resources
 func (r *AtlasNetworkContainerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -72,8 +76,8 @@ func (r *AtlasNetworkContainerReconciler) For() (client.Object, builder.Predicat
 }
 
 // SetupWithManager sets up the controller with the Manager.
-func (r *AtlasNetworkContainerReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
-	return ctrl.NewControllerManagedBy(mgr).
+func (r *AtlasNetworkContainerReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(r.For()).
 		Watches(
 			&akov2.AtlasProject{},
@@ -84,11 +88,33 @@ func (r *AtlasNetworkContainerReconciler) SetupWithManager(mgr ctrl.Manager, ski
 			&corev1.Secret{},
 			handler.EnqueueRequestsFromMapFunc(r.networkContainerForCredentialMapFunc()),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
-		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
-		Complete(r)
+		)
+	if r.cidrAllocator != nil {
+		b = b.Watches(
+			&akov2.AtlasCIDRPool{},
+			handler.EnqueueRequestsFromMapFunc(r.networkContainerForCIDRPoolMapFunc),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		)
+	}
+	return b.WithOptions(options).Complete(r)
+}
+
+func (r *AtlasNetworkContainerReconciler) networkContainerForCIDRPoolMapFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	pool, ok := obj.(*akov2.AtlasCIDRPool)
+	if !ok {
+		r.Log.Warnf("watching AtlasCIDRPool but got %T", obj)
+		return nil
+	}
+
+	containers := &akov2.AtlasNetworkContainerList{}
+	listOpts := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(indexer.AtlasNetworkContainerByCIDRPoolIndex, pool.Name),
+	}
+	if err := r.Client.List(ctx, containers, listOpts); err != nil {
+		r.Log.Errorf("failed to list AtlasNetworkContainers of AtlasCIDRPool %s: %s", pool.Name, err)
+		return nil
+	}
+	return indexer.NetworkContainerRequests(containers)
 }
 
 func (r *AtlasNetworkContainerReconciler) networkContainerForProjectMapFunc() handler.MapFunc {
@@ -119,18 +145,26 @@ func NewAtlasNetworkContainerReconciler(
 	logger *zap.Logger,
 	independentSyncPeriod time.Duration,
 	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
+	clusterWide bool,
 ) *AtlasNetworkContainerReconciler {
+	var cidrAllocator *atlascidrpool.Allocator
+	if clusterWide {
+		cidrAllocator = atlascidrpool.NewAllocator(c)
+	}
 	return &AtlasNetworkContainerReconciler{
 		AtlasReconciler: reconciler.AtlasReconciler{
-			Client:          c.GetClient(),
-			Log:             logger.Named("controllers").Named("AtlasNetworkContainer").Sugar(),
-			GlobalSecretRef: globalSecretRef,
-			AtlasProvider:   atlasProvider,
+			Client:              c.GetClient(),
+			Log:                 logger.Named("controllers").Named("AtlasNetworkContainer").Sugar(),
+			GlobalSecretRef:     globalSecretRef,
+			CredentialProviders: credentialProviders,
+			AtlasProvider:       atlasProvider,
 		},
 		Scheme:                   c.GetScheme(),
 		EventRecorder:            c.GetEventRecorderFor("AtlasNetworkContainer"),
 		GlobalPredicates:         predicates,
 		ObjectDeletionProtection: deletionProtection,
 		independentSyncPeriod:    independentSyncPeriod,
+		cidrAllocator:            cidrAllocator,
 	}
 }
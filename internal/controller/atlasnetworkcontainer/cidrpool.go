@@ -0,0 +1,56 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasnetworkcontainer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// poolCIDRBlock returns the CIDR block allocated to the container from its pool, allocating one if needed.
+// Blocks of the other containers of the project in Atlas are never allocated.
+func (r *AtlasNetworkContainerReconciler) poolCIDRBlock(ctx context.Context, req *reconcileRequest) (string, error) {
+	if r.cidrAllocator == nil {
+		return "", errors.New("CIDR pools require the operator to watch all namespaces")
+	}
+	ref := req.networkContainer.Spec.CIDRPoolRef
+	owner := client.ObjectKeyFromObject(req.networkContainer)
+	allocated, err := r.cidrAllocator.Allocated(ctx, ref, owner)
+	if err != nil {
+		return "", err
+	}
+	if allocated != "" {
+		return allocated, nil
+	}
+	if req.networkContainer.DeletionTimestamp != nil {
+		return req.networkContainer.Status.CIDRBlock, nil
+	}
+
+	containers, err := req.service.List(ctx, req.projectID, req.networkContainer.Spec.Provider)
+	if err != nil {
+		return "", fmt.Errorf("failed to list the containers in use: %w", err)
+	}
+	inUse := make([]string, 0, len(containers))
+	for _, container := range containers {
+		if container.ID == req.networkContainer.Spec.ID || container.ID == req.networkContainer.Status.ID {
+			continue
+		}
+		inUse = append(inUse, container.CIDRBlock)
+	}
+	return r.cidrAllocator.Allocate(ctx, ref, owner, req.networkContainer.Status.CIDRBlock, inUse)
+}
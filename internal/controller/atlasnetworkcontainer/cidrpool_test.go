@@ -0,0 +1,242 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasnetworkcontainer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlascidrpool"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	akomock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/networkcontainer"
+)
+
+func TestPoolCIDRBlock(t *testing.T) {
+	deletionTime := metav1.Now()
+	for _, tc := range []struct {
+		title         string
+		container     *akov2.AtlasNetworkContainer
+		allocations   []status.CIDRAllocation
+		inAtlas       []*networkcontainer.NetworkContainer
+		noAllocator   bool
+		wantCIDRBlock string
+		wantErr       string
+	}{
+		{
+			title:         "allocates the first free block",
+			container:     testPoolContainer(),
+			wantCIDRBlock: "10.64.0.0/21",
+		},
+		{
+			title:     "skips the blocks of the other containers in Atlas",
+			container: testPoolContainer(),
+			inAtlas: []*networkcontainer.NetworkContainer{
+				testAtlasContainer("other-id", "10.64.0.0/20"),
+				testAtlasContainer(testContainerID, "10.64.16.0/21"),
+			},
+			wantCIDRBlock: "10.64.16.0/21",
+		},
+		{
+			title:     "returns the existing allocation",
+			container: testPoolContainer(),
+			allocations: []status.CIDRAllocation{
+				{CIDRBlock: "10.64.8.0/21", Namespace: "ns", Name: "pooled"},
+			},
+			wantCIDRBlock: "10.64.8.0/21",
+		},
+		{
+			title: "keeps the block of a container being deleted",
+			container: func() *akov2.AtlasNetworkContainer {
+				container := testPoolContainer()
+				container.DeletionTimestamp = &deletionTime
+				container.Finalizers = []string{"test"}
+				container.Status.CIDRBlock = "10.64.24.0/21"
+				return container
+			}(),
+			wantCIDRBlock: "10.64.24.0/21",
+		},
+		{
+			title:       "fails without an allocator",
+			container:   testPoolContainer(),
+			noAllocator: true,
+			wantErr:     "CIDR pools require the operator to watch all namespaces",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			testScheme := runtime.NewScheme()
+			require.NoError(t, akov2.AddToScheme(testScheme))
+			pool := &akov2.AtlasCIDRPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec:       akov2.AtlasCIDRPoolSpec{CIDRBlocks: []string{"10.64.0.0/16"}},
+				Status:     status.AtlasCIDRPoolStatus{Allocations: tc.allocations},
+			}
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(pool).
+				WithStatusSubresource(pool).
+				Build()
+			ncs := akomock.NewNetworkContainerServiceMock(t)
+			if tc.container.DeletionTimestamp == nil && tc.allocations == nil && !tc.noAllocator {
+				ncs.EXPECT().List(context.Background(), testProjectID, "AWS").Return(tc.inAtlas, nil)
+			}
+			r := &AtlasNetworkContainerReconciler{}
+			if !tc.noAllocator {
+				r.cidrAllocator = &atlascidrpool.Allocator{Reader: k8sClient, Client: k8sClient}
+			}
+			req := &reconcileRequest{
+				projectID:        testProjectID,
+				networkContainer: tc.container,
+				service:          ncs,
+			}
+
+			cidrBlock, err := r.poolCIDRBlock(context.Background(), req)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCIDRBlock, cidrBlock)
+			if tc.container.DeletionTimestamp == nil {
+				updated := &akov2.AtlasCIDRPool{}
+				require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(pool), updated))
+				assert.Contains(t, updated.Status.Allocations, status.CIDRAllocation{CIDRBlock: tc.wantCIDRBlock, Namespace: "ns", Name: "pooled"})
+			}
+		})
+	}
+}
+
+func TestDeletePooledContainer(t *testing.T) {
+	allocation := status.CIDRAllocation{CIDRBlock: "10.64.8.0/21", Namespace: "ns", Name: "pooled"}
+	for _, tc := range []struct {
+		title           string
+		keep            bool
+		inAtlas         bool
+		deleteErr       error
+		wantErr         string
+		wantAllocations []status.CIDRAllocation
+		wantRetained    []status.CIDRAllocation
+	}{
+		{
+			title:   "releases the block once the container is deleted from Atlas",
+			inAtlas: true,
+		},
+		{
+			title: "releases the block when the container is gone from Atlas",
+		},
+		{
+			title:        "retains the block when the container is kept in Atlas",
+			keep:         true,
+			inAtlas:      true,
+			wantRetained: []status.CIDRAllocation{allocation},
+		},
+		{
+			title:           "keeps the allocation when the container fails to be deleted",
+			inAtlas:         true,
+			deleteErr:       ErrTestFail,
+			wantErr:         "failed to delete container",
+			wantAllocations: []status.CIDRAllocation{allocation},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			testScheme := runtime.NewScheme()
+			require.NoError(t, akov2.AddToScheme(testScheme))
+			pool := &akov2.AtlasCIDRPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec:       akov2.AtlasCIDRPoolSpec{CIDRBlocks: []string{"10.64.0.0/16"}},
+				Status:     status.AtlasCIDRPoolStatus{Allocations: []status.CIDRAllocation{allocation}},
+			}
+			container := testPoolContainer()
+			deletionTime := metav1.Now()
+			container.DeletionTimestamp = &deletionTime
+			container.Finalizers = []string{customresource.FinalizerLabel}
+			if tc.keep {
+				container.Annotations = map[string]string{customresource.ResourcePolicyAnnotation: customresource.ResourcePolicyKeep}
+			}
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(pool, container).
+				WithStatusSubresource(pool).
+				Build()
+			ncs := akomock.NewNetworkContainerServiceMock(t)
+			if tc.inAtlas {
+				ncs.EXPECT().Get(context.Background(), testProjectID, testContainerID).Return(testAtlasContainer(testContainerID, allocation.CIDRBlock), nil)
+			} else {
+				container.Spec.ID = ""
+				ncs.EXPECT().Find(context.Background(), testProjectID, mock.Anything).Return(nil, networkcontainer.ErrNotFound)
+			}
+			if tc.inAtlas && !tc.keep {
+				ncs.EXPECT().Delete(context.Background(), testProjectID, testContainerID).Return(tc.deleteErr)
+			}
+			r := testReconciler(k8sClient, &atlasmock.TestProvider{}, zaptest.NewLogger(t))
+			r.cidrAllocator = &atlascidrpool.Allocator{Reader: k8sClient, Client: k8sClient}
+
+			_, err := r.handle(&workflow.Context{Context: context.Background()}, &reconcileRequest{
+				projectID:        testProjectID,
+				networkContainer: container,
+				service:          ncs,
+			})
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			updated := &akov2.AtlasCIDRPool{}
+			require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(pool), updated))
+			assert.Equal(t, tc.wantAllocations, updated.Status.Allocations)
+			assert.Equal(t, tc.wantRetained, updated.Status.Retained)
+		})
+	}
+}
+
+func testPoolContainer() *akov2.AtlasNetworkContainer {
+	return &akov2.AtlasNetworkContainer{
+		ObjectMeta: metav1.ObjectMeta{Name: "pooled", Namespace: "ns"},
+		Spec: akov2.AtlasNetworkContainerSpec{
+			Provider: "AWS",
+			AtlasNetworkContainerConfig: akov2.AtlasNetworkContainerConfig{
+				ID:     testContainerID,
+				Region: "US_EAST_1",
+			},
+			CIDRPoolRef: &akov2.CIDRPoolReference{Name: "pool", PrefixLength: 21},
+		},
+	}
+}
+
+func testAtlasContainer(id, cidrBlock string) *networkcontainer.NetworkContainer {
+	return &networkcontainer.NetworkContainer{
+		NetworkContainerConfig: networkcontainer.NetworkContainerConfig{
+			Provider: "AWS",
+			AtlasNetworkContainerConfig: akov2.AtlasNetworkContainerConfig{
+				Region:    "US_EAST_1",
+				CIDRBlock: cidrBlock,
+			},
+		},
+		ID: id,
+	}
+}
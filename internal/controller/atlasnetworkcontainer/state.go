@@ -37,6 +37,18 @@ type reconcileRequest struct {
 	projectID        string
 	networkContainer *akov2.AtlasNetworkContainer
 	service          networkcontainer.NetworkContainerService
+	// cidrBlock is the block allocated from the CIDR pool of the container, if any
+	cidrBlock string
+}
+
+// config returns the desired configuration of the container, with the CIDR block allocated from its pool
+func (req *reconcileRequest) config() *networkcontainer.NetworkContainerConfig {
+	cfg := networkcontainer.NewNetworkContainerConfig(
+		req.networkContainer.Spec.Provider, &req.networkContainer.Spec.AtlasNetworkContainerConfig)
+	if req.cidrBlock != "" {
+		cfg.CIDRBlock = req.cidrBlock
+	}
+	return cfg
 }
 
 func (r *AtlasNetworkContainerReconciler) handleCustomResource(ctx context.Context, networkContainer *akov2.AtlasNetworkContainer) (ctrl.Result, error) {
@@ -53,14 +65,15 @@ func (r *AtlasNetworkContainerReconciler) handleCustomResource(ctx context.Conte
 		return r.Invalidate(typeName, isValid)
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(networkContainer) {
-		return r.Unsupport(workflowCtx, typeName)
-	}
-
 	connectionConfig, err := r.ResolveConnectionConfig(ctx, networkContainer)
 	if err != nil {
 		return r.release(workflowCtx, networkContainer, err)
 	}
+
+	if !r.AtlasProvider.IsResourceSupported(networkContainer, connectionConfig.Credentials) {
+		return r.Unsupport(workflowCtx, typeName)
+	}
+
 	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
 	if err != nil {
 		return r.terminate(workflowCtx, networkContainer, workflow.NetworkContainerNotConfigured, err)
@@ -77,6 +90,13 @@ func (r *AtlasNetworkContainerReconciler) handleCustomResource(ctx context.Conte
 }
 
 func (r *AtlasNetworkContainerReconciler) handle(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
+	if req.networkContainer.Spec.CIDRPoolRef != nil {
+		cidrBlock, err := r.poolCIDRBlock(workflowCtx.Context, req)
+		if err != nil {
+			return r.terminate(workflowCtx, req.networkContainer, workflow.NetworkContainerCIDRNotAllocated, err)
+		}
+		req.cidrBlock = cidrBlock
+	}
 	atlasContainer, err := discover(workflowCtx.Context, req)
 	if err != nil {
 		return r.terminate(workflowCtx, req.networkContainer, workflow.NetworkContainerNotConfigured, err)
@@ -91,7 +111,7 @@ func (r *AtlasNetworkContainerReconciler) handle(workflowCtx *workflow.Context,
 	case deleted && inAtlas:
 		return r.delete(workflowCtx, req, atlasContainer)
 	default: // deleted && !inAtlas:
-		return r.unmanage(workflowCtx, req.networkContainer)
+		return r.unmanage(workflowCtx, req.networkContainer, false)
 	}
 }
 
@@ -107,9 +127,7 @@ func discover(ctx context.Context, req *reconcileRequest) (*networkcontainer.Net
 		}
 		return container, nil
 	}
-	cfg := networkcontainer.NewNetworkContainerConfig(
-		req.networkContainer.Spec.Provider, &req.networkContainer.Spec.AtlasNetworkContainerConfig)
-	container, err := req.service.Find(ctx, req.projectID, cfg)
+	container, err := req.service.Find(ctx, req.projectID, req.config())
 	if err != nil && !errors.Is(err, networkcontainer.ErrNotFound) {
 		return nil, fmt.Errorf("failed to find container from project %s: %w", req.projectID, err)
 	}
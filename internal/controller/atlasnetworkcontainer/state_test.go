@@ -133,6 +133,11 @@ func TestHandleCustomResource(t *testing.T) {
 					Namespace: "default",
 				},
 				Spec: akov2.AtlasNetworkContainerSpec{
+					ProjectDualReference: akov2.ProjectDualReference{
+						ConnectionSecret: &api.LocalObjectReference{
+							Name: "my-secret",
+						},
+					},
 					Provider: "AWS",
 					AtlasNetworkContainerConfig: akov2.AtlasNetworkContainerConfig{
 						Region:    "US_EAST_1",
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
@@ -30,11 +31,7 @@ import (
 )
 
 func (r *AtlasNetworkContainerReconciler) create(workflowCtx *workflow.Context, req *reconcileRequest) (ctrl.Result, error) {
-	cfg := networkcontainer.NewNetworkContainerConfig(
-		req.networkContainer.Spec.Provider,
-		&req.networkContainer.Spec.AtlasNetworkContainerConfig,
-	)
-	createdContainer, err := req.service.Create(workflowCtx.Context, req.projectID, cfg)
+	createdContainer, err := req.service.Create(workflowCtx.Context, req.projectID, req.config())
 	if err != nil {
 		wrappedErr := fmt.Errorf("failed to create container: %w", err)
 		return r.terminate(workflowCtx, req.networkContainer, workflow.NetworkContainerNotConfigured, wrappedErr)
@@ -43,8 +40,7 @@ func (r *AtlasNetworkContainerReconciler) create(workflowCtx *workflow.Context,
 }
 
 func (r *AtlasNetworkContainerReconciler) sync(workflowCtx *workflow.Context, req *reconcileRequest, atlasContainer *networkcontainer.NetworkContainer) (ctrl.Result, error) {
-	desiredConfig := networkcontainer.NewNetworkContainerConfig(
-		req.networkContainer.Spec.Provider, &req.networkContainer.Spec.AtlasNetworkContainerConfig)
+	desiredConfig := req.config()
 	// only the CIDR block can be updated in a container
 	if desiredConfig.CIDRBlock != atlasContainer.NetworkContainerConfig.CIDRBlock {
 		return r.update(workflowCtx, req, atlasContainer.ID, desiredConfig)
@@ -63,14 +59,14 @@ func (r *AtlasNetworkContainerReconciler) update(workflowCtx *workflow.Context,
 
 func (r *AtlasNetworkContainerReconciler) delete(workflowCtx *workflow.Context, req *reconcileRequest, container *networkcontainer.NetworkContainer) (ctrl.Result, error) {
 	if customresource.IsResourcePolicyKeepOrDefault(req.networkContainer, r.ObjectDeletionProtection) {
-		return r.unmanage(workflowCtx, req.networkContainer)
+		return r.unmanage(workflowCtx, req.networkContainer, true)
 	}
 	err := req.service.Delete(workflowCtx.Context, req.projectID, container.ID)
 	if err != nil {
 		wrappedErr := fmt.Errorf("failed to delete container: %w", err)
 		return r.terminate(workflowCtx, req.networkContainer, workflow.NetworkContainerNotDeleted, wrappedErr)
 	}
-	return r.unmanage(workflowCtx, req.networkContainer)
+	return r.unmanage(workflowCtx, req.networkContainer, false)
 }
 
 func (r *AtlasNetworkContainerReconciler) ready(workflowCtx *workflow.Context, networkContainer *akov2.AtlasNetworkContainer, container *networkcontainer.NetworkContainer) (ctrl.Result, error) {
@@ -80,6 +76,9 @@ func (r *AtlasNetworkContainerReconciler) ready(workflowCtx *workflow.Context, n
 
 	workflowCtx.SetConditionTrueMsg(api.NetworkContainerReady, fmt.Sprintf("Network Container %s is ready", container.ID)).
 		SetConditionTrue(api.ReadyType).EnsureStatusOption(updateNetworkContainerStatusOption(container))
+	if networkContainer.Spec.CIDRPoolRef != nil {
+		workflowCtx.EnsureStatusOption(allocatedCIDRBlockStatusOption(container.CIDRBlock))
+	}
 
 	if networkContainer.Spec.ExternalProjectRef != nil {
 		return workflow.Requeue(r.independentSyncPeriod).ReconcileResult()
@@ -88,7 +87,19 @@ func (r *AtlasNetworkContainerReconciler) ready(workflowCtx *workflow.Context, n
 	return workflow.OK().ReconcileResult()
 }
 
-func (r *AtlasNetworkContainerReconciler) unmanage(workflowCtx *workflow.Context, networkContainer *akov2.AtlasNetworkContainer) (ctrl.Result, error) {
+// unmanage stops managing the container. The block allocated to it from its pool is released once the container
+// is gone from Atlas, and retained when it is kept in Atlas so that no other container is allocated an overlapping one.
+func (r *AtlasNetworkContainerReconciler) unmanage(workflowCtx *workflow.Context, networkContainer *akov2.AtlasNetworkContainer, keptInAtlas bool) (ctrl.Result, error) {
+	if networkContainer.Spec.CIDRPoolRef != nil && r.cidrAllocator != nil {
+		ref, owner := networkContainer.Spec.CIDRPoolRef, client.ObjectKeyFromObject(networkContainer)
+		if keptInAtlas {
+			if err := r.cidrAllocator.Retain(workflowCtx.Context, ref, owner); err != nil {
+				return r.terminate(workflowCtx, networkContainer, workflow.NetworkContainerNotDeleted, fmt.Errorf("failed to retain CIDR block: %w", err))
+			}
+		} else if err := r.cidrAllocator.Release(workflowCtx.Context, ref, owner); err != nil {
+			return r.terminate(workflowCtx, networkContainer, workflow.NetworkContainerNotDeleted, fmt.Errorf("failed to release CIDR block: %w", err))
+		}
+	}
 	if err := customresource.ManageFinalizer(workflowCtx.Context, r.Client, networkContainer, customresource.UnsetFinalizer); err != nil {
 		return r.terminate(workflowCtx, networkContainer, workflow.AtlasFinalizerNotRemoved, err)
 	}
@@ -119,6 +130,12 @@ func (r *AtlasNetworkContainerReconciler) terminate(
 	return result.ReconcileResult()
 }
 
+func allocatedCIDRBlockStatusOption(cidrBlock string) status.AtlasNetworkContainerStatusOption {
+	return func(containerStatus *status.AtlasNetworkContainerStatus) {
+		containerStatus.CIDRBlock = cidrBlock
+	}
+}
+
 func updateNetworkContainerStatusOption(container *networkcontainer.NetworkContainer) status.AtlasNetworkContainerStatusOption {
 	return func(containerStatus *status.AtlasNetworkContainerStatus) {
 		networkcontainer.ApplyNetworkContainerStatus(containerStatus, container)
@@ -41,8 +41,6 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 // AtlasNetworkPeeringReconciler reconciles a AtlasNetworkPeering object
@@ -63,13 +61,15 @@ func NewAtlasNetworkPeeringsReconciler(
 	logger *zap.Logger,
 	independentSyncPeriod time.Duration,
 	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
 ) *AtlasNetworkPeeringReconciler {
 	return &AtlasNetworkPeeringReconciler{
 		AtlasReconciler: reconciler.AtlasReconciler{
-			Client:          c.GetClient(),
-			Log:             logger.Named("controllers").Named("AtlasNetworkPeering").Sugar(),
-			GlobalSecretRef: globalSecretRef,
-			AtlasProvider:   atlasProvider,
+			Client:              c.GetClient(),
+			Log:                 logger.Named("controllers").Named("AtlasNetworkPeering").Sugar(),
+			GlobalSecretRef:     globalSecretRef,
+			CredentialProviders: credentialProviders,
+			AtlasProvider:       atlasProvider,
 		},
 		Scheme:                   c.GetScheme(),
 		EventRecorder:            c.GetEventRecorderFor("AtlasPrivateEndpoint"),
@@ -112,7 +112,7 @@ func (r *AtlasNetworkPeeringReconciler) For() (client.Object, builder.Predicates
 }
 
 // SetupWithManager sets up the controller with the Manager.
-func (r *AtlasNetworkPeeringReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+func (r *AtlasNetworkPeeringReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(r.For()).
 		Watches(
@@ -130,9 +130,7 @@ func (r *AtlasNetworkPeeringReconciler) SetupWithManager(mgr ctrl.Manager, skipN
 			handler.EnqueueRequestsFromMapFunc(r.networkPeeringForContainerByIDMapFunc()),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
 		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
+		WithOptions(options).
 		Complete(r)
 }
 
@@ -56,14 +56,15 @@ func (r *AtlasNetworkPeeringReconciler) handleCustomResource(ctx context.Context
 		return r.Invalidate(typeName, isValid)
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(networkPeering) {
-		return r.Unsupport(workflowCtx, typeName)
-	}
-
 	connectionConfig, err := r.ResolveConnectionConfig(ctx, networkPeering)
 	if err != nil {
 		return r.release(workflowCtx, networkPeering, err)
 	}
+
+	if !r.AtlasProvider.IsResourceSupported(networkPeering, connectionConfig.Credentials) {
+		return r.Unsupport(workflowCtx, typeName)
+	}
+
 	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
 	if err != nil {
 		return r.terminate(workflowCtx, networkPeering, workflow.NetworkPeeringNotConfigured, err)
@@ -120,6 +120,13 @@ func TestHandleCustomResource(t *testing.T) {
 					Name:      "network-peering",
 					Namespace: "default",
 				},
+				Spec: akov2.AtlasNetworkPeeringSpec{
+					ProjectDualReference: akov2.ProjectDualReference{
+						ConnectionSecret: &api.LocalObjectReference{
+							Name: "my-secret",
+						},
+					},
+				},
 			},
 			provider: &atlasmock.TestProvider{
 				IsSupportedFunc: func() bool {
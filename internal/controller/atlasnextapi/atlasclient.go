@@ -0,0 +1,140 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasnextapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312006/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/resource"
+)
+
+var ErrNotFound = errors.New("not found")
+
+// AtlasClient calls the Atlas API operations of generated resources
+type AtlasClient interface {
+	Do(ctx context.Context, op *resource.Operation, params map[string]string, body map[string]any) (map[string]any, error)
+}
+
+type httpAtlasClient struct {
+	httpClient *http.Client
+	serverURL  string
+	userAgent  string
+}
+
+// NewAtlasClient reuses the HTTP client, authentication and server of an Atlas SDK client
+func NewAtlasClient(sdkClient *admin.APIClient) (AtlasClient, error) {
+	cfg := sdkClient.GetConfig()
+	serverURL, err := cfg.ServerURL(0, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the Atlas server URL: %w", err)
+	}
+	return &httpAtlasClient{
+		httpClient: cfg.HTTPClient,
+		serverURL:  strings.TrimSuffix(serverURL, "/"),
+		userAgent:  cfg.UserAgent,
+	}, nil
+}
+
+func (c *httpAtlasClient) Do(ctx context.Context, op *resource.Operation, params map[string]string, body map[string]any) (map[string]any, error) {
+	path, err := op.URLPath(params)
+	if err != nil {
+		return nil, err
+	}
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s request: %w", op.ID, err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, op.Method, c.serverURL+path, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build %s request: %w", op.ID, err)
+	}
+	mediaType := op.MediaType
+	if mediaType == "" {
+		mediaType = "application/json"
+	}
+	req.Header.Set("Accept", mediaType)
+	if body != nil {
+		req.Header.Set("Content-Type", mediaType)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", op.ID, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s response: %w", op.ID, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%s: %w", op.ID, ErrNotFound)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("%s failed with status %d: %s", op.ID, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return map[string]any{}, nil
+	}
+	return decodeObject(data)
+}
+
+// decodeObject decodes a JSON object into values unstructured objects accept,
+// numbers become int64 when integral and float64 otherwise
+func decodeObject(data []byte) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	object := map[string]any{}
+	if err := decoder.Decode(&object); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return normalize(object).(map[string]any), nil
+}
+
+func normalize(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalize(item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = normalize(item)
+		}
+		return v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	default:
+		return v
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasnextapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/resource"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
+)
+
+func (h *AtlasNextAPIHandler) HandleInitial(ctx context.Context, obj *unstructured.Unstructured) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateInitial, state.StateCreated, obj)
+}
+
+func (h *AtlasNextAPIHandler) HandleCreated(ctx context.Context, obj *unstructured.Unstructured) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateCreated, state.StateUpdated, obj)
+}
+
+func (h *AtlasNextAPIHandler) HandleUpdated(ctx context.Context, obj *unstructured.Unstructured) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateUpdated, state.StateUpdated, obj)
+}
+
+func (h *AtlasNextAPIHandler) HandleDeletionRequested(ctx context.Context, obj *unstructured.Unstructured) (ctrlstate.Result, error) {
+	params, identified := h.statusParameters(obj)
+	if !identified || h.deletionProtection || h.definition.Delete == nil {
+		return h.unmanage(obj)
+	}
+	atlasClient, err := h.atlasClient(ctx, obj)
+	if err != nil {
+		return result.Error(state.StateDeletionRequested, err)
+	}
+	_, err = atlasClient.Do(ctx, h.definition.Delete, params, nil)
+	if errors.Is(err, ErrNotFound) {
+		return h.deleted(obj)
+	}
+	if err != nil {
+		return result.Error(state.StateDeletionRequested, err)
+	}
+	return result.NextState(state.StateDeleting, fmt.Sprintf("Deleting %s %s", h.definition.Kind, obj.GetName()))
+}
+
+func (h *AtlasNextAPIHandler) HandleDeleting(ctx context.Context, obj *unstructured.Unstructured) (ctrlstate.Result, error) {
+	params, _ := h.statusParameters(obj)
+	atlasClient, err := h.atlasClient(ctx, obj)
+	if err != nil {
+		return result.Error(state.StateDeleting, err)
+	}
+	_, err = atlasClient.Do(ctx, h.definition.Get, params, nil)
+	if errors.Is(err, ErrNotFound) {
+		return h.deleted(obj)
+	}
+	if err != nil {
+		return result.Error(state.StateDeleting, err)
+	}
+	return result.NextState(state.StateDeleting, fmt.Sprintf("Deleting %s %s", h.definition.Kind, obj.GetName()))
+}
+
+func (h *AtlasNextAPIHandler) upsert(ctx context.Context, currentState, nextState state.ResourceState,
+	obj *unstructured.Unstructured) (ctrlstate.Result, error) {
+	atlasClient, err := h.atlasClient(ctx, obj)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+	specParams, err := h.specParameters(obj)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+	entry, _, err := unstructured.NestedMap(obj.Object, "spec", resource.FieldEntry)
+	if err != nil {
+		return result.Error(currentState, fmt.Errorf("invalid spec.%s: %w", resource.FieldEntry, err))
+	}
+
+	// a resource not recorded in the status yet may still exist in Atlas, when a previous
+	// creation succeeded without its status being recorded, look it up before creating it
+	params, identified := h.statusParameters(obj)
+	if !identified {
+		params, identified = h.lookupParameters(specParams, entry)
+	}
+	var current map[string]any
+	if identified {
+		current, err = atlasClient.Do(ctx, h.definition.Get, params, nil)
+		if errors.Is(err, ErrNotFound) {
+			identified = false
+		} else if err != nil {
+			return result.Error(currentState, err)
+		}
+	}
+
+	msg := fmt.Sprintf("Synced %s %s", h.definition.Kind, obj.GetName())
+	switch {
+	case !identified:
+		current, err = atlasClient.Do(ctx, h.definition.Create, specParams, entry)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		params, err = h.createdParameters(specParams, current)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		nextState = state.StateCreated
+		msg = fmt.Sprintf("Created %s %s", h.definition.Kind, obj.GetName())
+	case !isSubset(entry, current):
+		if h.definition.Update == nil {
+			return result.Error(currentState, fmt.Errorf("%s cannot be updated in Atlas, recreate it to apply the changes", h.definition.Kind))
+		}
+		updated, err := atlasClient.Do(ctx, h.definition.Update, params, entry)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		if len(updated) > 0 {
+			current = updated
+		}
+		nextState = state.StateUpdated
+		msg = fmt.Sprintf("Updated %s %s", h.definition.Kind, obj.GetName())
+	}
+
+	if err := h.recordStatus(ctx, obj, params, current); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to record status: %w", err))
+	}
+	return result.NextState(nextState, msg)
+}
+
+func (h *AtlasNextAPIHandler) atlasClient(ctx context.Context, obj *unstructured.Unstructured) (AtlasClient, error) {
+	var secretRef *client.ObjectKey
+	if name, _, _ := unstructured.NestedString(obj.Object, "spec", resource.FieldConnectionSecretRef, "name"); name != "" {
+		secretRef = &client.ObjectKey{Namespace: obj.GetNamespace(), Name: name}
+	}
+	connectionConfig, err := reconciler.GetConnectionConfig(ctx, h.Client, secretRef, &h.GlobalSecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve Atlas credentials: %w", err)
+	}
+	clientSet, err := h.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, h.Log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Atlas client: %w", err)
+	}
+	return NewAtlasClient(clientSet.SdkClient20250312006)
+}
+
+func (h *AtlasNextAPIHandler) specParameters(obj *unstructured.Unstructured) (map[string]string, error) {
+	params, _, err := unstructured.NestedStringMap(obj.Object, "spec", resource.FieldParameters)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec.%s: %w", resource.FieldParameters, err)
+	}
+	return params, nil
+}
+
+// statusParameters returns the path parameters of the resource created in Atlas,
+// and whether the resource was created at all
+func (h *AtlasNextAPIHandler) statusParameters(obj *unstructured.Unstructured) (map[string]string, bool) {
+	params, found, err := unstructured.NestedStringMap(obj.Object, "status", resource.FieldParameters)
+	if err != nil || !found {
+		return nil, false
+	}
+	if h.definition.IDParameter == "" {
+		return params, true
+	}
+	return params, params[h.definition.IDParameter] != ""
+}
+
+// lookupParameters returns the path parameters of the resource the spec would create, when
+// its identifier is part of the entry rather than assigned by Atlas
+func (h *AtlasNextAPIHandler) lookupParameters(specParams map[string]string, entry map[string]any) (map[string]string, bool) {
+	params := maps.Clone(specParams)
+	if params == nil {
+		params = map[string]string{}
+	}
+	if h.definition.IDParameter != "" {
+		id, ok := entry[h.definition.IDField].(string)
+		if !ok || id == "" {
+			return nil, false
+		}
+		params[h.definition.IDParameter] = id
+	}
+	if _, err := h.definition.Get.URLPath(params); err != nil {
+		return nil, false
+	}
+	return params, true
+}
+
+func (h *AtlasNextAPIHandler) createdParameters(specParams map[string]string, created map[string]any) (map[string]string, error) {
+	params := maps.Clone(specParams)
+	if params == nil {
+		params = map[string]string{}
+	}
+	if h.definition.IDParameter == "" {
+		return params, nil
+	}
+	id, ok := created[h.definition.IDField]
+	if !ok || id == nil || fmt.Sprint(id) == "" {
+		return nil, fmt.Errorf("the %s response has no %s field", h.definition.Create.ID, h.definition.IDField)
+	}
+	params[h.definition.IDParameter] = fmt.Sprint(id)
+	return params, nil
+}
+
+func (h *AtlasNextAPIHandler) recordStatus(ctx context.Context, obj *unstructured.Unstructured, params map[string]string, current map[string]any) error {
+	original := obj.DeepCopy()
+	if err := unstructured.SetNestedStringMap(obj.Object, params, "status", resource.FieldParameters); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedMap(obj.Object, current, "status", resource.FieldEntry); err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(original.Object, obj.Object) {
+		return nil
+	}
+	return h.Client.Status().Patch(ctx, obj, client.MergeFrom(original))
+}
+
+func (h *AtlasNextAPIHandler) unmanage(obj *unstructured.Unstructured) (ctrlstate.Result, error) {
+	return result.NextState(
+		state.StateDeleted,
+		fmt.Sprintf("Released %s %s, it is kept in Atlas", h.definition.Kind, obj.GetName()),
+	)
+}
+
+func (h *AtlasNextAPIHandler) deleted(obj *unstructured.Unstructured) (ctrlstate.Result, error) {
+	return result.NextState(state.StateDeleted, fmt.Sprintf("Deleted %s %s", h.definition.Kind, obj.GetName()))
+}
+
+// isSubset reports whether Atlas holds every desired value, fields only set by Atlas are ignored
+func isSubset(desired, current any) bool {
+	switch d := desired.(type) {
+	case map[string]any:
+		c, ok := current.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, value := range d {
+			if !isSubset(value, c[key]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		c, ok := current.([]any)
+		if !ok || len(c) != len(d) {
+			return false
+		}
+		for i := range d {
+			if !isSubset(d[i], c[i]) {
+				return false
+			}
+		}
+		return true
+	case int64:
+		return equalNumbers(float64(d), current)
+	case float64:
+		return equalNumbers(d, current)
+	default:
+		return reflect.DeepEqual(desired, current)
+	}
+}
+
+func equalNumbers(desired float64, current any) bool {
+	switch c := current.(type) {
+	case int64:
+		return desired == float64(c)
+	case float64:
+		return desired == c
+	default:
+		return false
+	}
+}
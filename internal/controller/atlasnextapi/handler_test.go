@@ -0,0 +1,353 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasnextapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312006/admin"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/resource"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
+)
+
+const testMediaType = "application/vnd.atlas.2023-01-01+json"
+
+var customRoleDefinition = &resource.Definition{
+	Kind:    "AtlasCustomRole",
+	Version: "v1",
+	Create:  &resource.Operation{ID: "createRole", Method: http.MethodPost, Path: "/groups/{groupId}/roles", MediaType: testMediaType},
+	Get:     &resource.Operation{ID: "getRole", Method: http.MethodGet, Path: "/groups/{groupId}/roles/{roleName}", MediaType: testMediaType},
+	Update:  &resource.Operation{ID: "updateRole", Method: http.MethodPatch, Path: "/groups/{groupId}/roles/{roleName}", MediaType: testMediaType},
+	Delete:  &resource.Operation{ID: "deleteRole", Method: http.MethodDelete, Path: "/groups/{groupId}/roles/{roleName}", MediaType: testMediaType},
+
+	IDParameter: "roleName",
+	IDField:     "roleName",
+}
+
+// fakeAtlas serves custom roles of a single project from memory
+type fakeAtlas struct {
+	mu       sync.Mutex
+	roles    map[string]map[string]any
+	requests []string
+}
+
+func (f *fakeAtlas) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+	if r.Header.Get("Accept") != testMediaType {
+		http.Error(w, "unexpected media type "+r.Header.Get("Accept"), http.StatusNotAcceptable)
+		return
+	}
+	name, _ := strings.CutPrefix(r.URL.Path, "/groups/project-id/roles")
+	name = strings.TrimPrefix(name, "/")
+	body := map[string]any{}
+	if r.Body != nil && r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	switch {
+	case r.Method == http.MethodPost && name == "":
+		body["createdBy"] = "atlas"
+		f.roles[body["roleName"].(string)] = body
+		writeJSON(w, body)
+	case f.roles[name] == nil:
+		http.Error(w, `{"error":404}`, http.StatusNotFound)
+	case r.Method == http.MethodGet:
+		writeJSON(w, f.roles[name])
+	case r.Method == http.MethodPatch:
+		for key, value := range body {
+			f.roles[name][key] = value
+		}
+		writeJSON(w, f.roles[name])
+	case r.Method == http.MethodDelete:
+		delete(f.roles, name)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", testMediaType)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newRole(actions ...any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "reader", "namespace": "default"},
+		"spec": map[string]any{
+			"parameters": map[string]any{"groupId": "project-id"},
+			"entry":      map[string]any{"roleName": "reader", "actions": actions},
+		},
+	}}
+	obj.SetGroupVersionKind(customRoleDefinition.GroupVersionKind())
+	return obj
+}
+
+func newTestHandler(t *testing.T, definition *resource.Definition, atlasServer *fakeAtlas, objects ...client.Object) *AtlasNextAPIHandler {
+	t.Helper()
+	server := httptest.NewServer(atlasServer)
+	t.Cleanup(server.Close)
+
+	gvk := definition.GroupVersionKind()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	scheme.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(gvk.GroupVersion().WithKind(gvk.Kind+"List"), &unstructured.UnstructuredList{})
+
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "atlas-credentials", Namespace: "default"},
+		Data: map[string][]byte{
+			"orgId":         []byte("org-id"),
+			"publicApiKey":  []byte("public"),
+			"privateApiKey": []byte("private"),
+		},
+	}
+	statusObj := &unstructured.Unstructured{}
+	statusObj.SetGroupVersionKind(gvk)
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(objects, credentials)...).
+		WithStatusSubresource(statusObj).
+		Build()
+
+	return &AtlasNextAPIHandler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:          k8sClient,
+			Log:             zaptest.NewLogger(t).Sugar(),
+			GlobalSecretRef: client.ObjectKeyFromObject(credentials),
+			AtlasProvider: &atlasmock.TestProvider{
+				SdkClientSetFunc: func(_ context.Context, _ *atlas.Credentials, _ *zap.SugaredLogger) (*atlas.ClientSet, error) {
+					sdkClient, err := admin.NewClient(admin.UseBaseURL(server.URL))
+					require.NoError(t, err)
+					return &atlas.ClientSet{SdkClient20250312006: sdkClient}, nil
+				},
+			},
+		},
+		definition: definition,
+	}
+}
+
+func TestHandleLifecycle(t *testing.T) {
+	ctx := context.Background()
+	atlasServer := &fakeAtlas{roles: map[string]map[string]any{}}
+	role := newRole("FIND")
+	h := newTestHandler(t, customRoleDefinition, atlasServer, role)
+	get := func() *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(customRoleDefinition.GroupVersionKind())
+		require.NoError(t, h.Client.Get(ctx, client.ObjectKeyFromObject(role), obj))
+		return obj
+	}
+
+	res, err := h.HandleInitial(ctx, get())
+	require.NoError(t, err)
+	assert.Equal(t, ctrlstate.Result{NextState: state.StateCreated, StateMsg: "Created AtlasCustomRole reader."}, res)
+	obj := get()
+	params, _, _ := unstructured.NestedStringMap(obj.Object, "status", "parameters")
+	assert.Equal(t, map[string]string{"groupId": "project-id", "roleName": "reader"}, params)
+	createdBy, _, _ := unstructured.NestedString(obj.Object, "status", "entry", "createdBy")
+	assert.Equal(t, "atlas", createdBy)
+
+	res, err = h.HandleCreated(ctx, obj)
+	require.NoError(t, err)
+	assert.Equal(t, ctrlstate.Result{NextState: state.StateUpdated, StateMsg: "Synced AtlasCustomRole reader."}, res)
+
+	obj = get()
+	require.NoError(t, unstructured.SetNestedSlice(obj.Object, []any{"FIND", "INSERT"}, "spec", "entry", "actions"))
+	res, err = h.HandleUpdated(ctx, obj)
+	require.NoError(t, err)
+	assert.Equal(t, ctrlstate.Result{NextState: state.StateUpdated, StateMsg: "Updated AtlasCustomRole reader."}, res)
+	assert.Equal(t, []any{"FIND", "INSERT"}, atlasServer.roles["reader"]["actions"])
+
+	res, err = h.HandleDeletionRequested(ctx, get())
+	require.NoError(t, err)
+	assert.Equal(t, state.StateDeleting, res.NextState)
+	res, err = h.HandleDeleting(ctx, get())
+	require.NoError(t, err)
+	assert.Equal(t, ctrlstate.Result{NextState: state.StateDeleted, StateMsg: "Deleted AtlasCustomRole reader."}, res)
+
+	assert.Equal(t, []string{
+		"GET /groups/project-id/roles/reader",
+		"POST /groups/project-id/roles",
+		"GET /groups/project-id/roles/reader",
+		"GET /groups/project-id/roles/reader",
+		"PATCH /groups/project-id/roles/reader",
+		"DELETE /groups/project-id/roles/reader",
+		"GET /groups/project-id/roles/reader",
+	}, atlasServer.requests)
+}
+
+func TestHandleInitialDoesNotCreateTwice(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		status map[string]string
+	}{
+		{
+			name:   "resource recorded in the status",
+			status: map[string]string{"groupId": "project-id", "roleName": "reader"},
+		},
+		{
+			name: "resource identified by its entry",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			role := newRole("FIND", "INSERT")
+			if tc.status != nil {
+				require.NoError(t, unstructured.SetNestedStringMap(role.Object, tc.status, "status", "parameters"))
+			}
+			atlasServer := &fakeAtlas{roles: map[string]map[string]any{
+				"reader": {"roleName": "reader", "actions": []any{"FIND"}},
+			}}
+			h := newTestHandler(t, customRoleDefinition, atlasServer, role)
+
+			res, err := h.HandleInitial(ctx, role)
+			require.NoError(t, err)
+			assert.Equal(t, ctrlstate.Result{NextState: state.StateUpdated, StateMsg: "Updated AtlasCustomRole reader."}, res)
+			assert.Equal(t, []string{
+				"GET /groups/project-id/roles/reader",
+				"PATCH /groups/project-id/roles/reader",
+			}, atlasServer.requests)
+			params, _, _ := unstructured.NestedStringMap(role.Object, "status", "parameters")
+			assert.Equal(t, map[string]string{"groupId": "project-id", "roleName": "reader"}, params)
+		})
+	}
+}
+
+func TestHandleRecreatesMissingResource(t *testing.T) {
+	ctx := context.Background()
+	role := newRole("FIND")
+	require.NoError(t, unstructured.SetNestedStringMap(role.Object,
+		map[string]string{"groupId": "project-id", "roleName": "reader"}, "status", "parameters"))
+	atlasServer := &fakeAtlas{roles: map[string]map[string]any{}}
+	h := newTestHandler(t, customRoleDefinition, atlasServer, role)
+
+	res, err := h.HandleUpdated(ctx, role)
+	require.NoError(t, err)
+	assert.Equal(t, state.StateCreated, res.NextState)
+	assert.Contains(t, atlasServer.roles, "reader")
+}
+
+func TestHandleUpdateUnsupported(t *testing.T) {
+	ctx := context.Background()
+	definition := *customRoleDefinition
+	definition.Update = nil
+	role := newRole("INSERT")
+	require.NoError(t, unstructured.SetNestedStringMap(role.Object,
+		map[string]string{"groupId": "project-id", "roleName": "reader"}, "status", "parameters"))
+	atlasServer := &fakeAtlas{roles: map[string]map[string]any{
+		"reader": {"roleName": "reader", "actions": []any{"FIND"}},
+	}}
+	h := newTestHandler(t, &definition, atlasServer, role)
+
+	res, err := h.HandleCreated(ctx, role)
+	assert.EqualError(t, err, "AtlasCustomRole cannot be updated in Atlas, recreate it to apply the changes")
+	assert.Equal(t, state.StateCreated, res.NextState)
+}
+
+func TestHandleDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	role := newRole("FIND")
+	require.NoError(t, unstructured.SetNestedStringMap(role.Object,
+		map[string]string{"groupId": "project-id", "roleName": "reader"}, "status", "parameters"))
+	atlasServer := &fakeAtlas{roles: map[string]map[string]any{"reader": {"roleName": "reader"}}}
+	h := newTestHandler(t, customRoleDefinition, atlasServer, role)
+	h.deletionProtection = true
+
+	res, err := h.HandleDeletionRequested(ctx, role)
+	require.NoError(t, err)
+	assert.Equal(t, ctrlstate.Result{NextState: state.StateDeleted, StateMsg: "Released AtlasCustomRole reader, it is kept in Atlas."}, res)
+	assert.Contains(t, atlasServer.roles, "reader")
+	assert.Empty(t, atlasServer.requests)
+}
+
+func TestDiscover(t *testing.T) {
+	annotation, err := customRoleDefinition.Encode()
+	require.NoError(t, err)
+	scheme := runtime.NewScheme()
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "atlascustomroles.atlas.nextapi.mongodb.com",
+				Annotations: map[string]string{resource.DefinitionAnnotation: annotation},
+			},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{Group: "atlas.nextapi.mongodb.com"},
+		},
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "atlasprojects.atlas.mongodb.com"},
+			Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: "atlas.mongodb.com"},
+		},
+	).Build()
+
+	definitions, err := Discover(context.Background(), k8sClient)
+	require.NoError(t, err)
+	assert.Equal(t, []*resource.Definition{customRoleDefinition}, definitions)
+}
+
+func TestIsSubset(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		desired any
+		current any
+		want    bool
+	}{
+		{name: "fields set by Atlas are ignored", desired: map[string]any{"a": "x"}, current: map[string]any{"a": "x", "b": "y"}, want: true},
+		{name: "changed field", desired: map[string]any{"a": "x"}, current: map[string]any{"a": "z"}},
+		{name: "missing field", desired: map[string]any{"a": "x"}, current: map[string]any{}},
+		{name: "numbers of different types", desired: map[string]any{"n": int64(3)}, current: map[string]any{"n": float64(3)}, want: true},
+		{name: "lists compare in order", desired: []any{"a", "b"}, current: []any{"b", "a"}},
+		{name: "lists of objects", desired: []any{map[string]any{"a": "x"}}, current: []any{map[string]any{"a": "x", "id": "1"}}, want: true},
+		{name: "nil desired entry", desired: map[string]any(nil), current: map[string]any{"a": "x"}, want: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isSubset(tc.desired, tc.current))
+		})
+	}
+}
+
+func TestDecodeObject(t *testing.T) {
+	object, err := decodeObject([]byte(`{"count": 3, "ratio": 0.5, "items": [{"size": 10}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"count": int64(3),
+		"ratio": 0.5,
+		"items": []any{map[string]any{"size": int64(10)}},
+	}, object)
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasnextapi
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlrtbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/resource"
+	nextapiv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/v1"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	mckpredicate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/predicate"
+)
+
+// +kubebuilder:rbac:groups=atlas.nextapi.mongodb.com,resources=*,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.nextapi.mongodb.com,resources=*/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.nextapi.mongodb.com,resources=*/finalizers,verbs=update
+// +kubebuilder:rbac:groups=atlas.nextapi.mongodb.com,namespace=default,resources=*,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.nextapi.mongodb.com,namespace=default,resources=*/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.nextapi.mongodb.com,namespace=default,resources=*/finalizers,verbs=update
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list
+
+var crdListGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinitionList"}
+
+// AtlasNextAPIHandler reconciles the instances of one generated CRD through
+// the Atlas API operations recorded in its Definition
+type AtlasNextAPIHandler struct {
+	ctrlstate.StateHandler[unstructured.Unstructured]
+	reconciler.AtlasReconciler
+	definition         *resource.Definition
+	deletionProtection bool
+}
+
+func NewAtlasNextAPIHandler(
+	c cluster.Cluster,
+	definition *resource.Definition,
+	atlasProvider atlas.Provider,
+	deletionProtection bool,
+	logger *zap.Logger,
+	globalSecretRef client.ObjectKey,
+) *AtlasNextAPIHandler {
+	return &AtlasNextAPIHandler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:          c.GetClient(),
+			Log:             logger.Named("controllers").Named(definition.Kind).Sugar(),
+			GlobalSecretRef: globalSecretRef,
+			AtlasProvider:   atlasProvider,
+		},
+		definition:         definition,
+		deletionProtection: deletionProtection,
+	}
+}
+
+// NewAtlasNextAPIReconcilers returns a reconciler for each generated CRD installed in the cluster
+func NewAtlasNextAPIReconcilers(
+	ctx context.Context,
+	c cluster.Cluster,
+	atlasProvider atlas.Provider,
+	deletionProtection bool,
+	logger *zap.Logger,
+	globalSecretRef client.ObjectKey,
+) ([]*ctrlstate.Reconciler[unstructured.Unstructured], error) {
+	definitions, err := Discover(ctx, c.GetAPIReader())
+	if err != nil {
+		return nil, err
+	}
+	reconcilers := make([]*ctrlstate.Reconciler[unstructured.Unstructured], 0, len(definitions))
+	for _, definition := range definitions {
+		handler := NewAtlasNextAPIHandler(c, definition, atlasProvider, deletionProtection, logger, globalSecretRef)
+		reconcilers = append(reconcilers, ctrlstate.NewUnstructuredStateReconciler(handler, definition.GroupVersionKind()))
+	}
+	return reconcilers, nil
+}
+
+// Discover reads the Definitions of the generated CRDs installed in the cluster
+func Discover(ctx context.Context, reader client.Reader) ([]*resource.Definition, error) {
+	crds := &unstructured.UnstructuredList{}
+	crds.SetGroupVersionKind(crdListGVK)
+	if err := reader.List(ctx, crds); err != nil {
+		return nil, fmt.Errorf("failed to list custom resource definitions: %w", err)
+	}
+	var definitions []*resource.Definition
+	for _, crd := range crds.Items {
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		annotation, ok := crd.GetAnnotations()[resource.DefinitionAnnotation]
+		if group != nextapiv1.GroupVersion.Group || !ok {
+			continue
+		}
+		definition, err := resource.Decode(annotation)
+		if err != nil {
+			return nil, fmt.Errorf("custom resource definition %s: %w", crd.GetName(), err)
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions, nil
+}
+
+// For prepares the controller for its target Custom Resource; the generated kind
+func (h *AtlasNextAPIHandler) For() (client.Object, builder.Predicates) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(h.definition.GroupVersionKind())
+	return obj, ctrlrtbuilder.WithPredicates(
+		predicate.GenerationChangedPredicate{},
+		mckpredicate.IgnoreDeletedPredicate[client.Object](),
+	)
+}
+
+func (h *AtlasNextAPIHandler) SetupWithManager(mgr ctrl.Manager, rec reconcile.Reconciler, defaultOptions controller.Options) error {
+	h.Client = mgr.GetClient()
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(h.definition.Kind).
+		For(h.For()).
+		WithOptions(defaultOptions).Complete(rec)
+}
@@ -15,6 +15,9 @@
 package atlasorgsettings
 
 import (
+	"net/http"
+	"time"
+
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	controllerruntime "sigs.k8s.io/controller-runtime"
@@ -32,7 +35,9 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/teammembers"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/atlasorgsettings"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/orguser"
 	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
 	mckpredicate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/predicate"
 )
@@ -43,13 +48,24 @@ import (
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasorgsettings,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasorgsettings/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasorgsettings/finalizers,verbs=update
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasorgusers;atlasteams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasorgusers;atlasteams,verbs=get;list;watch
+
+// teamMembersFetchTimeout bounds the requests fetching the members of teams from HTTP sources
+const teamMembersFetchTimeout = 30 * time.Second
 
 type serviceBuilderFunc func(*atlas.ClientSet) atlasorgsettings.AtlasOrgSettingsService
 
+type usersServiceBuilderFunc func(*atlas.ClientSet) orguser.OrgUserService
+
 type AtlasOrgSettingsHandler struct {
 	ctrlstate.StateHandler[akov2.AtlasOrgSettings]
 	reconciler.AtlasReconciler
-	serviceBuilder serviceBuilderFunc
+	serviceBuilder      serviceBuilderFunc
+	usersServiceBuilder usersServiceBuilderFunc
+	apiReader           client.Reader
+	teamMembers         *teammembers.Resolver
+	clusterWide         bool
 }
 
 func NewAtlasOrgSettingsReconciler(
@@ -57,6 +73,7 @@ func NewAtlasOrgSettingsReconciler(
 	atlasProvider atlas.Provider,
 	logger *zap.Logger,
 	globalSecretRef client.ObjectKey,
+	clusterWide bool,
 	reapplySupport bool,
 ) *ctrlstate.Reconciler[akov2.AtlasOrgSettings] {
 	orgSettingsHandler := &AtlasOrgSettingsHandler{
@@ -69,6 +86,10 @@ func NewAtlasOrgSettingsReconciler(
 		serviceBuilder: func(clientSet *atlas.ClientSet) atlasorgsettings.AtlasOrgSettingsService {
 			return atlasorgsettings.NewAtlasOrgSettingsService(clientSet.SdkClient20250312006.OrganizationsApi)
 		},
+		usersServiceBuilder: orguser.NewOrgUserServiceFromClientSet,
+		apiReader:           c.GetAPIReader(),
+		teamMembers:         teammembers.NewResolver(c.GetAPIReader(), &http.Client{Timeout: teamMembersFetchTimeout}),
+		clusterWide:         clusterWide,
 	}
 	return ctrlstate.NewStateReconciler(
 		orgSettingsHandler,
@@ -90,6 +111,8 @@ func (h *AtlasOrgSettingsHandler) For() (client.Object, builder.Predicates) {
 
 func (h *AtlasOrgSettingsHandler) SetupWithManager(mgr ctrl.Manager, rec reconcile.Reconciler, defaultOptions controller.Options) error {
 	h.Client = mgr.GetClient()
+	h.apiReader = mgr.GetAPIReader()
+	h.teamMembers = teammembers.NewResolver(mgr.GetAPIReader(), &http.Client{Timeout: teamMembersFetchTimeout})
 	return controllerruntime.NewControllerManagedBy(mgr).
 		Named("AtlasOrgSettings").
 		For(h.For()).
@@ -68,6 +68,7 @@ func TestNewAtlasOrgSettingsReconciler(t *testing.T) {
 		atlasProvider,
 		logger,
 		client.ObjectKey{Name: globalSecretRef.Name, Namespace: globalSecretRef.Namespace},
+		true,
 		false,
 	)
 
@@ -258,6 +259,10 @@ func (f *fakeManager) GetClient() client.Client {
 	return f.client
 }
 
+func (f *fakeManager) GetAPIReader() client.Reader {
+	return f.client
+}
+
 type fakeCluster struct {
 	cluster.Cluster
 }
@@ -266,6 +271,10 @@ func (m *fakeCluster) GetClient() client.Client {
 	return &fakeClient{}
 }
 
+func (m *fakeCluster) GetAPIReader() client.Reader {
+	return &fakeClient{}
+}
+
 type fakeClient struct {
 	client.Client
 }
@@ -16,21 +16,28 @@ package atlasorgsettings
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/atlasorgsettings"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/orguser"
 	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
 )
 
 type reconcileRequest struct {
-	svc atlasorgsettings.AtlasOrgSettingsService
-	aos *akov2.AtlasOrgSettings
+	svc   atlasorgsettings.AtlasOrgSettingsService
+	users orguser.OrgUserService
+	aos   *akov2.AtlasOrgSettings
 }
 
 func (h *AtlasOrgSettingsHandler) newReconcileRequest(ctx context.Context, aos *akov2.AtlasOrgSettings) (*reconcileRequest, error) {
@@ -51,10 +58,14 @@ func (h *AtlasOrgSettingsHandler) newReconcileRequest(ctx context.Context, aos *
 	if err != nil {
 		return nil, err
 	}
-	return &reconcileRequest{
+	req := &reconcileRequest{
 		svc: h.serviceBuilder(atlasSdk),
 		aos: aos,
-	}, nil
+	}
+	if pointer.GetOrDefault(aos.Spec.PruneUndeclaredUsers, false) {
+		req.users = h.usersServiceBuilder(atlasSdk)
+	}
+	return req, nil
 }
 
 func (h *AtlasOrgSettingsHandler) upsert(ctx context.Context, currentState, nextState state.ResourceState,
@@ -71,6 +82,7 @@ func (h *AtlasOrgSettingsHandler) upsert(ctx context.Context, currentState, next
 
 	desiredSettings := atlasorgsettings.NewFromAKO(aos.Spec)
 
+	msg := "Ready"
 	if !desiredSettings.Equal(currentAtlasSettings) {
 		resp, apiErr := reconcileCtx.svc.Update(ctx, aos.Spec.OrgID, desiredSettings)
 		if apiErr != nil {
@@ -79,11 +91,104 @@ func (h *AtlasOrgSettingsHandler) upsert(ctx context.Context, currentState, next
 		if resp == nil {
 			return result.Error(currentState, fmt.Errorf("atlas returned OrgSettings which is nil after update"))
 		}
+		msg = "Updated"
+	}
+
+	if reconcileCtx.users != nil {
+		pruned, err := h.pruneUndeclaredUsers(ctx, reconcileCtx)
+		if err != nil {
+			return result.Error(currentState, fmt.Errorf("failed to prune undeclared users: %w", err))
+		}
+		if pruned > 0 {
+			msg = fmt.Sprintf("%s, pruned %d undeclared users", msg, pruned)
+		}
+	}
+
+	return result.NextState(nextState, msg)
+}
 
-		return result.NextState(nextState, "Updated")
+// pruneUndeclaredUsers removes the members and pending invitations of the organization
+// which are declared neither by an AtlasOrgUser nor by an AtlasTeam of the organization.
+// Declarations are read across all namespaces, so pruning requires a cluster wide operator.
+func (h *AtlasOrgSettingsHandler) pruneUndeclaredUsers(ctx context.Context, req *reconcileRequest) (int, error) {
+	if !h.clusterWide {
+		return 0, errors.New("pruning undeclared users requires the operator to watch all namespaces")
 	}
 
-	return result.NextState(nextState, "Ready")
+	users, err := req.users.List(ctx, req.aos.Spec.OrgID)
+	if err != nil {
+		return 0, err
+	}
+
+	declared, err := h.declaredUsernames(ctx, req.aos.Spec.OrgID, orgTeamIDs(users))
+	if err != nil {
+		return 0, err
+	}
+
+	pruned := 0
+	for _, user := range users {
+		if declared[strings.ToLower(user.Username)] || slices.Contains(user.OrgRoles, orguser.RoleOrgOwner) {
+			continue
+		}
+		if err := req.users.Remove(ctx, req.aos.Spec.OrgID, user.ID); err != nil && !errors.Is(err, orguser.ErrNotFound) {
+			return pruned, err
+		}
+		h.Log.Infof("removed undeclared user %q from organization %s", user.Username, req.aos.Spec.OrgID)
+		pruned++
+	}
+	return pruned, nil
+}
+
+// orgTeamIDs returns the IDs of the teams of the organization having members
+func orgTeamIDs(users []orguser.OrgUser) map[string]bool {
+	teamIDs := map[string]bool{}
+	for _, user := range users {
+		for _, teamID := range user.TeamIDs {
+			teamIDs[teamID] = true
+		}
+	}
+	return teamIDs
+}
+
+// declaredUsernames reads the declarations from the API server rather than from the cache,
+// which may not hold the objects of every namespace. Teams not created in Atlas yet cannot be
+// told apart and count as declarations, other teams only do when they belong to the organization.
+// The members of teams include those of their members source, when a source cannot be read
+// nothing is declared and the prune is skipped rather than removing its members.
+func (h *AtlasOrgSettingsHandler) declaredUsernames(ctx context.Context, orgID string, orgTeamIDs map[string]bool) (map[string]bool, error) {
+	declared := map[string]bool{}
+
+	orgUsers := &akov2.AtlasOrgUserList{}
+	if err := h.apiReader.List(ctx, orgUsers); err != nil {
+		return nil, fmt.Errorf("failed to list AtlasOrgUsers: %w", err)
+	}
+	for _, orgUser := range orgUsers.Items {
+		if orgUser.Spec.OrgID == orgID {
+			declared[strings.ToLower(orgUser.Spec.Username)] = true
+		}
+	}
+
+	teams := &akov2.AtlasTeamList{}
+	if err := h.apiReader.List(ctx, teams); err != nil {
+		return nil, fmt.Errorf("failed to list AtlasTeams: %w", err)
+	}
+	uids := make(map[types.UID]bool, len(teams.Items))
+	for i := range teams.Items {
+		team := &teams.Items[i]
+		uids[team.UID] = true
+		if team.Status.ID != "" && !orgTeamIDs[team.Status.ID] {
+			continue
+		}
+		members, err := h.teamMembers.Resolve(ctx, team)
+		if err != nil {
+			return nil, fmt.Errorf("skipped as the members of AtlasTeam %s/%s cannot be resolved: %w", team.Namespace, team.Name, err)
+		}
+		for _, username := range members.Usernames {
+			declared[strings.ToLower(username)] = true
+		}
+	}
+	h.teamMembers.Retain(uids)
+	return declared, nil
 }
 
 func (h *AtlasOrgSettingsHandler) unmanage(orgID string) (ctrlstate.Result, error) {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -39,7 +40,9 @@ import (
 	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
 	mocks "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/teammembers"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/atlasorgsettings"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/orguser"
 	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
 )
@@ -709,3 +712,179 @@ func TestEqualMethodBehaviorInUpsert(t *testing.T) {
 		}, got)
 	})
 }
+
+func TestUpsertPruneUndeclaredUsers(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	ctx := context.Background()
+
+	pruning := sampleAtlasOrgSettings.DeepCopy()
+	pruning.Spec.PruneUndeclaredUsers = pointer.MakePtr(true)
+	declaredObjects := []client.Object{
+		&fakeAtlasSecret,
+		&akov2.AtlasOrgUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "declared", Namespace: "default"},
+			Spec:       akov2.AtlasOrgUserSpec{OrgID: fakeOrgID, Username: "Declared@example.com"},
+		},
+		&akov2.AtlasOrgUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-org", Namespace: "default"},
+			Spec:       akov2.AtlasOrgUserSpec{OrgID: "other-org-id", Username: "other-org@example.com"},
+		},
+		&akov2.AtlasTeam{
+			ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "default"},
+			Spec:       akov2.TeamSpec{Name: "team", Usernames: []akov2.TeamUser{"team-member@example.com"}},
+			Status:     status.TeamStatus{ID: "team-id"},
+		},
+		&akov2.AtlasTeam{
+			ObjectMeta: metav1.ObjectMeta{Name: "new-team", Namespace: "other-namespace"},
+			Spec:       akov2.TeamSpec{Name: "new-team", Usernames: []akov2.TeamUser{"new-team-member@example.com"}},
+		},
+		&akov2.AtlasTeam{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-org-team", Namespace: "default"},
+			Spec:       akov2.TeamSpec{Name: "other-org-team", Usernames: []akov2.TeamUser{"other-org-team-member@example.com"}},
+			Status:     status.TeamStatus{ID: "other-org-team-id"},
+		},
+		&akov2.AtlasTeam{
+			ObjectMeta: metav1.ObjectMeta{Name: "configmap-team", Namespace: "default", UID: "configmap-team-uid"},
+			Spec: akov2.TeamSpec{
+				Name:        "configmap-team",
+				MembersFrom: &akov2.TeamMembersSource{ConfigMapRef: &akov2.TeamMembersConfigMapReference{Name: "team-members"}},
+			},
+		},
+	}
+	membersConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-members", Namespace: "default"},
+		Data:       map[string]string{"usernames": "configmap-member@example.com\n"},
+	}
+
+	tests := []struct {
+		name                string
+		input               *akov2.AtlasOrgSettings
+		namespaced          bool
+		missingSource       bool
+		usersServiceBuilder func(t *testing.T) usersServiceBuilderFunc
+		want                ctrlstate.Result
+		wantErr             string
+	}{
+		{
+			name:  "undeclared members and invitations are removed",
+			input: pruning.DeepCopy(),
+			usersServiceBuilder: func(t *testing.T) usersServiceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().List(mock.Anything, fakeOrgID).Return([]orguser.OrgUser{
+					{ID: "declared-id", Username: "declared@example.com", OrgRoles: []string{"ORG_MEMBER"}},
+					{ID: "team-member-id", Username: "team-member@example.com", OrgRoles: []string{"ORG_MEMBER"}, TeamIDs: []string{"team-id"}},
+					{ID: "new-team-member-id", Username: "new-team-member@example.com", OrgRoles: []string{"ORG_MEMBER"}},
+					{ID: "configmap-member-id", Username: "configmap-member@example.com", OrgRoles: []string{"ORG_MEMBER"}},
+					{ID: "owner-id", Username: "owner@example.com", OrgRoles: []string{"ORG_OWNER"}},
+					{ID: "other-org-id", Username: "other-org@example.com", OrgRoles: []string{"ORG_MEMBER"}},
+					{ID: "other-org-team-member-id", Username: "other-org-team-member@example.com", OrgRoles: []string{"ORG_MEMBER"}},
+					{ID: "invited-id", Username: "invited@example.com", OrgRoles: []string{"ORG_MEMBER"}, MembershipStatus: orguser.MembershipStatusPending},
+				}, nil)
+				svc.EXPECT().Remove(mock.Anything, fakeOrgID, "other-org-id").Return(nil)
+				svc.EXPECT().Remove(mock.Anything, fakeOrgID, "other-org-team-member-id").Return(nil)
+				svc.EXPECT().Remove(mock.Anything, fakeOrgID, "invited-id").Return(orguser.ErrNotFound)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want: ctrlstate.Result{NextState: state.StateUpdated, StateMsg: "Ready, pruned 3 undeclared users."},
+		},
+		{
+			name:       "nothing is pruned when the operator does not watch all namespaces",
+			input:      pruning.DeepCopy(),
+			namespaced: true,
+			usersServiceBuilder: func(t *testing.T) usersServiceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want:    ctrlstate.Result{NextState: state.StateUpdated},
+			wantErr: "pruning undeclared users requires the operator to watch all namespaces",
+		},
+		{
+			name:          "nothing is pruned when the members source of a team cannot be read",
+			input:         pruning.DeepCopy(),
+			missingSource: true,
+			usersServiceBuilder: func(t *testing.T) usersServiceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().List(mock.Anything, fakeOrgID).Return([]orguser.OrgUser{
+					{ID: "configmap-member-id", Username: "configmap-member@example.com", OrgRoles: []string{"ORG_MEMBER"}},
+				}, nil)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want:    ctrlstate.Result{NextState: state.StateUpdated},
+			wantErr: "skipped as the members of AtlasTeam default/configmap-team cannot be resolved",
+		},
+		{
+			name:  "nothing is pruned when every member is declared",
+			input: pruning.DeepCopy(),
+			usersServiceBuilder: func(t *testing.T) usersServiceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().List(mock.Anything, fakeOrgID).Return([]orguser.OrgUser{
+					{ID: "declared-id", Username: "declared@example.com", OrgRoles: []string{"ORG_MEMBER"}},
+				}, nil)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want: ctrlstate.Result{NextState: state.StateUpdated, StateMsg: "Ready."},
+		},
+		{
+			name:  "removal failures are reported",
+			input: pruning.DeepCopy(),
+			usersServiceBuilder: func(t *testing.T) usersServiceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().List(mock.Anything, fakeOrgID).Return([]orguser.OrgUser{
+					{ID: "invited-id", Username: "invited@example.com", OrgRoles: []string{"ORG_MEMBER"}},
+				}, nil)
+				svc.EXPECT().Remove(mock.Anything, fakeOrgID, "invited-id").Return(errors.New("fake-failure"))
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want:    ctrlstate.Result{NextState: state.StateUpdated},
+			wantErr: "failed to prune undeclared users: fake-failure",
+		},
+		{
+			name:  "users are left alone when pruning is disabled",
+			input: sampleAtlasOrgSettings.DeepCopy(),
+			usersServiceBuilder: func(t *testing.T) usersServiceBuilderFunc {
+				return func(*atlas.ClientSet) orguser.OrgUserService {
+					t.Fatal("users service must not be built when pruning is disabled")
+					return nil
+				}
+			},
+			want: ctrlstate.Result{NextState: state.StateUpdated, StateMsg: "Ready."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := append([]client.Object{tt.input}, declaredObjects...)
+			if !tt.missingSource {
+				objects = append(objects, membersConfigMap)
+			}
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				WithStatusSubresource(tt.input).Build()
+
+			h := &AtlasOrgSettingsHandler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Client:        k8sClient,
+					AtlasProvider: createSuccessfulProvider(),
+					Log:           zap.NewNop().Sugar(),
+				},
+				serviceBuilder: createServiceBuilder(t,
+					atlasorgsettings.NewFromAKO(tt.input.Spec), nil, nil, nil, false),
+				usersServiceBuilder: tt.usersServiceBuilder(t),
+				apiReader:           k8sClient,
+				teamMembers:         teammembers.NewResolver(k8sClient, http.DefaultClient),
+				clusterWide:         !tt.namespaced,
+			}
+
+			got, err := h.upsert(ctx, state.StateUpdated, state.StateUpdated, tt.input)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
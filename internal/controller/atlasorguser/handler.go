@@ -0,0 +1,199 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasorguser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/orguser"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
+)
+
+// pendingInvitationRequeue is how often a pending invitation is checked for acceptance
+const pendingInvitationRequeue = 10 * time.Minute
+
+func (h *AtlasOrgUserHandler) HandleInitial(ctx context.Context, orgUser *akov2.AtlasOrgUser) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateInitial, state.StateCreated, orgUser)
+}
+
+func (h *AtlasOrgUserHandler) HandleCreated(ctx context.Context, orgUser *akov2.AtlasOrgUser) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateCreated, state.StateUpdated, orgUser)
+}
+
+func (h *AtlasOrgUserHandler) HandleUpdated(ctx context.Context, orgUser *akov2.AtlasOrgUser) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateUpdated, state.StateUpdated, orgUser)
+}
+
+func (h *AtlasOrgUserHandler) HandleDeletionRequested(ctx context.Context, orgUser *akov2.AtlasOrgUser) (ctrlstate.Result, error) {
+	if orgUser.Status.ID == "" || h.deletionProtection {
+		return h.unmanage(orgUser)
+	}
+	req, err := h.newReconcileRequest(ctx, orgUser)
+	if err != nil {
+		return h.unmanage(orgUser)
+	}
+	err = req.service.Remove(ctx, orgUser.Spec.OrgID, orgUser.Status.ID)
+	if err != nil && !errors.Is(err, orguser.ErrNotFound) {
+		return result.Error(state.StateDeletionRequested, err)
+	}
+	return h.unmanage(orgUser)
+}
+
+func (h *AtlasOrgUserHandler) upsert(ctx context.Context, currentState, nextState state.ResourceState,
+	orgUser *akov2.AtlasOrgUser) (ctrlstate.Result, error) {
+	req, err := h.newReconcileRequest(ctx, orgUser)
+	if err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to build reconcile request: %w", err))
+	}
+	desired, err := h.desiredUser(ctx, orgUser)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+
+	msg := fmt.Sprintf("Synced Atlas organization user %q", orgUser.Spec.Username)
+	current, err := h.find(ctx, req)
+	switch {
+	case errors.Is(err, orguser.ErrNotFound):
+		current, err = req.service.Invite(ctx, orgUser.Spec.OrgID, desired)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		nextState = state.StateCreated
+		msg = fmt.Sprintf("Invited %q to Atlas organization %s", orgUser.Spec.Username, orgUser.Spec.OrgID)
+	case err != nil:
+		return result.Error(currentState, err)
+	case !desired.EqualRoles(current):
+		current, err = req.service.UpdateRoles(ctx, orgUser.Spec.OrgID, current.ID, desired)
+		if err != nil {
+			return result.Error(currentState, err)
+		}
+		nextState = state.StateUpdated
+		msg = fmt.Sprintf("Updated the roles of Atlas organization user %q", orgUser.Spec.Username)
+	}
+
+	if err := h.recordStatus(ctx, orgUser, current); err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to record status of user %q: %w", orgUser.Spec.Username, err))
+	}
+	if !current.IsPending() {
+		return result.NextState(nextState, msg)
+	}
+
+	res, err := result.NextState(nextState, pendingMessage(current))
+	res.RequeueAfter = pendingInvitationRequeue
+	return res, err
+}
+
+// find looks the user up by the recorded ID, falling back to the username to
+// adopt members and invitations created out of the operator
+func (h *AtlasOrgUserHandler) find(ctx context.Context, req *reconcileRequest) (*orguser.OrgUser, error) {
+	if req.orgUser.Status.ID != "" {
+		current, err := req.service.Get(ctx, req.orgUser.Spec.OrgID, req.orgUser.Status.ID)
+		if !errors.Is(err, orguser.ErrNotFound) {
+			return current, err
+		}
+	}
+	return req.service.FindByUsername(ctx, req.orgUser.Spec.OrgID, req.orgUser.Spec.Username)
+}
+
+func (h *AtlasOrgUserHandler) desiredUser(ctx context.Context, orgUser *akov2.AtlasOrgUser) (*orguser.OrgUser, error) {
+	desired := &orguser.OrgUser{
+		Username:     orgUser.Spec.Username,
+		OrgRoles:     make([]string, 0, len(orgUser.Spec.OrgRoles)),
+		ProjectRoles: make(map[string][]string, len(orgUser.Spec.ProjectRoles)),
+	}
+	for _, role := range orgUser.Spec.OrgRoles {
+		desired.OrgRoles = append(desired.OrgRoles, string(role))
+	}
+	for _, projectRoles := range orgUser.Spec.ProjectRoles {
+		projectID, err := h.projectID(ctx, orgUser, &projectRoles)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range projectRoles.Roles {
+			desired.ProjectRoles[projectID] = append(desired.ProjectRoles[projectID], string(role))
+		}
+	}
+	return desired, nil
+}
+
+func (h *AtlasOrgUserHandler) projectID(ctx context.Context, orgUser *akov2.AtlasOrgUser, projectRoles *akov2.OrgUserProjectRoles) (string, error) {
+	if projectRoles.ExternalProjectRef != nil {
+		return projectRoles.ExternalProjectRef.ID, nil
+	}
+	key := client.ObjectKey{Namespace: projectRoles.ProjectRef.Namespace, Name: projectRoles.ProjectRef.Name}
+	if key.Namespace == "" {
+		key.Namespace = orgUser.GetNamespace()
+	}
+	project := &akov2.AtlasProject{}
+	if err := h.Client.Get(ctx, key, project); err != nil {
+		return "", fmt.Errorf("failed to get project %s: %w", key, err)
+	}
+	if project.ID() == "" {
+		return "", fmt.Errorf("project %s is not ready yet", key)
+	}
+	return project.ID(), nil
+}
+
+func (h *AtlasOrgUserHandler) recordStatus(ctx context.Context, orgUser *akov2.AtlasOrgUser, current *orguser.OrgUser) error {
+	var expiresAt *metav1.Time
+	if current.IsPending() && current.InvitationExpiresAt != nil {
+		expiresAt = &metav1.Time{Time: *current.InvitationExpiresAt}
+	}
+	if orgUser.Status.ID == current.ID && orgUser.Status.MembershipStatus == current.MembershipStatus &&
+		orgUser.Status.InvitationExpiresAt.Equal(expiresAt) {
+		return nil
+	}
+	orgUser.Status.ID = current.ID
+	orgUser.Status.MembershipStatus = current.MembershipStatus
+	orgUser.Status.InvitationExpiresAt = expiresAt
+	return h.patchNonConditionStatus(ctx, orgUser)
+}
+
+func pendingMessage(user *orguser.OrgUser) string {
+	if user.InvitationExpiresAt == nil {
+		return fmt.Sprintf("Invitation of %q is pending acceptance", user.Username)
+	}
+	return fmt.Sprintf("Invitation of %q is pending acceptance until %s",
+		user.Username, user.InvitationExpiresAt.UTC().Format(time.RFC3339))
+}
+
+func (h *AtlasOrgUserHandler) unmanage(orgUser *akov2.AtlasOrgUser) (ctrlstate.Result, error) {
+	return result.NextState(
+		state.StateDeleted,
+		fmt.Sprintf("Removed %q from Atlas organization %s", orgUser.Spec.Username, orgUser.Spec.OrgID),
+	)
+}
+
+func (h *AtlasOrgUserHandler) patchNonConditionStatus(ctx context.Context, orgUser *akov2.AtlasOrgUser) error {
+	statusJSON, err := json.Marshal(orgUser)
+	if err != nil {
+		return fmt.Errorf("failed to marshal status: %w", err)
+	}
+	if err := h.Client.Status().Patch(ctx, orgUser, client.RawPatch(types.MergePatchType, statusJSON)); err != nil {
+		return fmt.Errorf("failed to patch: %w", err)
+	}
+	return nil
+}
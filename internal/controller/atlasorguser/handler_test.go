@@ -0,0 +1,400 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasorguser
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	mocks "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/orguser"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
+)
+
+//nolint:gosec
+const (
+	fakeOrgID     = "fake-org-id"
+	fakeUserID    = "fake-user-id"
+	fakeProjectID = "fake-project-id"
+	fakeUsername  = "jane.doe@example.com"
+)
+
+var fakeAtlasSecret = corev1.Secret{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "atlas-credentials",
+		Namespace: "default",
+	},
+	Data: map[string][]byte{
+		"orgId":         []byte(fakeOrgID),
+		"publicApiKey":  []byte("fake-api-key"),
+		"privateApiKey": []byte("fake-api-secret"),
+	},
+}
+
+var fakeProject = akov2.AtlasProject{
+	ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "default"},
+	Status:     status.AtlasProjectStatus{ID: fakeProjectID},
+}
+
+var fakeProvider = &atlasmock.TestProvider{
+	SdkClientSetFunc: func(ctx context.Context, creds *atlas.Credentials, log *zap.SugaredLogger) (*atlas.ClientSet, error) {
+		return &atlas.ClientSet{}, nil
+	},
+}
+
+func sampleOrgUser() *akov2.AtlasOrgUser {
+	return &akov2.AtlasOrgUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "default"},
+		Spec: akov2.AtlasOrgUserSpec{
+			OrgID:               fakeOrgID,
+			ConnectionSecretRef: &api.LocalObjectReference{Name: "atlas-credentials"},
+			Username:            fakeUsername,
+			OrgRoles:            []akov2.OrgRole{akov2.OrgRoleMember},
+			ProjectRoles: []akov2.OrgUserProjectRoles{
+				{
+					ProjectRef: &common.ResourceRefNamespaced{Name: "my-project"},
+					Roles:      []akov2.TeamRole{akov2.TeamRoleReadOnly},
+				},
+			},
+		},
+	}
+}
+
+func withID(orgUser *akov2.AtlasOrgUser) *akov2.AtlasOrgUser {
+	orgUser.Status.ID = fakeUserID
+	orgUser.Status.MembershipStatus = orguser.MembershipStatusActive
+	return orgUser
+}
+
+func atlasUser(membershipStatus string) *orguser.OrgUser {
+	return &orguser.OrgUser{
+		ID:               fakeUserID,
+		Username:         fakeUsername,
+		OrgRoles:         []string{"ORG_MEMBER"},
+		ProjectRoles:     map[string][]string{fakeProjectID: {"GROUP_READ_ONLY"}},
+		MembershipStatus: membershipStatus,
+	}
+}
+
+func TestHandleUpsert(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	ctx := context.Background()
+	expiresAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name             string
+		state            state.ResourceState
+		input            *akov2.AtlasOrgUser
+		objects          []client.Object
+		serviceBuilder   func(t *testing.T) serviceBuilderFunc
+		want             ctrlstate.Result
+		wantErr          string
+		wantID           string
+		wantMembership   string
+		wantInviteExpiry *metav1.Time
+	}{
+		{
+			name:    "initial invites missing users",
+			state:   state.StateInitial,
+			input:   sampleOrgUser(),
+			objects: []client.Object{&fakeProject},
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().FindByUsername(mock.Anything, fakeOrgID, fakeUsername).Return(nil, orguser.ErrNotFound)
+				svc.EXPECT().Invite(mock.Anything, fakeOrgID, &orguser.OrgUser{
+					Username:     fakeUsername,
+					OrgRoles:     []string{"ORG_MEMBER"},
+					ProjectRoles: map[string][]string{fakeProjectID: {"GROUP_READ_ONLY"}},
+				}).Return(func() *orguser.OrgUser {
+					user := atlasUser(orguser.MembershipStatusPending)
+					user.InvitationExpiresAt = &expiresAt
+					return user
+				}(), nil)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want: ctrlstate.Result{
+				Result:    reconcile.Result{RequeueAfter: pendingInvitationRequeue},
+				NextState: state.StateCreated,
+				StateMsg:  "Invitation of \"jane.doe@example.com\" is pending acceptance until 2025-06-01T00:00:00Z.",
+			},
+			wantID:           fakeUserID,
+			wantMembership:   orguser.MembershipStatusPending,
+			wantInviteExpiry: &metav1.Time{Time: expiresAt},
+		},
+		{
+			name:    "initial adopts existing members by username",
+			state:   state.StateInitial,
+			input:   sampleOrgUser(),
+			objects: []client.Object{&fakeProject},
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().FindByUsername(mock.Anything, fakeOrgID, fakeUsername).
+					Return(atlasUser(orguser.MembershipStatusActive), nil)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want: ctrlstate.Result{
+				NextState: state.StateCreated,
+				StateMsg:  "Synced Atlas organization user \"jane.doe@example.com\".",
+			},
+			wantID:         fakeUserID,
+			wantMembership: orguser.MembershipStatusActive,
+		},
+		{
+			name:    "drifted roles are updated",
+			state:   state.StateCreated,
+			input:   withID(sampleOrgUser()),
+			objects: []client.Object{&fakeProject},
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				current := atlasUser(orguser.MembershipStatusActive)
+				current.OrgRoles = []string{"ORG_READ_ONLY"}
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().Get(mock.Anything, fakeOrgID, fakeUserID).Return(current, nil)
+				svc.EXPECT().UpdateRoles(mock.Anything, fakeOrgID, fakeUserID, mock.Anything).
+					Return(atlasUser(orguser.MembershipStatusActive), nil)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want: ctrlstate.Result{
+				NextState: state.StateUpdated,
+				StateMsg:  "Updated the roles of Atlas organization user \"jane.doe@example.com\".",
+			},
+			wantID:         fakeUserID,
+			wantMembership: orguser.MembershipStatusActive,
+		},
+		{
+			name:    "users removed out of the operator are invited again",
+			state:   state.StateUpdated,
+			input:   withID(sampleOrgUser()),
+			objects: []client.Object{&fakeProject},
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().Get(mock.Anything, fakeOrgID, fakeUserID).Return(nil, orguser.ErrNotFound)
+				svc.EXPECT().FindByUsername(mock.Anything, fakeOrgID, fakeUsername).Return(nil, orguser.ErrNotFound)
+				svc.EXPECT().Invite(mock.Anything, fakeOrgID, mock.Anything).Return(func() *orguser.OrgUser {
+					user := atlasUser(orguser.MembershipStatusPending)
+					user.ID = "new-user-id"
+					return user
+				}(), nil)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want: ctrlstate.Result{
+				Result:    reconcile.Result{RequeueAfter: pendingInvitationRequeue},
+				NextState: state.StateCreated,
+				StateMsg:  "Invitation of \"jane.doe@example.com\" is pending acceptance.",
+			},
+			wantID:         "new-user-id",
+			wantMembership: orguser.MembershipStatusPending,
+		},
+		{
+			name:  "projects which are not ready block the reconciliation",
+			state: state.StateInitial,
+			input: sampleOrgUser(),
+			objects: []client.Object{&akov2.AtlasProject{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "default"},
+			}},
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want:    ctrlstate.Result{NextState: state.StateInitial},
+			wantErr: "project default/my-project is not ready yet",
+		},
+		{
+			name:  "external projects are referenced by ID",
+			state: state.StateInitial,
+			input: func() *akov2.AtlasOrgUser {
+				orgUser := sampleOrgUser()
+				orgUser.Spec.ProjectRoles[0].ProjectRef = nil
+				orgUser.Spec.ProjectRoles[0].ExternalProjectRef = &akov2.ExternalProjectReference{ID: fakeProjectID}
+				return orgUser
+			}(),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().FindByUsername(mock.Anything, fakeOrgID, fakeUsername).
+					Return(atlasUser(orguser.MembershipStatusActive), nil)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want: ctrlstate.Result{
+				NextState: state.StateCreated,
+				StateMsg:  "Synced Atlas organization user \"jane.doe@example.com\".",
+			},
+			wantID:         fakeUserID,
+			wantMembership: orguser.MembershipStatusActive,
+		},
+		{
+			name:    "invitation failures are reported",
+			state:   state.StateInitial,
+			input:   sampleOrgUser(),
+			objects: []client.Object{&fakeProject},
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().FindByUsername(mock.Anything, fakeOrgID, fakeUsername).Return(nil, orguser.ErrNotFound)
+				svc.EXPECT().Invite(mock.Anything, fakeOrgID, mock.Anything).Return(nil, fmt.Errorf("fake-failure"))
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want:    ctrlstate.Result{NextState: state.StateInitial},
+			wantErr: "fake-failure",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tc.objects, &fakeAtlasSecret, tc.input)...).
+				WithStatusSubresource(tc.input).Build()
+			h := AtlasOrgUserHandler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Client:        k8sClient,
+					AtlasProvider: fakeProvider,
+				},
+				serviceBuilder: tc.serviceBuilder(t),
+			}
+
+			handle := h.HandleInitial
+			switch tc.state {
+			case state.StateInitial:
+				handle = h.HandleInitial
+			case state.StateCreated:
+				handle = h.HandleCreated
+			case state.StateUpdated:
+				handle = h.HandleUpdated
+			default:
+				panic(fmt.Errorf("unsupported state %v for test", tc.state))
+			}
+			got, err := handle(ctx, tc.input)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+			assert.Equal(t, tc.want, got)
+
+			orgUser := &akov2.AtlasOrgUser{}
+			require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(tc.input), orgUser))
+			assert.Equal(t, tc.wantID, orgUser.Status.ID)
+			assert.Equal(t, tc.wantMembership, orgUser.Status.MembershipStatus)
+			if tc.wantInviteExpiry == nil {
+				assert.Nil(t, orgUser.Status.InvitationExpiresAt)
+			} else {
+				assert.True(t, tc.wantInviteExpiry.Equal(orgUser.Status.InvitationExpiresAt))
+			}
+		})
+	}
+}
+
+func TestHandleDeletion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	ctx := context.Background()
+	removed := ctrlstate.Result{
+		NextState: state.StateDeleted,
+		StateMsg:  "Removed \"jane.doe@example.com\" from Atlas organization fake-org-id.",
+	}
+
+	for _, tc := range []struct {
+		name               string
+		deletionProtection bool
+		input              *akov2.AtlasOrgUser
+		serviceBuilder     func(t *testing.T) serviceBuilderFunc
+		want               ctrlstate.Result
+		wantErr            string
+	}{
+		{
+			name:  "users are removed from the organization",
+			input: withID(sampleOrgUser()),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().Remove(mock.Anything, fakeOrgID, fakeUserID).Return(nil)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want: removed,
+		},
+		{
+			name:  "users already gone are released",
+			input: withID(sampleOrgUser()),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().Remove(mock.Anything, fakeOrgID, fakeUserID).Return(orguser.ErrNotFound)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want: removed,
+		},
+		{
+			name:  "removal failures are reported",
+			input: withID(sampleOrgUser()),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				svc.EXPECT().Remove(mock.Anything, fakeOrgID, fakeUserID).Return(fmt.Errorf("fake-failure"))
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want:    ctrlstate.Result{NextState: state.StateDeletionRequested},
+			wantErr: "fake-failure",
+		},
+		{
+			name:               "deletion protection keeps users in the organization",
+			deletionProtection: true,
+			input:              withID(sampleOrgUser()),
+			serviceBuilder: func(t *testing.T) serviceBuilderFunc {
+				svc := mocks.NewOrgUserServiceMock(t)
+				return func(*atlas.ClientSet) orguser.OrgUserService { return svc }
+			},
+			want: removed,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(&fakeAtlasSecret, tc.input).
+				WithStatusSubresource(tc.input).Build()
+			h := AtlasOrgUserHandler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Client:        k8sClient,
+					AtlasProvider: fakeProvider,
+				},
+				deletionProtection: tc.deletionProtection,
+				serviceBuilder:     tc.serviceBuilder(t),
+			}
+			got, err := h.HandleDeletionRequested(ctx, tc.input)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
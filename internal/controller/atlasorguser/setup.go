@@ -0,0 +1,145 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasorguser
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlrtbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/orguser"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	mckpredicate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/predicate"
+)
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasorgusers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasorgusers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasorgusers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasorgusers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasorgusers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasorgusers/finalizers,verbs=update
+
+type serviceBuilderFunc func(*atlas.ClientSet) orguser.OrgUserService
+
+type AtlasOrgUserHandler struct {
+	ctrlstate.StateHandler[akov2.AtlasOrgUser]
+	reconciler.AtlasReconciler
+	deletionProtection bool
+	serviceBuilder     serviceBuilderFunc
+}
+
+func NewAtlasOrgUserReconciler(
+	c cluster.Cluster,
+	atlasProvider atlas.Provider,
+	deletionProtection bool,
+	logger *zap.Logger,
+	globalSecretRef client.ObjectKey,
+	reapplySupport bool,
+) *ctrlstate.Reconciler[akov2.AtlasOrgUser] {
+	orgUserHandler := &AtlasOrgUserHandler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:          c.GetClient(),
+			AtlasProvider:   atlasProvider,
+			Log:             logger.Named("controllers").Named("AtlasOrgUser").Sugar(),
+			GlobalSecretRef: globalSecretRef,
+		},
+		deletionProtection: deletionProtection,
+		serviceBuilder:     orguser.NewOrgUserServiceFromClientSet,
+	}
+	return ctrlstate.NewStateReconciler(
+		orgUserHandler,
+		ctrlstate.WithCluster[akov2.AtlasOrgUser](c),
+		ctrlstate.WithReapplySupport[akov2.AtlasOrgUser](reapplySupport),
+	)
+}
+
+// For prepares the controller for its target Custom Resource; AtlasOrgUser
+func (h *AtlasOrgUserHandler) For() (client.Object, builder.Predicates) {
+	obj := &akov2.AtlasOrgUser{}
+	return obj, ctrlrtbuilder.WithPredicates(
+		predicate.Or(
+			mckpredicate.AnnotationChanged("mongodb.com/reapply-period"),
+			predicate.GenerationChangedPredicate{},
+		),
+		mckpredicate.IgnoreDeletedPredicate[client.Object](),
+	)
+}
+
+func (h *AtlasOrgUserHandler) SetupWithManager(mgr ctrl.Manager, rec reconcile.Reconciler, defaultOptions controller.Options) error {
+	h.Client = mgr.GetClient()
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named("AtlasOrgUser").
+		For(h.For()).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(h.orgUserForCredentialMapFunc()),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		WithOptions(defaultOptions).Complete(rec)
+}
+
+func (h *AtlasOrgUserHandler) orgUserForCredentialMapFunc() handler.MapFunc {
+	return indexer.CredentialsIndexMapperFunc(
+		indexer.AtlasOrgUserBySecretsIndex,
+		func() *akov2.AtlasOrgUserList { return &akov2.AtlasOrgUserList{} },
+		indexer.AtlasOrgUserRequests,
+		h.Client,
+		h.Log,
+	)
+}
+
+type reconcileRequest struct {
+	service orguser.OrgUserService
+	orgUser *akov2.AtlasOrgUser
+}
+
+func (h *AtlasOrgUserHandler) newReconcileRequest(ctx context.Context, orgUser *akov2.AtlasOrgUser) (*reconcileRequest, error) {
+	var objKey *client.ObjectKey
+	if orgUser.Spec.ConnectionSecretRef != nil && orgUser.Spec.ConnectionSecretRef.Name != "" {
+		objKey = &client.ObjectKey{
+			Namespace: orgUser.GetNamespace(),
+			Name:      orgUser.Spec.ConnectionSecretRef.Name,
+		}
+	}
+
+	cfg, err := reconciler.GetConnectionConfig(ctx, h.Client, objKey, &h.GlobalSecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkClientSet, err := h.AtlasProvider.SdkClientSet(ctx, cfg.Credentials, h.Log)
+	if err != nil {
+		return nil, err
+	}
+	return &reconcileRequest{
+		service: h.serviceBuilder(sdkClientSet),
+		orgUser: orgUser,
+	}, nil
+}
@@ -43,9 +43,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/privateendpoint"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 // AtlasPrivateEndpointReconciler reconciles a AtlasPrivateEndpoint object
@@ -65,6 +63,9 @@ type AtlasPrivateEndpointReconciler struct {
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprivateendpoints/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;update;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasdeployments,verbs=get;list;watch
 
 func (r *AtlasPrivateEndpointReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	r.Log.Infow("-> Starting AtlasPrivateEndpoint reconciliation")
@@ -92,14 +93,14 @@ func (r *AtlasPrivateEndpointReconciler) ensureCustomResource(ctx context.Contex
 		return r.invalidate(isValid)
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(akoPrivateEndpoint) {
-		return r.unsupport(workflowCtx)
-	}
-
 	connectionConfig, err := r.ResolveConnectionConfig(ctx, akoPrivateEndpoint)
 	if err != nil {
 		return r.terminate(workflowCtx, akoPrivateEndpoint, nil, api.ReadyType, workflow.AtlasAPIAccessNotConfigured, err)
 	}
+
+	if !r.AtlasProvider.IsResourceSupported(akoPrivateEndpoint, connectionConfig.Credentials) {
+		return r.unsupport(workflowCtx)
+	}
 	sdkClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, r.Log)
 	if err != nil {
 		return r.terminate(workflowCtx, akoPrivateEndpoint, nil, api.ReadyType, workflow.AtlasAPIAccessNotConfigured, err)
@@ -225,10 +226,12 @@ func (r *AtlasPrivateEndpointReconciler) For() (client.Object, builder.Predicate
 	return &akov2.AtlasPrivateEndpoint{}, builder.WithPredicates(r.GlobalPredicates...)
 }
 
-func (r *AtlasPrivateEndpointReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+func (r *AtlasPrivateEndpointReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasPrivateEndpoint").
 		For(r.For()).
+		Owns(&corev1.ConfigMap{}).
+		Owns(&corev1.Secret{}).
 		Watches(
 			&akov2.AtlasProject{},
 			handler.EnqueueRequestsFromMapFunc(r.privateEndpointForProjectMapFunc()),
@@ -239,9 +242,12 @@ func (r *AtlasPrivateEndpointReconciler) SetupWithManager(mgr ctrl.Manager, skip
 			handler.EnqueueRequestsFromMapFunc(r.privateEndpointForCredentialMapFunc()),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
 		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
+		Watches(
+			&akov2.AtlasDeployment{},
+			handler.EnqueueRequestsFromMapFunc(r.privateEndpointForDeploymentMapFunc()),
+			builder.WithPredicates(privateEndpointConnectionsChanged()),
+		).
+		WithOptions(options).
 		Complete(r)
 }
 
@@ -295,13 +301,15 @@ func NewAtlasPrivateEndpointReconciler(
 	independentSyncPeriod time.Duration,
 	logger *zap.Logger,
 	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
 ) *AtlasPrivateEndpointReconciler {
 	return &AtlasPrivateEndpointReconciler{
 		AtlasReconciler: reconciler.AtlasReconciler{
-			Client:          c.GetClient(),
-			Log:             logger.Named("controllers").Named("AtlasPrivateEndpoint").Sugar(),
-			GlobalSecretRef: globalSecretRef,
-			AtlasProvider:   atlasProvider,
+			Client:              c.GetClient(),
+			Log:                 logger.Named("controllers").Named("AtlasPrivateEndpoint").Sugar(),
+			GlobalSecretRef:     globalSecretRef,
+			CredentialProviders: credentialProviders,
+			AtlasProvider:       atlasProvider,
 		},
 		Scheme:                   c.GetScheme(),
 		EventRecorder:            c.GetEventRecorderFor("AtlasPrivateEndpoint"),
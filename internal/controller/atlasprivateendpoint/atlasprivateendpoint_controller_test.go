@@ -213,7 +213,9 @@ func TestEnsureCustomResource(t *testing.T) {
 						ExternalProjectRef: &akov2.ExternalProjectReference{
 							ID: projectID,
 						},
-						ConnectionSecret: &api.LocalObjectReference{},
+						ConnectionSecret: &api.LocalObjectReference{
+							Name: "my-secret",
+						},
 					},
 					Provider: "AWS",
 					Region:   "US_EAST_1",
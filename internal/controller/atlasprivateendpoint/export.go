@@ -0,0 +1,187 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasprivateendpoint
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/privateendpoint"
+)
+
+// export writes the details of the private endpoint service and the expected DNS names into the ConfigMap
+// or Secret requested by the resource, which owns the exported object
+func (r *AtlasPrivateEndpointReconciler) export(ctx context.Context, projectID string, akoPrivateEndpoint *akov2.AtlasPrivateEndpoint, atlasPEService privateendpoint.EndpointService) error {
+	exportTo := akoPrivateEndpoint.Spec.ExportTo
+	if exportTo == nil {
+		return nil
+	}
+
+	exports := privateendpoint.Exports(atlasPEService)
+	dnsNames, err := r.dnsNames(ctx, projectID, atlasPEService)
+	if err != nil {
+		return err
+	}
+	exports["dnsNames"] = strings.Join(dnsNames, ",")
+
+	meta := metav1.ObjectMeta{Name: exportTo.Name, Namespace: akoPrivateEndpoint.Namespace}
+	var obj client.Object
+	var mutate func()
+	switch exportTo.Kind {
+	case akov2.PrivateEndpointExportSecret:
+		secret := &corev1.Secret{ObjectMeta: meta}
+		obj = secret
+		mutate = func() {
+			// only labelled Secrets are visible to the operator when watching all namespaces
+			if secret.Labels == nil {
+				secret.Labels = map[string]string{}
+			}
+			secret.Labels[connectionsecret.TypeLabelKey] = connectionsecret.ExportLabelVal
+			secret.Data = make(map[string][]byte, len(exports))
+			for key, value := range exports {
+				secret.Data[key] = []byte(value)
+			}
+		}
+	default:
+		configMap := &corev1.ConfigMap{ObjectMeta: meta}
+		obj = configMap
+		mutate = func() {
+			configMap.Data = exports
+		}
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, obj, func() error {
+		// objects the resource did not create are never overwritten
+		if obj.GetResourceVersion() != "" && !metav1.IsControlledBy(obj, akoPrivateEndpoint) {
+			return fmt.Errorf("it already exists and is not controlled by AtlasPrivateEndpoint %s", akoPrivateEndpoint.Name)
+		}
+		mutate()
+		return controllerutil.SetControllerReference(akoPrivateEndpoint, obj, r.Client.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write %s %s: %w", exportTo.Kind, client.ObjectKeyFromObject(obj), err)
+	}
+	return nil
+}
+
+// dnsNames returns the hostnames of the private endpoint connection strings of the project deployments
+// going through the interfaces of the service. They are known once Atlas connected the deployments to the endpoints.
+func (r *AtlasPrivateEndpointReconciler) dnsNames(ctx context.Context, projectID string, atlasPEService privateendpoint.EndpointService) ([]string, error) {
+	deployments := &akov2.AtlasDeploymentList{}
+	listOpts := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(indexer.AtlasDeploymentByProject, projectID),
+	}
+	if err := r.Client.List(ctx, deployments, listOpts); err != nil {
+		return nil, fmt.Errorf("failed to list the deployments of the project: %w", err)
+	}
+
+	var dnsNames []string
+	for _, deployment := range deployments.Items {
+		if deployment.Status.ConnectionStrings == nil {
+			continue
+		}
+		for _, pe := range deployment.Status.ConnectionStrings.PrivateEndpoint {
+			throughService := slices.ContainsFunc(pe.Endpoints, func(endpoint status.Endpoint) bool {
+				return atlasPEService.EndpointInterfaces().Get(endpoint.EndpointID) != nil
+			})
+			if !throughService {
+				continue
+			}
+			connectionURL, err := url.Parse(pe.SRVConnectionString)
+			if err != nil || connectionURL.Hostname() == "" {
+				continue
+			}
+			dnsNames = append(dnsNames, connectionURL.Hostname())
+		}
+	}
+	slices.Sort(dnsNames)
+	return slices.Compact(dnsNames), nil
+}
+
+func (r *AtlasPrivateEndpointReconciler) privateEndpointForDeploymentMapFunc() handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		deployment, ok := obj.(*akov2.AtlasDeployment)
+		if !ok {
+			r.Log.Warnf("watching AtlasDeployment but got %T", obj)
+
+			return nil
+		}
+
+		peList := &akov2.AtlasPrivateEndpointList{}
+		if err := r.Client.List(ctx, peList); err != nil {
+			r.Log.Errorf("failed to list AtlasPrivateEndpoint: %s", err)
+
+			return []reconcile.Request{}
+		}
+
+		requests := make([]reconcile.Request, 0, len(peList.Items))
+		for _, item := range peList.Items {
+			if item.Spec.ExportTo != nil && sameProject(&item, deployment) {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&item)})
+			}
+		}
+
+		return requests
+	}
+}
+
+func sameProject(akoPrivateEndpoint *akov2.AtlasPrivateEndpoint, deployment *akov2.AtlasDeployment) bool {
+	if akoPrivateEndpoint.Spec.ExternalProjectRef != nil {
+		return deployment.Spec.ExternalProjectRef != nil && deployment.Spec.ExternalProjectRef.ID == akoPrivateEndpoint.Spec.ExternalProjectRef.ID
+	}
+	if akoPrivateEndpoint.Spec.ProjectRef == nil || deployment.Spec.ProjectRef == nil {
+		return false
+	}
+	return *akoPrivateEndpoint.Spec.ProjectRef.GetObject(akoPrivateEndpoint.Namespace) == *deployment.Spec.ProjectRef.GetObject(deployment.Namespace)
+}
+
+// privateEndpointConnectionsChanged filters the deployment updates changing their private endpoint connection strings
+func privateEndpointConnectionsChanged() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldDeployment, oldOK := e.ObjectOld.(*akov2.AtlasDeployment)
+			newDeployment, newOK := e.ObjectNew.(*akov2.AtlasDeployment)
+			if !oldOK || !newOK {
+				return false
+			}
+			return !reflect.DeepEqual(privateEndpointConnections(oldDeployment), privateEndpointConnections(newDeployment))
+		},
+	}
+}
+
+func privateEndpointConnections(deployment *akov2.AtlasDeployment) []status.PrivateEndpoint {
+	if deployment.Status.ConnectionStrings == nil {
+		return nil
+	}
+	return deployment.Status.ConnectionStrings.PrivateEndpoint
+}
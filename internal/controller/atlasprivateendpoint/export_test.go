@@ -0,0 +1,256 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasprivateendpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/privateendpoint"
+)
+
+func TestExport(t *testing.T) {
+	awsService := &privateendpoint.AWSService{
+		CommonEndpointService: privateendpoint.CommonEndpointService{
+			ID:          "svc-id",
+			CloudRegion: "US_EAST_1",
+			Interfaces: privateendpoint.EndpointInterfaces{
+				&privateendpoint.AWSInterface{CommonEndpointInterface: privateendpoint.CommonEndpointInterface{ID: "vpce-123"}},
+			},
+		},
+		ServiceName: "com.amazonaws.vpce.us-east-1.vpce-svc-123",
+	}
+	deployment := func(name, endpointID, srv string) *akov2.AtlasDeployment {
+		return &akov2.AtlasDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: akov2.AtlasDeploymentSpec{
+				ProjectDualReference: akov2.ProjectDualReference{ExternalProjectRef: &akov2.ExternalProjectReference{ID: "project-id"}},
+			},
+			Status: status.AtlasDeploymentStatus{
+				ConnectionStrings: &status.ConnectionStrings{
+					PrivateEndpoint: []status.PrivateEndpoint{
+						{SRVConnectionString: srv, Endpoints: []status.Endpoint{{EndpointID: endpointID, ProviderName: "AWS"}}},
+					},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		title       string
+		exportTo    *akov2.PrivateEndpointExport
+		objects     []client.Object
+		wantData    map[string]string
+		wantErr     string
+		wantNoWrite bool
+	}{
+		{
+			title:       "nothing is written without export",
+			wantNoWrite: true,
+		},
+		{
+			title:    "writes the service details and the DNS names to a ConfigMap",
+			exportTo: &akov2.PrivateEndpointExport{Kind: akov2.PrivateEndpointExportConfigMap, Name: "pe-outputs"},
+			objects: []client.Object{
+				deployment("cluster0", "vpce-123", "mongodb+srv://cluster0-pl-0.abcde.mongodb.net"),
+				deployment("cluster1", "vpce-123", "mongodb+srv://cluster1-pl-0.abcde.mongodb.net"),
+				deployment("other", "vpce-other", "mongodb+srv://other-pl-0.abcde.mongodb.net"),
+			},
+			wantData: map[string]string{
+				"provider":    "AWS",
+				"region":      "US_EAST_1",
+				"serviceId":   "svc-id",
+				"serviceName": "com.amazonaws.vpce.us-east-1.vpce-svc-123",
+				"dnsNames":    "cluster0-pl-0.abcde.mongodb.net,cluster1-pl-0.abcde.mongodb.net",
+			},
+		},
+		{
+			title:    "writes the service details to a Secret",
+			exportTo: &akov2.PrivateEndpointExport{Kind: akov2.PrivateEndpointExportSecret, Name: "pe-outputs"},
+			wantData: map[string]string{
+				"provider":    "AWS",
+				"region":      "US_EAST_1",
+				"serviceId":   "svc-id",
+				"serviceName": "com.amazonaws.vpce.us-east-1.vpce-svc-123",
+				"dnsNames":    "",
+			},
+		},
+		{
+			title:    "fails to take over an object owned by another resource",
+			exportTo: &akov2.PrivateEndpointExport{Kind: akov2.PrivateEndpointExportConfigMap, Name: "pe-outputs"},
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pe-outputs",
+						Namespace: "default",
+						OwnerReferences: []metav1.OwnerReference{
+							{APIVersion: "v1", Kind: "Pod", Name: "other", UID: "other-uid", Controller: pointer.MakePtr(true)},
+						},
+					},
+				},
+			},
+			wantErr: "failed to write ConfigMap default/pe-outputs",
+		},
+		{
+			title:    "fails to overwrite an object it does not control",
+			exportTo: &akov2.PrivateEndpointExport{Kind: akov2.PrivateEndpointExportSecret, Name: "pe-outputs"},
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "pe-outputs", Namespace: "default"},
+					Data:       map[string][]byte{"password": []byte("secret")},
+				},
+			},
+			wantErr: "failed to write Secret default/pe-outputs: it already exists and is not controlled by AtlasPrivateEndpoint pe",
+		},
+		{
+			title:    "updates the object it controls",
+			exportTo: &akov2.PrivateEndpointExport{Kind: akov2.PrivateEndpointExportConfigMap, Name: "pe-outputs"},
+			objects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pe-outputs",
+						Namespace: "default",
+						OwnerReferences: []metav1.OwnerReference{
+							{APIVersion: "atlas.mongodb.com/v1", Kind: "AtlasPrivateEndpoint", Name: "pe", UID: "pe-uid", Controller: pointer.MakePtr(true)},
+						},
+					},
+					Data: map[string]string{"serviceId": "outdated"},
+				},
+			},
+			wantData: map[string]string{
+				"provider":    "AWS",
+				"region":      "US_EAST_1",
+				"serviceId":   "svc-id",
+				"serviceName": "com.amazonaws.vpce.us-east-1.vpce-svc-123",
+				"dnsNames":    "",
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			testScheme := runtime.NewScheme()
+			require.NoError(t, akov2.AddToScheme(testScheme))
+			require.NoError(t, corev1.AddToScheme(testScheme))
+			pe := &akov2.AtlasPrivateEndpoint{
+				ObjectMeta: metav1.ObjectMeta{Name: "pe", Namespace: "default", UID: "pe-uid"},
+				Spec: akov2.AtlasPrivateEndpointSpec{
+					ProjectDualReference: akov2.ProjectDualReference{ExternalProjectRef: &akov2.ExternalProjectReference{ID: "project-id"}},
+					Provider:             "AWS",
+					Region:               "US_EAST_1",
+					ExportTo:             tc.exportTo,
+				},
+			}
+			deploymentIndexer := indexer.NewAtlasDeploymentByProjectIndexer(context.Background(), nil, zaptest.NewLogger(t))
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(append(tc.objects, pe)...).
+				WithIndex(deploymentIndexer.Object(), deploymentIndexer.Name(), deploymentIndexer.Keys).
+				Build()
+			r := &AtlasPrivateEndpointReconciler{
+				AtlasReconciler: reconciler.AtlasReconciler{Client: k8sClient, Log: zaptest.NewLogger(t).Sugar()},
+			}
+
+			err := r.export(context.Background(), "project-id", pe, awsService)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			key := client.ObjectKey{Name: "pe-outputs", Namespace: "default"}
+			switch {
+			case tc.wantNoWrite:
+				assert.True(t, apierrors.IsNotFound(k8sClient.Get(context.Background(), key, &corev1.ConfigMap{})))
+			case tc.exportTo.Kind == akov2.PrivateEndpointExportSecret:
+				secret := &corev1.Secret{}
+				require.NoError(t, k8sClient.Get(context.Background(), key, secret))
+				data := map[string]string{}
+				for k, v := range secret.Data {
+					data[k] = string(v)
+				}
+				assert.Equal(t, tc.wantData, data)
+				assert.Equal(t, "pe", secret.OwnerReferences[0].Name)
+				assert.Equal(t, connectionsecret.ExportLabelVal, secret.Labels[connectionsecret.TypeLabelKey])
+			default:
+				configMap := &corev1.ConfigMap{}
+				require.NoError(t, k8sClient.Get(context.Background(), key, configMap))
+				assert.Equal(t, tc.wantData, configMap.Data)
+				assert.Equal(t, "pe", configMap.OwnerReferences[0].Name)
+			}
+		})
+	}
+}
+
+func TestPrivateEndpointForDeploymentMapFunc(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(testScheme))
+	exportTo := &akov2.PrivateEndpointExport{Name: "outputs"}
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(
+			&akov2.AtlasPrivateEndpoint{
+				ObjectMeta: metav1.ObjectMeta{Name: "exported", Namespace: "default"},
+				Spec: akov2.AtlasPrivateEndpointSpec{
+					ProjectDualReference: akov2.ProjectDualReference{ProjectRef: &common.ResourceRefNamespaced{Name: "project"}},
+					ExportTo:             exportTo,
+				},
+			},
+			&akov2.AtlasPrivateEndpoint{
+				ObjectMeta: metav1.ObjectMeta{Name: "not-exported", Namespace: "default"},
+				Spec: akov2.AtlasPrivateEndpointSpec{
+					ProjectDualReference: akov2.ProjectDualReference{ProjectRef: &common.ResourceRefNamespaced{Name: "project"}},
+				},
+			},
+			&akov2.AtlasPrivateEndpoint{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-project", Namespace: "default"},
+				Spec: akov2.AtlasPrivateEndpointSpec{
+					ProjectDualReference: akov2.ProjectDualReference{ProjectRef: &common.ResourceRefNamespaced{Name: "other"}},
+					ExportTo:             exportTo,
+				},
+			},
+		).
+		Build()
+	r := &AtlasPrivateEndpointReconciler{
+		AtlasReconciler: reconciler.AtlasReconciler{Client: k8sClient, Log: zaptest.NewLogger(t).Sugar()},
+	}
+
+	deployment := &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster0", Namespace: "default"},
+		Spec: akov2.AtlasDeploymentSpec{
+			ProjectDualReference: akov2.ProjectDualReference{ProjectRef: &common.ResourceRefNamespaced{Name: "project", Namespace: "default"}},
+		},
+	}
+	assert.Equal(t,
+		[]reconcile.Request{{NamespacedName: client.ObjectKey{Name: "exported", Namespace: "default"}}},
+		r.privateEndpointForDeploymentMapFunc()(context.Background(), deployment),
+	)
+}
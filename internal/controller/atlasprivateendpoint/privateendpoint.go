@@ -17,6 +17,7 @@ package atlasprivateendpoint
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
@@ -108,6 +109,11 @@ func (r *AtlasPrivateEndpointReconciler) watchServiceState(
 		return r.inProgress(ctx, akoPrivateEndpoint, atlasPEService, api.PrivateEndpointServiceReady, workflow.PrivateEndpointServiceDeleting, "Private Endpoint is being deleted")
 	}
 
+	if err := r.export(ctx.Context, projectID, akoPrivateEndpoint, atlasPEService); err != nil {
+		wrappedErr := fmt.Errorf("failed to export private endpoint service: %w", err)
+		return r.terminate(ctx, akoPrivateEndpoint, atlasPEService, api.PrivateEndpointServiceReady, workflow.PrivateEndpointServiceNotExported, wrappedErr)
+	}
+
 	ctx.SetConditionTrue(api.PrivateEndpointServiceReady)
 	r.EventRecorder.Event(akoPrivateEndpoint, "Normal", string(workflow.PrivateEndpointServiceCreated), "Private Endpoint Service is available")
 
@@ -19,6 +19,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
@@ -44,14 +46,16 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/validate"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/teammembers"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/encryptionatrest"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/maintenancewindow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/project"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/teams"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
+// teamMembersFetchTimeout bounds the requests fetching the members of teams from HTTP sources
+const teamMembersFetchTimeout = 30 * time.Second
+
 // AtlasProjectReconciler reconciles a AtlasProject object
 type AtlasProjectReconciler struct {
 	Client                      client.Client
@@ -63,6 +67,8 @@ type AtlasProjectReconciler struct {
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
 	GlobalSecretRef             client.ObjectKey
+	CredentialProviders         reconciler.CredentialProviders
+	TeamMembers                 *teammembers.Resolver
 }
 
 type AtlasProjectServices struct {
@@ -77,11 +83,13 @@ type AtlasProjectServices struct {
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprojects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasprojects/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprojects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlasprojects/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",namespace=default,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=default,resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",namespace=default,resources=events,verbs=create;patch
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasteams,verbs=get;list;watch;create;update;patch;delete
@@ -133,27 +141,27 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return resourceVersionIsValid.ReconcileResult()
 	}
 
-	if err := validate.Project(atlasProject, r.AtlasProvider.IsCloudGov()); err != nil {
+	connectionConfig, err := reconciler.GetProjectConnectionConfig(ctx, r.Client, r.CredentialProviders, atlasProject, &r.GlobalSecretRef)
+	if err != nil {
+		result := workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err)
+		setCondition(workflowCtx, api.ProjectReadyType, result)
+		return result.ReconcileResult()
+	}
+
+	if err := validate.Project(atlasProject, r.AtlasProvider.IsCloudGov(connectionConfig.Credentials)); err != nil {
 		result := workflow.Terminate(workflow.Internal, err)
 		setCondition(workflowCtx, api.ValidationSucceeded, result)
 		return result.ReconcileResult()
 	}
 	workflowCtx.SetConditionTrue(api.ValidationSucceeded)
 
-	if !r.AtlasProvider.IsResourceSupported(atlasProject) {
+	if !r.AtlasProvider.IsResourceSupported(atlasProject, connectionConfig.Credentials) {
 		result := workflow.Terminate(workflow.AtlasGovUnsupported, errors.New("the AtlasProject is not supported by Atlas for government")).
 			WithoutRetry()
 		setCondition(workflowCtx, api.ProjectReadyType, result)
 		return result.ReconcileResult()
 	}
 
-	connectionConfig, err := reconciler.GetConnectionConfig(ctx, r.Client, atlasProject.ConnectionSecretObjectKey(), &r.GlobalSecretRef)
-	if err != nil {
-		result := workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err)
-		setCondition(workflowCtx, api.ProjectReadyType, result)
-		return result.ReconcileResult()
-	}
-
 	atlasSdkClient, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, log)
 	if err != nil {
 		result := workflow.Terminate(workflow.AtlasAPIAccessNotConfigured, err)
@@ -163,6 +171,7 @@ func (r *AtlasProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	workflowCtx.SdkClientSet = atlasSdkClient
 	workflowCtx.OrgID = connectionConfig.OrgID
+	workflowCtx.Credentials = connectionConfig.Credentials
 	services := AtlasProjectServices{}
 	services.projectService = project.NewProjectAPIService(atlasSdkClient.SdkClient20250312002.ProjectsApi)
 	services.teamsService = teams.NewTeamsAPIService(atlasSdkClient.SdkClient20250312002.TeamsApi, atlasSdkClient.SdkClient20250312002.MongoDBCloudUsersApi)
@@ -257,7 +266,7 @@ func (r *AtlasProjectReconciler) For() (client.Object, builder.Predicates) {
 	return &akov2.AtlasProject{}, builder.WithPredicates(r.GlobalPredicates...)
 }
 
-func (r *AtlasProjectReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+func (r *AtlasProjectReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasProject").
 		For(r.For()).
@@ -271,14 +280,17 @@ func (r *AtlasProjectReconciler) SetupWithManager(mgr ctrl.Manager, skipNameVali
 			handler.EnqueueRequestsFromMapFunc(newProjectsMapFunc[akov2.AtlasTeam](indexer.AtlasProjectByTeamIndex, r.Client, r.Log)),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.projectsForTeamMembersConfigMap()),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
 		Watches(
 			&akov2.AtlasBackupCompliancePolicy{},
 			handler.EnqueueRequestsFromMapFunc(newProjectsMapFunc[akov2.AtlasBackupCompliancePolicy](indexer.AtlasProjectByBackupCompliancePolicyIndex, r.Client, r.Log)),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
+		WithOptions(options).
 		Complete(r)
 }
 
@@ -289,6 +301,7 @@ func NewAtlasProjectReconciler(
 	deletionProtection bool,
 	logger *zap.Logger,
 	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
 ) *AtlasProjectReconciler {
 	return &AtlasProjectReconciler{
 		Scheme:                   c.GetScheme(),
@@ -299,6 +312,29 @@ func NewAtlasProjectReconciler(
 		AtlasProvider:            atlasProvider,
 		ObjectDeletionProtection: deletionProtection,
 		GlobalSecretRef:          globalSecretRef,
+		CredentialProviders:      credentialProviders,
+		TeamMembers:              teammembers.NewResolver(c.GetClient(), &http.Client{Timeout: teamMembersFetchTimeout}),
+	}
+}
+
+// projectsForTeamMembersConfigMap enqueues the projects assigned to the teams reading their members from the ConfigMap
+func (r *AtlasProjectReconciler) projectsForTeamMembersConfigMap() handler.MapFunc {
+	projectsForTeam := newProjectsMapFunc[akov2.AtlasTeam](indexer.AtlasProjectByTeamIndex, r.Client, r.Log)
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		teams := &akov2.AtlasTeamList{}
+		if err := r.Client.List(ctx, teams, client.InNamespace(obj.GetNamespace())); err != nil {
+			r.Log.Errorf("failed to list Atlas teams: %v", err)
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for i := range teams.Items {
+			source := teams.Items[i].Spec.MembersFrom
+			if source != nil && source.ConfigMapRef != nil && source.ConfigMapRef.Name == obj.GetName() {
+				requests = append(requests, projectsForTeam(ctx, &teams.Items[i])...)
+			}
+		}
+		return requests
 	}
 }
 
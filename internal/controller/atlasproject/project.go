@@ -72,7 +72,15 @@ func (r *AtlasProjectReconciler) handleProject(ctx *workflow.Context, orgID stri
 		return r.terminate(ctx, workflow.Internal, err)
 	}
 
-	return r.ready(ctx, projectInAtlas.ID)
+	result, err := r.ready(ctx, projectInAtlas.ID)
+	for i := range results {
+		// resources needing a periodic refresh, like teams fetching their members, requeue the project
+		if resourceResult, _ := results[i].ReconcileResult(); resourceResult.RequeueAfter > 0 &&
+			(result.RequeueAfter == 0 || resourceResult.RequeueAfter < result.RequeueAfter) {
+			result.RequeueAfter = resourceResult.RequeueAfter
+		}
+	}
+	return result, err
 }
 
 func (r *AtlasProjectReconciler) create(ctx *workflow.Context, orgID string, atlasProject *akov2.AtlasProject, projectService project.ProjectService) (ctrl.Result, error) {
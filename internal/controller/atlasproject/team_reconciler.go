@@ -18,11 +18,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"sync"
 
 	"github.com/google/go-cmp/cmp"
 	"go.mongodb.org/atlas-sdk/v20250312002/admin"
 	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
@@ -32,6 +35,7 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/teammembers"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/teams"
 )
 
@@ -60,7 +64,7 @@ func (r *AtlasProjectReconciler) teamReconcile(team *akov2.AtlasTeam, workflowCt
 			return resourceVersionIsValid.ReconcileResult()
 		}
 
-		if !r.AtlasProvider.IsResourceSupported(team) {
+		if !r.AtlasProvider.IsResourceSupported(team, workflowCtx.Credentials) {
 			result := workflow.Terminate(workflow.AtlasGovUnsupported, errors.New("the AtlasTeam is not supported by Atlas for government")).
 				WithoutRetry()
 			setCondition(teamCtx, api.ReadyType, result)
@@ -70,7 +74,14 @@ func (r *AtlasProjectReconciler) teamReconcile(team *akov2.AtlasTeam, workflowCt
 		teamCtx.OrgID = workflowCtx.OrgID
 		teamCtx.SdkClientSet = workflowCtx.SdkClientSet
 
-		teamID, result := r.ensureTeamState(teamCtx, teamsService, team)
+		members, err := r.resolveTeamMembers(teamCtx.Context, team)
+		if err != nil {
+			result := workflow.Terminate(workflow.TeamUsersNotReady, err)
+			teamCtx.SetConditionFromResult(api.ReadyType, result)
+			return result.ReconcileResult()
+		}
+
+		teamID, result := r.ensureTeamState(teamCtx, teamsService, team, members)
 		if !result.IsOk() {
 			teamCtx.SetConditionFromResult(api.ReadyType, result)
 			if result.IsWarning() {
@@ -82,7 +93,7 @@ func (r *AtlasProjectReconciler) teamReconcile(team *akov2.AtlasTeam, workflowCt
 
 		teamCtx.EnsureStatusOption(status.AtlasTeamSetID(teamID))
 
-		result = r.ensureTeamUsersAreInSync(teamCtx, teamsService, teamID, team)
+		result = r.ensureTeamUsersAreInSync(teamCtx, teamsService, teamID, members)
 		if !result.IsOk() {
 			teamCtx.SetConditionFromResult(api.ReadyType, result)
 			return result.ReconcileResult()
@@ -120,7 +131,7 @@ func (r *AtlasProjectReconciler) teamReconcile(team *akov2.AtlasTeam, workflowCt
 			}
 		}
 
-		err := customresource.ApplyLastConfigApplied(teamCtx.Context, team, r.Client)
+		err = customresource.ApplyLastConfigApplied(teamCtx.Context, team, r.Client)
 		if err != nil {
 			result = workflow.Terminate(workflow.Internal, err)
 			teamCtx.SetConditionFromResult(api.ReadyType, result)
@@ -130,11 +141,31 @@ func (r *AtlasProjectReconciler) teamReconcile(team *akov2.AtlasTeam, workflowCt
 		}
 
 		teamCtx.SetConditionTrue(api.ReadyType)
+		if members.RefreshAfter > 0 {
+			return workflow.Requeue(members.RefreshAfter).ReconcileResult()
+		}
 		return workflow.OK().ReconcileResult()
 	}
 }
 
-func (r *AtlasProjectReconciler) ensureTeamState(workflowCtx *workflow.Context, teamsService teams.TeamsService, team *akov2.AtlasTeam) (string, workflow.DeprecatedResult) {
+// resolveTeamMembers reads the members of the team from its usernames and members source,
+// teams being deleted fall back to their usernames so a missing source does not block them
+// and drop the usernames cached for them
+func (r *AtlasProjectReconciler) resolveTeamMembers(ctx context.Context, team *akov2.AtlasTeam) (*teammembers.Members, error) {
+	if team.Spec.MembersFrom == nil {
+		return teammembers.Inline(team), nil
+	}
+	members, err := r.TeamMembers.Resolve(ctx, team)
+	if !team.GetDeletionTimestamp().IsZero() {
+		r.TeamMembers.Forget(team.UID)
+		if err != nil {
+			return teammembers.Inline(team), nil
+		}
+	}
+	return members, err
+}
+
+func (r *AtlasProjectReconciler) ensureTeamState(workflowCtx *workflow.Context, teamsService teams.TeamsService, team *akov2.AtlasTeam, members *teammembers.Members) (string, workflow.DeprecatedResult) {
 	var atlasAssignedTeam *teams.AssignedTeam
 	var err error
 
@@ -152,6 +183,7 @@ func (r *AtlasProjectReconciler) ensureTeamState(workflowCtx *workflow.Context,
 		if desiredAtlasTeam == nil {
 			return "", workflow.Terminate(workflow.TeamInvalidSpec, errors.New("teamspec is invalid"))
 		}
+		desiredAtlasTeam.Usernames = members.Usernames
 
 		atlasTeam, err := r.createTeam(workflowCtx, teamsService, desiredAtlasTeam)
 		if err != nil {
@@ -168,15 +200,15 @@ func (r *AtlasProjectReconciler) ensureTeamState(workflowCtx *workflow.Context,
 	return atlasAssignedTeam.TeamID, workflow.OK()
 }
 
-func (r *AtlasProjectReconciler) ensureTeamUsersAreInSync(workflowCtx *workflow.Context, teamsService teams.TeamsService, teamID string, team *akov2.AtlasTeam) workflow.DeprecatedResult {
+func (r *AtlasProjectReconciler) ensureTeamUsersAreInSync(workflowCtx *workflow.Context, teamsService teams.TeamsService, teamID string, members *teammembers.Members) workflow.DeprecatedResult {
 	atlasUsers, err := teamsService.GetTeamUsers(workflowCtx.Context, workflowCtx.OrgID, teamID)
 	if err != nil {
 		return workflow.Terminate(workflow.TeamUsersNotReady, err)
 	}
 
 	usernamesMap := map[string]struct{}{}
-	for _, username := range team.Spec.Usernames {
-		usernamesMap[string(username)] = struct{}{}
+	for _, username := range members.Usernames {
+		usernamesMap[username] = struct{}{}
 	}
 
 	atlasUsernamesMap := map[string]teams.TeamUser{}
@@ -184,14 +216,37 @@ func (r *AtlasProjectReconciler) ensureTeamUsersAreInSync(workflowCtx *workflow.
 		atlasUsernamesMap[atlasUser.Username] = atlasUser
 	}
 
+	membersStatus := &status.TeamMembersStatus{
+		Source: members.Source,
+		Count:  len(members.Usernames),
+	}
+	if !members.FetchedAt.IsZero() {
+		membersStatus.FetchedAt = &metav1.Time{Time: members.FetchedAt}
+	}
+	defer func() {
+		slices.Sort(membersStatus.Removed)
+		slices.Sort(membersStatus.Added)
+		slices.Sort(membersStatus.Unresolved)
+		workflowCtx.EnsureStatusOption(status.AtlasTeamSetMembers(membersStatus))
+	}()
+
 	g, taskContext := errgroup.WithContext(workflowCtx.Context)
+	lock := sync.Mutex{}
 
 	for _, user := range atlasUsers {
 		if _, ok := usernamesMap[user.Username]; !ok {
 			g.Go(func() error {
 				workflowCtx.Log.Debugf("removing user %s from team %s", user.UserID, teamID)
 				err := teamsService.RemoveUser(workflowCtx.Context, workflowCtx.OrgID, teamID, user.UserID)
-				return err
+				if err != nil {
+					return err
+				}
+
+				lock.Lock()
+				membersStatus.Removed = append(membersStatus.Removed, user.Username)
+				lock.Unlock()
+
+				return nil
 			})
 		}
 	}
@@ -203,21 +258,24 @@ func (r *AtlasProjectReconciler) ensureTeamUsersAreInSync(workflowCtx *workflow.
 	}
 
 	g, taskContext = errgroup.WithContext(workflowCtx.Context)
-	toAdd := make([]teams.TeamUser, 0, len(team.Spec.Usernames))
-	lock := sync.Mutex{}
-	for i := range team.Spec.Usernames {
-		username := team.Spec.Usernames[i]
-		if _, ok := atlasUsernamesMap[string(username)]; !ok {
+	toAdd := make([]teams.TeamUser, 0, len(members.Usernames))
+	for _, username := range members.Usernames {
+		if _, ok := atlasUsernamesMap[username]; !ok {
 			g.Go(func() error {
-				user, _, err := workflowCtx.SdkClientSet.SdkClient20250312002.MongoDBCloudUsersApi.GetUserByUsername(taskContext, string(username)).Execute()
+				user, httpResp, err := workflowCtx.SdkClientSet.SdkClient20250312002.MongoDBCloudUsersApi.GetUserByUsername(taskContext, username).Execute()
 
+				lock.Lock()
+				defer lock.Unlock()
+
+				if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+					membersStatus.Unresolved = append(membersStatus.Unresolved, username)
+					return nil
+				}
 				if err != nil {
 					return err
 				}
 
-				lock.Lock()
-				toAdd = append(toAdd, teams.TeamUser{UserID: user.GetId()})
-				lock.Unlock()
+				toAdd = append(toAdd, teams.TeamUser{UserID: user.GetId(), Username: username})
 
 				return nil
 			})
@@ -230,6 +288,10 @@ func (r *AtlasProjectReconciler) ensureTeamUsersAreInSync(workflowCtx *workflow.
 		return workflow.Terminate(workflow.TeamUsersNotReady, err)
 	}
 
+	if len(membersStatus.Unresolved) > 0 {
+		workflowCtx.Log.Infof("skipping users without an Atlas account for team %s: %v", teamID, membersStatus.Unresolved)
+	}
+
 	if len(toAdd) == 0 {
 		return workflow.OK()
 	}
@@ -240,6 +302,10 @@ func (r *AtlasProjectReconciler) ensureTeamUsersAreInSync(workflowCtx *workflow.
 		return workflow.Terminate(workflow.TeamUsersNotReady, err)
 	}
 
+	for _, user := range toAdd {
+		membersStatus.Added = append(membersStatus.Added, user.Username)
+	}
+
 	return workflow.OK()
 }
 
@@ -17,16 +17,25 @@ package atlasproject
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	"go.mongodb.org/atlas-sdk/v20250312002/mockadmin"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/translation"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/teammembers"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/teams"
 )
 
@@ -205,3 +214,51 @@ func TestTeamManagedByAtlas(t *testing.T) {
 		assert.True(t, result)
 	})
 }
+
+func TestEnsureTeamUsersAreInSync(t *testing.T) {
+	ctx := context.Background()
+	// requests are executed concurrently, so each username gets its own executor
+	janeAPI := mockadmin.NewMongoDBCloudUsersApi(t)
+	janeAPI.EXPECT().GetUserByUsernameExecute(mock.Anything).
+		Return(&admin.CloudAppUser{Id: pointer.MakePtr("jane-id"), Username: "jane@example.com"}, nil, nil).Once()
+	unknownAPI := mockadmin.NewMongoDBCloudUsersApi(t)
+	unknownAPI.EXPECT().GetUserByUsernameExecute(mock.Anything).
+		Return(nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")).Once()
+	usersAPI := mockadmin.NewMongoDBCloudUsersApi(t)
+	usersAPI.EXPECT().GetUserByUsername(mock.Anything, "jane@example.com").
+		Return(admin.GetUserByUsernameApiRequest{ApiService: janeAPI}).Once()
+	usersAPI.EXPECT().GetUserByUsername(mock.Anything, "not-signed-up@example.com").
+		Return(admin.GetUserByUsernameApiRequest{ApiService: unknownAPI}).Once()
+
+	teamsService := translation.NewTeamsServiceMock(t)
+	teamsService.EXPECT().GetTeamUsers(mock.Anything, "orgID", "teamID").Return([]teams.TeamUser{
+		{UserID: "john-id", Username: "john@example.com"},
+		{UserID: "kept-id", Username: "kept@example.com"},
+	}, nil)
+	teamsService.EXPECT().RemoveUser(mock.Anything, "orgID", "teamID", "john-id").Return(nil)
+	teamsService.EXPECT().AddUsers(mock.Anything, &[]teams.TeamUser{{UserID: "jane-id", Username: "jane@example.com"}}, "orgID", "teamID").Return(nil)
+
+	team := &akov2.AtlasTeam{}
+	workflowCtx := workflow.NewContext(zap.S(), []api.Condition{}, ctx, team)
+	workflowCtx.OrgID = "orgID"
+	workflowCtx.SdkClientSet = &atlas.ClientSet{SdkClient20250312002: &admin.APIClient{MongoDBCloudUsersApi: usersAPI}}
+	fetchedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	var r AtlasProjectReconciler
+	result := r.ensureTeamUsersAreInSync(workflowCtx, teamsService, "teamID", &teammembers.Members{
+		Usernames: []string{"jane@example.com", "kept@example.com", "not-signed-up@example.com"},
+		Source:    teammembers.SourceHTTP,
+		FetchedAt: fetchedAt,
+	})
+	assert.True(t, result.IsOk())
+
+	team.UpdateStatus(workflowCtx.Conditions(), workflowCtx.StatusOptions()...)
+	assert.Equal(t, &status.TeamMembersStatus{
+		Source:     teammembers.SourceHTTP,
+		Count:      3,
+		FetchedAt:  &metav1.Time{Time: fetchedAt},
+		Added:      []string{"jane@example.com"},
+		Removed:    []string{"john@example.com"},
+		Unresolved: []string{"not-signed-up@example.com"},
+	}, team.Status.Members)
+}
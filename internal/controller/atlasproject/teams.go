@@ -16,6 +16,7 @@ package atlasproject
 
 import (
 	"errors"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	controllerruntime "sigs.k8s.io/controller-runtime"
@@ -39,6 +40,8 @@ type TeamDataContainer struct {
 
 func (r *AtlasProjectReconciler) ensureAssignedTeams(workflowCtx *workflow.Context, teamsService teams.TeamsService, project *akov2.AtlasProject) workflow.DeprecatedResult {
 	teamsToAssign := map[string]*akov2.Team{}
+	// refreshAfter is the soonest time a team needs its members fetched again
+	var refreshAfter time.Duration
 	for _, entry := range project.Spec.Teams {
 		assignedTeam := entry
 
@@ -53,7 +56,7 @@ func (r *AtlasProjectReconciler) ensureAssignedTeams(workflowCtx *workflow.Conte
 
 		team := &akov2.AtlasTeam{}
 		teamReconciler := r.teamReconcile(team, workflowCtx, teamsService)
-		_, err := teamReconciler(
+		teamResult, err := teamReconciler(
 			workflowCtx.Context,
 			controllerruntime.Request{NamespacedName: types.NamespacedName{Name: assignedTeam.TeamRef.Name, Namespace: assignedTeam.TeamRef.Namespace}},
 		)
@@ -63,6 +66,9 @@ func (r *AtlasProjectReconciler) ensureAssignedTeams(workflowCtx *workflow.Conte
 		}
 
 		teamsToAssign[team.Status.ID] = &assignedTeam
+		if teamResult.RequeueAfter > 0 && (refreshAfter == 0 || teamResult.RequeueAfter < refreshAfter) {
+			refreshAfter = teamResult.RequeueAfter
+		}
 	}
 
 	err := r.syncAssignedTeams(workflowCtx, teamsService, project.ID(), project, teamsToAssign)
@@ -78,6 +84,9 @@ func (r *AtlasProjectReconciler) ensureAssignedTeams(workflowCtx *workflow.Conte
 		workflowCtx.UnsetCondition(api.ProjectTeamsReadyType)
 	}
 
+	if refreshAfter > 0 {
+		return workflow.Requeue(refreshAfter)
+	}
 	return workflow.OK()
 }
 
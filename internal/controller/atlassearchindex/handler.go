@@ -0,0 +1,245 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlassearchindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/searchindex"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
+)
+
+const (
+	IndexTypeSearch = "search"
+	IndexTypeVector = "vectorSearch"
+
+	IndexStatusPending  = "PENDING"
+	IndexStatusBuilding = "BUILDING"
+	IndexStatusFailed   = "FAILED"
+)
+
+func (h *AtlasSearchIndexHandler) HandleInitial(ctx context.Context, index *akov2.AtlasSearchIndex) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateInitial, index)
+}
+
+func (h *AtlasSearchIndexHandler) HandleCreating(ctx context.Context, index *akov2.AtlasSearchIndex) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateCreating, index)
+}
+
+func (h *AtlasSearchIndexHandler) HandleCreated(ctx context.Context, index *akov2.AtlasSearchIndex) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateCreated, index)
+}
+
+func (h *AtlasSearchIndexHandler) HandleUpdating(ctx context.Context, index *akov2.AtlasSearchIndex) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateUpdating, index)
+}
+
+func (h *AtlasSearchIndexHandler) HandleUpdated(ctx context.Context, index *akov2.AtlasSearchIndex) (ctrlstate.Result, error) {
+	return h.upsert(ctx, state.StateUpdated, index)
+}
+
+func (h *AtlasSearchIndexHandler) HandleDeletionRequested(ctx context.Context, index *akov2.AtlasSearchIndex) (ctrlstate.Result, error) {
+	if index.Status.ID == "" || h.deletionProtection {
+		return h.unmanage(index)
+	}
+	req, err := h.newReconcileRequest(ctx, index)
+	if err != nil {
+		return h.unmanage(index)
+	}
+	err = req.service.DeleteIndex(ctx, req.projectID, req.clusterName, index.Status.ID)
+	if err != nil {
+		return result.Error(state.StateDeletionRequested, err)
+	}
+	return result.NextState(state.StateDeleting, fmt.Sprintf("Deleting search index %q", index.Spec.Name))
+}
+
+func (h *AtlasSearchIndexHandler) HandleDeleting(ctx context.Context, index *akov2.AtlasSearchIndex) (ctrlstate.Result, error) {
+	req, err := h.newReconcileRequest(ctx, index)
+	if err != nil {
+		return h.unmanage(index)
+	}
+	_, err = req.service.GetIndex(ctx, req.projectID, req.clusterName, index.Spec.Name, index.Status.ID)
+	switch {
+	case errors.Is(err, searchindex.ErrNotFound):
+		return h.unmanage(index)
+	case err != nil:
+		return result.Error(state.StateDeleting, err)
+	}
+	return result.NextState(state.StateDeleting, fmt.Sprintf("Deleting search index %q", index.Spec.Name))
+}
+
+func (h *AtlasSearchIndexHandler) upsert(ctx context.Context, currentState state.ResourceState, index *akov2.AtlasSearchIndex) (ctrlstate.Result, error) {
+	req, err := h.newReconcileRequest(ctx, index)
+	if err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to build reconcile request: %w", err))
+	}
+	desired, err := h.desiredIndex(ctx, index)
+	if err != nil {
+		return result.Error(currentState, err)
+	}
+
+	current, err := h.find(ctx, req)
+	switch {
+	case errors.Is(err, searchindex.ErrNotFound):
+		created, err := req.service.CreateIndex(ctx, req.projectID, req.clusterName, desired)
+		if err != nil {
+			return result.Error(currentState, fmt.Errorf("failed to create search index %q: %w", index.Spec.Name, err))
+		}
+		if err := h.recordStatus(ctx, req, created); err != nil {
+			return result.Error(currentState, err)
+		}
+		return result.NextState(state.StateCreating, fmt.Sprintf("Creating search index %q", index.Spec.Name))
+	case err != nil:
+		return result.Error(currentState, err)
+	}
+
+	if err := h.recordStatus(ctx, req, current); err != nil {
+		return result.Error(currentState, err)
+	}
+
+	// Atlas is still building the index, changes are applied once it is done
+	if current.GetStatus() == IndexStatusPending || current.GetStatus() == IndexStatusBuilding {
+		return result.NextState(transitionalState(currentState), statusMessage(current))
+	}
+
+	equal, err := desired.EqualTo(current)
+	if err != nil {
+		return result.Error(currentState, fmt.Errorf("failed to compare search index %q: %w", index.Spec.Name, err))
+	}
+	if !equal {
+		desired.ID = current.ID
+		updated, err := req.service.UpdateIndex(ctx, req.projectID, req.clusterName, desired)
+		if err != nil {
+			return result.Error(currentState, fmt.Errorf("failed to update search index %q: %w", index.Spec.Name, err))
+		}
+		if err := h.recordStatus(ctx, req, updated); err != nil {
+			return result.Error(currentState, err)
+		}
+		return result.NextState(state.StateUpdating, fmt.Sprintf("Updating search index %q", index.Spec.Name))
+	}
+
+	if current.GetStatus() == IndexStatusFailed {
+		return result.Error(currentState, fmt.Errorf("search index %q failed to build", index.Spec.Name))
+	}
+	return result.NextState(settledState(currentState), statusMessage(current))
+}
+
+// find looks the index up by the recorded ID, falling back to its name to adopt
+// indexes created out of the operator
+func (h *AtlasSearchIndexHandler) find(ctx context.Context, req *reconcileRequest) (*searchindex.SearchIndex, error) {
+	spec := &req.index.Spec
+	if req.index.Status.ID != "" {
+		current, err := req.service.GetIndex(ctx, req.projectID, req.clusterName, spec.Name, req.index.Status.ID)
+		if !errors.Is(err, searchindex.ErrNotFound) {
+			return current, err
+		}
+	}
+	return req.service.GetIndexByName(ctx, req.projectID, req.clusterName, spec.DBName, spec.CollectionName, spec.Name)
+}
+
+func (h *AtlasSearchIndexHandler) desiredIndex(ctx context.Context, index *akov2.AtlasSearchIndex) (*searchindex.SearchIndex, error) {
+	spec := &index.Spec.SearchIndex
+	switch spec.Type {
+	case IndexTypeSearch:
+		if spec.Search == nil {
+			return nil, fmt.Errorf("index %q has type %q but the spec is missing", spec.Name, IndexTypeSearch)
+		}
+		config := &akov2.AtlasSearchIndexConfig{}
+		key := *spec.Search.SearchConfigurationRef.GetObject(index.GetNamespace())
+		if err := h.Client.Get(ctx, key, config); err != nil {
+			return nil, fmt.Errorf("failed to get search index configuration %s: %w", key, err)
+		}
+		return searchindex.NewSearchIndex(spec, &config.Spec), nil
+	case IndexTypeVector:
+		return searchindex.NewSearchIndex(spec, &akov2.AtlasSearchIndexConfigSpec{}), nil
+	default:
+		return nil, fmt.Errorf("index %q has unknown type %q", spec.Name, spec.Type)
+	}
+}
+
+func (h *AtlasSearchIndexHandler) recordStatus(ctx context.Context, req *reconcileRequest, current *searchindex.SearchIndex) error {
+	index := req.index
+	observed := status.AtlasSearchIndexStatus{
+		UnifiedStatus: index.Status.UnifiedStatus,
+		ID:            current.GetID(),
+		ProjectID:     req.projectID,
+		ClusterName:   req.clusterName,
+		IndexStatus:   current.GetStatus(),
+		Queryable:     current.IsQueryable(),
+	}
+	for _, host := range current.Hosts {
+		observed.Hosts = append(observed.Hosts, status.SearchIndexHostStatus{
+			Hostname:  host.Hostname,
+			Status:    host.Status,
+			Queryable: host.Queryable,
+		})
+	}
+	if equality.Semantic.DeepEqual(index.Status, observed) {
+		return nil
+	}
+	index.Status = observed
+	return h.patchNonConditionStatus(ctx, index)
+}
+
+func transitionalState(currentState state.ResourceState) state.ResourceState {
+	switch currentState {
+	case state.StateInitial, state.StateCreating:
+		return state.StateCreating
+	default:
+		return state.StateUpdating
+	}
+}
+
+func settledState(currentState state.ResourceState) state.ResourceState {
+	switch currentState {
+	case state.StateInitial, state.StateCreating, state.StateCreated:
+		return state.StateCreated
+	default:
+		return state.StateUpdated
+	}
+}
+
+func statusMessage(index *searchindex.SearchIndex) string {
+	if index.IsQueryable() {
+		return fmt.Sprintf("Search index %q is %s and queryable", index.Name, index.GetStatus())
+	}
+	return fmt.Sprintf("Search index %q is %s and not queryable yet", index.Name, index.GetStatus())
+}
+
+func (h *AtlasSearchIndexHandler) unmanage(index *akov2.AtlasSearchIndex) (ctrlstate.Result, error) {
+	return result.NextState(state.StateDeleted, fmt.Sprintf("Removed search index %q", index.Spec.Name))
+}
+
+func (h *AtlasSearchIndexHandler) patchNonConditionStatus(ctx context.Context, index *akov2.AtlasSearchIndex) error {
+	statusJSON, err := json.Marshal(index)
+	if err != nil {
+		return fmt.Errorf("failed to marshal status: %w", err)
+	}
+	if err := h.Client.Status().Patch(ctx, index, client.RawPatch(types.MergePatchType, statusJSON)); err != nil {
+		return fmt.Errorf("failed to patch: %w", err)
+	}
+	return nil
+}
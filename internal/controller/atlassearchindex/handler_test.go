@@ -0,0 +1,452 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlassearchindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	atlasmock "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/searchindex"
+	fakesearch "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/searchindex/fake"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/result"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/state"
+)
+
+//nolint:gosec
+const (
+	fakeOrgID       = "fake-org-id"
+	fakeProjectID   = "fake-project-id"
+	fakeClusterName = "my-cluster"
+	fakeIndexID     = "fake-index-id"
+)
+
+var fakeAtlasSecret = corev1.Secret{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "atlas-credentials",
+		Namespace: "default",
+	},
+	Data: map[string][]byte{
+		"orgId":         []byte(fakeOrgID),
+		"publicApiKey":  []byte("fake-api-key"),
+		"privateApiKey": []byte("fake-api-secret"),
+	},
+}
+
+var fakeProject = akov2.AtlasProject{
+	ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "default"},
+	Status:     status.AtlasProjectStatus{ID: fakeProjectID},
+}
+
+var fakeDeployment = akov2.AtlasDeployment{
+	ObjectMeta: metav1.ObjectMeta{Name: "my-deployment", Namespace: "default"},
+	Spec: akov2.AtlasDeploymentSpec{
+		ProjectDualReference: akov2.ProjectDualReference{
+			ProjectRef: &common.ResourceRefNamespaced{Name: "my-project"},
+		},
+		DeploymentSpec: &akov2.AdvancedDeploymentSpec{Name: fakeClusterName},
+	},
+}
+
+var fakeSearchConfig = akov2.AtlasSearchIndexConfig{
+	ObjectMeta: metav1.ObjectMeta{Name: "my-config", Namespace: "default"},
+	Spec:       akov2.AtlasSearchIndexConfigSpec{Analyzer: pointer.MakePtr("lucene.standard")},
+}
+
+var fakeProvider = &atlasmock.TestProvider{
+	SdkClientSetFunc: func(ctx context.Context, creds *atlas.Credentials, log *zap.SugaredLogger) (*atlas.ClientSet, error) {
+		return &atlas.ClientSet{}, nil
+	},
+}
+
+var creating = ctrlstate.Result{
+	Result:    reconcile.Result{RequeueAfter: result.DefaultRequeueTIme},
+	NextState: state.StateCreating,
+}
+
+func vectorIndex() *akov2.AtlasSearchIndex {
+	return &akov2.AtlasSearchIndex{
+		ObjectMeta: metav1.ObjectMeta{Name: "embeddings", Namespace: "default"},
+		Spec: akov2.AtlasSearchIndexSpec{
+			DeploymentRef: &common.ResourceRefNamespaced{Name: "my-deployment"},
+			SearchIndex: akov2.SearchIndex{
+				Name:           "embeddings",
+				DBName:         "db",
+				CollectionName: "movies",
+				Type:           IndexTypeVector,
+				VectorSearch: &akov2.VectorSearch{
+					Fields: &apiextensionsv1.JSON{Raw: []byte(`[{"type":"vector","path":"plot","numDimensions":3,"similarity":"cosine"}]`)},
+				},
+			},
+		},
+	}
+}
+
+func searchIndex() *akov2.AtlasSearchIndex {
+	return &akov2.AtlasSearchIndex{
+		ObjectMeta: metav1.ObjectMeta{Name: "titles", Namespace: "default"},
+		Spec: akov2.AtlasSearchIndexSpec{
+			ExternalDeploymentRef: &akov2.ExternalDeploymentReference{ProjectID: fakeProjectID, ClusterName: fakeClusterName},
+			ConnectionSecret:      &api.LocalObjectReference{Name: "atlas-credentials"},
+			SearchIndex: akov2.SearchIndex{
+				Name:           "titles",
+				DBName:         "db",
+				CollectionName: "movies",
+				Type:           IndexTypeSearch,
+				Search: &akov2.Search{
+					Mappings:               &akov2.Mappings{Dynamic: pointer.MakePtr(true)},
+					SearchConfigurationRef: common.ResourceRefNamespaced{Name: "my-config"},
+				},
+			},
+		},
+	}
+}
+
+func withID(index *akov2.AtlasSearchIndex) *akov2.AtlasSearchIndex {
+	index.Status.ID = fakeIndexID
+	return index
+}
+
+// inAtlas returns the Atlas side of the given index with its build status
+func inAtlas(index *akov2.AtlasSearchIndex, config *akov2.AtlasSearchIndexConfigSpec, indexStatus string, queryable bool) *searchindex.SearchIndex {
+	if config == nil {
+		config = &akov2.AtlasSearchIndexConfigSpec{}
+	}
+	atlasIndex := searchindex.NewSearchIndex(&index.Spec.SearchIndex, config)
+	atlasIndex.ID = pointer.MakePtr(fakeIndexID)
+	atlasIndex.Status = pointer.MakePtr(indexStatus)
+	atlasIndex.Queryable = pointer.MakePtr(queryable)
+	return atlasIndex
+}
+
+func TestHandleUpsert(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name       string
+		state      state.ResourceState
+		input      *akov2.AtlasSearchIndex
+		service    func(t *testing.T) *fakesearch.FakeAtlasSearch
+		want       ctrlstate.Result
+		wantErr    string
+		wantStatus status.AtlasSearchIndexStatus
+	}{
+		{
+			name:  "initial creates missing indexes",
+			state: state.StateInitial,
+			input: vectorIndex(),
+			service: func(t *testing.T) *fakesearch.FakeAtlasSearch {
+				return &fakesearch.FakeAtlasSearch{
+					GetIndexByNameFunc: func(_ context.Context, projectID, clusterName, dbName, collectionName, indexName string) (*searchindex.SearchIndex, error) {
+						assert.Equal(t, []string{fakeProjectID, fakeClusterName, "db", "movies", "embeddings"},
+							[]string{projectID, clusterName, dbName, collectionName, indexName})
+						return nil, searchindex.ErrNotFound
+					},
+					CreateIndexFunc: func(_ context.Context, _, _ string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+						assert.Nil(t, index.ID)
+						return inAtlas(vectorIndex(), nil, IndexStatusPending, false), nil
+					},
+				}
+			},
+			want: withMsg(creating, "Creating search index \"embeddings\"."),
+			wantStatus: status.AtlasSearchIndexStatus{
+				ID: fakeIndexID, ProjectID: fakeProjectID, ClusterName: fakeClusterName, IndexStatus: IndexStatusPending,
+			},
+		},
+		{
+			name:  "initial adopts existing indexes",
+			state: state.StateInitial,
+			input: vectorIndex(),
+			service: func(t *testing.T) *fakesearch.FakeAtlasSearch {
+				return &fakesearch.FakeAtlasSearch{
+					GetIndexByNameFunc: func(context.Context, string, string, string, string, string) (*searchindex.SearchIndex, error) {
+						return inAtlas(vectorIndex(), nil, "READY", true), nil
+					},
+				}
+			},
+			want: ctrlstate.Result{
+				NextState: state.StateCreated,
+				StateMsg:  "Search index \"embeddings\" is READY and queryable.",
+			},
+			wantStatus: status.AtlasSearchIndexStatus{
+				ID: fakeIndexID, ProjectID: fakeProjectID, ClusterName: fakeClusterName, IndexStatus: "READY", Queryable: true,
+			},
+		},
+		{
+			name:  "creating waits for the index to be built",
+			state: state.StateCreating,
+			input: withID(vectorIndex()),
+			service: func(t *testing.T) *fakesearch.FakeAtlasSearch {
+				return &fakesearch.FakeAtlasSearch{
+					GetIndexFunc: func(_ context.Context, _, _, _, indexID string) (*searchindex.SearchIndex, error) {
+						assert.Equal(t, fakeIndexID, indexID)
+						atlasIndex := inAtlas(vectorIndex(), nil, IndexStatusBuilding, false)
+						atlasIndex.Hosts = []searchindex.HostStatus{{Hostname: "host-0", Status: IndexStatusBuilding}}
+						return atlasIndex, nil
+					},
+				}
+			},
+			want: withMsg(creating, "Search index \"embeddings\" is BUILDING and not queryable yet."),
+			wantStatus: status.AtlasSearchIndexStatus{
+				ID: fakeIndexID, ProjectID: fakeProjectID, ClusterName: fakeClusterName, IndexStatus: IndexStatusBuilding,
+				Hosts: []status.SearchIndexHostStatus{{Hostname: "host-0", Status: IndexStatusBuilding}},
+			},
+		},
+		{
+			name:  "updated indexes with a different definition are updated",
+			state: state.StateUpdated,
+			input: withID(searchIndex()),
+			service: func(t *testing.T) *fakesearch.FakeAtlasSearch {
+				return &fakesearch.FakeAtlasSearch{
+					GetIndexFunc: func(context.Context, string, string, string, string) (*searchindex.SearchIndex, error) {
+						return inAtlas(searchIndex(), &akov2.AtlasSearchIndexConfigSpec{Analyzer: pointer.MakePtr("lucene.simple")}, "READY", true), nil
+					},
+					UpdateIndexFunc: func(_ context.Context, _, _ string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+						assert.Equal(t, fakeIndexID, index.GetID())
+						assert.Equal(t, "lucene.standard", *index.Analyzer)
+						return inAtlas(searchIndex(), &fakeSearchConfig.Spec, IndexStatusPending, true), nil
+					},
+				}
+			},
+			want: ctrlstate.Result{
+				Result:    reconcile.Result{RequeueAfter: result.DefaultRequeueTIme},
+				NextState: state.StateUpdating,
+				StateMsg:  "Updating search index \"titles\".",
+			},
+			wantStatus: status.AtlasSearchIndexStatus{
+				ID: fakeIndexID, ProjectID: fakeProjectID, ClusterName: fakeClusterName, IndexStatus: IndexStatusPending, Queryable: true,
+			},
+		},
+		{
+			name:  "failed builds are reported",
+			state: state.StateCreating,
+			input: withID(searchIndex()),
+			service: func(t *testing.T) *fakesearch.FakeAtlasSearch {
+				return &fakesearch.FakeAtlasSearch{
+					GetIndexFunc: func(context.Context, string, string, string, string) (*searchindex.SearchIndex, error) {
+						return inAtlas(searchIndex(), &fakeSearchConfig.Spec, IndexStatusFailed, false), nil
+					},
+				}
+			},
+			want:    ctrlstate.Result{NextState: state.StateCreating},
+			wantErr: "search index \"titles\" failed to build",
+			wantStatus: status.AtlasSearchIndexStatus{
+				ID: fakeIndexID, ProjectID: fakeProjectID, ClusterName: fakeClusterName, IndexStatus: IndexStatusFailed,
+			},
+		},
+		{
+			name:  "missing search configurations are reported",
+			state: state.StateInitial,
+			input: func() *akov2.AtlasSearchIndex {
+				index := searchIndex()
+				index.Spec.Search.SearchConfigurationRef.Name = "missing"
+				return index
+			}(),
+			service: func(t *testing.T) *fakesearch.FakeAtlasSearch {
+				return &fakesearch.FakeAtlasSearch{}
+			},
+			want:    ctrlstate.Result{NextState: state.StateInitial},
+			wantErr: "failed to get search index configuration default/missing",
+		},
+		{
+			name:  "creation failures are reported",
+			state: state.StateInitial,
+			input: searchIndex(),
+			service: func(t *testing.T) *fakesearch.FakeAtlasSearch {
+				return &fakesearch.FakeAtlasSearch{
+					GetIndexByNameFunc: func(context.Context, string, string, string, string, string) (*searchindex.SearchIndex, error) {
+						return nil, searchindex.ErrNotFound
+					},
+					CreateIndexFunc: func(context.Context, string, string, *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
+						return nil, errors.New("fake-failure")
+					},
+				}
+			},
+			want:    ctrlstate.Result{NextState: state.StateInitial},
+			wantErr: "fake-failure",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(&fakeAtlasSecret, &fakeProject, &fakeDeployment, &fakeSearchConfig, tc.input).
+				WithStatusSubresource(tc.input).Build()
+			svc := tc.service(t)
+			h := AtlasSearchIndexHandler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Client:          k8sClient,
+					AtlasProvider:   fakeProvider,
+					GlobalSecretRef: client.ObjectKeyFromObject(&fakeAtlasSecret),
+				},
+				serviceBuilder: func(*atlas.ClientSet) searchindex.AtlasSearchIdxService { return svc },
+			}
+			var handle func(context.Context, *akov2.AtlasSearchIndex) (ctrlstate.Result, error)
+			switch tc.state {
+			case state.StateInitial:
+				handle = h.HandleInitial
+			case state.StateCreating:
+				handle = h.HandleCreating
+			case state.StateUpdated:
+				handle = h.HandleUpdated
+			default:
+				panic(fmt.Errorf("unsupported state %v for test", tc.state))
+			}
+			got, err := handle(ctx, tc.input)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+			assert.Equal(t, tc.want, got)
+			index := &akov2.AtlasSearchIndex{}
+			require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(tc.input), index))
+			assert.Equal(t, tc.wantStatus, index.Status)
+		})
+	}
+}
+
+func TestHandleDeletion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, akov2.AddToScheme(scheme))
+	ctx := context.Background()
+	deleting := ctrlstate.Result{
+		Result:    reconcile.Result{RequeueAfter: result.DefaultRequeueTIme},
+		NextState: state.StateDeleting,
+		StateMsg:  "Deleting search index \"embeddings\".",
+	}
+	removed := ctrlstate.Result{
+		NextState: state.StateDeleted,
+		StateMsg:  "Removed search index \"embeddings\".",
+	}
+
+	for _, tc := range []struct {
+		name               string
+		state              state.ResourceState
+		deletionProtection bool
+		service            *fakesearch.FakeAtlasSearch
+		want               ctrlstate.Result
+		wantErr            string
+	}{
+		{
+			name:  "indexes are deleted",
+			state: state.StateDeletionRequested,
+			service: &fakesearch.FakeAtlasSearch{
+				DeleteIndexFunc: func(_ context.Context, projectID, clusterName, indexID string) error {
+					if projectID != fakeProjectID || clusterName != fakeClusterName || indexID != fakeIndexID {
+						return fmt.Errorf("unexpected index %s/%s/%s", projectID, clusterName, indexID)
+					}
+					return nil
+				},
+			},
+			want: deleting,
+		},
+		{
+			name:  "deletion failures are reported",
+			state: state.StateDeletionRequested,
+			service: &fakesearch.FakeAtlasSearch{
+				DeleteIndexFunc: func(context.Context, string, string, string) error {
+					return errors.New("fake-failure")
+				},
+			},
+			want:    ctrlstate.Result{NextState: state.StateDeletionRequested},
+			wantErr: "fake-failure",
+		},
+		{
+			name:               "deletion protection keeps indexes in Atlas",
+			state:              state.StateDeletionRequested,
+			deletionProtection: true,
+			service:            &fakesearch.FakeAtlasSearch{},
+			want:               removed,
+		},
+		{
+			name:  "deleting waits for indexes to be gone",
+			state: state.StateDeleting,
+			service: &fakesearch.FakeAtlasSearch{
+				GetIndexFunc: func(context.Context, string, string, string, string) (*searchindex.SearchIndex, error) {
+					return inAtlas(vectorIndex(), nil, "DELETING", false), nil
+				},
+			},
+			want: deleting,
+		},
+		{
+			name:  "deleting releases indexes gone from Atlas",
+			state: state.StateDeleting,
+			service: &fakesearch.FakeAtlasSearch{
+				GetIndexFunc: func(context.Context, string, string, string, string) (*searchindex.SearchIndex, error) {
+					return nil, searchindex.ErrNotFound
+				},
+			},
+			want: removed,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			input := withID(vectorIndex())
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(&fakeAtlasSecret, &fakeProject, &fakeDeployment, input).
+				WithStatusSubresource(input).Build()
+			h := AtlasSearchIndexHandler{
+				AtlasReconciler: reconciler.AtlasReconciler{
+					Client:          k8sClient,
+					AtlasProvider:   fakeProvider,
+					GlobalSecretRef: client.ObjectKeyFromObject(&fakeAtlasSecret),
+				},
+				deletionProtection: tc.deletionProtection,
+				serviceBuilder:     func(*atlas.ClientSet) searchindex.AtlasSearchIdxService { return tc.service },
+			}
+			handle := h.HandleDeletionRequested
+			if tc.state == state.StateDeleting {
+				handle = h.HandleDeleting
+			}
+			got, err := handle(ctx, input)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func withMsg(res ctrlstate.Result, msg string) ctrlstate.Result {
+	res.StateMsg = msg
+	return res
+}
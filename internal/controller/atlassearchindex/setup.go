@@ -0,0 +1,232 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlassearchindex
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlrtbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/searchindex"
+	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
+	mckpredicate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/predicate"
+)
+
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlassearchindices,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlassearchindices/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlassearchindices/finalizers,verbs=update
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlassearchindices,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlassearchindices/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=atlas.mongodb.com,namespace=default,resources=atlassearchindices/finalizers,verbs=update
+
+type serviceBuilderFunc func(*atlas.ClientSet) searchindex.AtlasSearchIdxService
+
+type AtlasSearchIndexHandler struct {
+	ctrlstate.StateHandler[akov2.AtlasSearchIndex]
+	reconciler.AtlasReconciler
+	deletionProtection bool
+	serviceBuilder     serviceBuilderFunc
+}
+
+func NewAtlasSearchIndexReconciler(
+	c cluster.Cluster,
+	atlasProvider atlas.Provider,
+	deletionProtection bool,
+	logger *zap.Logger,
+	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
+	reapplySupport bool,
+) *ctrlstate.Reconciler[akov2.AtlasSearchIndex] {
+	searchIndexHandler := &AtlasSearchIndexHandler{
+		AtlasReconciler: reconciler.AtlasReconciler{
+			Client:              c.GetClient(),
+			AtlasProvider:       atlasProvider,
+			Log:                 logger.Named("controllers").Named("AtlasSearchIndex").Sugar(),
+			GlobalSecretRef:     globalSecretRef,
+			CredentialProviders: credentialProviders,
+		},
+		deletionProtection: deletionProtection,
+		serviceBuilder: func(clientSet *atlas.ClientSet) searchindex.AtlasSearchIdxService {
+			return searchindex.NewSearchIndexes(clientSet.SdkClient20250312002.AtlasSearchApi)
+		},
+	}
+	return ctrlstate.NewStateReconciler(
+		searchIndexHandler,
+		ctrlstate.WithCluster[akov2.AtlasSearchIndex](c),
+		ctrlstate.WithReapplySupport[akov2.AtlasSearchIndex](reapplySupport),
+	)
+}
+
+// For prepares the controller for its target Custom Resource; AtlasSearchIndex
+func (h *AtlasSearchIndexHandler) For() (client.Object, builder.Predicates) {
+	obj := &akov2.AtlasSearchIndex{}
+	return obj, ctrlrtbuilder.WithPredicates(
+		predicate.Or(
+			mckpredicate.AnnotationChanged("mongodb.com/reapply-period"),
+			predicate.GenerationChangedPredicate{},
+		),
+		mckpredicate.IgnoreDeletedPredicate[client.Object](),
+	)
+}
+
+func (h *AtlasSearchIndexHandler) SetupWithManager(mgr ctrl.Manager, rec reconcile.Reconciler, defaultOptions controller.Options) error {
+	h.Client = mgr.GetClient()
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named("AtlasSearchIndex").
+		For(h.For()).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(h.searchIndexForCredentialMapFunc()),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Watches(
+			&akov2.AtlasSearchIndexConfig{},
+			handler.EnqueueRequestsFromMapFunc(h.searchIndexForConfigMapFunc()),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		WithOptions(defaultOptions).Complete(rec)
+}
+
+func (h *AtlasSearchIndexHandler) searchIndexForCredentialMapFunc() handler.MapFunc {
+	return indexer.CredentialsIndexMapperFunc(
+		indexer.AtlasSearchIndexBySecretsIndex,
+		func() *akov2.AtlasSearchIndexList { return &akov2.AtlasSearchIndexList{} },
+		indexer.AtlasSearchIndexRequests,
+		h.Client,
+		h.Log,
+	)
+}
+
+func (h *AtlasSearchIndexHandler) searchIndexForConfigMapFunc() handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		config, ok := obj.(*akov2.AtlasSearchIndexConfig)
+		if !ok {
+			h.Log.Warnf("watching AtlasSearchIndexConfig but got %T", obj)
+			return nil
+		}
+
+		indexes := &akov2.AtlasSearchIndexList{}
+		listOpts := &client.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector(
+				indexer.AtlasSearchIndexByConfigIndex,
+				client.ObjectKeyFromObject(config).String(),
+			),
+		}
+		if err := h.Client.List(ctx, indexes, listOpts); err != nil {
+			h.Log.Errorf("failed to list AtlasSearchIndex: %s", err)
+			return nil
+		}
+		return indexer.AtlasSearchIndexRequests(indexes)
+	}
+}
+
+type reconcileRequest struct {
+	service     searchindex.AtlasSearchIdxService
+	index       *akov2.AtlasSearchIndex
+	projectID   string
+	clusterName string
+}
+
+func (h *AtlasSearchIndexHandler) newReconcileRequest(ctx context.Context, index *akov2.AtlasSearchIndex) (*reconcileRequest, error) {
+	req := &reconcileRequest{index: index}
+	var cfg *atlas.ConnectionConfig
+	var err error
+	if ref := index.Spec.ExternalDeploymentRef; ref != nil {
+		req.projectID = ref.ProjectID
+		req.clusterName = ref.ClusterName
+		cfg, err = h.connectionConfig(ctx, index)
+	} else {
+		cfg, err = h.resolveDeployment(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sdkClientSet, err := h.AtlasProvider.SdkClientSet(ctx, cfg.Credentials, h.Log)
+	if err != nil {
+		return nil, err
+	}
+	req.service = h.serviceBuilder(sdkClientSet)
+	return req, nil
+}
+
+// resolveDeployment fills in the project and cluster of the referenced AtlasDeployment
+// and returns its credentials, unless the index defines its own
+func (h *AtlasSearchIndexHandler) resolveDeployment(ctx context.Context, req *reconcileRequest) (*atlas.ConnectionConfig, error) {
+	deployment := &akov2.AtlasDeployment{}
+	key := *req.index.Spec.DeploymentRef.GetObject(req.index.GetNamespace())
+	if err := h.Client.Get(ctx, key, deployment); err != nil {
+		return nil, fmt.Errorf("failed to get deployment %s: %w", key, err)
+	}
+	req.clusterName = deployment.GetDeploymentName()
+
+	projectID, err := h.projectID(ctx, deployment)
+	if err != nil {
+		return nil, err
+	}
+	req.projectID = projectID
+
+	if req.index.Spec.ConnectionSecret != nil {
+		return h.connectionConfig(ctx, req.index)
+	}
+	return h.ResolveConnectionConfig(ctx, deployment)
+}
+
+func (h *AtlasSearchIndexHandler) projectID(ctx context.Context, deployment *akov2.AtlasDeployment) (string, error) {
+	ref := deployment.ProjectDualRef()
+	if ref.ExternalProjectRef != nil {
+		return ref.ExternalProjectRef.ID, nil
+	}
+	if ref.ProjectRef == nil {
+		return "", fmt.Errorf("deployment %s has no project reference", client.ObjectKeyFromObject(deployment))
+	}
+	project := &akov2.AtlasProject{}
+	key := *ref.ProjectRef.GetObject(deployment.GetNamespace())
+	if err := h.Client.Get(ctx, key, project); err != nil {
+		return "", fmt.Errorf("failed to get project %s: %w", key, err)
+	}
+	if project.ID() == "" {
+		return "", fmt.Errorf("project %s is not ready yet", key)
+	}
+	return project.ID(), nil
+}
+
+func (h *AtlasSearchIndexHandler) connectionConfig(ctx context.Context, index *akov2.AtlasSearchIndex) (*atlas.ConnectionConfig, error) {
+	var objKey *client.ObjectKey
+	if index.Spec.ConnectionSecret != nil && index.Spec.ConnectionSecret.Name != "" {
+		objKey = &client.ObjectKey{
+			Namespace: index.GetNamespace(),
+			Name:      index.Spec.ConnectionSecret.Name,
+		}
+	}
+	return reconciler.GetConnectionConfig(ctx, h.Client, objKey, &h.GlobalSecretRef)
+}
@@ -36,11 +36,10 @@ import (
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlassearchindexconfigs,verbs=get;list;watch;create;update;patch;delete
@@ -61,6 +60,8 @@ type AtlasSearchIndexConfigReconciler struct {
 	Log                         *zap.SugaredLogger
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
+	GlobalSecretRef             client.ObjectKey
+	CredentialProviders         reconciler.CredentialProviders
 }
 
 func (r *AtlasSearchIndexConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -86,10 +87,6 @@ func (r *AtlasSearchIndexConfigReconciler) Reconcile(ctx context.Context, req ct
 		return r.invalidate(isValid)
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(atlasSearchIndexConfig) {
-		return r.unsupport(workflowCtx)
-	}
-
 	deployments := &akov2.AtlasDeploymentList{}
 	listOps := &client.ListOptions{
 		FieldSelector: fields.OneTermEqualSelector(
@@ -102,6 +99,24 @@ func (r *AtlasSearchIndexConfigReconciler) Reconcile(ctx context.Context, req ct
 		return r.terminate(workflowCtx, workflow.Internal, err)
 	}
 
+	credentialsResolver := reconciler.AtlasReconciler{
+		Client:              r.Client,
+		Log:                 r.Log,
+		GlobalSecretRef:     r.GlobalSecretRef,
+		CredentialProviders: r.CredentialProviders,
+	}
+	referrers := make([]*atlas.ConnectionConfig, 0, len(deployments.Items))
+	for i := range deployments.Items {
+		connectionConfig, err := credentialsResolver.ResolveConnectionConfig(ctx, &deployments.Items[i])
+		if err != nil {
+			return r.terminate(workflowCtx, workflow.AtlasAPIAccessNotConfigured, err)
+		}
+		referrers = append(referrers, connectionConfig)
+	}
+	if !reconciler.IsSupportedByReferrers(r.AtlasProvider, atlasSearchIndexConfig, referrers) {
+		return r.unsupport(workflowCtx)
+	}
+
 	if len(deployments.Items) > 0 {
 		// set finalizer
 		return r.lock(workflowCtx, atlasSearchIndexConfig)
@@ -115,7 +130,7 @@ func (r *AtlasSearchIndexConfigReconciler) For() (client.Object, builder.Predica
 	return &akov2.AtlasSearchIndexConfig{}, builder.WithPredicates(r.GlobalPredicates...)
 }
 
-func (r *AtlasSearchIndexConfigReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+func (r *AtlasSearchIndexConfigReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasSearchIndexConfig").
 		For(r.For()).
@@ -124,9 +139,7 @@ func (r *AtlasSearchIndexConfigReconciler) SetupWithManager(mgr ctrl.Manager, sk
 			handler.EnqueueRequestsFromMapFunc(r.findReferencesInAtlasDeployments),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
+		WithOptions(options).
 		Complete(r)
 }
 
@@ -136,6 +149,8 @@ func NewAtlasSearchIndexConfigReconciler(
 	atlasProvider atlas.Provider,
 	deletionProtection bool,
 	logger *zap.Logger,
+	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
 ) *AtlasSearchIndexConfigReconciler {
 	return &AtlasSearchIndexConfigReconciler{
 		Scheme:                   c.GetScheme(),
@@ -145,6 +160,8 @@ func NewAtlasSearchIndexConfigReconciler(
 		Log:                      logger.Named("controllers").Named("AtlasSearchIndexConfig").Sugar(),
 		AtlasProvider:            atlasProvider,
 		ObjectDeletionProtection: deletionProtection,
+		GlobalSecretRef:          globalSecretRef,
+		CredentialProviders:      credentialProviders,
 	}
 }
 
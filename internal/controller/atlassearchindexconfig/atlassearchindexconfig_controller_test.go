@@ -34,7 +34,6 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
@@ -156,18 +155,27 @@ func TestAtlasSearchIndexConfigReconciler_Reconcile(t *testing.T) {
 				Namespace: "mongodb-atlas-system",
 			},
 		}
+		atlasDeployment := referringDeployment(searchIndexConfig)
 		testScheme := runtime.NewScheme()
 		assert.NoError(t, akov2.AddToScheme(testScheme))
+		assert.NoError(t, corev1.AddToScheme(testScheme))
+		deploymentIndexer := indexer.NewAtlasDeploymentBySearchIndexIndexer(zaptest.NewLogger(t))
 		k8sClient := fake.NewClientBuilder().
 			WithScheme(testScheme).
-			WithObjects(searchIndexConfig).
+			WithObjects(searchIndexConfig, atlasDeployment, globalSecret()).
 			WithStatusSubresource(searchIndexConfig).
+			WithIndex(
+				deploymentIndexer.Object(),
+				deploymentIndexer.Name(),
+				deploymentIndexer.Keys,
+			).
 			Build()
 
 		reconciler := &AtlasSearchIndexConfigReconciler{
-			Client:        k8sClient,
-			Log:           zaptest.NewLogger(t).Sugar(),
-			EventRecorder: record.NewFakeRecorder(1),
+			Client:          k8sClient,
+			Log:             zaptest.NewLogger(t).Sugar(),
+			EventRecorder:   record.NewFakeRecorder(1),
+			GlobalSecretRef: client.ObjectKeyFromObject(globalSecret()),
 			AtlasProvider: &atlasmock.TestProvider{
 				IsSupportedFunc: func() bool {
 					return false
@@ -261,34 +269,14 @@ func TestAtlasSearchIndexConfigReconciler_Reconcile(t *testing.T) {
 				Namespace: "mongodb-atlas-system",
 			},
 		}
-		atlasDeployment := &akov2.AtlasDeployment{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "testAtlasDeployment",
-				Namespace: "mongodb-atlas-system",
-			},
-			Spec: akov2.AtlasDeploymentSpec{
-				DeploymentSpec: &akov2.AdvancedDeploymentSpec{
-					SearchIndexes: []akov2.SearchIndex{
-						{
-							Name: "testSearchIndex",
-							Search: &akov2.Search{
-								SearchConfigurationRef: common.ResourceRefNamespaced{
-									Name:      searchIndexConfig.GetName(),
-									Namespace: searchIndexConfig.GetNamespace(),
-								},
-							},
-						},
-					},
-				},
-			},
-			Status: status.AtlasDeploymentStatus{},
-		}
+		atlasDeployment := referringDeployment(searchIndexConfig)
 		testScheme := runtime.NewScheme()
 		assert.NoError(t, akov2.AddToScheme(testScheme))
+		assert.NoError(t, corev1.AddToScheme(testScheme))
 		deploymentIndexer := indexer.NewAtlasDeploymentBySearchIndexIndexer(zaptest.NewLogger(t))
 		k8sClient := fake.NewClientBuilder().
 			WithScheme(testScheme).
-			WithObjects(searchIndexConfig, atlasDeployment).
+			WithObjects(searchIndexConfig, atlasDeployment, globalSecret()).
 			WithStatusSubresource(searchIndexConfig).
 			WithIndex(
 				deploymentIndexer.Object(),
@@ -298,9 +286,10 @@ func TestAtlasSearchIndexConfigReconciler_Reconcile(t *testing.T) {
 			Build()
 
 		reconciler := &AtlasSearchIndexConfigReconciler{
-			Client:        k8sClient,
-			Log:           zaptest.NewLogger(t).Sugar(),
-			EventRecorder: record.NewFakeRecorder(1),
+			Client:          k8sClient,
+			Log:             zaptest.NewLogger(t).Sugar(),
+			EventRecorder:   record.NewFakeRecorder(1),
+			GlobalSecretRef: client.ObjectKeyFromObject(globalSecret()),
 			AtlasProvider: &atlasmock.TestProvider{
 				IsSupportedFunc: func() bool {
 					return true
@@ -570,3 +559,41 @@ func TestAtlasSearchIndexConfigReconciler_Reconcile(t *testing.T) {
 		assert.Equal(t, corev1.ConditionTrue, ctx.Conditions()[0].Status)
 	})
 }
+
+func referringDeployment(searchIndexConfig *akov2.AtlasSearchIndexConfig) *akov2.AtlasDeployment {
+	return &akov2.AtlasDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testAtlasDeployment",
+			Namespace: searchIndexConfig.GetNamespace(),
+		},
+		Spec: akov2.AtlasDeploymentSpec{
+			DeploymentSpec: &akov2.AdvancedDeploymentSpec{
+				SearchIndexes: []akov2.SearchIndex{
+					{
+						Name: "testSearchIndex",
+						Search: &akov2.Search{
+							SearchConfigurationRef: common.ResourceRefNamespaced{
+								Name:      searchIndexConfig.GetName(),
+								Namespace: searchIndexConfig.GetNamespace(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func globalSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "global-secret",
+			Namespace: "mongodb-atlas-system",
+		},
+		Data: map[string][]byte{
+			"orgId":         []byte("orgId"),
+			"publicApiKey":  []byte("publicApiKey"),
+			"privateApiKey": []byte("privateApiKey"),
+		},
+	}
+}
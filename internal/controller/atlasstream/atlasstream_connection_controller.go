@@ -36,11 +36,10 @@ import (
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/customresource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 type AtlasStreamsConnectionReconciler struct {
@@ -52,6 +51,8 @@ type AtlasStreamsConnectionReconciler struct {
 	Log                         *zap.SugaredLogger
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
+	GlobalSecretRef             client.ObjectKey
+	CredentialProviders         reconciler.CredentialProviders
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasstreamconnections,verbs=get;list;watch;create;update;patch;delete
@@ -89,10 +90,6 @@ func (r *AtlasStreamsConnectionReconciler) ensureAtlasStreamConnection(ctx conte
 		return r.invalidate(isValid)
 	}
 
-	if !r.AtlasProvider.IsResourceSupported(akoStreamConnection) {
-		return r.unsupport(workflowCtx)
-	}
-
 	streamInstances := &akov2.AtlasStreamInstanceList{}
 	listOps := &client.ListOptions{
 		FieldSelector: fields.OneTermEqualSelector(
@@ -105,6 +102,14 @@ func (r *AtlasStreamsConnectionReconciler) ensureAtlasStreamConnection(ctx conte
 		return r.terminate(workflowCtx, workflow.Internal, err)
 	}
 
+	referrers, err := r.instancesConnectionConfigs(ctx, streamInstances.Items)
+	if err != nil {
+		return r.terminate(workflowCtx, workflow.AtlasAPIAccessNotConfigured, err)
+	}
+	if !reconciler.IsSupportedByReferrers(r.AtlasProvider, akoStreamConnection, referrers) {
+		return r.unsupport(workflowCtx)
+	}
+
 	if len(streamInstances.Items) > 0 {
 		return r.lock(workflowCtx, akoStreamConnection)
 	}
@@ -112,11 +117,29 @@ func (r *AtlasStreamsConnectionReconciler) ensureAtlasStreamConnection(ctx conte
 	return r.release(workflowCtx, akoStreamConnection)
 }
 
+// instancesConnectionConfigs returns the credentials of the projects of the stream instances,
+// the connection is used with them
+func (r *AtlasStreamsConnectionReconciler) instancesConnectionConfigs(ctx context.Context, instances []akov2.AtlasStreamInstance) ([]*atlas.ConnectionConfig, error) {
+	configs := make([]*atlas.ConnectionConfig, 0, len(instances))
+	for i := range instances {
+		project := akov2.AtlasProject{}
+		if err := r.Client.Get(ctx, instances[i].AtlasProjectObjectKey(), &project); err != nil {
+			return nil, fmt.Errorf("failed to get the project of stream instance %s: %w", instances[i].Name, err)
+		}
+		connectionConfig, err := reconciler.GetProjectConnectionConfig(ctx, r.Client, r.CredentialProviders, &project, &r.GlobalSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, connectionConfig)
+	}
+	return configs, nil
+}
+
 func (r *AtlasStreamsConnectionReconciler) For() (client.Object, builder.Predicates) {
 	return &akov2.AtlasStreamConnection{}, builder.WithPredicates(r.GlobalPredicates...)
 }
 
-func (r *AtlasStreamsConnectionReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+func (r *AtlasStreamsConnectionReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasStreamConnection").
 		For(r.For()).
@@ -125,9 +148,7 @@ func (r *AtlasStreamsConnectionReconciler) SetupWithManager(mgr ctrl.Manager, sk
 			handler.EnqueueRequestsFromMapFunc(r.findStreamConnectionsForStreamInstances),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
+		WithOptions(options).
 		Complete(r)
 }
 
@@ -137,6 +158,8 @@ func NewAtlasStreamsConnectionReconciler(
 	atlasProvider atlas.Provider,
 	deletionProtection bool,
 	logger *zap.Logger,
+	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
 ) *AtlasStreamsConnectionReconciler {
 	return &AtlasStreamsConnectionReconciler{
 		Scheme:                   c.GetScheme(),
@@ -146,6 +169,8 @@ func NewAtlasStreamsConnectionReconciler(
 		Log:                      logger.Named("controllers").Named("AtlasStreamsConnection").Sugar(),
 		AtlasProvider:            atlasProvider,
 		ObjectDeletionProtection: deletionProtection,
+		GlobalSecretRef:          globalSecretRef,
+		CredentialProviders:      credentialProviders,
 	}
 }
 
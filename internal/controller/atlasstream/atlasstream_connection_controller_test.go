@@ -262,16 +262,24 @@ func TestEnsureAtlasStreamConnection(t *testing.T) {
 		}
 		testScheme := runtime.NewScheme()
 		assert.NoError(t, akov2.AddToScheme(testScheme))
+		assert.NoError(t, corev1.AddToScheme(testScheme))
+		streamInstanceIndexer := indexer.NewAtlasStreamInstanceByConnectionIndexer(zaptest.NewLogger(t))
 		k8sClient := fake.NewClientBuilder().
 			WithScheme(testScheme).
-			WithObjects(streamConnection).
+			WithObjects(referringStreamInstance(streamConnection), streamConnection, streamProject(), globalSecret()).
 			WithStatusSubresource(streamConnection).
+			WithIndex(
+				streamInstanceIndexer.Object(),
+				streamInstanceIndexer.Name(),
+				streamInstanceIndexer.Keys,
+			).
 			Build()
 
 		reconciler := &AtlasStreamsConnectionReconciler{
-			Client:        k8sClient,
-			Log:           zaptest.NewLogger(t).Sugar(),
-			EventRecorder: record.NewFakeRecorder(1),
+			Client:          k8sClient,
+			Log:             zaptest.NewLogger(t).Sugar(),
+			EventRecorder:   record.NewFakeRecorder(1),
+			GlobalSecretRef: client.ObjectKeyFromObject(globalSecret()),
 			AtlasProvider: &atlasmock.TestProvider{
 				IsSupportedFunc: func() bool {
 					return false
@@ -363,21 +371,6 @@ func TestEnsureAtlasStreamConnection(t *testing.T) {
 	})
 
 	t.Run("should transition to lock state when referred by an instance", func(t *testing.T) {
-		streamInstance := &akov2.AtlasStreamInstance{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "my-stream-processing-instance",
-				Namespace: "default",
-			},
-			Spec: akov2.AtlasStreamInstanceSpec{
-				Name: "instance",
-				ConnectionRegistry: []common.ResourceRefNamespaced{
-					{
-						Name:      "my-stream-processing-connection",
-						Namespace: "default",
-					},
-				},
-			},
-		}
 		streamConnection := &akov2.AtlasStreamConnection{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "my-stream-processing-connection",
@@ -390,10 +383,11 @@ func TestEnsureAtlasStreamConnection(t *testing.T) {
 		}
 		testScheme := runtime.NewScheme()
 		assert.NoError(t, akov2.AddToScheme(testScheme))
+		assert.NoError(t, corev1.AddToScheme(testScheme))
 		streamInstanceIndexer := indexer.NewAtlasStreamInstanceByConnectionIndexer(zaptest.NewLogger(t))
 		k8sClient := fake.NewClientBuilder().
 			WithScheme(testScheme).
-			WithObjects(streamInstance, streamConnection).
+			WithObjects(referringStreamInstance(streamConnection), streamConnection, streamProject(), globalSecret()).
 			WithStatusSubresource(streamConnection).
 			WithIndex(
 				streamInstanceIndexer.Object(),
@@ -403,9 +397,10 @@ func TestEnsureAtlasStreamConnection(t *testing.T) {
 			Build()
 
 		reconciler := &AtlasStreamsConnectionReconciler{
-			Client:        k8sClient,
-			Log:           zaptest.NewLogger(t).Sugar(),
-			EventRecorder: record.NewFakeRecorder(1),
+			Client:          k8sClient,
+			Log:             zaptest.NewLogger(t).Sugar(),
+			EventRecorder:   record.NewFakeRecorder(1),
+			GlobalSecretRef: client.ObjectKeyFromObject(globalSecret()),
 			AtlasProvider: &atlasmock.TestProvider{
 				IsSupportedFunc: func() bool {
 					return true
@@ -745,3 +740,51 @@ func TestRelease(t *testing.T) {
 		assert.Equal(t, corev1.ConditionTrue, ctx.Conditions()[0].Status)
 	})
 }
+
+func referringStreamInstance(streamConnection *akov2.AtlasStreamConnection) *akov2.AtlasStreamInstance {
+	return &akov2.AtlasStreamInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-stream-processing-instance",
+			Namespace: streamConnection.GetNamespace(),
+		},
+		Spec: akov2.AtlasStreamInstanceSpec{
+			Name: "instance",
+			ConnectionRegistry: []common.ResourceRefNamespaced{
+				{
+					Name:      streamConnection.GetName(),
+					Namespace: streamConnection.GetNamespace(),
+				},
+			},
+			Project: common.ResourceRefNamespaced{
+				Name:      "my-project",
+				Namespace: "default",
+			},
+		},
+	}
+}
+
+func streamProject() *akov2.AtlasProject {
+	return &akov2.AtlasProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-project",
+			Namespace: "default",
+		},
+		Spec: akov2.AtlasProjectSpec{
+			Name: "my-project",
+		},
+	}
+}
+
+func globalSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "global-secret",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"orgId":         []byte("orgId"),
+			"publicApiKey":  []byte("publicApiKey"),
+			"privateApiKey": []byte("privateApiKey"),
+		},
+	}
+}
@@ -41,8 +41,6 @@ import (
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/statushandler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/workflow"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 const instanceNotFound = "STREAM_TENANT_NOT_FOUND_FOR_NAME"
@@ -57,6 +55,7 @@ type AtlasStreamsInstanceReconciler struct {
 	ObjectDeletionProtection    bool
 	SubObjectDeletionProtection bool
 	GlobalSecretRef             client.ObjectKey
+	CredentialProviders         reconciler.CredentialProviders
 }
 
 // +kubebuilder:rbac:groups=atlas.mongodb.com,resources=atlasstreaminstances,verbs=get;list;watch;create;update;patch;delete
@@ -100,21 +99,21 @@ func (r *AtlasStreamsInstanceReconciler) ensureAtlasStreamsInstance(ctx context.
 		return r.invalidate(isValid)
 	}
 
-	// check if stream instance is in "unsupported" state
-	if !r.AtlasProvider.IsResourceSupported(akoStreamInstance) {
-		return r.unsupport(workflowCtx)
-	}
-
 	project := akov2.AtlasProject{}
 	if err := r.Client.Get(ctx, akoStreamInstance.AtlasProjectObjectKey(), &project); err != nil {
 		return r.terminate(workflowCtx, workflow.Internal, err)
 	}
 
-	connectionConfig, err := reconciler.GetConnectionConfig(ctx, r.Client, project.ConnectionSecretObjectKey(), &r.GlobalSecretRef)
+	connectionConfig, err := reconciler.GetProjectConnectionConfig(ctx, r.Client, r.CredentialProviders, &project, &r.GlobalSecretRef)
 	if err != nil {
 		return r.terminate(workflowCtx, workflow.Internal, err)
 	}
 
+	// check if stream instance is in "unsupported" state
+	if !r.AtlasProvider.IsResourceSupported(akoStreamInstance, connectionConfig.Credentials) {
+		return r.unsupport(workflowCtx)
+	}
+
 	atlasClientSet, err := r.AtlasProvider.SdkClientSet(ctx, connectionConfig.Credentials, log)
 	if err != nil {
 		return r.terminate(workflowCtx, workflow.AtlasAPIAccessNotConfigured, err)
@@ -162,7 +161,7 @@ func (r *AtlasStreamsInstanceReconciler) For() (client.Object, builder.Predicate
 	return &akov2.AtlasStreamInstance{}, builder.WithPredicates(r.GlobalPredicates...)
 }
 
-func (r *AtlasStreamsInstanceReconciler) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
+func (r *AtlasStreamsInstanceReconciler) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("AtlasStreamInstance").
 		For(r.For()).
@@ -176,9 +175,7 @@ func (r *AtlasStreamsInstanceReconciler) SetupWithManager(mgr ctrl.Manager, skip
 			handler.EnqueueRequestsFromMapFunc(r.findStreamInstancesForSecret),
 			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
 		).
-		WithOptions(controller.TypedOptions[reconcile.Request]{
-			RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-			SkipNameValidation: pointer.MakePtr(skipNameValidation)}).
+		WithOptions(options).
 		Complete(r)
 }
 
@@ -189,6 +186,7 @@ func NewAtlasStreamsInstanceReconciler(
 	deletionProtection bool,
 	logger *zap.Logger,
 	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
 ) *AtlasStreamsInstanceReconciler {
 	return &AtlasStreamsInstanceReconciler{
 		Scheme:                   c.GetScheme(),
@@ -199,6 +197,7 @@ func NewAtlasStreamsInstanceReconciler(
 		AtlasProvider:            atlasProvider,
 		ObjectDeletionProtection: deletionProtection,
 		GlobalSecretRef:          globalSecretRef,
+		CredentialProviders:      credentialProviders,
 	}
 }
 
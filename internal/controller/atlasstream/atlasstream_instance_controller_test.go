@@ -188,6 +188,18 @@ func TestEnsureAtlasStreamsInstance(t *testing.T) {
 	})
 
 	t.Run("should transition to unsupported state when resource is not supported by platform", func(t *testing.T) {
+		project := &akov2.AtlasProject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-project",
+				Namespace: "default",
+			},
+			Spec: akov2.AtlasProjectSpec{
+				Name: "my-project",
+			},
+			Status: status.AtlasProjectStatus{
+				ID: "my-project-id",
+			},
+		}
 		streamInstance := &akov2.AtlasStreamInstance{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "my-stream-processing-instance",
@@ -215,7 +227,17 @@ func TestEnsureAtlasStreamsInstance(t *testing.T) {
 		assert.NoError(t, corev1.AddToScheme(testScheme))
 		k8sClient := fake.NewClientBuilder().
 			WithScheme(testScheme).
-			WithObjects(streamInstance).
+			WithObjects(project, streamInstance, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"orgId":         []byte("orgId"),
+					"publicApiKey":  []byte("publicApiKey"),
+					"privateApiKey": []byte("privateApiKey"),
+				},
+			}).
 			WithStatusSubresource(streamInstance).
 			Build()
 
@@ -228,6 +250,10 @@ func TestEnsureAtlasStreamsInstance(t *testing.T) {
 					return false
 				},
 			},
+			GlobalSecretRef: client.ObjectKey{
+				Namespace: "default",
+				Name:      "secret",
+			},
 		}
 
 		result, err := reconciler.Reconcile(
@@ -64,14 +64,16 @@ func NewAtlasThirdPartyIntegrationsReconciler(
 	deletionProtection bool,
 	logger *zap.Logger,
 	globalSecretRef client.ObjectKey,
+	credentialProviders reconciler.CredentialProviders,
 	reapplySupport bool,
 ) *ctrlstate.Reconciler[akov2.AtlasThirdPartyIntegration] {
 	intHandler := &AtlasThirdPartyIntegrationHandler{
 		AtlasReconciler: reconciler.AtlasReconciler{
-			Client:          c.GetClient(),
-			AtlasProvider:   atlasProvider,
-			Log:             logger.Named("controllers").Named("AtlasThirdPartyIntegration").Sugar(),
-			GlobalSecretRef: globalSecretRef,
+			Client:              c.GetClient(),
+			AtlasProvider:       atlasProvider,
+			Log:                 logger.Named("controllers").Named("AtlasThirdPartyIntegration").Sugar(),
+			GlobalSecretRef:     globalSecretRef,
+			CredentialProviders: credentialProviders,
 		},
 		deletionProtection: deletionProtection,
 		serviceBuilder:     thirdpartyintegration.NewThirdPartyIntegrationServiceFromClientSet,
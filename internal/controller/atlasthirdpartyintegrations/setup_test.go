@@ -84,7 +84,7 @@ func TestNewAtlasThirdPartyIntegrationsReconciler(t *testing.T) {
 	globalSecretRef := types.NamespacedName{Name: "global-secret", Namespace: "default"}
 
 	rec := NewAtlasThirdPartyIntegrationsReconciler(
-		fakeCluster, atlasProvider, true, logger, globalSecretRef, false,
+		fakeCluster, atlasProvider, true, logger, globalSecretRef, reconciler.CredentialProviders{}, false,
 	)
 	assert.NotNil(t, rec)
 }
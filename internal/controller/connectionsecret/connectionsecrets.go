@@ -101,9 +101,14 @@ func createOrUpdateConnectionSecretsFromDeploymentSecrets(ctx *workflow.Context,
 		data := ConnectionData{
 			DBUserName: dbUser.Spec.Username,
 			Password:   password,
+			X509:       dbUser.Spec.X509Type == "MANAGED" || dbUser.Spec.X509Type == "CUSTOMER",
+			OIDC:       dbUser.Spec.OIDCAuthType == "USER" || dbUser.Spec.OIDCAuthType == "IDP_GROUP",
 			ConnURL:    di.ConnURL,
 			SrvConnURL: di.SrvConnURL,
 		}
+		if dbUser.Spec.ServiceAccountRef != nil {
+			data.OIDCEnvironment = "k8s"
+		}
 		FillPrivateConns(di, &data)
 
 		var secretName string
@@ -32,6 +32,7 @@ const (
 	ClusterLabelKey string = "atlas.mongodb.com/cluster-name"
 	TypeLabelKey           = "atlas.mongodb.com/type"
 	CredLabelVal           = "credentials"
+	ExportLabelVal         = "export"
 
 	standardKey     string = "connectionStringStandard"
 	standardKeySrv  string = "connectionStringStandardSrv"
@@ -45,6 +46,9 @@ const (
 type ConnectionData struct {
 	DBUserName      string
 	Password        string
+	X509            bool
+	OIDC            bool
+	OIDCEnvironment string
 	ConnURL         string
 	SrvConnURL      string
 	PrivateConnURLs []PrivateLinkConnURLs
@@ -79,21 +83,30 @@ func Ensure(ctx context.Context, client client.Client, namespace, projectName, p
 }
 
 func fillSecret(secret *corev1.Secret, projectID string, clusterName string, data ConnectionData) error {
+	addAuth := func(connURL string) (string, error) {
+		if data.X509 {
+			return AddX509ToConnectionURL(connURL)
+		}
+		if data.OIDC {
+			return AddOIDCToConnectionURL(connURL, data.OIDCEnvironment)
+		}
+		return AddCredentialsToConnectionURL(connURL, data.DBUserName, data.Password)
+	}
 	var err error
-	if data.ConnURL, err = AddCredentialsToConnectionURL(data.ConnURL, data.DBUserName, data.Password); err != nil {
+	if data.ConnURL, err = addAuth(data.ConnURL); err != nil {
 		return err
 	}
-	if data.SrvConnURL, err = AddCredentialsToConnectionURL(data.SrvConnURL, data.DBUserName, data.Password); err != nil {
+	if data.SrvConnURL, err = addAuth(data.SrvConnURL); err != nil {
 		return err
 	}
 	for idx, privateConn := range data.PrivateConnURLs {
-		if data.PrivateConnURLs[idx].PvtConnURL, err = AddCredentialsToConnectionURL(privateConn.PvtConnURL, data.DBUserName, data.Password); err != nil {
+		if data.PrivateConnURLs[idx].PvtConnURL, err = addAuth(privateConn.PvtConnURL); err != nil {
 			return err
 		}
-		if data.PrivateConnURLs[idx].PvtSrvConnURL, err = AddCredentialsToConnectionURL(privateConn.PvtSrvConnURL, data.DBUserName, data.Password); err != nil {
+		if data.PrivateConnURLs[idx].PvtSrvConnURL, err = addAuth(privateConn.PvtSrvConnURL); err != nil {
 			return err
 		}
-		if data.PrivateConnURLs[idx].PvtShardConnURL, err = AddCredentialsToConnectionURL(privateConn.PvtShardConnURL, data.DBUserName, data.Password); err != nil {
+		if data.PrivateConnURLs[idx].PvtShardConnURL, err = addAuth(privateConn.PvtShardConnURL); err != nil {
 			return err
 		}
 	}
@@ -147,3 +160,53 @@ func AddCredentialsToConnectionURL(connURL, userName, password string) (string,
 	cs.User = url.UserPassword(userName, password)
 	return cs.String(), nil
 }
+
+// AddX509ToConnectionURL sets the connection URL to authenticate with the client certificate
+// instead of credentials. An empty URL, such as an unset private connection string, stays empty
+func AddX509ToConnectionURL(connURL string) (string, error) {
+	if connURL == "" {
+		return "", nil
+	}
+	cs, err := url.Parse(connURL)
+	if err != nil {
+		return "", err
+	}
+	cs.User = nil
+	query := cs.Query()
+	query.Set("authMechanism", "MONGODB-X509")
+	query.Set("authSource", "$external")
+	cs.RawQuery = query.Encode()
+	return cs.String(), nil
+}
+
+// AddOIDCToConnectionURL sets the connection URL to authenticate with OIDC tokens, the environment
+// tells the driver where to read the tokens from, e.g. k8s for service account tokens. An empty URL stays empty
+func AddOIDCToConnectionURL(connURL, environment string) (string, error) {
+	if connURL == "" {
+		return "", nil
+	}
+	cs, err := url.Parse(connURL)
+	if err != nil {
+		return "", err
+	}
+	cs.User = nil
+	query := cs.Query()
+	query.Set("authMechanism", "MONGODB-OIDC")
+	query.Set("authSource", "$external")
+	if environment != "" {
+		query.Set("authMechanismProperties", "ENVIRONMENT:"+environment)
+	}
+	cs.RawQuery = query.Encode()
+	return cs.String(), nil
+}
+
+// ConnectionURL returns the connection string stored in a connection secret, preferring the SRV
+// form and falling back to the standard one
+func ConnectionURL(secret *corev1.Secret) (string, error) {
+	for _, key := range []string{standardKeySrv, standardKey} {
+		if connURL := string(secret.Data[key]); connURL != "" {
+			return connURL, nil
+		}
+	}
+	return "", fmt.Errorf("secret %v is broken: missing the field %s or %s", secret.Name, standardKeySrv, standardKey)
+}
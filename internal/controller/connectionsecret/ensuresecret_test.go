@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,6 +44,65 @@ func TestAddCredentialsToConnectionURL(t *testing.T) {
 	})
 }
 
+func TestAddX509ToConnectionURL(t *testing.T) {
+	t.Run("Adding X.509 authentication to standard url", func(t *testing.T) {
+		url, err := AddX509ToConnectionURL("mongodb://mongodb0.example.com:27017,mongodb1.example.com:27017/?authSource=admin&tls=true")
+		assert.NoError(t, err)
+		assert.Equal(t, "mongodb://mongodb0.example.com:27017,mongodb1.example.com:27017/?authMechanism=MONGODB-X509&authSource=%24external&tls=true", url)
+	})
+	t.Run("Adding X.509 authentication to srv url", func(t *testing.T) {
+		url, err := AddX509ToConnectionURL("mongodb+srv://server.example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, "mongodb+srv://server.example.com?authMechanism=MONGODB-X509&authSource=%24external", url)
+	})
+	t.Run("Keeping empty urls empty", func(t *testing.T) {
+		url, err := AddX509ToConnectionURL("")
+		assert.NoError(t, err)
+		assert.Empty(t, url)
+	})
+}
+
+func TestAddOIDCToConnectionURL(t *testing.T) {
+	t.Run("Adding OIDC authentication with service account tokens", func(t *testing.T) {
+		url, err := AddOIDCToConnectionURL("mongodb+srv://server.example.com/?authSource=admin", "k8s")
+		assert.NoError(t, err)
+		assert.Equal(t, "mongodb+srv://server.example.com/?authMechanism=MONGODB-OIDC&authMechanismProperties=ENVIRONMENT%3Ak8s&authSource=%24external", url)
+	})
+	t.Run("Adding OIDC authentication without environment", func(t *testing.T) {
+		url, err := AddOIDCToConnectionURL("mongodb://mongodb0.example.com:27017", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "mongodb://mongodb0.example.com:27017?authMechanism=MONGODB-OIDC&authSource=%24external", url)
+	})
+	t.Run("Keeping empty urls empty", func(t *testing.T) {
+		url, err := AddOIDCToConnectionURL("", "k8s")
+		assert.NoError(t, err)
+		assert.Empty(t, url)
+	})
+}
+
+func TestConnectionURL(t *testing.T) {
+	t.Run("Prefers the srv connection string", func(t *testing.T) {
+		url, err := ConnectionURL(&corev1.Secret{Data: map[string][]byte{
+			standardKey:    []byte("mongodb://u:p@host:27017"),
+			standardKeySrv: []byte("mongodb+srv://u:p@cluster.mongodb.net"),
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, "mongodb+srv://u:p@cluster.mongodb.net", url)
+	})
+	t.Run("Falls back to the standard connection string", func(t *testing.T) {
+		url, err := ConnectionURL(&corev1.Secret{Data: map[string][]byte{
+			standardKey:    []byte("mongodb://u:p@host:27017"),
+			standardKeySrv: []byte(""),
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, "mongodb://u:p@host:27017", url)
+	})
+	t.Run("Fails without connection strings", func(t *testing.T) {
+		_, err := ConnectionURL(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "creds"}})
+		assert.EqualError(t, err, "secret creds is broken: missing the field connectionStringStandardSrv or connectionStringStandard")
+	})
+}
+
 func TestEnsure(t *testing.T) {
 	// Fake client
 	scheme := runtime.NewScheme()
@@ -0,0 +1,113 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectionsecret
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/dbuser"
+)
+
+// X509SecretName returns the name of the Secret holding the Atlas managed certificate of a database user
+func X509SecretName(projectName, dbUserResourceName string) string {
+	return kube.NormalizeIdentifier(fmt.Sprintf("%s-%s-x509",
+		kube.NormalizeIdentifier(projectName),
+		kube.NormalizeIdentifier(dbUserResourceName)))
+}
+
+// EnsureX509Secret creates or updates the kubernetes.io/tls Secret holding the certificate of the database user,
+// the Secret is owned by the AtlasDatabaseUser so it is removed along with it
+func EnsureX509Secret(ctx context.Context, k8sClient client.Client, dbUser *akov2.AtlasDatabaseUser, projectID, name string, cert *dbuser.Certificate) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: dbUser.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, k8sClient, secret, func() error {
+		// the type of a Secret is immutable, an existing Secret of another type cannot be reused
+		if secret.ResourceVersion != "" && secret.Type != corev1.SecretTypeTLS {
+			return fmt.Errorf("the Secret already exists with type %q instead of %q, remove it to let the certificate be stored",
+				secret.Type, corev1.SecretTypeTLS)
+		}
+		// Secrets the database user did not create are never overwritten
+		if secret.ResourceVersion != "" && !metav1.IsControlledBy(secret, dbUser) {
+			return fmt.Errorf("the Secret already exists and is not controlled by AtlasDatabaseUser %s, remove it to let the certificate be stored", dbUser.Name)
+		}
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[TypeLabelKey] = CredLabelVal
+		secret.Labels[ProjectLabelKey] = projectID
+		secret.Type = corev1.SecretTypeTLS
+		secret.Data = map[string][]byte{
+			corev1.TLSCertKey:       cert.CertificatePEM,
+			corev1.TLSPrivateKeyKey: cert.PrivateKeyPEM,
+		}
+		return controllerutil.SetControllerReference(dbUser, secret, k8sClient.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write X.509 certificate Secret %s: %w", name, err)
+	}
+	return nil
+}
+
+// ReadX509Certificate returns the certificate stored in the given Secret, or nil when the Secret does
+// not exist or holds no valid certificate
+func ReadX509Certificate(ctx context.Context, k8sClient client.Client, namespace, name string) (*x509.Certificate, error) {
+	secret := &corev1.Secret{}
+	err := k8sClient.Get(ctx, kube.ObjectKey(namespace, name), secret)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read X.509 certificate Secret %s: %w", name, err)
+	}
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil || len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		return nil, nil
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, nil
+	}
+	return cert, nil
+}
+
+// RemoveX509Secret removes the certificate Secret of a database user no longer using managed X.509 authentication,
+// a Secret of the same name the database user does not control is left untouched
+func RemoveX509Secret(ctx context.Context, k8sClient client.Client, dbUser *akov2.AtlasDatabaseUser, name string) error {
+	secret := &corev1.Secret{}
+	err := k8sClient.Get(ctx, kube.ObjectKey(dbUser.Namespace, name), secret)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read X.509 certificate Secret %s: %w", name, err)
+	}
+	if !metav1.IsControlledBy(secret, dbUser) {
+		return nil
+	}
+	if err := k8sClient.Delete(ctx, secret, client.Preconditions{UID: &secret.UID}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to remove X.509 certificate Secret %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectionsecret
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/dbuser"
+)
+
+func testCertificate(t *testing.T, username string) *dbuser.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: username},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	cert, err := dbuser.ParseCertificate(append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})...,
+	))
+	require.NoError(t, err)
+	return cert
+}
+
+func TestX509SecretTypeMismatch(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(akov2.AddToScheme(scheme))
+	dbUser := &akov2.AtlasDatabaseUser{ObjectMeta: metav1.ObjectMeta{Name: "user1", Namespace: "testNs", UID: "user1-uid"}}
+	opaque := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "user1-x509", Namespace: "testNs"},
+		Type:       corev1.SecretTypeOpaque,
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dbUser, opaque).Build()
+
+	err := EnsureX509Secret(ctx, fakeClient, dbUser, "603e7bf38a94956835659ae5", "user1-x509", testCertificate(t, "user1"))
+	assert.ErrorContains(t, err, `the Secret already exists with type "Opaque" instead of "kubernetes.io/tls"`)
+}
+
+func TestX509SecretName(t *testing.T) {
+	assert.Equal(t, "my-project-user1-x509", X509SecretName("My Project", "user1"))
+}
+
+func TestX509Secret(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(akov2.AddToScheme(scheme))
+	dbUser := &akov2.AtlasDatabaseUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "user1", Namespace: "testNs", UID: "user1-uid"},
+		Spec:       akov2.AtlasDatabaseUserSpec{Username: "CN=user1", X509Type: "MANAGED"},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dbUser).Build()
+
+	cert, err := ReadX509Certificate(ctx, fakeClient, "testNs", "user1-x509")
+	require.NoError(t, err)
+	assert.Nil(t, cert, "a missing Secret holds no certificate")
+
+	issued := testCertificate(t, "user1")
+	require.NoError(t, EnsureX509Secret(ctx, fakeClient, dbUser, "603e7bf38a94956835659ae5", "user1-x509", issued))
+
+	secret := &corev1.Secret{}
+	require.NoError(t, fakeClient.Get(ctx, kube.ObjectKey("testNs", "user1-x509"), secret))
+	assert.Equal(t, corev1.SecretTypeTLS, secret.Type)
+	assert.Equal(t, CredLabelVal, secret.Labels[TypeLabelKey])
+	assert.Equal(t, "603e7bf38a94956835659ae5", secret.Labels[ProjectLabelKey])
+	assert.Equal(t, issued.PrivateKeyPEM, secret.Data[corev1.TLSPrivateKeyKey])
+	require.Len(t, secret.OwnerReferences, 1)
+	assert.Equal(t, "user1", secret.OwnerReferences[0].Name)
+
+	cert, err = ReadX509Certificate(ctx, fakeClient, "testNs", "user1-x509")
+	require.NoError(t, err)
+	require.NotNil(t, cert)
+	assert.Equal(t, issued.Certificate.SerialNumber, cert.SerialNumber)
+
+	require.NoError(t, RemoveX509Secret(ctx, fakeClient, dbUser, "user1-x509"))
+	err = fakeClient.Get(ctx, kube.ObjectKey("testNs", "user1-x509"), secret)
+	assert.True(t, apierrors.IsNotFound(err))
+	assert.NoError(t, RemoveX509Secret(ctx, fakeClient, dbUser, "user1-x509"), "removing a missing Secret is a no-op")
+}
+
+func TestX509SecretNotControlled(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(akov2.AddToScheme(scheme))
+	dbUser := &akov2.AtlasDatabaseUser{ObjectMeta: metav1.ObjectMeta{Name: "user1", Namespace: "testNs", UID: "user1-uid"}}
+	userSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "user1-x509", Namespace: "testNs"},
+		Type:       corev1.SecretTypeTLS,
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("user-cert"), corev1.TLSPrivateKeyKey: []byte("user-key")},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dbUser, userSecret).Build()
+
+	err := EnsureX509Secret(ctx, fakeClient, dbUser, "603e7bf38a94956835659ae5", "user1-x509", testCertificate(t, "user1"))
+	assert.ErrorContains(t, err, "the Secret already exists and is not controlled by AtlasDatabaseUser user1")
+	require.NoError(t, RemoveX509Secret(ctx, fakeClient, dbUser, "user1-x509"))
+
+	secret := &corev1.Secret{}
+	require.NoError(t, fakeClient.Get(ctx, kube.ObjectKey("testNs", "user1-x509"), secret))
+	assert.Equal(t, userSecret.Data, secret.Data)
+	assert.Empty(t, secret.OwnerReferences)
+}
@@ -0,0 +1,292 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	akov2next "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
+)
+
+// DefaultReconcilerKind selects the settings applying to all controllers
+// in the --controller-options flag
+const DefaultReconcilerKind = "default"
+
+// reconciledScheme holds the Kinds that can be given controller settings
+var reconciledScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(akov2.AddToScheme(reconciledScheme))
+	utilruntime.Must(akov2next.AddToScheme(reconciledScheme))
+}
+
+// ReconcilerOptions tunes the concurrency and the work queue of a controller,
+// unset fields keep the operator defaults
+type ReconcilerOptions struct {
+	MaxConcurrentReconciles int             `json:"maxConcurrentReconciles,omitempty"`
+	BaseDelay               metav1.Duration `json:"baseDelay,omitempty"`
+	MaxDelay                metav1.Duration `json:"maxDelay,omitempty"`
+	QPS                     float64         `json:"qps,omitempty"`
+	Burst                   int             `json:"burst,omitempty"`
+}
+
+// ReconcilerConfig holds the controller settings, the Kinds settings are applied
+// over the Default ones for the controller reconciling that Kind
+type ReconcilerConfig struct {
+	Default ReconcilerOptions            `json:"default,omitempty"`
+	Kinds   map[string]ReconcilerOptions `json:"kinds,omitempty"`
+}
+
+// LoadReconcilerConfig reads the controller settings from a YAML or JSON file
+func LoadReconcilerConfig(path string) (*ReconcilerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read controller config: %w", err)
+	}
+	cfg := &ReconcilerConfig{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse controller config %q: %w", path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid controller config %q: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Validate rejects unknown Kinds and negative or inconsistent settings,
+// the settings of each Kind are checked once applied over the default ones
+func (c *ReconcilerConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if err := c.Default.validate(); err != nil {
+		return fmt.Errorf("default: %w", err)
+	}
+	for _, kind := range slices.Sorted(maps.Keys(c.Kinds)) {
+		if err := validateKind(kind); err != nil {
+			return err
+		}
+		if err := c.ForKind(kind).validate(); err != nil {
+			return fmt.Errorf("%s: %w", kind, err)
+		}
+	}
+	return nil
+}
+
+func validateKind(kind string) error {
+	for gvk := range reconciledScheme.AllKnownTypes() {
+		if gvk.Kind == kind && !strings.HasSuffix(kind, "List") &&
+			(gvk.Group == akov2.GroupVersion.Group || gvk.Group == akov2next.GroupVersion.Group) {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown kind %q", kind)
+}
+
+// Set parses a flag value of the form Kind:key=value,key=value and merges it
+// over the current settings, the default Kind updates the settings of all controllers.
+// The settings are checked once merged, so defaults must be given before the Kinds they apply to.
+func (c *ReconcilerConfig) Set(value string) error {
+	kind, settings, ok := strings.Cut(value, ":")
+	if !ok || kind == "" || settings == "" {
+		return fmt.Errorf("expected Kind:key=value[,key=value], got %q", value)
+	}
+	if kind != DefaultReconcilerKind {
+		if err := validateKind(kind); err != nil {
+			return err
+		}
+	}
+
+	opts := ReconcilerOptions{}
+	for _, setting := range strings.Split(settings, ",") {
+		if err := opts.set(setting); err != nil {
+			return err
+		}
+	}
+
+	if kind == DefaultReconcilerKind {
+		if err := c.Default.merge(opts).validate(); err != nil {
+			return err
+		}
+		c.Default = c.Default.merge(opts)
+		return nil
+	}
+	if err := c.ForKind(kind).merge(opts).validate(); err != nil {
+		return err
+	}
+	c.setKind(kind, c.Kinds[kind].merge(opts))
+	return nil
+}
+
+func (c *ReconcilerConfig) String() string {
+	if c == nil {
+		return ""
+	}
+	var values []string
+	if c.Default != (ReconcilerOptions{}) {
+		values = append(values, DefaultReconcilerKind+":"+c.Default.String())
+	}
+	for _, kind := range slices.Sorted(maps.Keys(c.Kinds)) {
+		values = append(values, kind+":"+c.Kinds[kind].String())
+	}
+	return strings.Join(values, " ")
+}
+
+// Merge applies the settings of other over the ones of c
+func (c *ReconcilerConfig) Merge(other *ReconcilerConfig) *ReconcilerConfig {
+	merged := &ReconcilerConfig{}
+	if c != nil {
+		merged.Default = c.Default
+		for kind, opts := range c.Kinds {
+			merged.setKind(kind, opts)
+		}
+	}
+	if other != nil {
+		merged.Default = merged.Default.merge(other.Default)
+		for kind, opts := range other.Kinds {
+			merged.setKind(kind, merged.Kinds[kind].merge(opts))
+		}
+	}
+	return merged
+}
+
+func (c *ReconcilerConfig) setKind(kind string, opts ReconcilerOptions) {
+	if c.Kinds == nil {
+		c.Kinds = map[string]ReconcilerOptions{}
+	}
+	c.Kinds[kind] = opts
+}
+
+// ForKind returns the settings of the controller reconciling the given Kind
+func (c *ReconcilerConfig) ForKind(kind string) ReconcilerOptions {
+	if c == nil {
+		return ReconcilerOptions{}
+	}
+	return c.Default.merge(c.Kinds[kind])
+}
+
+// ControllerOptions builds the controller-runtime options for these settings
+func (o ReconcilerOptions) ControllerOptions(skipNameValidation bool) controller.TypedOptions[reconcile.Request] {
+	return controller.TypedOptions[reconcile.Request]{
+		MaxConcurrentReconciles: o.MaxConcurrentReconciles,
+		RateLimiter: ratelimit.NewConfiguredRateLimiter[reconcile.Request](ratelimit.Config{
+			BaseDelay: o.BaseDelay.Duration,
+			MaxDelay:  o.MaxDelay.Duration,
+			QPS:       o.QPS,
+			Burst:     o.Burst,
+		}),
+		SkipNameValidation: pointer.MakePtr(skipNameValidation),
+	}
+}
+
+func (o ReconcilerOptions) String() string {
+	var settings []string
+	if o.MaxConcurrentReconciles != 0 {
+		settings = append(settings, fmt.Sprintf("maxConcurrentReconciles=%d", o.MaxConcurrentReconciles))
+	}
+	if o.BaseDelay.Duration != 0 {
+		settings = append(settings, "baseDelay="+o.BaseDelay.Duration.String())
+	}
+	if o.MaxDelay.Duration != 0 {
+		settings = append(settings, "maxDelay="+o.MaxDelay.Duration.String())
+	}
+	if o.QPS != 0 {
+		settings = append(settings, "qps="+strconv.FormatFloat(o.QPS, 'f', -1, 64))
+	}
+	if o.Burst != 0 {
+		settings = append(settings, fmt.Sprintf("burst=%d", o.Burst))
+	}
+	return strings.Join(settings, ",")
+}
+
+func (o *ReconcilerOptions) set(setting string) error {
+	key, value, ok := strings.Cut(setting, "=")
+	if !ok {
+		return fmt.Errorf("expected key=value, got %q", setting)
+	}
+	var err error
+	switch key {
+	case "maxConcurrentReconciles":
+		o.MaxConcurrentReconciles, err = strconv.Atoi(value)
+	case "baseDelay":
+		o.BaseDelay.Duration, err = time.ParseDuration(value)
+	case "maxDelay":
+		o.MaxDelay.Duration, err = time.ParseDuration(value)
+	case "qps":
+		o.QPS, err = strconv.ParseFloat(value, 64)
+	case "burst":
+		o.Burst, err = strconv.Atoi(value)
+	default:
+		return fmt.Errorf("unknown controller setting %q", key)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+	return nil
+}
+
+// validate checks the delays the rate limiter ends up with, unset ones take the operator defaults
+func (o ReconcilerOptions) validate() error {
+	if o.MaxConcurrentReconciles < 0 || o.BaseDelay.Duration < 0 || o.MaxDelay.Duration < 0 || o.QPS < 0 || o.Burst < 0 {
+		return errors.New("controller settings cannot be negative")
+	}
+	baseDelay, maxDelay := o.BaseDelay.Duration, o.MaxDelay.Duration
+	if baseDelay == 0 {
+		baseDelay = ratelimit.DefaultBaseDelay
+	}
+	if maxDelay == 0 {
+		maxDelay = ratelimit.DefaultMaxDelay
+	}
+	if baseDelay > maxDelay {
+		return fmt.Errorf("baseDelay %v cannot exceed maxDelay %v", baseDelay, maxDelay)
+	}
+	return nil
+}
+
+func (o ReconcilerOptions) merge(other ReconcilerOptions) ReconcilerOptions {
+	if other.MaxConcurrentReconciles != 0 {
+		o.MaxConcurrentReconciles = other.MaxConcurrentReconciles
+	}
+	if other.BaseDelay.Duration != 0 {
+		o.BaseDelay = other.BaseDelay
+	}
+	if other.MaxDelay.Duration != 0 {
+		o.MaxDelay = other.MaxDelay
+	}
+	if other.QPS != 0 {
+		o.QPS = other.QPS
+	}
+	if other.Burst != 0 {
+		o.Burst = other.Burst
+	}
+	return o
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
+)
+
+func TestReconcilerConfigSet(t *testing.T) {
+	for _, tc := range []struct {
+		title   string
+		values  []string
+		want    ReconcilerConfig
+		wantErr string
+	}{
+		{
+			title:  "default settings",
+			values: []string{"default:maxConcurrentReconciles=3,baseDelay=1s,maxDelay=30s"},
+			want: ReconcilerConfig{Default: ReconcilerOptions{
+				MaxConcurrentReconciles: 3,
+				BaseDelay:               metav1.Duration{Duration: time.Second},
+				MaxDelay:                metav1.Duration{Duration: 30 * time.Second},
+			}},
+		},
+		{
+			title:  "repeated kinds are merged",
+			values: []string{"AtlasProject:qps=5", "AtlasProject:burst=50,qps=7.5"},
+			want: ReconcilerConfig{Kinds: map[string]ReconcilerOptions{
+				"AtlasProject": {QPS: 7.5, Burst: 50},
+			}},
+		},
+		{
+			title:   "unknown kind",
+			values:  []string{"AtlasDeplyment:qps=5"},
+			wantErr: `unknown kind "AtlasDeplyment"`,
+		},
+		{
+			title:   "missing kind",
+			values:  []string{"qps=5"},
+			wantErr: "expected Kind:key=value[,key=value]",
+		},
+		{
+			title:   "invalid value",
+			values:  []string{"AtlasProject:burst=many"},
+			wantErr: "invalid value for burst",
+		},
+		{
+			title:   "inconsistent delays",
+			values:  []string{"AtlasProject:baseDelay=2m,maxDelay=1m"},
+			wantErr: "baseDelay 2m0s cannot exceed maxDelay 1m0s",
+		},
+		{
+			title:   "base delay exceeding the default max delay",
+			values:  []string{"AtlasProject:baseDelay=2m"},
+			wantErr: "baseDelay 2m0s cannot exceed maxDelay 1m0s",
+		},
+		{
+			title:   "max delay below the default base delay",
+			values:  []string{"AtlasProject:maxDelay=10s"},
+			wantErr: "baseDelay 15s cannot exceed maxDelay 10s",
+		},
+		{
+			title:  "base delay within the configured default max delay",
+			values: []string{"default:maxDelay=5m", "AtlasProject:baseDelay=2m"},
+			want: ReconcilerConfig{
+				Default: ReconcilerOptions{MaxDelay: metav1.Duration{Duration: 5 * time.Minute}},
+				Kinds: map[string]ReconcilerOptions{
+					"AtlasProject": {BaseDelay: metav1.Duration{Duration: 2 * time.Minute}},
+				},
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			cfg := ReconcilerConfig{}
+			var err error
+			for _, value := range tc.values {
+				if err = cfg.Set(value); err != nil {
+					break
+				}
+			}
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, cfg)
+		})
+	}
+}
+
+func TestReconcilerConfigString(t *testing.T) {
+	cfg := ReconcilerConfig{}
+	require.NoError(t, cfg.Set("default:maxConcurrentReconciles=2"))
+	require.NoError(t, cfg.Set("AtlasProject:qps=2.5,burst=10"))
+	require.NoError(t, cfg.Set("AtlasDeployment:maxDelay=5m"))
+	assert.Equal(t, "default:maxConcurrentReconciles=2 AtlasDeployment:maxDelay=5m0s AtlasProject:qps=2.5,burst=10", cfg.String())
+}
+
+func TestLoadReconcilerConfig(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.yaml")
+	require.NoError(t, os.WriteFile(valid, []byte(`
+default:
+  maxConcurrentReconciles: 2
+kinds:
+  AtlasDeployment:
+    baseDelay: 30s
+    maxDelay: 10m
+`), 0o600))
+	unknown := filepath.Join(dir, "unknown.yaml")
+	require.NoError(t, os.WriteFile(unknown, []byte("default:\n  workers: 2\n"), 0o600))
+	typo := filepath.Join(dir, "typo.yaml")
+	require.NoError(t, os.WriteFile(typo, []byte("kinds:\n  AtlasDeplyment:\n    qps: 10\n"), 0o600))
+	inconsistent := filepath.Join(dir, "inconsistent.yaml")
+	require.NoError(t, os.WriteFile(inconsistent, []byte("default:\n  baseDelay: 1m\nkinds:\n  AtlasProject:\n    maxDelay: 10s\n"), 0o600))
+	negative := filepath.Join(dir, "negative.yaml")
+	require.NoError(t, os.WriteFile(negative, []byte("kinds:\n  AtlasProject:\n    qps: -1\n"), 0o600))
+
+	cfg, err := LoadReconcilerConfig(valid)
+	require.NoError(t, err)
+	assert.Equal(t, &ReconcilerConfig{
+		Default: ReconcilerOptions{MaxConcurrentReconciles: 2},
+		Kinds: map[string]ReconcilerOptions{
+			"AtlasDeployment": {
+				BaseDelay: metav1.Duration{Duration: 30 * time.Second},
+				MaxDelay:  metav1.Duration{Duration: 10 * time.Minute},
+			},
+		},
+	}, cfg)
+
+	_, err = LoadReconcilerConfig(unknown)
+	assert.ErrorContains(t, err, "failed to parse controller config")
+	_, err = LoadReconcilerConfig(typo)
+	assert.ErrorContains(t, err, `unknown kind "AtlasDeplyment"`)
+	_, err = LoadReconcilerConfig(inconsistent)
+	assert.ErrorContains(t, err, "AtlasProject: baseDelay 1m0s cannot exceed maxDelay 10s")
+	_, err = LoadReconcilerConfig(negative)
+	assert.ErrorContains(t, err, "AtlasProject: controller settings cannot be negative")
+}
+
+func TestReconcilerConfigForKind(t *testing.T) {
+	file := &ReconcilerConfig{
+		Default: ReconcilerOptions{MaxConcurrentReconciles: 2, QPS: 20},
+		Kinds: map[string]ReconcilerOptions{
+			"AtlasDeployment": {MaxConcurrentReconciles: 8},
+		},
+	}
+	flags := &ReconcilerConfig{
+		Default: ReconcilerOptions{Burst: 300},
+		Kinds: map[string]ReconcilerOptions{
+			"AtlasDeployment": {QPS: 40},
+			"AtlasProject":    {MaxConcurrentReconciles: 1},
+		},
+	}
+	cfg := file.Merge(flags)
+
+	assert.Equal(t, ReconcilerOptions{MaxConcurrentReconciles: 8, QPS: 40, Burst: 300}, cfg.ForKind("AtlasDeployment"))
+	assert.Equal(t, ReconcilerOptions{MaxConcurrentReconciles: 1, QPS: 20, Burst: 300}, cfg.ForKind("AtlasProject"))
+	assert.Equal(t, ReconcilerOptions{MaxConcurrentReconciles: 2, QPS: 20, Burst: 300}, cfg.ForKind("AtlasCustomRole"))
+	assert.Equal(t, ReconcilerOptions{MaxConcurrentReconciles: 8, QPS: 20}, file.ForKind("AtlasDeployment"), "merging must not modify the merged configs")
+
+	var unset *ReconcilerConfig
+	assert.Equal(t, ReconcilerOptions{}, unset.ForKind("AtlasDeployment"))
+}
+
+func TestReconcilerOptionsControllerOptions(t *testing.T) {
+	opts := ReconcilerOptions{
+		MaxConcurrentReconciles: 4,
+		BaseDelay:               metav1.Duration{Duration: time.Second},
+		QPS:                     50,
+	}
+	assert.Equal(t, controller.TypedOptions[reconcile.Request]{
+		MaxConcurrentReconciles: 4,
+		RateLimiter: ratelimit.NewConfiguredRateLimiter[reconcile.Request](ratelimit.Config{
+			BaseDelay: time.Second,
+			QPS:       50,
+		}),
+		SkipNameValidation: pointer.MakePtr(false),
+	}, opts.ControllerOptions(false))
+}
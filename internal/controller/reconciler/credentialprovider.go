@@ -0,0 +1,81 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+)
+
+// CredentialProvider reads the Atlas API credentials of a project
+type CredentialProvider interface {
+	ConnectionConfig(ctx context.Context, project *akov2.AtlasProject) (*atlas.ConnectionConfig, error)
+}
+
+// SecretCredentialProvider reads the credentials from the connection Secret of the project, or the global one
+type SecretCredentialProvider struct {
+	Client          client.Client
+	GlobalSecretRef client.ObjectKey
+}
+
+func (p *SecretCredentialProvider) ConnectionConfig(ctx context.Context, project *akov2.AtlasProject) (*atlas.ConnectionConfig, error) {
+	return GetConnectionConfig(ctx, p.Client, project.ConnectionSecretObjectKey(), &p.GlobalSecretRef)
+}
+
+// CredentialProviders are the providers a project may select with its credentialsRef instead of a Secret.
+// They are nil when the Operator is not configured for them.
+type CredentialProviders struct {
+	File  CredentialProvider
+	Vault CredentialProvider
+}
+
+// GetProjectConnectionConfig reads the credentials of the project from the provider its credentialsRef selects,
+// or from its connection Secret otherwise
+func GetProjectConnectionConfig(ctx context.Context, k8sClient client.Client, providers CredentialProviders, project *akov2.AtlasProject, globalSecretRef *client.ObjectKey) (*atlas.ConnectionConfig, error) {
+	ref := project.Spec.CredentialsRef
+	if ref == nil {
+		provider := &SecretCredentialProvider{Client: k8sClient, GlobalSecretRef: *globalSecretRef}
+		return provider.ConnectionConfig(ctx, project)
+	}
+
+	var provider CredentialProvider
+	var source, flag string
+	switch {
+	case ref.File != nil:
+		provider, source, flag = providers.File, fmt.Sprintf("the credentials directory %q", ref.File.Name), "--credentials-dir"
+	case ref.Vault != nil:
+		provider, source, flag = providers.Vault, fmt.Sprintf("the Vault secret %q", ref.Vault.Path), "--vault-address"
+	default:
+		return nil, errors.New("the credentialsRef of the project sets no credentials source")
+	}
+	if provider == nil {
+		return nil, fmt.Errorf("cannot read the Atlas API credentials from %s, the Operator is not started with %s", source, flag)
+	}
+
+	cfg, err := provider.ConnectionConfig(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkConnectionConfig(cfg, source); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+)
+
+type staticCredentialProvider struct {
+	config *atlas.ConnectionConfig
+}
+
+func (p *staticCredentialProvider) ConnectionConfig(_ context.Context, _ *akov2.AtlasProject) (*atlas.ConnectionConfig, error) {
+	return p.config, nil
+}
+
+func TestGetProjectConnectionConfig(t *testing.T) {
+	fileConfig := &atlas.ConnectionConfig{OrgID: "file", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "file", PrivateKey: "file"}}}
+	vaultConfig := &atlas.ConnectionConfig{OrgID: "vault", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "vault", PrivateKey: "vault"}}}
+	providers := CredentialProviders{
+		File:  &staticCredentialProvider{config: fileConfig},
+		Vault: &staticCredentialProvider{config: vaultConfig},
+	}
+
+	for _, tc := range []struct {
+		title       string
+		ref         *akov2.CredentialsReference
+		providers   CredentialProviders
+		expected    *atlas.ConnectionConfig
+		expectedErr string
+	}{
+		{
+			title:     "falls back to the global secret",
+			providers: providers,
+			expected:  &atlas.ConnectionConfig{OrgID: "global", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "global", PrivateKey: "global"}}},
+		},
+		{
+			title:     "reads the credentials files",
+			ref:       &akov2.CredentialsReference{File: &akov2.FileCredentialsSource{Name: "team-a"}},
+			providers: providers,
+			expected:  fileConfig,
+		},
+		{
+			title:     "reads the Vault secret",
+			ref:       &akov2.CredentialsReference{Vault: &akov2.VaultCredentialsSource{Path: "atlas/team-a"}},
+			providers: providers,
+			expected:  vaultConfig,
+		},
+		{
+			title:       "fails when the provider is not configured",
+			ref:         &akov2.CredentialsReference{Vault: &akov2.VaultCredentialsSource{Path: "atlas/team-a"}},
+			expectedErr: `cannot read the Atlas API credentials from the Vault secret "atlas/team-a", the Operator is not started with --vault-address`,
+		},
+		{
+			title: "fails when fields are missing",
+			ref:   &akov2.CredentialsReference{File: &akov2.FileCredentialsSource{Name: "team-a"}},
+			providers: CredentialProviders{
+				File: &staticCredentialProvider{config: &atlas.ConnectionConfig{Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "file"}}}},
+			},
+			expectedErr: `the following fields are missing in the credentials directory "team-a": [orgId privateApiKey]`,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			k8sClient := newFakeKubeClient(t, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "default"},
+				Data: map[string][]byte{
+					"orgId": []byte("global"), "publicApiKey": []byte("global"), "privateApiKey": []byte("global"),
+				},
+			})
+			project := &akov2.AtlasProject{
+				ObjectMeta: metav1.ObjectMeta{Name: "project", Namespace: "default"},
+				Spec:       akov2.AtlasProjectSpec{Name: "project", CredentialsRef: tc.ref},
+			}
+
+			cfg, err := GetProjectConnectionConfig(context.Background(), k8sClient, tc.providers, project, &client.ObjectKey{Namespace: "default", Name: "secret"})
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, cfg)
+		})
+	}
+}
@@ -17,20 +17,17 @@ package reconciler
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/credentials"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/project"
 )
 
-const (
-	orgIDKey      = "orgId"
-	publicAPIKey  = "publicApiKey"
-	privateAPIKey = "privateApiKey"
-)
-
 func (r *AtlasReconciler) ResolveConnectionConfig(ctx context.Context, referrer project.ProjectReferrerObject) (*atlas.ConnectionConfig, error) {
 	connectionSecret := r.connectionSecretRef(referrer)
 	if connectionSecret != nil && connectionSecret.Name != "" {
@@ -46,18 +43,30 @@ func (r *AtlasReconciler) ResolveConnectionConfig(ctx context.Context, referrer
 		return nil, fmt.Errorf("error resolving project reference: %w", err)
 	}
 
-	var projectSecret *client.ObjectKey
+	var cfg *atlas.ConnectionConfig
 	if prj != nil {
-		projectSecret = prj.ConnectionSecretObjectKey()
+		cfg, err = GetProjectConnectionConfig(ctx, r.Client, r.CredentialProviders, prj, &r.GlobalSecretRef)
+	} else {
+		cfg, err = GetConnectionConfig(ctx, r.Client, nil, &r.GlobalSecretRef)
 	}
-
-	cfg, err := GetConnectionConfig(ctx, r.Client, projectSecret, &r.GlobalSecretRef)
 	if err != nil {
 		return nil, fmt.Errorf("error getting credentials from project reference: %w", err)
 	}
 	return cfg, nil
 }
 
+// IsSupportedByReferrers tells whether a resource without credentials of its own is supported on the
+// Atlas domains of the credentials of the resources referencing it. A resource nothing references is
+// not used in Atlas and is always supported.
+func IsSupportedByReferrers(provider atlas.Provider, resource api.AtlasCustomResource, referrers []*atlas.ConnectionConfig) bool {
+	for _, cfg := range referrers {
+		if !provider.IsResourceSupported(resource, cfg.Credentials) {
+			return false
+		}
+	}
+	return true
+}
+
 func (r *AtlasReconciler) connectionSecretRef(pro project.ProjectReferrerObject) *client.ObjectKey {
 	key := client.ObjectKeyFromObject(pro)
 	pdr := pro.ProjectDualRef()
@@ -79,43 +88,61 @@ func GetConnectionConfig(ctx context.Context, k8sClient client.Client, secretRef
 	}
 
 	cfg := &atlas.ConnectionConfig{
-		OrgID: string(secret.Data[orgIDKey]),
+		OrgID: string(secret.Data[credentials.OrgIDKey]),
 		Credentials: &atlas.Credentials{
 			APIKeys: &atlas.APIKeys{
-				PublicKey:  string(secret.Data[publicAPIKey]),
-				PrivateKey: string(secret.Data[privateAPIKey]),
+				PublicKey:  string(secret.Data[credentials.PublicAPIKey]),
+				PrivateKey: string(secret.Data[credentials.PrivateAPIKey]),
 			},
+			AtlasDomain: string(secret.Data[credentials.AtlasDomainKey]),
 		},
 	}
 
-	if missingFields, valid := validate(cfg); !valid {
-		return nil, fmt.Errorf("the following fields are missing in the secret %v: %v", secretRef, missingFields)
+	if err := checkConnectionConfig(cfg, fmt.Sprintf("the secret %v", secretRef)); err != nil {
+		return nil, err
 	}
 
 	return cfg, nil
 }
 
+// checkConnectionConfig validates the credentials read from the given source
+func checkConnectionConfig(cfg *atlas.ConnectionConfig, source string) error {
+	if missingFields, valid := validate(cfg); !valid {
+		return fmt.Errorf("the following fields are missing in %s: %v", source, missingFields)
+	}
+
+	// the Atlas provider further restricts the host to the allowed ones
+	if domain := cfg.Credentials.AtlasDomain; domain != "" {
+		domainURL, err := url.Parse(domain)
+		if err != nil || domainURL.Scheme != "https" || domainURL.Host == "" {
+			return fmt.Errorf("the %s of %s is not an https Atlas URL: %q", credentials.AtlasDomainKey, source, domain)
+		}
+	}
+
+	return nil
+}
+
 func validate(cfg *atlas.ConnectionConfig) ([]string, bool) {
 	missingFields := make([]string, 0, 3)
 
 	if cfg == nil {
-		return []string{orgIDKey, publicAPIKey, privateAPIKey}, false
+		return []string{credentials.OrgIDKey, credentials.PublicAPIKey, credentials.PrivateAPIKey}, false
 	}
 
 	if cfg.OrgID == "" {
-		missingFields = append(missingFields, orgIDKey)
+		missingFields = append(missingFields, credentials.OrgIDKey)
 	}
 
 	if cfg.Credentials == nil || cfg.Credentials.APIKeys == nil {
-		return append(missingFields, []string{publicAPIKey, privateAPIKey}...), false
+		return append(missingFields, []string{credentials.PublicAPIKey, credentials.PrivateAPIKey}...), false
 	}
 
 	if cfg.Credentials.APIKeys.PublicKey == "" {
-		missingFields = append(missingFields, publicAPIKey)
+		missingFields = append(missingFields, credentials.PublicAPIKey)
 	}
 
 	if cfg.Credentials.APIKeys.PrivateKey == "" {
-		missingFields = append(missingFields, privateAPIKey)
+		missingFields = append(missingFields, credentials.PrivateAPIKey)
 	}
 
 	if len(missingFields) > 0 {
@@ -16,6 +16,7 @@ package reconciler
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -76,6 +77,36 @@ func TestResolveConnectionConfig(t *testing.T) {
 			// we expect the credentials to match the local secret
 			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"}}},
 		},
+		{
+			title: "local connection secret with an Atlas domain",
+			// given an AtlasIPAccessList referencing a local connection secret with an Atlas domain
+			input: &akov2.AtlasIPAccessList{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-list",
+					Namespace: "project-namespace",
+				},
+				Spec: akov2.AtlasIPAccessListSpec{
+					ProjectDualReference: akov2.ProjectDualReference{
+						ConnectionSecret: &api.LocalObjectReference{Name: "gov-secret"},
+					},
+				},
+			},
+			objects: []client.Object{&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gov-secret",
+					Namespace: "project-namespace",
+				},
+				Data: map[string][]byte{
+					"orgId": []byte("some"), "publicApiKey": []byte("local"), "privateApiKey": []byte("secret"),
+					"atlasDomain": []byte("https://cloud.mongodbgov.com/"),
+				},
+			}},
+			// we expect the credentials to carry the Atlas domain
+			expected: &atlas.ConnectionConfig{OrgID: "some", Credentials: &atlas.Credentials{
+				APIKeys:     &atlas.APIKeys{PublicKey: "local", PrivateKey: "secret"},
+				AtlasDomain: "https://cloud.mongodbgov.com/",
+			}},
+		},
 		{
 			title: "project reference",
 			// given an AtlasIPAccessList referencing an AtlasProject resource
@@ -312,6 +343,28 @@ func TestValidateConnectionConfig(t *testing.T) {
 	})
 }
 
+func TestGetConnectionConfigInvalidAtlasDomain(t *testing.T) {
+	for _, domain := range []string{
+		"cloud.mongodbgov.com",
+		"http://cloud.mongodbgov.com/",
+		"https:///",
+	} {
+		t.Run(domain, func(t *testing.T) {
+			secretRef := client.ObjectKey{Namespace: "default", Name: "gov-secret"}
+			k8sClient := newFakeKubeClient(t, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: secretRef.Name, Namespace: secretRef.Namespace},
+				Data: map[string][]byte{
+					"orgId": []byte("some"), "publicApiKey": []byte("local"), "privateApiKey": []byte("secret"),
+					"atlasDomain": []byte(domain),
+				},
+			})
+
+			_, err := GetConnectionConfig(context.Background(), k8sClient, &secretRef, nil)
+			assert.EqualError(t, err, fmt.Sprintf("the atlasDomain of the secret default/gov-secret is not an https Atlas URL: %q", domain))
+		})
+	}
+}
+
 func newFakeKubeClient(t *testing.T, objs ...client.Object) client.Client {
 	t.Helper()
 
@@ -29,10 +29,11 @@ import (
 )
 
 type AtlasReconciler struct {
-	AtlasProvider   atlas.Provider
-	Client          client.Client
-	Log             *zap.SugaredLogger
-	GlobalSecretRef client.ObjectKey
+	AtlasProvider       atlas.Provider
+	Client              client.Client
+	Log                 *zap.SugaredLogger
+	GlobalSecretRef     client.ObjectKey
+	CredentialProviders CredentialProviders
 }
 
 func (r *AtlasReconciler) Skip(ctx context.Context, typeName string, resource api.AtlasCustomResource, spec any) (ctrl.Result, error) {
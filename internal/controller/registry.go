@@ -15,6 +15,7 @@
 package controller
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -29,27 +31,36 @@ import (
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasbackupcompliancepolicy"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasbackupexportbucket"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlascidrpool"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlascloudprovideraccess"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlascollection"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlascustomrole"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasdatabaseuser"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasdatafederation"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasdeployment"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasdeploymentaction"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasfederatedauth"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasidentityprovider"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasipaccesslist"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasnetworkcontainer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasnetworkpeering"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasnextapi"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasorgsettings"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasorguser"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasprivateendpoint"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasproject"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlassearchindex"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlassearchindexconfig"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasstream"
 	integrations "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlasthirdpartyintegrations"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/dryrun"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/featureflags"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/sharding"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/version"
 	ctrlstate "github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/controller/state"
-	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/ratelimit"
 )
 
 const DefaultReapplySupport = true
@@ -57,7 +68,7 @@ const DefaultReapplySupport = true
 type Reconciler interface {
 	reconcile.Reconciler
 	For() (client.Object, builder.Predicates)
-	SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error
+	SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error
 }
 
 type Registry struct {
@@ -70,10 +81,18 @@ type Registry struct {
 	reconcilers     []Reconciler
 	globalSecretRef client.ObjectKey
 
+	credentialProviders reconciler.CredentialProviders
+
 	reapplySupport bool
+
+	reconcilerConfig *ReconcilerConfig
+
+	clusterWide bool
+
+	shard *sharding.Shard
 }
 
-func NewRegistry(predicates []predicate.Predicate, deletionProtection bool, logger *zap.Logger, independentSyncPeriod time.Duration, featureFlags *featureflags.FeatureFlags, globalSecretRef client.ObjectKey) *Registry {
+func NewRegistry(predicates []predicate.Predicate, deletionProtection bool, logger *zap.Logger, independentSyncPeriod time.Duration, featureFlags *featureflags.FeatureFlags, globalSecretRef client.ObjectKey, credentialProviders reconciler.CredentialProviders, reconcilerConfig *ReconcilerConfig, clusterWide bool, shard *sharding.Shard) *Registry {
 	return &Registry{
 		sharedPredicates:      predicates,
 		deletionProtection:    deletionProtection,
@@ -81,7 +100,11 @@ func NewRegistry(predicates []predicate.Predicate, deletionProtection bool, logg
 		independentSyncPeriod: independentSyncPeriod,
 		featureFlags:          featureFlags,
 		globalSecretRef:       globalSecretRef,
+		credentialProviders:   credentialProviders,
 		reapplySupport:        DefaultReapplySupport,
+		reconcilerConfig:      reconcilerConfig,
+		clusterWide:           clusterWide,
+		shard:                 shard,
 	}
 }
 
@@ -99,7 +122,16 @@ func (r *Registry) RegisterWithManager(mgr ctrl.Manager, skipNameValidation bool
 	r.registerControllers(mgr, ap)
 
 	for _, reconciler := range r.reconcilers {
-		if err := reconciler.SetupWithManager(mgr, skipNameValidation); err != nil {
+		obj, _ := reconciler.For()
+		gvk, err := apiutil.GVKForObject(obj, mgr.GetScheme())
+		if err != nil {
+			return fmt.Errorf("failed to resolve the kind reconciled by %T: %w", reconciler, err)
+		}
+		options := r.reconcilerConfig.ForKind(gvk.Kind).ControllerOptions(skipNameValidation)
+		if r.shard != nil {
+			options.NewQueue = sharding.NewQueue(r.shard, mgr.GetCache(), obj)
+		}
+		if err := reconciler.SetupWithManager(mgr, options); err != nil {
 			return fmt.Errorf("failed to set up with manager: %w", err)
 		}
 	}
@@ -112,28 +144,51 @@ func (r *Registry) registerControllers(c cluster.Cluster, ap atlas.Provider) {
 	}
 
 	var reconcilers []Reconciler
-	reconcilers = append(reconcilers, atlasproject.NewAtlasProjectReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef))
-	reconcilers = append(reconcilers, atlasdeployment.NewAtlasDeploymentReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef))
-	reconcilers = append(reconcilers, atlasdatabaseuser.NewAtlasDatabaseUserReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.featureFlags, r.logger, r.globalSecretRef))
-	reconcilers = append(reconcilers, atlasdatafederation.NewAtlasDataFederationReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef))
+	reconcilers = append(reconcilers, atlasproject.NewAtlasProjectReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.credentialProviders))
+	reconcilers = append(reconcilers, atlasdeployment.NewAtlasDeploymentReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef, r.credentialProviders))
+	reconcilers = append(reconcilers, atlasdatabaseuser.NewAtlasDatabaseUserReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.featureFlags, r.logger, r.globalSecretRef, r.credentialProviders))
+	reconcilers = append(reconcilers, atlasdatafederation.NewAtlasDataFederationReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.credentialProviders))
 	reconcilers = append(reconcilers, atlasfederatedauth.NewAtlasFederatedAuthReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef))
-	reconcilers = append(reconcilers, atlasstream.NewAtlasStreamsInstanceReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef))
-	reconcilers = append(reconcilers, atlasstream.NewAtlasStreamsConnectionReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger))
-	reconcilers = append(reconcilers, atlassearchindexconfig.NewAtlasSearchIndexConfigReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger))
-	reconcilers = append(reconcilers, atlasbackupcompliancepolicy.NewAtlasBackupCompliancePolicyReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger))
-	reconcilers = append(reconcilers, atlascustomrole.NewAtlasCustomRoleReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef))
-	reconcilers = append(reconcilers, atlasprivateendpoint.NewAtlasPrivateEndpointReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef))
-	reconcilers = append(reconcilers, atlasipaccesslist.NewAtlasIPAccessListReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef))
-	reconcilers = append(reconcilers, atlasnetworkcontainer.NewAtlasNetworkContainerReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.independentSyncPeriod, r.globalSecretRef))
-	reconcilers = append(reconcilers, atlasnetworkpeering.NewAtlasNetworkPeeringsReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.independentSyncPeriod, r.globalSecretRef))
-
-	orgSettingsReconciler := atlasorgsettings.NewAtlasOrgSettingsReconciler(c, ap, r.logger, r.globalSecretRef, r.reapplySupport)
+	reconcilers = append(reconcilers, atlasstream.NewAtlasStreamsInstanceReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.credentialProviders))
+	reconcilers = append(reconcilers, atlasstream.NewAtlasStreamsConnectionReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.credentialProviders))
+	reconcilers = append(reconcilers, atlassearchindexconfig.NewAtlasSearchIndexConfigReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.credentialProviders))
+	reconcilers = append(reconcilers, atlasbackupcompliancepolicy.NewAtlasBackupCompliancePolicyReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.logger, r.globalSecretRef, r.credentialProviders))
+	reconcilers = append(reconcilers, atlascustomrole.NewAtlasCustomRoleReconciler(c, r.deprecatedPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef, r.credentialProviders))
+	reconcilers = append(reconcilers, atlasprivateendpoint.NewAtlasPrivateEndpointReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef, r.credentialProviders))
+	reconcilers = append(reconcilers, atlasipaccesslist.NewAtlasIPAccessListReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.independentSyncPeriod, r.logger, r.globalSecretRef, r.credentialProviders, r.clusterWide))
+	reconcilers = append(reconcilers, atlasnetworkcontainer.NewAtlasNetworkContainerReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.independentSyncPeriod, r.globalSecretRef, r.credentialProviders, r.clusterWide))
+	reconcilers = append(reconcilers, atlasnetworkpeering.NewAtlasNetworkPeeringsReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.independentSyncPeriod, r.globalSecretRef, r.credentialProviders))
+	reconcilers = append(reconcilers, atlascloudprovideraccess.NewAtlasCloudProviderAccessReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.independentSyncPeriod, r.globalSecretRef, r.credentialProviders))
+	reconcilers = append(reconcilers, atlasbackupexportbucket.NewAtlasBackupExportBucketReconciler(c, r.defaultPredicates(), ap, r.deletionProtection, r.logger, r.independentSyncPeriod, r.globalSecretRef, r.credentialProviders))
+	if r.clusterWide {
+		// pools are cluster scoped, they are not available when the operator is limited to some namespaces
+		reconcilers = append(reconcilers, atlascidrpool.NewAtlasCIDRPoolReconciler(c, r.defaultPredicates(), r.logger))
+	}
+
+	orgSettingsReconciler := atlasorgsettings.NewAtlasOrgSettingsReconciler(c, ap, r.logger, r.globalSecretRef, r.clusterWide, r.reapplySupport)
 	reconcilers = append(reconcilers, newCtrlStateReconciler(orgSettingsReconciler))
-	integrationsReconciler := integrations.NewAtlasThirdPartyIntegrationsReconciler(c, ap, r.deletionProtection, r.logger, r.globalSecretRef, r.reapplySupport)
+	integrationsReconciler := integrations.NewAtlasThirdPartyIntegrationsReconciler(c, ap, r.deletionProtection, r.logger, r.globalSecretRef, r.credentialProviders, r.reapplySupport)
 	reconcilers = append(reconcilers, newCtrlStateReconciler(integrationsReconciler))
+	identityProviderReconciler := atlasidentityprovider.NewAtlasIdentityProviderReconciler(c, ap, r.deletionProtection, r.logger, r.globalSecretRef, r.reapplySupport)
+	reconcilers = append(reconcilers, newCtrlStateReconciler(identityProviderReconciler))
+	orgUserReconciler := atlasorguser.NewAtlasOrgUserReconciler(c, ap, r.deletionProtection, r.logger, r.globalSecretRef, r.reapplySupport)
+	reconcilers = append(reconcilers, newCtrlStateReconciler(orgUserReconciler))
+	searchIndexReconciler := atlassearchindex.NewAtlasSearchIndexReconciler(c, ap, r.deletionProtection, r.logger, r.globalSecretRef, r.credentialProviders, r.reapplySupport)
+	reconcilers = append(reconcilers, newCtrlStateReconciler(searchIndexReconciler))
+	collectionReconciler := atlascollection.NewAtlasCollectionReconciler(c, r.logger, r.reapplySupport)
+	reconcilers = append(reconcilers, newCtrlStateReconciler(collectionReconciler))
+	deploymentActionReconciler := atlasdeploymentaction.NewAtlasDeploymentActionReconciler(c, ap, r.logger, r.globalSecretRef, r.credentialProviders, r.reapplySupport)
+	reconcilers = append(reconcilers, newCtrlStateReconciler(deploymentActionReconciler))
 
 	if version.IsExperimental() {
 		// Add experimental controllers here
+		nextAPIReconcilers, err := atlasnextapi.NewAtlasNextAPIReconcilers(context.Background(), c, ap, r.deletionProtection, r.logger, r.globalSecretRef)
+		if err != nil {
+			r.logger.Warn("skipping the atlas.nextapi.mongodb.com controllers", zap.Error(err))
+		}
+		for _, nextAPIReconciler := range nextAPIReconcilers {
+			reconcilers = append(reconcilers, newCtrlStateReconciler(nextAPIReconciler))
+		}
 	}
 	r.reconcilers = reconcilers
 }
@@ -157,10 +212,6 @@ func newCtrlStateReconciler[T any](r *ctrlstate.Reconciler[T]) *ctrlStateReconci
 	return &ctrlStateReconciler[T]{Reconciler: r}
 }
 
-func (nr *ctrlStateReconciler[T]) SetupWithManager(mgr ctrl.Manager, skipNameValidation bool) error {
-	defaultReconcilerOptions := controller.TypedOptions[reconcile.Request]{
-		RateLimiter:        ratelimit.NewRateLimiter[reconcile.Request](),
-		SkipNameValidation: pointer.MakePtr(skipNameValidation),
-	}
-	return nr.Reconciler.SetupWithManager(mgr, defaultReconcilerOptions)
+func (nr *ctrlStateReconciler[T]) SetupWithManager(mgr ctrl.Manager, options controller.TypedOptions[reconcile.Request]) error {
+	return nr.Reconciler.SetupWithManager(mgr, options)
 }
@@ -35,7 +35,8 @@ func TestCtrlStateReconciler_SetupWithManager_NoGomock(t *testing.T) {
 	skipNameValidation := true
 
 	r := newCtrlStateReconciler(fakeReconciler)
-	require.NoError(t, r.SetupWithManager(fakeMgr, skipNameValidation))
+	opts := (&ReconcilerConfig{}).ForKind("AtlasOrgSettings").ControllerOptions(skipNameValidation)
+	require.NoError(t, r.SetupWithManager(fakeMgr, opts))
 	require.Equal(t, fakeMgr, mock.ReceivedMgr)
 	wantOpts := controller.TypedOptions[reconcile.Request]{
 		SkipNameValidation: pointer.MakePtr(skipNameValidation),
@@ -39,6 +39,9 @@ type Context struct {
 
 	SdkClientSet *atlas.ClientSet
 
+	// Credentials are the ones the Atlas client was configured with
+	Credentials *atlas.Credentials
+
 	status Status
 
 	// This is the condition happened the last (most of all it contains the most important information that needs
@@ -85,6 +85,12 @@ const (
 	ServerlessPrivateEndpointInProgress   ConditionReason = "ServerlessPrivateEndpointInProgress"
 	ManagedNamespacesReady                ConditionReason = "ManagedNamespacesReady"
 	CustomZoneMappingReady                ConditionReason = "CustomZoneMappingReady"
+	DeploymentFinalSnapshotInProgress     ConditionReason = "DeploymentFinalSnapshotInProgress"
+	DeploymentFinalSnapshotFailed         ConditionReason = "DeploymentFinalSnapshotFailed"
+	MajorVersionUpgradeInProgress         ConditionReason = "MajorVersionUpgradeInProgress"
+	MajorVersionRollbackInProgress        ConditionReason = "MajorVersionRollbackInProgress"
+	MajorVersionUpgradeNotAllowed         ConditionReason = "MajorVersionUpgradeNotAllowed"
+	FeatureCompatibilityVersionNotPinned  ConditionReason = "FeatureCompatibilityVersionNotPinned"
 )
 
 // Atlas SearchNodes reasons
@@ -109,6 +115,8 @@ const (
 	DatabaseUserDeploymentAppliedChanges    ConditionReason = "DeploymentAppliedDatabaseUsersChanges"
 	DatabaseUserInvalidSpec                 ConditionReason = "DatabaseUserInvalidSpec"
 	DatabaseUserExpired                     ConditionReason = "DatabaseUserExpired"
+	DatabaseUserX509CertificateNotCreated   ConditionReason = "DatabaseUserX509CertificateNotCreated"
+	DatabaseUserServiceAccountNotFound      ConditionReason = "DatabaseUserServiceAccountNotFound"
 )
 
 // Atlas Data Federation reasons
@@ -135,6 +143,8 @@ const (
 	FederatedAuthIsNotEnabledInCR ConditionReason = "FederatedAuthNotEnabledInCR"
 	FederatedAuthOrgNotConnected  ConditionReason = "FederatedAuthOrgIsNotConnected"
 	FederatedAuthUsersConflict    ConditionReason = "FederatedAuthUsersConflict"
+
+	FederatedAuthWorkloadIdentityProviderNotReady ConditionReason = "FederatedAuthWorkloadIdentityProviderNotReady"
 )
 
 // Atlas Streams reasons
@@ -167,21 +177,47 @@ const (
 	PrivateEndpointConfigurationPending     ConditionReason = "PrivateEndpointConfigurationPending"
 	PrivateEndpointFailedToConfigure        ConditionReason = "PrivateEndpointFailedToConfigure"
 	PrivateEndpointFailedToDelete           ConditionReason = "PrivateEndpointFailedToDelete"
+	PrivateEndpointServiceNotExported       ConditionReason = "PrivateEndpointServiceNotExported"
 )
 
 // Atlas IP Access List reasons
 const (
-	IPAccessListFailedToCreate   ConditionReason = "IPAccessListFailedToCreate"
-	IPAccessListFailedToDelete   ConditionReason = "IPAccessListFailedToDelete"
-	IPAccessListFailedToGetState ConditionReason = "IPAccessListFailedToGetState"
-	IPAccessListPending          ConditionReason = "IPAccessListPending"
+	IPAccessListFailedToCreate    ConditionReason = "IPAccessListFailedToCreate"
+	IPAccessListFailedToDelete    ConditionReason = "IPAccessListFailedToDelete"
+	IPAccessListFailedToGetState  ConditionReason = "IPAccessListFailedToGetState"
+	IPAccessListPending           ConditionReason = "IPAccessListPending"
+	IPAccessListSourceUnavailable ConditionReason = "IPAccessListSourceUnavailable"
 )
 
 // Atlas Network Container reasons
 const (
-	NetworkContainerNotConfigured ConditionReason = "NetworkContainerNotConfigured"
-	NetworkContainerCreated       ConditionReason = "NetworkContainerCreated"
-	NetworkContainerNotDeleted    ConditionReason = "NetworkContainerNotDeleted"
+	NetworkContainerNotConfigured    ConditionReason = "NetworkContainerNotConfigured"
+	NetworkContainerCreated          ConditionReason = "NetworkContainerCreated"
+	NetworkContainerNotDeleted       ConditionReason = "NetworkContainerNotDeleted"
+	NetworkContainerCIDRNotAllocated ConditionReason = "NetworkContainerCIDRNotAllocated"
+)
+
+// Atlas CIDR Pool reasons
+const (
+	CIDRPoolInvalid ConditionReason = "CIDRPoolInvalid"
+	CIDRPoolInUse   ConditionReason = "CIDRPoolInUse"
+)
+
+// Atlas Cloud Provider Access reasons
+const (
+	CloudProviderAccessNotConfigured        ConditionReason = "CloudProviderAccessNotConfigured"
+	CloudProviderAccessNotExported          ConditionReason = "CloudProviderAccessNotExported"
+	CloudProviderAccessNotAuthorized        ConditionReason = "CloudProviderAccessNotAuthorized"
+	CloudProviderAccessPendingAuthorization ConditionReason = "CloudProviderAccessPendingAuthorization"
+	CloudProviderAccessNotDeleted           ConditionReason = "CloudProviderAccessNotDeleted"
+)
+
+// Atlas Backup Export Bucket reasons
+const (
+	BackupExportBucketNotConfigured              ConditionReason = "BackupExportBucketNotConfigured"
+	BackupExportBucketRoleChanged                ConditionReason = "BackupExportBucketRoleChanged"
+	BackupExportBucketPendingCloudProviderAccess ConditionReason = "BackupExportBucketPendingCloudProviderAccess"
+	BackupExportBucketNotDeleted                 ConditionReason = "BackupExportBucketNotDeleted"
 )
 
 // Atlas Network Peering reasons
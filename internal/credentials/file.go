@@ -0,0 +1,154 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+)
+
+const (
+	OrgIDKey       = "orgId"
+	PublicAPIKey   = "publicApiKey"
+	PrivateAPIKey  = "privateApiKey"
+	AtlasDomainKey = "atlasDomain"
+
+	// NamespacePlaceholder stands for the namespace of the project in the credentials locations
+	// the Operator is configured with, binding the projects of a namespace to their own credentials
+	NamespacePlaceholder = "{namespace}"
+)
+
+var keys = []string{OrgIDKey, PublicAPIKey, PrivateAPIKey, AtlasDomainKey}
+
+type fileVersion struct {
+	modTime time.Time
+	size    int64
+}
+
+type cachedFiles struct {
+	versions map[string]fileVersion
+	config   *atlas.ConnectionConfig
+}
+
+// FileProvider reads Atlas API credentials from the files of a directory per project, such as the projected volumes
+// of the Operator, reading them again whenever one of them changes. When the credentials directory contains
+// the namespace placeholder, a project only reads the directories of its own namespace.
+type FileProvider struct {
+	dir string
+
+	lock  sync.Mutex
+	cache map[string]cachedFiles
+}
+
+func NewFileProvider(dir string) *FileProvider {
+	return &FileProvider{
+		dir:   dir,
+		cache: map[string]cachedFiles{},
+	}
+}
+
+// ConnectionConfig returns the credentials of the directory the project references
+func (p *FileProvider) ConnectionConfig(_ context.Context, project *akov2.AtlasProject) (*atlas.ConnectionConfig, error) {
+	ref := project.Spec.CredentialsRef
+	if ref == nil || ref.File == nil {
+		return nil, errors.New("the project does not reference credentials files")
+	}
+	name := ref.File.Name
+	if !filepath.IsLocal(name) || strings.ContainsRune(name, filepath.Separator) {
+		return nil, fmt.Errorf("the credentials directory %q is not a plain directory name", name)
+	}
+
+	dir := filepath.Join(strings.ReplaceAll(p.dir, NamespacePlaceholder, project.Namespace), name)
+	versions, err := stat(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	p.lock.Lock()
+	cached, ok := p.cache[dir]
+	p.lock.Unlock()
+	if ok && sameVersions(cached.versions, versions) {
+		return cached.config, nil
+	}
+
+	values := map[string]string{}
+	for key := range versions {
+		data, err := os.ReadFile(filepath.Join(dir, key))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Atlas API credentials from %s: %w", dir, err)
+		}
+		values[key] = strings.TrimSpace(string(data))
+	}
+	config := connectionConfig(values)
+
+	p.lock.Lock()
+	p.cache[dir] = cachedFiles{versions: versions, config: config}
+	p.lock.Unlock()
+	return config, nil
+}
+
+// stat returns the version of the credentials files of the directory, skipping the missing ones
+func stat(dir string) (map[string]fileVersion, error) {
+	versions := map[string]fileVersion{}
+	for _, key := range keys {
+		info, err := os.Stat(filepath.Join(dir, key))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Atlas API credentials from %s: %w", dir, err)
+		}
+		versions[key] = fileVersion{modTime: info.ModTime(), size: info.Size()}
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("no Atlas API credentials found in %s", dir)
+	}
+	return versions, nil
+}
+
+func sameVersions(a, b map[string]fileVersion) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, version := range a {
+		other, ok := b[key]
+		if !ok || !version.modTime.Equal(other.modTime) || version.size != other.size {
+			return false
+		}
+	}
+	return true
+}
+
+func connectionConfig(values map[string]string) *atlas.ConnectionConfig {
+	return &atlas.ConnectionConfig{
+		OrgID: values[OrgIDKey],
+		Credentials: &atlas.Credentials{
+			APIKeys: &atlas.APIKeys{
+				PublicKey:  values[PublicAPIKey],
+				PrivateKey: values[PrivateAPIKey],
+			},
+			AtlasDomain: values[AtlasDomainKey],
+		},
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+)
+
+func TestFileProvider(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	writeFiles(t, filepath.Join(dir, "team-a"), now, map[string]string{
+		OrgIDKey:      "org\n",
+		PublicAPIKey:  "public",
+		PrivateAPIKey: "private",
+	})
+	provider := NewFileProvider(dir)
+	project := fileProject("team-a")
+
+	cfg, err := provider.ConnectionConfig(context.Background(), project)
+	require.NoError(t, err)
+	assert.Equal(t, &atlas.ConnectionConfig{
+		OrgID:       "org",
+		Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "public", PrivateKey: "private"}},
+	}, cfg)
+
+	cached, err := provider.ConnectionConfig(context.Background(), project)
+	require.NoError(t, err)
+	assert.Same(t, cfg, cached)
+
+	// rotate the keys
+	writeFiles(t, filepath.Join(dir, "team-a"), now.Add(time.Minute), map[string]string{
+		PublicAPIKey:   "rotated-public",
+		PrivateAPIKey:  "rotated-private",
+		AtlasDomainKey: "https://cloud.mongodbgov.com/",
+	})
+	rotated, err := provider.ConnectionConfig(context.Background(), project)
+	require.NoError(t, err)
+	assert.Equal(t, &atlas.ConnectionConfig{
+		OrgID: "org",
+		Credentials: &atlas.Credentials{
+			APIKeys:     &atlas.APIKeys{PublicKey: "rotated-public", PrivateKey: "rotated-private"},
+			AtlasDomain: "https://cloud.mongodbgov.com/",
+		},
+	}, rotated)
+}
+
+func TestFileProviderErrors(t *testing.T) {
+	dir := t.TempDir()
+	provider := NewFileProvider(dir)
+
+	_, err := provider.ConnectionConfig(context.Background(), fileProject("../etc"))
+	assert.EqualError(t, err, `the credentials directory "../etc" is not a plain directory name`)
+
+	_, err = provider.ConnectionConfig(context.Background(), fileProject("missing"))
+	assert.EqualError(t, err, "no Atlas API credentials found in "+filepath.Join(dir, "missing"))
+
+	_, err = provider.ConnectionConfig(context.Background(), &akov2.AtlasProject{})
+	assert.EqualError(t, err, "the project does not reference credentials files")
+}
+
+func TestFileProviderNamespaced(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, filepath.Join(dir, "team-a", "atlas"), time.Now(), map[string]string{
+		OrgIDKey:      "org",
+		PublicAPIKey:  "public",
+		PrivateAPIKey: "private",
+	})
+	provider := NewFileProvider(filepath.Join(dir, NamespacePlaceholder))
+
+	project := fileProject("atlas")
+	project.Namespace = "team-a"
+	cfg, err := provider.ConnectionConfig(context.Background(), project)
+	require.NoError(t, err)
+	assert.Equal(t, "public", cfg.Credentials.APIKeys.PublicKey)
+
+	project.Namespace = "team-b"
+	_, err = provider.ConnectionConfig(context.Background(), project)
+	assert.EqualError(t, err, "no Atlas API credentials found in "+filepath.Join(dir, "team-b", "atlas"))
+}
+
+func fileProject(name string) *akov2.AtlasProject {
+	return &akov2.AtlasProject{
+		Spec: akov2.AtlasProjectSpec{
+			CredentialsRef: &akov2.CredentialsReference{File: &akov2.FileCredentialsSource{Name: name}},
+		},
+	}
+}
+
+func writeFiles(t *testing.T, dir string, modTime time.Time, files map[string]string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+}
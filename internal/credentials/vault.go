@@ -0,0 +1,269 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+)
+
+const (
+	DefaultVaultAuthMount       = "kubernetes"
+	DefaultVaultKVMount         = "secret"
+	DefaultVaultRefreshInterval = 5 * time.Minute
+	DefaultServiceAccountToken  = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+	// tokenExpiryMargin renews the Vault token a bit before it expires
+	tokenExpiryMargin = 30 * time.Second
+	maxVaultResponse  = 1 << 20
+)
+
+var errVaultForbidden = errors.New("forbidden by Vault")
+
+// VaultConfig is how the Operator logs into Vault, with its Kubernetes auth method
+type VaultConfig struct {
+	Address string
+	// AuthMount is the path the Kubernetes auth method is mounted at
+	AuthMount string
+	Role      string
+	// TokenPath is the file of the service account token the Operator logs in with
+	TokenPath string
+	// RefreshInterval is how long the credentials are cached before they are read again
+	RefreshInterval time.Duration
+	// PathTemplate is the mount and path prefix of the secrets a project may read, such as
+	// secret/atlas/{namespace}, the namespace placeholder standing for the namespace of the project.
+	// Projects read any secret the role of the Operator grants when it is empty.
+	PathTemplate string
+	HTTPClient   *http.Client
+}
+
+type cachedSecret struct {
+	config    *atlas.ConnectionConfig
+	fetchedAt time.Time
+}
+
+// VaultProvider reads Atlas API credentials from the KV version 2 secrets of HashiCorp Vault, caching them for
+// the refresh interval so that rotated credentials are picked up without restarting the Operator
+type VaultProvider struct {
+	config VaultConfig
+	now    func() time.Time
+
+	lock        sync.Mutex
+	token       string
+	tokenExpiry time.Time
+	cache       map[string]cachedSecret
+}
+
+func NewVaultProvider(config VaultConfig) *VaultProvider {
+	if config.AuthMount == "" {
+		config.AuthMount = DefaultVaultAuthMount
+	}
+	if config.TokenPath == "" {
+		config.TokenPath = DefaultServiceAccountToken
+	}
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = DefaultVaultRefreshInterval
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &VaultProvider{
+		config: config,
+		now:    time.Now,
+		cache:  map[string]cachedSecret{},
+	}
+}
+
+// ConnectionConfig returns the credentials of the Vault secret the project references
+func (p *VaultProvider) ConnectionConfig(ctx context.Context, project *akov2.AtlasProject) (*atlas.ConnectionConfig, error) {
+	ref := project.Spec.CredentialsRef
+	if ref == nil || ref.Vault == nil {
+		return nil, errors.New("the project does not reference a Vault secret")
+	}
+	mount := strings.Trim(ref.Vault.Mount, "/")
+	if mount == "" {
+		mount = DefaultVaultKVMount
+	}
+	secretPath := strings.Trim(ref.Vault.Path, "/")
+	cacheKey := mount + "/" + secretPath
+	if err := p.checkSecretPath(project.Namespace, cacheKey); err != nil {
+		return nil, err
+	}
+
+	now := p.now()
+	p.lock.Lock()
+	cached, ok := p.cache[cacheKey]
+	p.lock.Unlock()
+	if ok && now.Sub(cached.fetchedAt) < p.config.RefreshInterval {
+		return cached.config, nil
+	}
+
+	values, err := p.readSecret(ctx, mount, secretPath)
+	if errors.Is(err, errVaultForbidden) {
+		// the token may have been revoked, log in again once
+		p.lock.Lock()
+		p.token = ""
+		p.lock.Unlock()
+		values, err = p.readSecret(ctx, mount, secretPath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Atlas API credentials from Vault secret %s: %w", cacheKey, err)
+	}
+
+	config := connectionConfig(values)
+	p.lock.Lock()
+	p.cache[cacheKey] = cachedSecret{config: config, fetchedAt: now}
+	p.lock.Unlock()
+	return config, nil
+}
+
+// checkSecretPath rejects the secrets out of the path template expanded with the namespace of the project
+func (p *VaultProvider) checkSecretPath(namespace, secretPath string) error {
+	for _, segment := range strings.Split(secretPath, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("the Vault secret %q is not a plain path", secretPath)
+		}
+	}
+	if p.config.PathTemplate == "" {
+		return nil
+	}
+	allowed := strings.Trim(strings.ReplaceAll(p.config.PathTemplate, NamespacePlaceholder, namespace), "/")
+	if !strings.HasPrefix(secretPath+"/", allowed+"/") {
+		return fmt.Errorf("the Vault secret %s is not under %s, the projects of namespace %q cannot read it", secretPath, allowed, namespace)
+	}
+	return nil
+}
+
+func (p *VaultProvider) readSecret(ctx context.Context, mount, secretPath string) (map[string]string, error) {
+	token, err := p.login(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secretURL, err := url.JoinPath(p.config.Address, "v1", mount, "data", secretPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Vault address %q: %w", p.config.Address, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, secretURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+
+	response := struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}{}
+	if err := p.do(req, &response); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if value, ok := response.Data.Data[key]; ok {
+			values[key] = strings.TrimSpace(fmt.Sprint(value))
+		}
+	}
+	return values, nil
+}
+
+// login returns the Vault token of the Operator, logging in with its service account token when it expired
+func (p *VaultProvider) login(ctx context.Context) (string, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	now := p.now()
+	if p.token != "" && (p.tokenExpiry.IsZero() || now.Before(p.tokenExpiry)) {
+		return p.token, nil
+	}
+
+	jwt, err := os.ReadFile(p.config.TokenPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the service account token: %w", err)
+	}
+	body, err := json.Marshal(map[string]string{"role": p.config.Role, "jwt": strings.TrimSpace(string(jwt))})
+	if err != nil {
+		return "", err
+	}
+	loginURL, err := url.JoinPath(p.config.Address, "v1", "auth", strings.Trim(p.config.AuthMount, "/"), "login")
+	if err != nil {
+		return "", fmt.Errorf("invalid Vault address %q: %w", p.config.Address, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, loginURL, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	response := struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+		} `json:"auth"`
+	}{}
+	if err := p.do(req, &response); err != nil {
+		return "", fmt.Errorf("failed to log into Vault: %w", err)
+	}
+	if response.Auth.ClientToken == "" {
+		return "", errors.New("failed to log into Vault: no client token returned")
+	}
+
+	p.token = response.Auth.ClientToken
+	p.tokenExpiry = time.Time{}
+	if lease := time.Duration(response.Auth.LeaseDuration) * time.Second; lease > 0 {
+		p.tokenExpiry = now.Add(max(lease-tokenExpiryMargin, lease/2))
+	}
+	return p.token, nil
+}
+
+func (p *VaultProvider) do(req *http.Request, target any) error {
+	resp, err := p.config.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxVaultResponse))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		vaultErrors := struct {
+			Errors []string `json:"errors"`
+		}{}
+		_ = json.Unmarshal(body, &vaultErrors)
+		if resp.StatusCode == http.StatusForbidden {
+			return fmt.Errorf("%w: %s", errVaultForbidden, strings.Join(vaultErrors.Errors, ", "))
+		}
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.Join(vaultErrors.Errors, ", "))
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("failed to decode the Vault response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+)
+
+type fakeVault struct {
+	logins  int
+	reads   int
+	token   string
+	version int
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/auth/kubernetes/login":
+		login := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&login); err != nil || login["role"] != "ako" || login["jwt"] != "sa-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		v.logins++
+		v.token = "token-" + string(rune('0'+v.logins))
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"auth": map[string]any{"client_token": v.token, "lease_duration": 3600},
+		})
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/atlas/data/teams/team-a":
+		if r.Header.Get("X-Vault-Token") != v.token {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		v.reads++
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"data": map[string]any{
+					OrgIDKey:      "org",
+					PublicAPIKey:  "public-" + string(rune('0'+v.version)),
+					PrivateAPIKey: "private-" + string(rune('0'+v.version)),
+				},
+				"metadata": map[string]any{"version": v.version},
+			},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[]}`))
+	}
+}
+
+func TestVaultProvider(t *testing.T) {
+	vault := &fakeVault{version: 1}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	now := time.Now()
+	provider := NewVaultProvider(VaultConfig{
+		Address:         server.URL,
+		Role:            "ako",
+		TokenPath:       serviceAccountToken(t),
+		RefreshInterval: time.Minute,
+	})
+	provider.now = func() time.Time { return now }
+	project := vaultProject("atlas", "teams/team-a")
+
+	cfg, err := provider.ConnectionConfig(context.Background(), project)
+	require.NoError(t, err)
+	assert.Equal(t, &atlas.ConnectionConfig{
+		OrgID:       "org",
+		Credentials: &atlas.Credentials{APIKeys: &atlas.APIKeys{PublicKey: "public-1", PrivateKey: "private-1"}},
+	}, cfg)
+
+	// the credentials are cached until the refresh interval elapses
+	vault.version = 2
+	cached, err := provider.ConnectionConfig(context.Background(), project)
+	require.NoError(t, err)
+	assert.Same(t, cfg, cached)
+
+	now = now.Add(time.Minute)
+	rotated, err := provider.ConnectionConfig(context.Background(), project)
+	require.NoError(t, err)
+	assert.Equal(t, "public-2", rotated.Credentials.APIKeys.PublicKey)
+	assert.Equal(t, 1, vault.logins)
+	assert.Equal(t, 2, vault.reads)
+
+	// a revoked token is replaced by logging in again
+	vault.token = "revoked"
+	now = now.Add(time.Minute)
+	_, err = provider.ConnectionConfig(context.Background(), project)
+	require.NoError(t, err)
+	assert.Equal(t, 2, vault.logins)
+
+	// the token is renewed before it expires
+	now = now.Add(time.Hour)
+	_, err = provider.ConnectionConfig(context.Background(), project)
+	require.NoError(t, err)
+	assert.Equal(t, 3, vault.logins)
+}
+
+func TestVaultProviderErrors(t *testing.T) {
+	server := httptest.NewServer(&fakeVault{})
+	defer server.Close()
+
+	provider := NewVaultProvider(VaultConfig{Address: server.URL, Role: "ako", TokenPath: serviceAccountToken(t)})
+	_, err := provider.ConnectionConfig(context.Background(), vaultProject("", "teams/team-b"))
+	assert.EqualError(t, err, "failed to read Atlas API credentials from Vault secret secret/teams/team-b: unexpected status 404: ")
+
+	provider = NewVaultProvider(VaultConfig{Address: server.URL, Role: "other", TokenPath: serviceAccountToken(t)})
+	_, err = provider.ConnectionConfig(context.Background(), vaultProject("atlas", "teams/team-a"))
+	assert.EqualError(t, err, "failed to read Atlas API credentials from Vault secret atlas/teams/team-a: failed to log into Vault: unexpected status 400: ")
+}
+
+func TestVaultProviderPathTemplate(t *testing.T) {
+	vault := &fakeVault{version: 1}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	provider := NewVaultProvider(VaultConfig{
+		Address:      server.URL,
+		Role:         "ako",
+		TokenPath:    serviceAccountToken(t),
+		PathTemplate: "/atlas/teams/{namespace}/",
+	})
+	project := vaultProject("atlas", "teams/team-a")
+	project.Namespace = "team-a"
+	cfg, err := provider.ConnectionConfig(context.Background(), project)
+	require.NoError(t, err)
+	assert.Equal(t, "public-1", cfg.Credentials.APIKeys.PublicKey)
+
+	for _, tc := range []struct {
+		name      string
+		namespace string
+		mount     string
+		path      string
+		wantErr   string
+	}{
+		{
+			name:      "other namespace",
+			namespace: "team-b",
+			mount:     "atlas",
+			path:      "teams/team-a",
+			wantErr:   `the Vault secret atlas/teams/team-a is not under atlas/teams/team-b, the projects of namespace "team-b" cannot read it`,
+		},
+		{
+			name:      "other mount",
+			namespace: "team-a",
+			mount:     "secret",
+			path:      "teams/team-a",
+			wantErr:   `the Vault secret secret/teams/team-a is not under atlas/teams/team-a, the projects of namespace "team-a" cannot read it`,
+		},
+		{
+			name:      "sibling prefix",
+			namespace: "team",
+			mount:     "atlas",
+			path:      "teams/team-a",
+			wantErr:   `the Vault secret atlas/teams/team-a is not under atlas/teams/team, the projects of namespace "team" cannot read it`,
+		},
+		{
+			name:      "parent segment",
+			namespace: "team-b",
+			mount:     "atlas",
+			path:      "teams/team-b/../team-a",
+			wantErr:   `the Vault secret "atlas/teams/team-b/../team-a" is not a plain path`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			project := vaultProject(tc.mount, tc.path)
+			project.Namespace = tc.namespace
+			_, err := provider.ConnectionConfig(context.Background(), project)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+	assert.Equal(t, 1, vault.reads)
+}
+
+func vaultProject(mount, path string) *akov2.AtlasProject {
+	return &akov2.AtlasProject{
+		Spec: akov2.AtlasProjectSpec{
+			CredentialsRef: &akov2.CredentialsReference{Vault: &akov2.VaultCredentialsSource{Mount: mount, Path: path}},
+		},
+	}
+}
+
+func serviceAccountToken(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("sa-token\n"), 0o600))
+	return path
+}
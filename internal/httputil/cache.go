@@ -0,0 +1,254 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httputil
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	cacheResultHit         = "hit"
+	cacheResultRevalidated = "revalidated"
+	cacheResultMiss        = "miss"
+)
+
+var (
+	cacheHits   atomic.Int64
+	cacheMisses atomic.Int64
+
+	cacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "mongodb_atlas_operator_http_cache_requests_total",
+		Help: "Atlas API GET requests going through the response cache, by result (hit, revalidated or miss)",
+	}, []string{"result"})
+
+	cacheHitRatio = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "mongodb_atlas_operator_http_cache_hit_ratio",
+		Help: "Ratio of Atlas API GET requests served from the response cache, including revalidated ones",
+	}, func() float64 {
+		hits, misses := cacheHits.Load(), cacheMisses.Load()
+		if hits+misses == 0 {
+			return 0
+		}
+		return float64(hits) / float64(hits+misses)
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(cacheRequests, cacheHitRatio)
+}
+
+type cacheEntry struct {
+	path       string
+	statusCode int
+	header     http.Header
+	body       []byte
+	etag       string
+	expires    time.Time
+}
+
+// ResponseCache keeps successful Atlas GET responses for a short TTL.
+// It is shared by all transports of a provider, entries are partitioned by credentials.
+// Stale entries are kept one more TTL for revalidation and then swept.
+type ResponseCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	entries   map[string]map[string]*cacheEntry
+	lastSweep time.Time
+}
+
+// CacheScope returns the cache scope of a set of credentials on an Atlas domain.
+// Both keys are part of it, so that responses are never served to a caller
+// that only knows the public key.
+func CacheScope(domain, publicKey, privateKey string) string {
+	sum := sha256.Sum256([]byte(domain + "\x00" + publicKey + "\x00" + privateKey))
+	return hex.EncodeToString(sum[:])
+}
+
+func NewResponseCache(ttl time.Duration) *ResponseCache {
+	return &ResponseCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]map[string]*cacheEntry{},
+	}
+}
+
+// get returns a copy of the entry, so that it can be used without holding the lock,
+// and whether it is still fresh
+func (c *ResponseCache) get(scope, key string) (cacheEntry, bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[scope][key]
+	if !ok {
+		return cacheEntry{}, false, false
+	}
+	return *entry, c.now().Before(entry.expires), true
+}
+
+func (c *ResponseCache) put(scope, key string, entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sweep()
+	if c.entries[scope] == nil {
+		c.entries[scope] = map[string]*cacheEntry{}
+	}
+	c.entries[scope][key] = entry
+}
+
+func (c *ResponseCache) refresh(scope, key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[scope][key]; ok {
+		entry.expires = c.now().Add(c.ttl)
+	}
+}
+
+// sweep drops the entries that have been stale for more than a TTL, and the scopes
+// left empty, such as the ones of rotated credentials. It runs at most once per TTL
+// and must be called with the lock held.
+func (c *ResponseCache) sweep() {
+	now := c.now()
+	if now.Sub(c.lastSweep) < c.ttl {
+		return
+	}
+	c.lastSweep = now
+	for scope, entries := range c.entries {
+		for key, entry := range entries {
+			if now.After(entry.expires.Add(c.ttl)) {
+				delete(entries, key)
+			}
+		}
+		if len(entries) == 0 {
+			delete(c.entries, scope)
+		}
+	}
+}
+
+// invalidate drops the entries of the given path, its parents (such as lists) and its children
+func (c *ResponseCache) invalidate(scope, path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.entries[scope] {
+		if isPathPrefix(entry.path, path) || isPathPrefix(path, entry.path) {
+			delete(c.entries[scope], key)
+		}
+	}
+}
+
+func isPathPrefix(prefix, path string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+type cachingRoundTripper struct {
+	cache    *ResponseCache
+	scope    string
+	delegate http.RoundTripper
+}
+
+// NewCachingTransport serves GET requests from the cache while fresh, revalidates
+// stale entries with If-None-Match when an ETag was returned, and invalidates the
+// affected entries on any other method. The scope must identify the credentials in use.
+func NewCachingTransport(cache *ResponseCache, scope string, delegate http.RoundTripper) http.RoundTripper {
+	return &cachingRoundTripper{cache: cache, scope: scope, delegate: delegate}
+}
+
+func (t *cachingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		resp, err := t.delegate.RoundTrip(req)
+		t.cache.invalidate(t.scope, req.URL.Path)
+		return resp, err
+	}
+
+	key := fmt.Sprintf("%s %s", req.URL.String(), req.Header.Get("Accept"))
+	entry, fresh, cached := t.cache.get(t.scope, key)
+	if fresh {
+		countCache(cacheResultHit)
+		return entry.response(req), nil
+	}
+
+	if cached && entry.etag != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+	resp, err := t.delegate.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if cached && entry.etag != "" && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		t.cache.refresh(t.scope, key)
+		countCache(cacheResultRevalidated)
+		return entry.response(req), nil
+	}
+
+	countCache(cacheResultMiss)
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	t.cache.put(t.scope, key, &cacheEntry{
+		path:       req.URL.Path,
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+		etag:       resp.Header.Get("ETag"),
+		expires:    t.cache.now().Add(t.cache.ttl),
+	})
+	return resp, nil
+}
+
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.statusCode, http.StatusText(e.statusCode)),
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+func countCache(result string) {
+	if result == cacheResultMiss {
+		cacheMisses.Add(1)
+	} else {
+		cacheHits.Add(1)
+	}
+	cacheRequests.WithLabelValues(result).Inc()
+}
@@ -0,0 +1,184 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httputil
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAtlas struct {
+	version      int
+	gets         int
+	notModified  int
+	withoutETags bool
+}
+
+func (f *fakeAtlas) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		f.version++
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	f.gets++
+	etag := fmt.Sprintf(`"v%d"`, f.version)
+	if !f.withoutETags {
+		if r.Header.Get("If-None-Match") == etag {
+			f.notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+	}
+	_, _ = fmt.Fprintf(w, "%s version %d", r.URL.Path, f.version)
+}
+
+func get(t *testing.T, c *http.Client, url string) string {
+	t.Helper()
+	resp, err := c.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestCachingTransport(t *testing.T) {
+	atlas := &fakeAtlas{}
+	srv := httptest.NewServer(atlas)
+	defer srv.Close()
+
+	now := time.Now()
+	cache := NewResponseCache(time.Minute)
+	cache.now = func() time.Time { return now }
+	c := &http.Client{Transport: NewCachingTransport(cache, "key1", http.DefaultTransport)}
+	clusters := srv.URL + "/api/atlas/v2/groups/1/clusters"
+	hits := testutil.ToFloat64(cacheRequests.WithLabelValues(cacheResultHit))
+
+	assert.Equal(t, "/api/atlas/v2/groups/1/clusters version 0", get(t, c, clusters))
+	assert.Equal(t, "/api/atlas/v2/groups/1/clusters version 0", get(t, c, clusters))
+	assert.Equal(t, 1, atlas.gets)
+	assert.Equal(t, hits+1, testutil.ToFloat64(cacheRequests.WithLabelValues(cacheResultHit)))
+
+	t.Run("stale entries are revalidated with the ETag", func(t *testing.T) {
+		now = now.Add(2 * time.Minute)
+		assert.Equal(t, "/api/atlas/v2/groups/1/clusters version 0", get(t, c, clusters))
+		assert.Equal(t, 2, atlas.gets)
+		assert.Equal(t, 1, atlas.notModified)
+
+		assert.Equal(t, "/api/atlas/v2/groups/1/clusters version 0", get(t, c, clusters))
+		assert.Equal(t, 2, atlas.gets, "a revalidated entry is fresh again")
+	})
+
+	t.Run("writes invalidate the path and its parents", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPatch, clusters+"/cluster0", strings.NewReader("{}"))
+		require.NoError(t, err)
+		resp, err := c.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, "/api/atlas/v2/groups/1/clusters version 1", get(t, c, clusters))
+		assert.Equal(t, 3, atlas.gets)
+	})
+
+	t.Run("other credentials do not share entries", func(t *testing.T) {
+		other := &http.Client{Transport: NewCachingTransport(cache, "key2", http.DefaultTransport)}
+		get(t, other, clusters)
+		assert.Equal(t, 4, atlas.gets)
+	})
+
+	t.Run("entries without ETag are fetched again once stale", func(t *testing.T) {
+		atlas.withoutETags = true
+		users := srv.URL + "/api/atlas/v2/groups/1/databaseUsers"
+		get(t, c, users)
+		get(t, c, users)
+		assert.Equal(t, 5, atlas.gets)
+
+		now = now.Add(2 * time.Minute)
+		get(t, c, users)
+		assert.Equal(t, 6, atlas.gets)
+	})
+}
+
+func TestCachingTransportConcurrentRevalidation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v0"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v0"`)
+		_, _ = fmt.Fprint(w, "version 0")
+	}))
+	defer srv.Close()
+
+	// a zero TTL makes every request revalidate, refreshing the entry concurrently
+	c := &http.Client{Transport: NewCachingTransport(NewResponseCache(0), "key1", http.DefaultTransport)}
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				assert.Equal(t, "version 0", get(t, c, srv.URL+"/api/atlas/v2/groups"))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestResponseCacheSweep(t *testing.T) {
+	now := time.Now()
+	cache := NewResponseCache(time.Minute)
+	cache.now = func() time.Time { return now }
+	cache.put("rotated", "k", &cacheEntry{expires: now.Add(time.Minute)})
+	cache.put("active", "k", &cacheEntry{expires: now.Add(time.Minute)})
+
+	now = now.Add(90 * time.Second)
+	cache.put("active", "k", &cacheEntry{expires: now.Add(time.Minute)})
+	_, _, ok := cache.get("rotated", "k")
+	assert.True(t, ok, "stale entries are kept for revalidation")
+
+	now = now.Add(90 * time.Second)
+	cache.put("active", "k", &cacheEntry{expires: now.Add(time.Minute)})
+	assert.NotContains(t, cache.entries, "rotated")
+	_, fresh, ok := cache.get("active", "k")
+	assert.True(t, ok)
+	assert.True(t, fresh)
+}
+
+func TestCacheScope(t *testing.T) {
+	scope := CacheScope("cloud.mongodb.com", "public", "private")
+	assert.Equal(t, scope, CacheScope("cloud.mongodb.com", "public", "private"))
+	assert.NotEqual(t, scope, CacheScope("cloud.mongodb.com", "public", "other-private"))
+	assert.NotEqual(t, scope, CacheScope("cloud.mongodbgov.com", "public", "private"))
+	assert.NotContains(t, scope, "private")
+}
+
+func TestIsPathPrefix(t *testing.T) {
+	assert.True(t, isPathPrefix("/groups/1/clusters", "/groups/1/clusters/c0"))
+	assert.True(t, isPathPrefix("/groups/1/clusters/", "/groups/1/clusters"))
+	assert.False(t, isPathPrefix("/groups/1/clusters", "/groups/1/clustersX"))
+	assert.False(t, isPathPrefix("/groups/1/clusters/c0", "/groups/1/clusters"))
+}
@@ -0,0 +1,349 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httputil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// CassetteMode selects whether Atlas API traffic is recorded to or replayed from a cassette
+type CassetteMode string
+
+const (
+	CassetteModeOff    CassetteMode = ""
+	CassetteModeRecord CassetteMode = "record"
+	CassetteModeReplay CassetteMode = "replay"
+
+	redacted = "REDACTED"
+)
+
+// sensitiveHeaders are never written to a cassette
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Www-Authenticate",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// sensitiveFields are lower case fragments of JSON field names whose values get redacted
+var sensitiveFields = []string{
+	"password",
+	"secret",
+	"token",
+	"privatekey",
+	"apikey",
+	"accesskey",
+	"certificate",
+}
+
+// Interaction is a single sanitized request/response pair
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Cassette holds the interactions recorded to, or replayed from, a file
+// holding one JSON interaction per line.
+// It is safe for concurrent use and meant to be shared by all transports of a provider.
+type Cassette struct {
+	path string
+
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+	started      bool
+}
+
+// NewCassette returns an empty cassette that will be saved at the given path
+func NewCassette(path string) *Cassette {
+	return &Cassette{path: path}
+}
+
+// LoadCassette reads a previously recorded cassette
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette %q: %w", path, err)
+	}
+	interactions := []Interaction{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var interaction Interaction
+		err := decoder.Decode(&interaction)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode cassette %q: %w", path, err)
+		}
+		interactions = append(interactions, interaction)
+	}
+	return &Cassette{
+		path:         path,
+		interactions: interactions,
+		replayed:     make([]bool, len(interactions)),
+	}, nil
+}
+
+// Interactions returns a copy of the interactions held by the cassette
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Interaction{}, c.interactions...)
+}
+
+func (c *Cassette) record(interaction Interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.append(interaction); err != nil {
+		return err
+	}
+	c.interactions = append(c.interactions, interaction)
+	c.replayed = append(c.replayed, false)
+	return nil
+}
+
+// append writes the interaction at the end of the cassette file, so that a crashing operator
+// still leaves the interactions recorded so far behind. The first one replaces any previous recording.
+func (c *Cassette) append(interaction Interaction) error {
+	data, err := json.Marshal(interaction)
+	if err != nil {
+		return fmt.Errorf("failed to encode interaction: %w", err)
+	}
+	flags := os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	if !c.started {
+		flags |= os.O_TRUNC
+	}
+	file, err := os.OpenFile(c.path, flags, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open cassette file: %w", err)
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write cassette file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close cassette file: %w", err)
+	}
+	c.started = true
+	return nil
+}
+
+// find returns the next interaction not replayed yet matching the request,
+// preferring an exact body match. Once all matches are used up, the last one
+// keeps being served, so polling for a final state works.
+func (c *Cassette) find(req RecordedRequest) (Interaction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	last, sameBody, first := -1, -1, -1
+	for i, interaction := range c.interactions {
+		if interaction.Request.Method != req.Method || interaction.Request.URL != req.URL {
+			continue
+		}
+		last = i
+		if c.replayed[i] {
+			continue
+		}
+		if sameBody == -1 && interaction.Request.Body == req.Body {
+			sameBody = i
+		}
+		if first == -1 {
+			first = i
+		}
+	}
+
+	for _, i := range []int{sameBody, first, last} {
+		if i >= 0 {
+			c.replayed[i] = true
+			return c.interactions[i], true
+		}
+	}
+	return Interaction{}, false
+}
+
+type recordingRoundTripper struct {
+	cassette *Cassette
+	delegate http.RoundTripper
+}
+
+// NewRecordingTransport records every sanitized request/response pair going through delegate into the cassette
+func NewRecordingTransport(cassette *Cassette, delegate http.RoundTripper) http.RoundTripper {
+	return &recordingRoundTripper{cassette: cassette, delegate: delegate}
+}
+
+func (r *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	recordedReq, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.delegate.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	interaction := Interaction{
+		Request: recordedReq,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    sanitizeHeaders(resp.Header),
+			Body:       sanitizeBody(body),
+		},
+	}
+	if err := r.cassette.record(interaction); err != nil {
+		return nil, fmt.Errorf("failed to record interaction: %w", err)
+	}
+	return resp, nil
+}
+
+type replayRoundTripper struct {
+	cassette *Cassette
+}
+
+// NewReplayTransport serves responses from the cassette and never reaches the network
+func NewReplayTransport(cassette *Cassette) http.RoundTripper {
+	return &replayRoundTripper{cassette: cassette}
+}
+
+func (r *replayRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	recordedReq, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction, ok := r.cassette.find(recordedReq)
+	if !ok {
+		return nil, fmt.Errorf("no recorded interaction for %s %s", recordedReq.Method, recordedReq.URL)
+	}
+
+	header := interaction.Response.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+func recordRequest(req *http.Request) (RecordedRequest, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return RecordedRequest{}, fmt.Errorf("failed to read request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return RecordedRequest{
+		Method:  req.Method,
+		URL:     req.URL.RequestURI(),
+		Headers: sanitizeHeaders(req.Header),
+		Body:    sanitizeBody(body),
+	}, nil
+}
+
+func sanitizeHeaders(header http.Header) http.Header {
+	sanitized := header.Clone()
+	for _, name := range sensitiveHeaders {
+		sanitized.Del(name)
+	}
+	if len(sanitized) == 0 {
+		return nil
+	}
+	return sanitized
+}
+
+func sanitizeBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	sanitized, err := json.Marshal(redact(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(sanitized)
+}
+
+func redact(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for field, fieldValue := range v {
+			if _, isString := fieldValue.(string); isString && isSensitive(field) {
+				v[field] = redacted
+				continue
+			}
+			v[field] = redact(fieldValue)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+	}
+	return value
+}
+
+func isSensitive(field string) bool {
+	field = strings.ToLower(field)
+	for _, fragment := range sensitiveFields {
+		if strings.Contains(field, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httputil
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mongodb-forks/digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.Header().Set("WWW-Authenticate", `Digest realm="MMS Public API", nonce="abc", qop="auth", algorithm=MD5`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"username":"app","password":"hunter2"}`))
+		default:
+			_, _ = w.Write([]byte(`{"username":"app","stateName":"IDLE"}`))
+		}
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder := &http.Client{Transport: NewRecordingTransport(NewCassette(path), digest.NewTransport("public", "private"))}
+
+	resp, err := recorder.Post(srv.URL+"/api/atlas/v2/groups/1/databaseUsers", "application/json", strings.NewReader(`{"username":"app","password":"hunter2"}`))
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Contains(t, string(body), "hunter2", "the caller must get the original response")
+
+	resp, err = recorder.Get(srv.URL + "/api/atlas/v2/groups/1/databaseUsers/admin/app")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 2, calls)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "hunter2")
+	assert.NotContains(t, string(data), "Authorization")
+	assert.NotContains(t, string(data), "private")
+
+	cassette, err := LoadCassette(path)
+	require.NoError(t, err)
+	require.Len(t, cassette.Interactions(), 2)
+	assert.Equal(t, "/api/atlas/v2/groups/1/databaseUsers", cassette.Interactions()[0].Request.URL)
+
+	replayer := &http.Client{Transport: NewReplayTransport(cassette)}
+	for range 2 {
+		resp, err = replayer.Get("https://cloud.mongodb.com/api/atlas/v2/groups/1/databaseUsers/admin/app")
+		require.NoError(t, err)
+		body, _ = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.JSONEq(t, `{"username":"app","stateName":"IDLE"}`, string(body))
+	}
+	assert.Equal(t, 2, calls, "replay must not reach the server")
+
+	_, err = replayer.Get("https://cloud.mongodb.com/api/atlas/v2/groups/2")
+	assert.ErrorContains(t, err, "no recorded interaction for GET /api/atlas/v2/groups/2")
+}
+
+func TestCassetteRecordAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, os.WriteFile(path, []byte("previous recording\n"), 0o600))
+
+	cassette := NewCassette(path)
+	for _, url := range []string{"/a", "/b", "/c"} {
+		require.NoError(t, cassette.record(Interaction{
+			Request:  RecordedRequest{Method: http.MethodGet, URL: url},
+			Response: RecordedResponse{StatusCode: http.StatusOK},
+		}))
+	}
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 3, "each interaction must be appended on its own line, replacing the previous recording")
+	assert.JSONEq(t, `{"request":{"method":"GET","url":"/a"},"response":{"statusCode":200}}`, lines[0])
+
+	loaded, err := LoadCassette(path)
+	require.NoError(t, err)
+	assert.Equal(t, cassette.Interactions(), loaded.Interactions())
+}
+
+func TestCassetteReplayOrder(t *testing.T) {
+	cassette := &Cassette{
+		interactions: []Interaction{
+			{Request: RecordedRequest{Method: http.MethodGet, URL: "/c"}, Response: RecordedResponse{StatusCode: http.StatusOK, Body: "CREATING"}},
+			{Request: RecordedRequest{Method: http.MethodPatch, URL: "/c", Body: `{"a":2}`}, Response: RecordedResponse{StatusCode: http.StatusOK, Body: "2"}},
+			{Request: RecordedRequest{Method: http.MethodPatch, URL: "/c", Body: `{"a":1}`}, Response: RecordedResponse{StatusCode: http.StatusOK, Body: "1"}},
+			{Request: RecordedRequest{Method: http.MethodGet, URL: "/c"}, Response: RecordedResponse{StatusCode: http.StatusOK, Body: "IDLE"}},
+		},
+		replayed: make([]bool, 4),
+	}
+
+	for _, tc := range []struct {
+		method, body, want string
+	}{
+		{method: http.MethodGet, want: "CREATING"},
+		{method: http.MethodPatch, body: `{"a":1}`, want: "1"},
+		{method: http.MethodGet, want: "IDLE"},
+		{method: http.MethodGet, want: "IDLE"},
+		{method: http.MethodPatch, body: `{"a":3}`, want: "2"},
+	} {
+		interaction, ok := cassette.find(RecordedRequest{Method: tc.method, URL: "/c", Body: tc.body})
+		require.True(t, ok)
+		assert.Equal(t, tc.want, interaction.Response.Body)
+	}
+}
+
+func TestSanitizeBody(t *testing.T) {
+	for _, tc := range []struct {
+		title string
+		body  string
+		want  string
+	}{
+		{
+			title: "empty body",
+		},
+		{
+			title: "not JSON",
+			body:  "plain text",
+			want:  "plain text",
+		},
+		{
+			title: "nested secrets are redacted",
+			body:  `{"name":"x","password":"p","nested":[{"apiKey":"k","region":"r"}],"awsSecretAccessKey":"s","expiresAfterHours":1}`,
+			want:  `{"awsSecretAccessKey":"REDACTED","expiresAfterHours":1,"name":"x","nested":[{"apiKey":"REDACTED","region":"r"}],"password":"REDACTED"}`,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			assert.Equal(t, tc.want, sanitizeBody([]byte(tc.body)))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httputil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// TransportConfig sets how outbound requests reach their servers
+type TransportConfig struct {
+	// ProxyURL is the proxy all requests go through, including its credentials if any.
+	// Requests use the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables when it is not set
+	ProxyURL *url.URL
+	// NoProxy lists the hosts, domains and CIDRs reached without the proxy, in the NO_PROXY format
+	NoProxy string
+	// CABundle holds PEM encoded certificates trusted on top of the system roots
+	CABundle []byte
+}
+
+// NewTransport returns a copy of http.DefaultTransport configured by the given TransportConfig
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != nil {
+		proxyFunc := (&httpproxy.Config{
+			HTTPProxy:  config.ProxyURL.String(),
+			HTTPSProxy: config.ProxyURL.String(),
+			NoProxy:    config.NoProxy,
+		}).ProxyFunc()
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+	}
+
+	if len(config.CABundle) > 0 {
+		roots, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load system root certificates: %w", err)
+		}
+		if !roots.AppendCertsFromPEM(config.CABundle) {
+			return nil, errors.New("no PEM certificate found in the CA bundle")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+	}
+
+	return transport, nil
+}
+
+// ReloadableTransport sends requests through a transport which can be replaced while in use,
+// so that a changed TransportConfig applies without restarting
+type ReloadableTransport struct {
+	current atomic.Pointer[http.Transport]
+}
+
+func NewReloadableTransport(transport *http.Transport) *ReloadableTransport {
+	reloadable := &ReloadableTransport{}
+	reloadable.current.Store(transport)
+	return reloadable
+}
+
+func (t *ReloadableTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.current.Load().RoundTrip(req)
+}
+
+// Transport returns the transport requests are currently sent through
+func (t *ReloadableTransport) Transport() *http.Transport {
+	return t.current.Load()
+}
+
+// Store replaces the transport, the idle connections of the previous one are closed
+// and those still in use expire with its idle connection timeout
+func (t *ReloadableTransport) Store(transport *http.Transport) {
+	if previous := t.current.Swap(transport); previous != nil {
+		previous.CloseIdleConnections()
+	}
+}
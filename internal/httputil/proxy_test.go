@@ -0,0 +1,201 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httputil
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connectProxy tunnels CONNECT requests with the expected credentials to its target,
+// whatever the requested host is
+type connectProxy struct {
+	target     string
+	credential string
+
+	mu       sync.Mutex
+	requests []string
+}
+
+func (p *connectProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	p.requests = append(p.requests, r.Method+" "+r.Host)
+	p.mu.Unlock()
+
+	if r.Header.Get("Proxy-Authorization") != "Basic "+base64.StdEncoding.EncodeToString([]byte(p.credential)) {
+		w.WriteHeader(http.StatusProxyAuthRequired)
+		return
+	}
+	if r.Method != http.MethodConnect {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	upstream, err := net.Dial("tcp", p.target)
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	downstream, _, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		upstream.Close()
+		return
+	}
+	go func() {
+		defer upstream.Close()
+		defer downstream.Close()
+		_, _ = io.Copy(upstream, downstream)
+	}()
+	_, _ = io.Copy(downstream, upstream)
+}
+
+func (p *connectProxy) Requests() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string{}, p.requests...)
+}
+
+func TestNewTransport(t *testing.T) {
+	atlas := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	}))
+	defer atlas.Close()
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: atlas.Certificate().Raw})
+
+	proxy := &connectProxy{target: atlas.Listener.Addr().String(), credential: "user:secret"}
+	proxyServer := httptest.NewServer(proxy)
+	defer proxyServer.Close()
+	proxyURL, err := url.Parse(proxyServer.URL)
+	require.NoError(t, err)
+
+	// the test certificate is valid for example.com, which the proxy resolves to the test server
+	const atlasURL = "https://example.com/api/atlas/v2"
+
+	for _, tc := range []struct {
+		title        string
+		config       TransportConfig
+		wantErr      string
+		wantRequests []string
+	}{
+		{
+			title: "proxy with credentials and CA bundle",
+			config: TransportConfig{
+				ProxyURL: withUser(proxyURL, url.UserPassword("user", "secret")),
+				CABundle: caBundle,
+			},
+			wantRequests: []string{"CONNECT example.com:443"},
+		},
+		{
+			title: "wrong proxy credentials",
+			config: TransportConfig{
+				ProxyURL: withUser(proxyURL, url.UserPassword("user", "wrong")),
+				CABundle: caBundle,
+			},
+			wantErr:      "Proxy Authentication Required",
+			wantRequests: []string{"CONNECT example.com:443"},
+		},
+		{
+			title: "untrusted server certificate",
+			config: TransportConfig{
+				ProxyURL: withUser(proxyURL, url.UserPassword("user", "secret")),
+			},
+			wantErr:      "certificate signed by unknown authority",
+			wantRequests: []string{"CONNECT example.com:443"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			proxy.requests = nil
+			transport, err := NewTransport(tc.config)
+			require.NoError(t, err)
+
+			resp, err := (&http.Client{Transport: transport}).Get(atlasURL)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+				defer resp.Body.Close()
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, "hello", string(body))
+			}
+			assert.Equal(t, tc.wantRequests, proxy.Requests())
+		})
+	}
+}
+
+func TestNewTransportNoProxy(t *testing.T) {
+	proxyURL, err := url.Parse("http://proxy.internal:3128")
+	require.NoError(t, err)
+	transport, err := NewTransport(TransportConfig{ProxyURL: proxyURL, NoProxy: "internal.example.com,10.0.0.0/8"})
+	require.NoError(t, err)
+
+	for target, want := range map[string]*url.URL{
+		"https://cloud.mongodb.com/api":      proxyURL,
+		"https://internal.example.com/api":   nil,
+		"https://a.internal.example.com/api": nil,
+		"https://10.1.2.3/api":               nil,
+	} {
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		require.NoError(t, err)
+		got, err := transport.Proxy(req)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, target)
+	}
+}
+
+func TestNewTransportInvalidCABundle(t *testing.T) {
+	_, err := NewTransport(TransportConfig{CABundle: []byte("not a certificate")})
+	assert.EqualError(t, err, "no PEM certificate found in the CA bundle")
+}
+
+func withUser(u *url.URL, user *url.Userinfo) *url.URL {
+	withUser := *u
+	withUser.User = user
+	return &withUser
+}
+
+func TestReloadableTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	first, err := NewTransport(TransportConfig{})
+	require.NoError(t, err)
+	transport := NewReloadableTransport(first)
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	second, err := NewTransport(TransportConfig{})
+	require.NoError(t, err)
+	transport.Store(second)
+	assert.Same(t, second, transport.Transport())
+	resp, err = (&http.Client{Transport: transport}).Get(srv.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
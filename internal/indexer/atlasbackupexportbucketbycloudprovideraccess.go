@@ -0,0 +1,58 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+const (
+	AtlasBackupExportBucketByCloudProviderAccessIndex = "atlasbackupexportbucket.spec.cloudProviderAccessRef"
+)
+
+type AtlasBackupExportBucketByCloudProviderAccessIndexer struct {
+	logger *zap.SugaredLogger
+}
+
+func NewAtlasBackupExportBucketByCloudProviderAccessIndexer(logger *zap.Logger) *AtlasBackupExportBucketByCloudProviderAccessIndexer {
+	return &AtlasBackupExportBucketByCloudProviderAccessIndexer{
+		logger: logger.Named(AtlasBackupExportBucketByCloudProviderAccessIndex).Sugar(),
+	}
+}
+
+func (*AtlasBackupExportBucketByCloudProviderAccessIndexer) Object() client.Object {
+	return &akov2.AtlasBackupExportBucket{}
+}
+
+func (*AtlasBackupExportBucketByCloudProviderAccessIndexer) Name() string {
+	return AtlasBackupExportBucketByCloudProviderAccessIndex
+}
+
+func (a *AtlasBackupExportBucketByCloudProviderAccessIndexer) Keys(object client.Object) []string {
+	bucket, ok := object.(*akov2.AtlasBackupExportBucket)
+	if !ok {
+		a.logger.Errorf("expected *akov2.AtlasBackupExportBucket but got %T", object)
+		return nil
+	}
+
+	if bucket.Spec.CloudProviderAccessRef.Name == "" {
+		return nil
+	}
+
+	return []string{bucket.Spec.CloudProviderAccessRef.GetObject(bucket.Namespace).String()}
+}
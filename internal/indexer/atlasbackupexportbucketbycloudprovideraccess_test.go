@@ -0,0 +1,72 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+)
+
+func TestAtlasBackupExportBucketByCloudProviderAccessIndexer(t *testing.T) {
+	for _, tc := range []struct {
+		title    string
+		object   client.Object
+		wantKeys []string
+	}{
+		{
+			title: "nil obj renders nothing",
+		},
+		{
+			title:  "wrong obj renders nothing",
+			object: &akov2.AtlasCloudProviderAccess{},
+		},
+		{
+			title:  "bucket without a role ref renders nothing",
+			object: &akov2.AtlasBackupExportBucket{},
+		},
+		{
+			title: "bucket renders the role in its own namespace by default",
+			object: &akov2.AtlasBackupExportBucket{
+				ObjectMeta: metav1.ObjectMeta{Name: "bucket", Namespace: "backups"},
+				Spec: akov2.AtlasBackupExportBucketSpec{
+					CloudProviderAccessRef: common.ResourceRefNamespaced{Name: "s3-role"},
+				},
+			},
+			wantKeys: []string{"backups/s3-role"},
+		},
+		{
+			title: "bucket renders the role in the referenced namespace",
+			object: &akov2.AtlasBackupExportBucket{
+				ObjectMeta: metav1.ObjectMeta{Name: "bucket", Namespace: "backups"},
+				Spec: akov2.AtlasBackupExportBucketSpec{
+					CloudProviderAccessRef: common.ResourceRefNamespaced{Name: "s3-role", Namespace: "roles"},
+				},
+			},
+			wantKeys: []string{"roles/s3-role"},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			indexer := NewAtlasBackupExportBucketByCloudProviderAccessIndexer(zaptest.NewLogger(t))
+			assert.Equal(t, tc.wantKeys, indexer.Keys(tc.object))
+		})
+	}
+}
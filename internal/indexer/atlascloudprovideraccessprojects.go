@@ -0,0 +1,44 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:dupl
+package indexer
+
+import (
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+const (
+	AtlasCloudProviderAccessByProjectIndex = "atlascloudprovideraccess.spec.projectRef"
+)
+
+type AtlasCloudProviderAccessByProjectIndexer struct {
+	AtlasReferrerByProjectIndexerBase
+}
+
+func NewAtlasCloudProviderAccessByProjectIndexer(logger *zap.Logger) *AtlasCloudProviderAccessByProjectIndexer {
+	return &AtlasCloudProviderAccessByProjectIndexer{
+		AtlasReferrerByProjectIndexerBase: *NewAtlasReferrerByProjectIndexer(
+			logger,
+			AtlasCloudProviderAccessByProjectIndex,
+		),
+	}
+}
+
+func (*AtlasCloudProviderAccessByProjectIndexer) Object() client.Object {
+	return &akov2.AtlasCloudProviderAccess{}
+}
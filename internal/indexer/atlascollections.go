@@ -0,0 +1,67 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+const (
+	AtlasCollectionBySecretIndex = "atlascollection.spec.connectionSecretRef"
+)
+
+type AtlasCollectionBySecretIndexer struct {
+	logger *zap.SugaredLogger
+}
+
+func NewAtlasCollectionBySecretIndexer(logger *zap.Logger) *AtlasCollectionBySecretIndexer {
+	return &AtlasCollectionBySecretIndexer{
+		logger: logger.Named(AtlasCollectionBySecretIndex).Sugar(),
+	}
+}
+
+func (*AtlasCollectionBySecretIndexer) Object() client.Object {
+	return &akov2.AtlasCollection{}
+}
+
+func (*AtlasCollectionBySecretIndexer) Name() string {
+	return AtlasCollectionBySecretIndex
+}
+
+func (a *AtlasCollectionBySecretIndexer) Keys(object client.Object) []string {
+	collection, ok := object.(*akov2.AtlasCollection)
+	if !ok {
+		a.logger.Errorf("expected *akov2.AtlasCollection but got %T", object)
+		return nil
+	}
+
+	if collection.Spec.ConnectionSecretRef.Name == "" {
+		return nil
+	}
+
+	return []string{client.ObjectKey{Namespace: collection.GetNamespace(), Name: collection.Spec.ConnectionSecretRef.Name}.String()}
+}
+
+func AtlasCollectionRequests(list *akov2.AtlasCollectionList) []reconcile.Request {
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, toRequest(&item))
+	}
+	return requests
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+const (
+	AtlasIPAccessListBySourceIndex = "atlasipaccesslist.spec.dynamicSources"
+
+	// IPAccessListNodesSourceKey is the key of the AtlasIPAccessLists adding the addresses of nodes
+	IPAccessListNodesSourceKey = "Node"
+)
+
+type AtlasIPAccessListBySourceIndexer struct {
+	logger *zap.SugaredLogger
+}
+
+func NewAtlasIPAccessListBySourceIndexer(logger *zap.Logger) *AtlasIPAccessListBySourceIndexer {
+	return &AtlasIPAccessListBySourceIndexer{
+		logger: logger.Named(AtlasIPAccessListBySourceIndex).Sugar(),
+	}
+}
+
+func (*AtlasIPAccessListBySourceIndexer) Object() client.Object {
+	return &akov2.AtlasIPAccessList{}
+}
+
+func (*AtlasIPAccessListBySourceIndexer) Name() string {
+	return AtlasIPAccessListBySourceIndex
+}
+
+// Keys returns "Node" for the lists selecting nodes, and "<kind>/<namespace>/<name>" for those referencing
+// a Service or a ConfigMap
+func (a *AtlasIPAccessListBySourceIndexer) Keys(object client.Object) []string {
+	ipAccessList, ok := object.(*akov2.AtlasIPAccessList)
+	if !ok {
+		a.logger.Errorf("expected *akov2.AtlasIPAccessList but got %T", object)
+		return nil
+	}
+
+	keys := make([]string, 0, len(ipAccessList.Spec.DynamicSources))
+	for _, source := range ipAccessList.Spec.DynamicSources {
+		switch {
+		case source.NodeSelector != nil:
+			keys = append(keys, IPAccessListNodesSourceKey)
+		case source.ServiceRef != nil:
+			keys = append(keys, IPAccessListSourceKey("Service", ipAccessList.Namespace, source.ServiceRef.Name))
+		case source.ConfigMapRef != nil:
+			keys = append(keys, IPAccessListSourceKey("ConfigMap", ipAccessList.Namespace, source.ConfigMapRef.Name))
+		}
+	}
+	return keys
+}
+
+func IPAccessListSourceKey(kind, namespace, name string) string {
+	return kind + "/" + client.ObjectKey{Namespace: namespace, Name: name}.String()
+}
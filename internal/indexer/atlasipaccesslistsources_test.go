@@ -0,0 +1,70 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+func TestAtlasIPAccessListBySourceIndexer(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		object   client.Object
+		wantKeys []string
+	}{
+		{
+			name:   "should return nil on wrong type",
+			object: &akov2.AtlasProject{},
+		},
+		{
+			name:     "should return no keys without dynamic sources",
+			object:   &akov2.AtlasIPAccessList{},
+			wantKeys: []string{},
+		},
+		{
+			name: "should return the keys of all sources",
+			object: &akov2.AtlasIPAccessList{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "egress",
+					Namespace: "ns",
+				},
+				Spec: akov2.AtlasIPAccessListSpec{
+					DynamicSources: []akov2.IPAccessDynamicSource{
+						{NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "db-clients"}}},
+						{ServiceRef: &api.LocalObjectReference{Name: "gateway"}},
+						{ConfigMapRef: &akov2.IPAccessListConfigMapReference{Name: "nat-ips"}},
+					},
+				},
+			},
+			wantKeys: []string{"ConfigMap/ns/nat-ips", "Node", "Service/ns/gateway"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			indexer := NewAtlasIPAccessListBySourceIndexer(zaptest.NewLogger(t))
+			keys := indexer.Keys(tc.object)
+			sort.Strings(keys)
+			assert.Equal(t, tc.wantKeys, keys)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+const (
+	AtlasNetworkContainerByCIDRPoolIndex = "atlasnetworkcontainer.spec.cidrPoolRef"
+)
+
+type AtlasNetworkContainerByCIDRPoolIndexer struct {
+	logger *zap.SugaredLogger
+}
+
+func NewAtlasNetworkContainerByCIDRPoolIndexer(logger *zap.Logger) *AtlasNetworkContainerByCIDRPoolIndexer {
+	return &AtlasNetworkContainerByCIDRPoolIndexer{
+		logger: logger.Named(AtlasNetworkContainerByCIDRPoolIndex).Sugar(),
+	}
+}
+
+func (*AtlasNetworkContainerByCIDRPoolIndexer) Object() client.Object {
+	return &akov2.AtlasNetworkContainer{}
+}
+
+func (*AtlasNetworkContainerByCIDRPoolIndexer) Name() string {
+	return AtlasNetworkContainerByCIDRPoolIndex
+}
+
+func (c *AtlasNetworkContainerByCIDRPoolIndexer) Keys(object client.Object) []string {
+	container, ok := object.(*akov2.AtlasNetworkContainer)
+	if !ok {
+		c.logger.Errorf("expected *akov2.AtlasNetworkContainer but got %T", object)
+		return nil
+	}
+
+	if container.Spec.CIDRPoolRef != nil && container.Spec.CIDRPoolRef.Name != "" {
+		return []string{container.Spec.CIDRPoolRef.Name}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+const (
+	AtlasSearchIndexBySecretsIndex = "atlassearchindex.spec.connectionSecret"
+	AtlasSearchIndexByConfigIndex  = "atlassearchindex.spec.search.searchConfigurationRef"
+)
+
+func NewAtlasSearchIndexByConnectionSecretIndexer(logger *zap.Logger) *LocalCredentialIndexer {
+	return NewLocalCredentialsIndexer(AtlasSearchIndexBySecretsIndex, &akov2.AtlasSearchIndex{}, logger)
+}
+
+type AtlasSearchIndexByConfigIndexer struct {
+	logger *zap.SugaredLogger
+}
+
+func NewAtlasSearchIndexByConfigIndexer(logger *zap.Logger) *AtlasSearchIndexByConfigIndexer {
+	return &AtlasSearchIndexByConfigIndexer{
+		logger: logger.Named(AtlasSearchIndexByConfigIndex).Sugar(),
+	}
+}
+
+func (*AtlasSearchIndexByConfigIndexer) Object() client.Object {
+	return &akov2.AtlasSearchIndex{}
+}
+
+func (*AtlasSearchIndexByConfigIndexer) Name() string {
+	return AtlasSearchIndexByConfigIndex
+}
+
+func (a *AtlasSearchIndexByConfigIndexer) Keys(object client.Object) []string {
+	index, ok := object.(*akov2.AtlasSearchIndex)
+	if !ok {
+		a.logger.Errorf("expected *akov2.AtlasSearchIndex but got %T", object)
+		return nil
+	}
+
+	if index.Spec.Search == nil || index.Spec.Search.SearchConfigurationRef.Name == "" {
+		return nil
+	}
+
+	return []string{index.Spec.Search.SearchConfigurationRef.GetObject(index.GetNamespace()).String()}
+}
+
+func AtlasSearchIndexRequests(list *akov2.AtlasSearchIndexList) []reconcile.Request {
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, toRequest(&item))
+	}
+	return requests
+}
@@ -0,0 +1,68 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/common"
+)
+
+func TestAtlasSearchIndexByConfigIndexer(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		object   *akov2.AtlasSearchIndex
+		wantKeys []string
+	}{
+		{
+			name: "vector search index has no keys",
+			object: &akov2.AtlasSearchIndex{
+				Spec: akov2.AtlasSearchIndexSpec{SearchIndex: akov2.SearchIndex{Type: "vectorSearch"}},
+			},
+		},
+		{
+			name: "config in the namespace of the index",
+			object: &akov2.AtlasSearchIndex{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns"},
+				Spec: akov2.AtlasSearchIndexSpec{SearchIndex: akov2.SearchIndex{
+					Type:   "search",
+					Search: &akov2.Search{SearchConfigurationRef: common.ResourceRefNamespaced{Name: "config"}},
+				}},
+			},
+			wantKeys: []string{"ns/config"},
+		},
+		{
+			name: "config in another namespace",
+			object: &akov2.AtlasSearchIndex{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns"},
+				Spec: akov2.AtlasSearchIndexSpec{SearchIndex: akov2.SearchIndex{
+					Type:   "search",
+					Search: &akov2.Search{SearchConfigurationRef: common.ResourceRefNamespaced{Name: "config", Namespace: "shared"}},
+				}},
+			},
+			wantKeys: []string{"shared/config"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			indexer := NewAtlasSearchIndexByConfigIndexer(zaptest.NewLogger(t))
+			assert.Equal(t, tc.wantKeys, indexer.Keys(tc.object))
+		})
+	}
+}
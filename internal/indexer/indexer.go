@@ -62,15 +62,27 @@ func RegisterAll(ctx context.Context, c cluster.Cluster, logger *zap.Logger) err
 		NewAtlasPrivateEndpointByProjectIndexer(logger),
 		NewAtlasIPAccessListCredentialsByCredentialIndexer(logger),
 		NewAtlasIPAccessListByProjectIndexer(logger),
+		NewAtlasIPAccessListBySourceIndexer(logger),
 		NewAtlasNetworkPeeringByCredentialIndexer(logger),
 		NewAtlasNetworkPeeringByProjectIndexer(logger),
 		NewAtlasNetworkContainerByCredentialIndexer(logger),
 		NewAtlasNetworkContainerByProjectIndexer(logger),
+		NewAtlasNetworkContainerByCIDRPoolIndexer(logger),
 		NewAtlasNetworkPeeringByContainerIndexer(logger),
 		NewAtlasThirdPartyIntegrationByProjectIndexer(logger),
 		NewAtlasThirdPartyIntegrationByCredentialIndexer(logger),
 		NewAtlasThirdPartyIntegrationBySecretsIndexer(logger),
 		NewAtlasOrgSettingsByConnectionSecretIndexer(logger),
+		NewAtlasCloudProviderAccessByCredentialIndexer(logger),
+		NewAtlasCloudProviderAccessByProjectIndexer(logger),
+		NewAtlasBackupExportBucketByCredentialIndexer(logger),
+		NewAtlasBackupExportBucketByProjectIndexer(logger),
+		NewAtlasBackupExportBucketByCloudProviderAccessIndexer(logger),
+		NewAtlasIdentityProviderByConnectionSecretIndexer(logger),
+		NewAtlasOrgUserByConnectionSecretIndexer(logger),
+		NewAtlasSearchIndexByConnectionSecretIndexer(logger),
+		NewAtlasSearchIndexByConfigIndexer(logger),
+		NewAtlasCollectionBySecretIndexer(logger),
 	)
 	if version.IsExperimental() {
 		// add experimental indexers here
@@ -29,7 +29,7 @@ type TestProvider struct {
 	IsSupportedFunc  func() bool
 }
 
-func (f *TestProvider) IsCloudGov() bool {
+func (f *TestProvider) IsCloudGov(_ *atlas.Credentials) bool {
 	return f.IsCloudGovFunc()
 }
 
@@ -37,6 +37,6 @@ func (f *TestProvider) SdkClientSet(ctx context.Context, creds *atlas.Credential
 	return f.SdkClientSetFunc(ctx, creds, log)
 }
 
-func (f *TestProvider) IsResourceSupported(_ api.AtlasCustomResource) bool {
+func (f *TestProvider) IsResourceSupported(_ api.AtlasCustomResource, _ *atlas.Credentials) bool {
 	return f.IsSupportedFunc()
 }
@@ -5,6 +5,8 @@ package translation
 import (
 	context "context"
 
+	time "time"
+
 	mock "github.com/stretchr/testify/mock"
 
 	v1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
@@ -858,6 +860,55 @@ func (_c *AtlasDeploymentsServiceMock_ListDeploymentNames_Call) RunAndReturn(run
 	return _c
 }
 
+// PinFeatureCompatibilityVersion provides a mock function with given fields: ctx, projectID, clusterName, until
+func (_m *AtlasDeploymentsServiceMock) PinFeatureCompatibilityVersion(ctx context.Context, projectID string, clusterName string, until time.Time) error {
+	ret := _m.Called(ctx, projectID, clusterName, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PinFeatureCompatibilityVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, projectID, clusterName, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AtlasDeploymentsServiceMock_PinFeatureCompatibilityVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PinFeatureCompatibilityVersion'
+type AtlasDeploymentsServiceMock_PinFeatureCompatibilityVersion_Call struct {
+	*mock.Call
+}
+
+// PinFeatureCompatibilityVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - clusterName string
+//   - until time.Time
+func (_e *AtlasDeploymentsServiceMock_Expecter) PinFeatureCompatibilityVersion(ctx interface{}, projectID interface{}, clusterName interface{}, until interface{}) *AtlasDeploymentsServiceMock_PinFeatureCompatibilityVersion_Call {
+	return &AtlasDeploymentsServiceMock_PinFeatureCompatibilityVersion_Call{Call: _e.mock.On("PinFeatureCompatibilityVersion", ctx, projectID, clusterName, until)}
+}
+
+func (_c *AtlasDeploymentsServiceMock_PinFeatureCompatibilityVersion_Call) Run(run func(ctx context.Context, projectID string, clusterName string, until time.Time)) *AtlasDeploymentsServiceMock_PinFeatureCompatibilityVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AtlasDeploymentsServiceMock_PinFeatureCompatibilityVersion_Call) Return(_a0 error) *AtlasDeploymentsServiceMock_PinFeatureCompatibilityVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AtlasDeploymentsServiceMock_PinFeatureCompatibilityVersion_Call) RunAndReturn(run func(context.Context, string, string, time.Time) error) *AtlasDeploymentsServiceMock_PinFeatureCompatibilityVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateDeployment provides a mock function with given fields: ctx, _a1
 func (_m *AtlasDeploymentsServiceMock) UpdateDeployment(ctx context.Context, _a1 deployment.Deployment) (deployment.Deployment, error) {
 	ret := _m.Called(ctx, _a1)
@@ -251,6 +251,66 @@ func (_c *NetworkContainerServiceMock_Get_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// List provides a mock function with given fields: ctx, projectID, provider
+func (_m *NetworkContainerServiceMock) List(ctx context.Context, projectID string, provider string) ([]*networkcontainer.NetworkContainer, error) {
+	ret := _m.Called(ctx, projectID, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*networkcontainer.NetworkContainer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*networkcontainer.NetworkContainer, error)); ok {
+		return rf(ctx, projectID, provider)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*networkcontainer.NetworkContainer); ok {
+		r0 = rf(ctx, projectID, provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*networkcontainer.NetworkContainer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, projectID, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkContainerServiceMock_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type NetworkContainerServiceMock_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - provider string
+func (_e *NetworkContainerServiceMock_Expecter) List(ctx interface{}, projectID interface{}, provider interface{}) *NetworkContainerServiceMock_List_Call {
+	return &NetworkContainerServiceMock_List_Call{Call: _e.mock.On("List", ctx, projectID, provider)}
+}
+
+func (_c *NetworkContainerServiceMock_List_Call) Run(run func(ctx context.Context, projectID string, provider string)) *NetworkContainerServiceMock_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *NetworkContainerServiceMock_List_Call) Return(_a0 []*networkcontainer.NetworkContainer, _a1 error) *NetworkContainerServiceMock_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkContainerServiceMock_List_Call) RunAndReturn(run func(context.Context, string, string) ([]*networkcontainer.NetworkContainer, error)) *NetworkContainerServiceMock_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, projectID, containerID, cfg
 func (_m *NetworkContainerServiceMock) Update(ctx context.Context, projectID string, containerID string, cfg *networkcontainer.NetworkContainerConfig) (*networkcontainer.NetworkContainer, error) {
 	ret := _m.Called(ctx, projectID, containerID, cfg)
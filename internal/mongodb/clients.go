@@ -0,0 +1,111 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultClientIdleTimeout is how long an unused connection is kept open
+const DefaultClientIdleTimeout = 30 * time.Minute
+
+// Clients keeps one connection per connection URL shared across reconciliations, so
+// that every reconciliation does not open and close its own connection pool.
+// Connections unused for the idle timeout are closed, which also releases those of
+// rotated credentials.
+type Clients struct {
+	connect     ConnectFunc
+	idleTimeout time.Duration
+	now         func() time.Time
+
+	lock    sync.Mutex
+	clients map[string]*pooledClient
+}
+
+type pooledClient struct {
+	service  CollectionService
+	lastUsed time.Time
+}
+
+func NewClients(connect ConnectFunc, idleTimeout time.Duration) *Clients {
+	return &Clients{
+		connect:     connect,
+		idleTimeout: idleTimeout,
+		now:         time.Now,
+		clients:     map[string]*pooledClient{},
+	}
+}
+
+// Get returns the connection to the connection URL, opening it on first use
+func (c *Clients) Get(ctx context.Context, connURL string) (CollectionService, error) {
+	now := c.now()
+	c.lock.Lock()
+	idle := c.evictIdle(now)
+	pooled, ok := c.clients[connURL]
+	if !ok {
+		service, err := c.connect(ctx, connURL)
+		if err != nil {
+			c.lock.Unlock()
+			_ = disconnectAll(ctx, idle)
+			return nil, err
+		}
+		pooled = &pooledClient{service: service}
+		c.clients[connURL] = pooled
+	}
+	pooled.lastUsed = now
+	c.lock.Unlock()
+
+	_ = disconnectAll(ctx, idle)
+	return pooled.service, nil
+}
+
+// Close disconnects every connection
+func (c *Clients) Close(ctx context.Context) error {
+	c.lock.Lock()
+	services := make([]CollectionService, 0, len(c.clients))
+	for connURL, pooled := range c.clients {
+		services = append(services, pooled.service)
+		delete(c.clients, connURL)
+	}
+	c.lock.Unlock()
+
+	return disconnectAll(ctx, services)
+}
+
+// evictIdle removes the connections unused for the idle timeout and returns them
+// to be disconnected once the lock is released. The lock must be held.
+func (c *Clients) evictIdle(now time.Time) []CollectionService {
+	var idle []CollectionService
+	for connURL, pooled := range c.clients {
+		if now.Sub(pooled.lastUsed) >= c.idleTimeout {
+			idle = append(idle, pooled.service)
+			delete(c.clients, connURL)
+		}
+	}
+	return idle
+}
+
+func disconnectAll(ctx context.Context, services []CollectionService) error {
+	var errs []error
+	for _, service := range services {
+		if err := service.Disconnect(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeService struct {
+	CollectionService
+	connURL      string
+	disconnected bool
+}
+
+func (f *fakeService) Disconnect(context.Context) error {
+	f.disconnected = true
+	return nil
+}
+
+func TestClients(t *testing.T) {
+	ctx := context.Background()
+	connected := map[string]*fakeService{}
+	failing := true
+	clients := NewClients(func(_ context.Context, connURL string) (CollectionService, error) {
+		if connURL == "mongodb://unreachable" && failing {
+			return nil, errors.New("fake connection failure")
+		}
+		service := &fakeService{connURL: connURL}
+		connected[connURL] = service
+		return service, nil
+	}, 10*time.Minute)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	clients.now = func() time.Time { return now }
+
+	first, err := clients.Get(ctx, "mongodb://first")
+	require.NoError(t, err)
+	again, err := clients.Get(ctx, "mongodb://first")
+	require.NoError(t, err)
+	assert.Same(t, first, again, "one connection is kept per connection URL")
+
+	_, err = clients.Get(ctx, "mongodb://unreachable")
+	assert.ErrorContains(t, err, "fake connection failure")
+	failing = false
+	_, err = clients.Get(ctx, "mongodb://unreachable")
+	require.NoError(t, err, "failed connections are not kept")
+
+	now = now.Add(5 * time.Minute)
+	_, err = clients.Get(ctx, "mongodb://second")
+	require.NoError(t, err)
+	now = now.Add(5 * time.Minute)
+	_, err = clients.Get(ctx, "mongodb://second")
+	require.NoError(t, err)
+	assert.True(t, connected["mongodb://first"].disconnected, "idle connections are closed")
+	assert.True(t, connected["mongodb://unreachable"].disconnected)
+	assert.False(t, connected["mongodb://second"].disconnected)
+
+	renewed, err := clients.Get(ctx, "mongodb://first")
+	require.NoError(t, err)
+	assert.NotSame(t, first, renewed)
+
+	require.NoError(t, clients.Close(ctx))
+	assert.True(t, connected["mongodb://second"].disconnected)
+	assert.True(t, connected["mongodb://first"].disconnected)
+	assert.Empty(t, clients.clients)
+}
@@ -0,0 +1,290 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	TypeCollection = "collection"
+	TypeTimeSeries = "timeseries"
+
+	defaultValidationLevel  = "strict"
+	defaultValidationAction = "error"
+
+	connectTimeout = 30 * time.Second
+)
+
+var ErrImmutable = errors.New("existing collection does not match an immutable setting")
+
+type Collection struct {
+	Database   string
+	Name       string
+	Capped     *Capped
+	TimeSeries *TimeSeries
+	Validator  *Validator
+}
+
+type Capped struct {
+	SizeBytes    int64
+	MaxDocuments int64
+}
+
+type TimeSeries struct {
+	TimeField          string
+	MetaField          string
+	Granularity        string
+	ExpireAfterSeconds *int64
+}
+
+type Validator struct {
+	JSONSchema bson.D
+	Level      string
+	Action     string
+}
+
+func (c *Collection) Type() string {
+	if c.TimeSeries != nil {
+		return TypeTimeSeries
+	}
+	return TypeCollection
+}
+
+type CollectionService interface {
+	// EnsureCollection creates the collection or updates its mutable options,
+	// it returns true when the collection was created
+	EnsureCollection(ctx context.Context, collection *Collection) (bool, error)
+	// EnsureIndexes creates and recreates the desired indexes and drops the
+	// previously managed ones no longer desired
+	EnsureIndexes(ctx context.Context, database, collection string, desired []Index, managed []string) (*IndexChanges, error)
+	Disconnect(ctx context.Context) error
+}
+
+type ConnectFunc func(ctx context.Context, connURL string) (CollectionService, error)
+
+type MongoDBCollectionService struct {
+	client *mongo.Client
+}
+
+func NewCollectionService(client *mongo.Client) *MongoDBCollectionService {
+	return &MongoDBCollectionService{client: client}
+}
+
+// Connect opens a connection to the deployment behind the connection URL
+func Connect(ctx context.Context, connURL string) (CollectionService, error) {
+	opts := options.Client().ApplyURI(connURL).
+		SetConnectTimeout(connectTimeout).
+		SetServerSelectionTimeout(connectTimeout)
+	client, err := mongo.Connect(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the deployment: %w", err)
+	}
+	return NewCollectionService(client), nil
+}
+
+func (s *MongoDBCollectionService) Disconnect(ctx context.Context) error {
+	return s.client.Disconnect(ctx)
+}
+
+// collectionOptions are the options listCollections reports for a collection
+type collectionOptions struct {
+	Capped             bool               `bson:"capped"`
+	Size               int64              `bson:"size"`
+	Max                int64              `bson:"max"`
+	TimeSeries         *timeSeriesOptions `bson:"timeseries"`
+	ExpireAfterSeconds *int64             `bson:"expireAfterSeconds"`
+	Validator          bson.Raw           `bson:"validator"`
+	ValidationLevel    string             `bson:"validationLevel"`
+	ValidationAction   string             `bson:"validationAction"`
+}
+
+type timeSeriesOptions struct {
+	TimeField   string `bson:"timeField"`
+	MetaField   string `bson:"metaField"`
+	Granularity string `bson:"granularity"`
+}
+
+func (s *MongoDBCollectionService) EnsureCollection(ctx context.Context, collection *Collection) (bool, error) {
+	db := s.client.Database(collection.Database)
+	specs, err := db.ListCollectionSpecifications(ctx, bson.D{{Key: "name", Value: collection.Name}})
+	if err != nil {
+		return false, fmt.Errorf("failed to list collections of database %s: %w", collection.Database, err)
+	}
+	if len(specs) == 0 {
+		if err := db.CreateCollection(ctx, collection.Name, createOptions(collection)); err != nil {
+			return false, fmt.Errorf("failed to create collection %s.%s: %w", collection.Database, collection.Name, err)
+		}
+		return true, nil
+	}
+
+	current := collectionOptions{}
+	if len(specs[0].Options) > 0 {
+		if err := bson.Unmarshal(specs[0].Options, &current); err != nil {
+			return false, fmt.Errorf("failed to decode options of collection %s.%s: %w", collection.Database, collection.Name, err)
+		}
+	}
+	if err := checkImmutable(collection, specs[0].Type, &current); err != nil {
+		return false, err
+	}
+
+	collMod, err := collModCommand(collection, &current)
+	if err != nil {
+		return false, err
+	}
+	if len(collMod) > 1 {
+		if err := db.RunCommand(ctx, collMod).Err(); err != nil {
+			return false, fmt.Errorf("failed to update collection %s.%s: %w", collection.Database, collection.Name, err)
+		}
+	}
+	return false, nil
+}
+
+func createOptions(collection *Collection) *options.CreateCollectionOptions {
+	opts := options.CreateCollection()
+	if collection.Capped != nil {
+		opts.SetCapped(true).SetSizeInBytes(collection.Capped.SizeBytes)
+		if collection.Capped.MaxDocuments > 0 {
+			opts.SetMaxDocuments(collection.Capped.MaxDocuments)
+		}
+	}
+	if ts := collection.TimeSeries; ts != nil {
+		tsOpts := options.TimeSeries().SetTimeField(ts.TimeField)
+		if ts.MetaField != "" {
+			tsOpts.SetMetaField(ts.MetaField)
+		}
+		if ts.Granularity != "" {
+			tsOpts.SetGranularity(ts.Granularity)
+		}
+		opts.SetTimeSeriesOptions(tsOpts)
+		if ts.ExpireAfterSeconds != nil {
+			opts.SetExpireAfterSeconds(*ts.ExpireAfterSeconds)
+		}
+	}
+	if v := collection.Validator; v != nil {
+		opts.SetValidator(bson.D{{Key: "$jsonSchema", Value: v.JSONSchema}}).
+			SetValidationLevel(orDefault(v.Level, defaultValidationLevel)).
+			SetValidationAction(orDefault(v.Action, defaultValidationAction))
+	}
+	return opts
+}
+
+func checkImmutable(collection *Collection, currentType string, current *collectionOptions) error {
+	if currentType == "view" {
+		return fmt.Errorf("%w: %s.%s is a view", ErrImmutable, collection.Database, collection.Name)
+	}
+	if (collection.Capped != nil) != current.Capped {
+		return fmt.Errorf("%w: capped is %v in Atlas", ErrImmutable, current.Capped)
+	}
+	if collection.Capped != nil && (collection.Capped.SizeBytes != current.Size || collection.Capped.MaxDocuments != current.Max) {
+		return fmt.Errorf("%w: capped collection has size %d and max %d in Atlas", ErrImmutable, current.Size, current.Max)
+	}
+	if (collection.TimeSeries != nil) != (current.TimeSeries != nil) {
+		return fmt.Errorf("%w: collection type is %s in Atlas", ErrImmutable, orDefault(currentType, TypeCollection))
+	}
+	if ts := collection.TimeSeries; ts != nil &&
+		(ts.TimeField != current.TimeSeries.TimeField || ts.MetaField != current.TimeSeries.MetaField) {
+		return fmt.Errorf("%w: time series has timeField %q and metaField %q in Atlas",
+			ErrImmutable, current.TimeSeries.TimeField, current.TimeSeries.MetaField)
+	}
+	return nil
+}
+
+func collModCommand(collection *Collection, current *collectionOptions) (bson.D, error) {
+	collMod := bson.D{{Key: "collMod", Value: collection.Name}}
+
+	if ts := collection.TimeSeries; ts != nil {
+		if ts.Granularity != "" && ts.Granularity != current.TimeSeries.Granularity {
+			collMod = append(collMod, bson.E{Key: "timeseries", Value: bson.D{{Key: "granularity", Value: ts.Granularity}}})
+		}
+		switch {
+		case ts.ExpireAfterSeconds != nil && !reflect.DeepEqual(ts.ExpireAfterSeconds, current.ExpireAfterSeconds):
+			collMod = append(collMod, bson.E{Key: "expireAfterSeconds", Value: *ts.ExpireAfterSeconds})
+		case ts.ExpireAfterSeconds == nil && current.ExpireAfterSeconds != nil:
+			collMod = append(collMod, bson.E{Key: "expireAfterSeconds", Value: "off"})
+		}
+	}
+
+	currentValidator, err := normalize(current.Validator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the validator in Atlas: %w", err)
+	}
+	if v := collection.Validator; v != nil {
+		desired := bson.D{{Key: "$jsonSchema", Value: v.JSONSchema}}
+		desiredValidator, err := normalize(desired)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode the validator: %w", err)
+		}
+		level := orDefault(v.Level, defaultValidationLevel)
+		action := orDefault(v.Action, defaultValidationAction)
+		if !reflect.DeepEqual(desiredValidator, currentValidator) ||
+			level != orDefault(current.ValidationLevel, defaultValidationLevel) ||
+			action != orDefault(current.ValidationAction, defaultValidationAction) {
+			collMod = append(collMod,
+				bson.E{Key: "validator", Value: desired},
+				bson.E{Key: "validationLevel", Value: level},
+				bson.E{Key: "validationAction", Value: action},
+			)
+		}
+	} else if currentValidator != nil {
+		collMod = append(collMod, bson.E{Key: "validator", Value: bson.D{}})
+	}
+	return collMod, nil
+}
+
+// normalize turns a BSON value into plain JSON values so documents with the
+// same content compare equal regardless of their numeric types
+func normalize(value any) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case bson.Raw:
+		if len(v) == 0 {
+			return nil, nil
+		}
+	case bson.D:
+		if len(v) == 0 {
+			return nil, nil
+		}
+	}
+	data, err := bson.MarshalExtJSON(value, false, false)
+	if err != nil {
+		return nil, err
+	}
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+	if m, ok := normalized.(map[string]any); ok && len(m) == 0 {
+		return nil, nil
+	}
+	return normalized, nil
+}
+
+func orDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,246 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+var testSchema = bson.D{
+	{Key: "bsonType", Value: "object"},
+	{Key: "required", Value: bson.A{"name"}},
+}
+
+func listCollectionsResponse(collections ...bson.D) bson.D {
+	return mtest.CreateCursorResponse(0, "db.$cmd.listCollections", mtest.FirstBatch, collections...)
+}
+
+func commandNames(mt *mtest.T) []string {
+	names := []string{}
+	for evt := mt.GetStartedEvent(); evt != nil; evt = mt.GetStartedEvent() {
+		names = append(names, evt.CommandName)
+	}
+	return names
+}
+
+func TestEnsureCollection(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("creates a missing capped collection with a validator", func(mt *mtest.T) {
+		mt.AddMockResponses(listCollectionsResponse(), mtest.CreateSuccessResponse())
+
+		created, err := NewCollectionService(mt.Client).EnsureCollection(context.Background(), &Collection{
+			Database:  "db",
+			Name:      "logs",
+			Capped:    &Capped{SizeBytes: 1024, MaxDocuments: 10},
+			Validator: &Validator{JSONSchema: testSchema},
+		})
+		require.NoError(t, err)
+		assert.True(t, created)
+
+		mt.GetStartedEvent()
+		create := mt.GetStartedEvent()
+		require.Equal(t, "create", create.CommandName)
+		assert.Equal(t, "logs", create.Command.Lookup("create").StringValue())
+		assert.True(t, create.Command.Lookup("capped").Boolean())
+		assert.Equal(t, int64(1024), create.Command.Lookup("size").Int64())
+		assert.Equal(t, int64(10), create.Command.Lookup("max").Int64())
+		assert.Equal(t, "strict", create.Command.Lookup("validationLevel").StringValue())
+		assert.Equal(t, "error", create.Command.Lookup("validationAction").StringValue())
+		assert.Equal(t, "object", create.Command.Lookup("validator", "$jsonSchema", "bsonType").StringValue())
+	})
+
+	mt.Run("creates a missing time series collection", func(mt *mtest.T) {
+		mt.AddMockResponses(listCollectionsResponse(), mtest.CreateSuccessResponse())
+
+		created, err := NewCollectionService(mt.Client).EnsureCollection(context.Background(), &Collection{
+			Database: "db",
+			Name:     "metrics",
+			TimeSeries: &TimeSeries{
+				TimeField:          "ts",
+				MetaField:          "host",
+				Granularity:        "minutes",
+				ExpireAfterSeconds: pointer.MakePtr(int64(3600)),
+			},
+		})
+		require.NoError(t, err)
+		assert.True(t, created)
+
+		mt.GetStartedEvent()
+		create := mt.GetStartedEvent()
+		require.Equal(t, "create", create.CommandName)
+		assert.Equal(t, "ts", create.Command.Lookup("timeseries", "timeField").StringValue())
+		assert.Equal(t, "host", create.Command.Lookup("timeseries", "metaField").StringValue())
+		assert.Equal(t, "minutes", create.Command.Lookup("timeseries", "granularity").StringValue())
+		assert.Equal(t, int64(3600), create.Command.Lookup("expireAfterSeconds").Int64())
+	})
+
+	mt.Run("leaves a matching collection untouched", func(mt *mtest.T) {
+		mt.AddMockResponses(listCollectionsResponse(bson.D{
+			{Key: "name", Value: "users"},
+			{Key: "type", Value: "collection"},
+			{Key: "options", Value: bson.D{
+				{Key: "validator", Value: bson.D{{Key: "$jsonSchema", Value: bson.D{
+					{Key: "bsonType", Value: "object"},
+					{Key: "required", Value: bson.A{"name"}},
+				}}}},
+				{Key: "validationLevel", Value: "strict"},
+				{Key: "validationAction", Value: "error"},
+			}},
+		}))
+
+		created, err := NewCollectionService(mt.Client).EnsureCollection(context.Background(), &Collection{
+			Database:  "db",
+			Name:      "users",
+			Validator: &Validator{JSONSchema: testSchema},
+		})
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, []string{"listCollections"}, commandNames(mt))
+	})
+
+	mt.Run("updates a changed validator", func(mt *mtest.T) {
+		mt.AddMockResponses(listCollectionsResponse(bson.D{
+			{Key: "name", Value: "users"},
+			{Key: "type", Value: "collection"},
+			{Key: "options", Value: bson.D{
+				{Key: "validator", Value: bson.D{{Key: "$jsonSchema", Value: bson.D{{Key: "bsonType", Value: "object"}}}}},
+				{Key: "validationLevel", Value: "strict"},
+				{Key: "validationAction", Value: "error"},
+			}},
+		}), mtest.CreateSuccessResponse())
+
+		_, err := NewCollectionService(mt.Client).EnsureCollection(context.Background(), &Collection{
+			Database:  "db",
+			Name:      "users",
+			Validator: &Validator{JSONSchema: testSchema, Level: "moderate", Action: "warn"},
+		})
+		require.NoError(t, err)
+
+		mt.GetStartedEvent()
+		collMod := mt.GetStartedEvent()
+		require.Equal(t, "collMod", collMod.CommandName)
+		assert.Equal(t, "moderate", collMod.Command.Lookup("validationLevel").StringValue())
+		assert.Equal(t, "warn", collMod.Command.Lookup("validationAction").StringValue())
+		assert.Equal(t, "name", collMod.Command.Lookup("validator", "$jsonSchema", "required", "0").StringValue())
+	})
+
+	mt.Run("removes a validator no longer desired", func(mt *mtest.T) {
+		mt.AddMockResponses(listCollectionsResponse(bson.D{
+			{Key: "name", Value: "users"},
+			{Key: "type", Value: "collection"},
+			{Key: "options", Value: bson.D{
+				{Key: "validator", Value: bson.D{{Key: "$jsonSchema", Value: testSchema}}},
+			}},
+		}), mtest.CreateSuccessResponse())
+
+		_, err := NewCollectionService(mt.Client).EnsureCollection(context.Background(), &Collection{Database: "db", Name: "users"})
+		require.NoError(t, err)
+
+		mt.GetStartedEvent()
+		collMod := mt.GetStartedEvent()
+		require.Equal(t, "collMod", collMod.CommandName)
+		validator, err := collMod.Command.LookupErr("validator")
+		require.NoError(t, err)
+		elements, err := validator.Document().Elements()
+		require.NoError(t, err)
+		assert.Empty(t, elements)
+	})
+
+	mt.Run("updates the expiration of a time series", func(mt *mtest.T) {
+		mt.AddMockResponses(listCollectionsResponse(bson.D{
+			{Key: "name", Value: "metrics"},
+			{Key: "type", Value: "timeseries"},
+			{Key: "options", Value: bson.D{
+				{Key: "timeseries", Value: bson.D{
+					{Key: "timeField", Value: "ts"},
+					{Key: "granularity", Value: "seconds"},
+				}},
+				{Key: "expireAfterSeconds", Value: int64(60)},
+			}},
+		}), mtest.CreateSuccessResponse())
+
+		_, err := NewCollectionService(mt.Client).EnsureCollection(context.Background(), &Collection{
+			Database: "db",
+			Name:     "metrics",
+			TimeSeries: &TimeSeries{
+				TimeField:          "ts",
+				Granularity:        "seconds",
+				ExpireAfterSeconds: pointer.MakePtr(int64(120)),
+			},
+		})
+		require.NoError(t, err)
+
+		mt.GetStartedEvent()
+		collMod := mt.GetStartedEvent()
+		require.Equal(t, "collMod", collMod.CommandName)
+		assert.Equal(t, int64(120), collMod.Command.Lookup("expireAfterSeconds").Int64())
+		_, err = collMod.Command.LookupErr("timeseries")
+		assert.Error(t, err)
+	})
+
+	mt.Run("refuses to convert an existing collection", func(mt *mtest.T) {
+		mt.AddMockResponses(listCollectionsResponse(bson.D{
+			{Key: "name", Value: "logs"},
+			{Key: "type", Value: "collection"},
+			{Key: "options", Value: bson.D{}},
+		}))
+
+		_, err := NewCollectionService(mt.Client).EnsureCollection(context.Background(), &Collection{
+			Database: "db",
+			Name:     "logs",
+			Capped:   &Capped{SizeBytes: 1024},
+		})
+		assert.ErrorIs(t, err, ErrImmutable)
+		assert.Equal(t, []string{"listCollections"}, commandNames(mt))
+	})
+
+	mt.Run("refuses to change the time field of a time series", func(mt *mtest.T) {
+		mt.AddMockResponses(listCollectionsResponse(bson.D{
+			{Key: "name", Value: "metrics"},
+			{Key: "type", Value: "timeseries"},
+			{Key: "options", Value: bson.D{
+				{Key: "timeseries", Value: bson.D{{Key: "timeField", Value: "ts"}}},
+			}},
+		}))
+
+		_, err := NewCollectionService(mt.Client).EnsureCollection(context.Background(), &Collection{
+			Database:   "db",
+			Name:       "metrics",
+			TimeSeries: &TimeSeries{TimeField: "timestamp"},
+		})
+		assert.ErrorIs(t, err, ErrImmutable)
+	})
+
+	mt.Run("surfaces create errors", func(mt *mtest.T) {
+		mt.AddMockResponses(listCollectionsResponse(), mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    13,
+			Name:    "Unauthorized",
+			Message: "not authorized on db to execute command",
+		}))
+
+		_, err := NewCollectionService(mt.Client).EnsureCollection(context.Background(), &Collection{Database: "db", Name: "users"})
+		assert.ErrorContains(t, err, "failed to create collection db.users")
+		assert.ErrorContains(t, err, "not authorized")
+	})
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodb
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+func NewCollection(spec *akov2.AtlasCollectionSpec) (*Collection, error) {
+	collection := &Collection{
+		Database: spec.Database,
+		Name:     spec.Name,
+	}
+	if spec.Capped != nil {
+		collection.Capped = &Capped{
+			SizeBytes:    spec.Capped.SizeBytes,
+			MaxDocuments: spec.Capped.MaxDocuments,
+		}
+	}
+	if ts := spec.TimeSeries; ts != nil {
+		collection.TimeSeries = &TimeSeries{
+			TimeField:          ts.TimeField,
+			MetaField:          ts.MetaField,
+			Granularity:        ts.Granularity,
+			ExpireAfterSeconds: ts.ExpireAfterSeconds,
+		}
+	}
+	if v := spec.Validator; v != nil {
+		schema, err := toDocument(v.JSONSchema)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jsonSchema: %w", err)
+		}
+		collection.Validator = &Validator{
+			JSONSchema: schema,
+			Level:      v.ValidationLevel,
+			Action:     v.ValidationAction,
+		}
+	}
+	return collection, nil
+}
+
+func NewIndexes(indexes []akov2.CollectionIndex) ([]Index, error) {
+	result := make([]Index, 0, len(indexes))
+	for _, index := range indexes {
+		filter, err := toDocument(index.PartialFilterExpression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid partialFilterExpression of index %s: %w", index.Name, err)
+		}
+		keys := make(bson.D, 0, len(index.Keys))
+		for _, key := range index.Keys {
+			keys = append(keys, bson.E{Key: key.Field, Value: keyType(key.Type)})
+		}
+		result = append(result, Index{
+			Name:                    index.Name,
+			Keys:                    keys,
+			Unique:                  index.Unique,
+			Sparse:                  index.Sparse,
+			ExpireAfterSeconds:      index.ExpireAfterSeconds,
+			PartialFilterExpression: filter,
+		})
+	}
+	return result, nil
+}
+
+func keyType(t string) any {
+	switch t {
+	case "", akov2.IndexKeyAscending:
+		return int32(1)
+	case akov2.IndexKeyDescending:
+		return int32(-1)
+	default:
+		return t
+	}
+}
+
+// toDocument parses a JSON object, accepting MongoDB Extended JSON, into an ordered document
+func toDocument(value *apiextensions.JSON) (bson.D, error) {
+	if value == nil || len(value.Raw) == 0 {
+		return nil, nil
+	}
+	doc := bson.D{}
+	if err := bson.UnmarshalExtJSON(value.Raw, false, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
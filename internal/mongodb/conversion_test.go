@@ -0,0 +1,93 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+func TestNewCollection(t *testing.T) {
+	t.Run("converts a time series with a validator", func(t *testing.T) {
+		collection, err := NewCollection(&akov2.AtlasCollectionSpec{
+			Database: "db",
+			Name:     "metrics",
+			TimeSeries: &akov2.TimeSeriesCollection{
+				TimeField:          "ts",
+				Granularity:        "hours",
+				ExpireAfterSeconds: pointer.MakePtr(int64(60)),
+			},
+			Validator: &akov2.CollectionValidator{
+				JSONSchema:      &apiextensions.JSON{Raw: []byte(`{"bsonType":"object","required":["ts"]}`)},
+				ValidationLevel: "moderate",
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &Collection{
+			Database: "db",
+			Name:     "metrics",
+			TimeSeries: &TimeSeries{
+				TimeField:          "ts",
+				Granularity:        "hours",
+				ExpireAfterSeconds: pointer.MakePtr(int64(60)),
+			},
+			Validator: &Validator{
+				JSONSchema: bson.D{{Key: "bsonType", Value: "object"}, {Key: "required", Value: bson.A{"ts"}}},
+				Level:      "moderate",
+			},
+		}, collection)
+		assert.Equal(t, TypeTimeSeries, collection.Type())
+	})
+
+	t.Run("fails on an invalid schema", func(t *testing.T) {
+		_, err := NewCollection(&akov2.AtlasCollectionSpec{
+			Validator: &akov2.CollectionValidator{JSONSchema: &apiextensions.JSON{Raw: []byte(`["object"]`)}},
+		})
+		assert.ErrorContains(t, err, "invalid jsonSchema")
+	})
+}
+
+func TestNewIndexes(t *testing.T) {
+	indexes, err := NewIndexes([]akov2.CollectionIndex{
+		{
+			Name: "by_tenant_location",
+			Keys: []akov2.CollectionIndexKey{
+				{Field: "tenant"},
+				{Field: "createdAt", Type: akov2.IndexKeyDescending},
+				{Field: "location", Type: akov2.IndexKey2dsphere},
+			},
+			Unique:                  true,
+			PartialFilterExpression: &apiextensions.JSON{Raw: []byte(`{"createdAt":{"$gt":{"$date":"2025-01-01T00:00:00Z"}}}`)},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, indexes, 1)
+	assert.Equal(t, "by_tenant_location", indexes[0].Name)
+	assert.True(t, indexes[0].Unique)
+	assert.Equal(t, bson.D{
+		{Key: "tenant", Value: int32(1)},
+		{Key: "createdAt", Value: int32(-1)},
+		{Key: "location", Value: "2dsphere"},
+	}, indexes[0].Keys)
+	require.Len(t, indexes[0].PartialFilterExpression, 1)
+	assert.Equal(t, "createdAt", indexes[0].PartialFilterExpression[0].Key)
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const idIndexName = "_id_"
+
+type Index struct {
+	Name                    string
+	Keys                    bson.D
+	Unique                  bool
+	Sparse                  bool
+	ExpireAfterSeconds      *int32
+	PartialFilterExpression bson.D
+}
+
+type IndexChanges struct {
+	Created []string
+	Dropped []string
+}
+
+// indexDocument is an index as reported by listIndexes
+type indexDocument struct {
+	Name                    string   `bson:"name"`
+	Key                     bson.D   `bson:"key"`
+	Unique                  bool     `bson:"unique"`
+	Sparse                  bool     `bson:"sparse"`
+	ExpireAfterSeconds      *int32   `bson:"expireAfterSeconds"`
+	PartialFilterExpression bson.Raw `bson:"partialFilterExpression"`
+}
+
+func (s *MongoDBCollectionService) EnsureIndexes(ctx context.Context, database, collection string, desired []Index, managed []string) (*IndexChanges, error) {
+	indexes := s.client.Database(database).Collection(collection).Indexes()
+	cursor, err := indexes.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexes of %s.%s: %w", database, collection, err)
+	}
+	current := []indexDocument{}
+	if err := cursor.All(ctx, &current); err != nil {
+		return nil, fmt.Errorf("failed to decode indexes of %s.%s: %w", database, collection, err)
+	}
+	currentByName := make(map[string]indexDocument, len(current))
+	for _, index := range current {
+		currentByName[index.Name] = index
+	}
+
+	changes := &IndexChanges{}
+	desiredNames := make(map[string]struct{}, len(desired))
+	for _, index := range desired {
+		desiredNames[index.Name] = struct{}{}
+		existing, ok := currentByName[index.Name]
+		if ok {
+			equal, err := indexEqual(&index, &existing)
+			if err != nil {
+				return nil, err
+			}
+			if equal {
+				continue
+			}
+			if _, err := indexes.DropOne(ctx, index.Name); err != nil {
+				return nil, fmt.Errorf("failed to drop index %s to recreate it: %w", index.Name, err)
+			}
+		}
+		if _, err := indexes.CreateOne(ctx, indexModel(&index)); err != nil {
+			return nil, fmt.Errorf("failed to create index %s: %w", index.Name, err)
+		}
+		changes.Created = append(changes.Created, index.Name)
+	}
+
+	for _, name := range managed {
+		if _, ok := desiredNames[name]; ok || name == idIndexName {
+			continue
+		}
+		if _, ok := currentByName[name]; !ok {
+			continue
+		}
+		if _, err := indexes.DropOne(ctx, name); err != nil {
+			return nil, fmt.Errorf("failed to drop index %s: %w", name, err)
+		}
+		changes.Dropped = append(changes.Dropped, name)
+	}
+	return changes, nil
+}
+
+func indexModel(index *Index) mongo.IndexModel {
+	opts := options.Index().SetName(index.Name)
+	if index.Unique {
+		opts.SetUnique(true)
+	}
+	if index.Sparse {
+		opts.SetSparse(true)
+	}
+	if index.ExpireAfterSeconds != nil {
+		opts.SetExpireAfterSeconds(*index.ExpireAfterSeconds)
+	}
+	if len(index.PartialFilterExpression) > 0 {
+		opts.SetPartialFilterExpression(index.PartialFilterExpression)
+	}
+	return mongo.IndexModel{Keys: index.Keys, Options: opts}
+}
+
+func indexEqual(desired *Index, current *indexDocument) (bool, error) {
+	if len(desired.Keys) != len(current.Key) {
+		return false, nil
+	}
+	for i := range desired.Keys {
+		if desired.Keys[i].Key != current.Key[i].Key ||
+			normalizeKeyType(desired.Keys[i].Value) != normalizeKeyType(current.Key[i].Value) {
+			return false, nil
+		}
+	}
+	if desired.Unique != current.Unique || desired.Sparse != current.Sparse ||
+		!reflect.DeepEqual(desired.ExpireAfterSeconds, current.ExpireAfterSeconds) {
+		return false, nil
+	}
+	desiredFilter, err := normalize(desired.PartialFilterExpression)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode the partial filter of index %s: %w", desired.Name, err)
+	}
+	currentFilter, err := normalize(current.PartialFilterExpression)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode the partial filter of index %s: %w", current.Name, err)
+	}
+	return reflect.DeepEqual(desiredFilter, currentFilter), nil
+}
+
+// normalizeKeyType maps the numeric directions of index keys, which the server
+// may report as any numeric type, to 1 or -1
+func normalizeKeyType(value any) any {
+	var n float64
+	switch v := value.(type) {
+	case int32:
+		n = float64(v)
+	case int64:
+		n = float64(v)
+	case float64:
+		n = v
+	default:
+		return value
+	}
+	if n < 0 {
+		return -1
+	}
+	return 1
+}
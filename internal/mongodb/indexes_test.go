@@ -0,0 +1,133 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+func listIndexesResponse(indexes ...bson.D) bson.D {
+	return mtest.CreateCursorResponse(0, "db.users", mtest.FirstBatch, indexes...)
+}
+
+func TestEnsureIndexes(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("syncs managed indexes and leaves the others untouched", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			listIndexesResponse(
+				bson.D{{Key: "v", Value: 2}, {Key: "key", Value: bson.D{{Key: "_id", Value: 1}}}, {Key: "name", Value: "_id_"}},
+				bson.D{
+					{Key: "v", Value: 2},
+					{Key: "key", Value: bson.D{{Key: "email", Value: float64(1)}}},
+					{Key: "name", Value: "by_email"},
+					{Key: "unique", Value: true},
+					{Key: "partialFilterExpression", Value: bson.D{{Key: "age", Value: bson.D{{Key: "$gt", Value: int64(18)}}}}},
+				},
+				bson.D{{Key: "v", Value: 2}, {Key: "key", Value: bson.D{{Key: "name", Value: 1}}}, {Key: "name", Value: "by_name"}},
+				bson.D{{Key: "v", Value: 2}, {Key: "key", Value: bson.D{{Key: "old", Value: 1}}}, {Key: "name", Value: "by_old"}},
+				bson.D{{Key: "v", Value: 2}, {Key: "key", Value: bson.D{{Key: "external", Value: 1}}}, {Key: "name", Value: "external"}},
+			),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(),
+		)
+
+		changes, err := NewCollectionService(mt.Client).EnsureIndexes(context.Background(), "db", "users", []Index{
+			{
+				Name:                    "by_email",
+				Keys:                    bson.D{{Key: "email", Value: int32(1)}},
+				Unique:                  true,
+				PartialFilterExpression: bson.D{{Key: "age", Value: bson.D{{Key: "$gt", Value: int32(18)}}}},
+			},
+			{
+				Name: "by_name",
+				Keys: bson.D{{Key: "name", Value: int32(-1)}},
+			},
+			{
+				Name:               "by_created",
+				Keys:               bson.D{{Key: "createdAt", Value: int32(1)}},
+				ExpireAfterSeconds: pointer.MakePtr(int32(3600)),
+			},
+		}, []string{"by_email", "by_name", "by_old", "_id_"})
+		require.NoError(t, err)
+		assert.Equal(t, &IndexChanges{
+			Created: []string{"by_name", "by_created"},
+			Dropped: []string{"by_old"},
+		}, changes)
+
+		events := []struct{ name, index string }{}
+		for evt := mt.GetStartedEvent(); evt != nil; evt = mt.GetStartedEvent() {
+			switch evt.CommandName {
+			case "dropIndexes":
+				events = append(events, struct{ name, index string }{evt.CommandName, evt.Command.Lookup("index").StringValue()})
+			case "createIndexes":
+				events = append(events, struct{ name, index string }{evt.CommandName, evt.Command.Lookup("indexes", "0", "name").StringValue()})
+			default:
+				events = append(events, struct{ name, index string }{evt.CommandName, ""})
+			}
+		}
+		assert.Equal(t, []struct{ name, index string }{
+			{"listIndexes", ""},
+			{"dropIndexes", "by_name"},
+			{"createIndexes", "by_name"},
+			{"createIndexes", "by_created"},
+			{"dropIndexes", "by_old"},
+		}, events)
+	})
+
+	mt.Run("creates indexes with their options", func(mt *mtest.T) {
+		mt.AddMockResponses(listIndexesResponse(), mtest.CreateSuccessResponse())
+
+		_, err := NewCollectionService(mt.Client).EnsureIndexes(context.Background(), "db", "users", []Index{
+			{
+				Name:                    "by_location",
+				Keys:                    bson.D{{Key: "tenant", Value: int32(1)}, {Key: "location", Value: "2dsphere"}},
+				Sparse:                  true,
+				PartialFilterExpression: bson.D{{Key: "active", Value: true}},
+			},
+		}, nil)
+		require.NoError(t, err)
+
+		mt.GetStartedEvent()
+		create := mt.GetStartedEvent()
+		require.Equal(t, "createIndexes", create.CommandName)
+		assert.Equal(t, "2dsphere", create.Command.Lookup("indexes", "0", "key", "location").StringValue())
+		assert.True(t, create.Command.Lookup("indexes", "0", "sparse").Boolean())
+		assert.True(t, create.Command.Lookup("indexes", "0", "partialFilterExpression", "active").Boolean())
+	})
+
+	mt.Run("surfaces create errors", func(mt *mtest.T) {
+		mt.AddMockResponses(listIndexesResponse(), mtest.CreateCommandErrorResponse(mtest.CommandError{
+			Code:    85,
+			Name:    "IndexOptionsConflict",
+			Message: "Index already exists with a different name",
+		}))
+
+		_, err := NewCollectionService(mt.Client).EnsureIndexes(context.Background(), "db", "users", []Index{
+			{Name: "by_email", Keys: bson.D{{Key: "email", Value: int32(1)}}},
+		}, nil)
+		assert.ErrorContains(t, err, "failed to create index by_email")
+	})
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/control"
+)
+
+// commandRecorder records the names of the write commands sent to the server, so that
+// tests can assert a second synchronization converges without changes
+type commandRecorder struct {
+	lock     sync.Mutex
+	commands []string
+}
+
+func (r *commandRecorder) started(_ context.Context, evt *event.CommandStartedEvent) {
+	switch evt.CommandName {
+	case "create", "collMod", "createIndexes", "dropIndexes":
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		r.commands = append(r.commands, evt.CommandName)
+	}
+}
+
+func (r *commandRecorder) take() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	commands := r.commands
+	r.commands = nil
+	return commands
+}
+
+// TestMongod runs the collection and index synchronization against a real server, which the
+// mocked responses of the unit tests cannot replace for the options reported by listCollections
+// and listIndexes. Start a server with e.g. `docker run -d -p 27017:27017 mongo:8` and run with
+// AKO_MONGODB_TEST=1 MONGODB_URI=mongodb://localhost:27017.
+func TestMongod(t *testing.T) {
+	control.SkipTestUnless(t, "AKO_MONGODB_TEST")
+	ctx := context.Background()
+
+	recorder := &commandRecorder{}
+	client, err := mongo.Connect(ctx, options.Client().
+		ApplyURI(control.MustEnvVar("MONGODB_URI")).
+		SetServerSelectionTimeout(10*time.Second).
+		SetMonitor(&event.CommandMonitor{Started: recorder.started}))
+	require.NoError(t, err)
+	database := fmt.Sprintf("ako-test-%d", time.Now().UnixNano())
+	t.Cleanup(func() {
+		assert.NoError(t, client.Database(database).Drop(ctx))
+		assert.NoError(t, client.Disconnect(ctx))
+	})
+	service := NewCollectionService(client)
+
+	specOf := func(t *testing.T, name string) collectionOptions {
+		t.Helper()
+		specs, err := client.Database(database).ListCollectionSpecifications(ctx, bson.D{{Key: "name", Value: name}})
+		require.NoError(t, err)
+		require.Len(t, specs, 1)
+		current := collectionOptions{}
+		require.NoError(t, bson.Unmarshal(specs[0].Options, &current))
+		return current
+	}
+
+	t.Run("capped collection with a validator", func(t *testing.T) {
+		collection := &Collection{
+			Database:  database,
+			Name:      "logs",
+			Capped:    &Capped{SizeBytes: 4096, MaxDocuments: 10},
+			Validator: &Validator{JSONSchema: testSchema},
+		}
+		created, err := service.EnsureCollection(ctx, collection)
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, []string{"create"}, recorder.take())
+
+		created, err = service.EnsureCollection(ctx, collection)
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Empty(t, recorder.take(), "the collection converged")
+
+		collection.Validator.Level = "moderate"
+		_, err = service.EnsureCollection(ctx, collection)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"collMod"}, recorder.take())
+		assert.Equal(t, "moderate", specOf(t, "logs").ValidationLevel)
+
+		collection.Validator = nil
+		_, err = service.EnsureCollection(ctx, collection)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"collMod"}, recorder.take())
+		_, err = service.EnsureCollection(ctx, collection)
+		require.NoError(t, err)
+		assert.Empty(t, recorder.take(), "the validator was removed")
+
+		collection.Capped.SizeBytes = 8192
+		_, err = service.EnsureCollection(ctx, collection)
+		assert.ErrorIs(t, err, ErrImmutable)
+	})
+
+	t.Run("time series collection", func(t *testing.T) {
+		collection := &Collection{
+			Database: database,
+			Name:     "metrics",
+			TimeSeries: &TimeSeries{
+				TimeField:          "ts",
+				MetaField:          "host",
+				Granularity:        "seconds",
+				ExpireAfterSeconds: pointer.MakePtr(int64(3600)),
+			},
+		}
+		created, err := service.EnsureCollection(ctx, collection)
+		require.NoError(t, err)
+		assert.True(t, created)
+		recorder.take()
+
+		_, err = service.EnsureCollection(ctx, collection)
+		require.NoError(t, err)
+		assert.Empty(t, recorder.take(), "the collection converged")
+
+		collection.TimeSeries.Granularity = "minutes"
+		collection.TimeSeries.ExpireAfterSeconds = nil
+		_, err = service.EnsureCollection(ctx, collection)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"collMod"}, recorder.take())
+		current := specOf(t, "metrics")
+		assert.Equal(t, "minutes", current.TimeSeries.Granularity)
+		assert.Nil(t, current.ExpireAfterSeconds)
+
+		collection.TimeSeries.MetaField = "region"
+		_, err = service.EnsureCollection(ctx, collection)
+		assert.ErrorIs(t, err, ErrImmutable)
+	})
+
+	t.Run("indexes", func(t *testing.T) {
+		_, err := service.EnsureCollection(ctx, &Collection{Database: database, Name: "users"})
+		require.NoError(t, err)
+		recorder.take()
+
+		indexes := []Index{
+			{Name: "email", Keys: bson.D{{Key: "email", Value: 1}}, Unique: true},
+			{
+				Name:                    "active_by_date",
+				Keys:                    bson.D{{Key: "createdAt", Value: -1}},
+				ExpireAfterSeconds:      pointer.MakePtr(int32(60)),
+				PartialFilterExpression: bson.D{{Key: "active", Value: bson.D{{Key: "$eq", Value: true}}}},
+			},
+		}
+		changes, err := service.EnsureIndexes(ctx, database, "users", indexes, nil)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"email", "active_by_date"}, changes.Created)
+
+		changes, err = service.EnsureIndexes(ctx, database, "users", indexes, []string{"active_by_date", "email"})
+		require.NoError(t, err)
+		assert.Empty(t, changes.Created, "the indexes converged")
+		assert.Empty(t, changes.Dropped)
+		recorder.take()
+
+		indexes[0].Unique = false
+		changes, err = service.EnsureIndexes(ctx, database, "users", indexes[:1], []string{"active_by_date", "email"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"email"}, changes.Created)
+		assert.Equal(t, []string{"active_by_date"}, changes.Dropped)
+		assert.Equal(t, []string{"dropIndexes", "createIndexes", "dropIndexes"}, recorder.take())
+
+		specs, err := client.Database(database).Collection("users").Indexes().ListSpecifications(ctx)
+		require.NoError(t, err)
+		names := []string{}
+		for _, spec := range specs {
+			names = append(names, spec.Name)
+		}
+		slices.Sort(names)
+		assert.Equal(t, []string{idIndexName, "email"}, names)
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Config lists the Atlas resources to generate CRDs for
+type Config struct {
+	Resources []ResourceConfig `json:"resources"`
+}
+
+// ResourceConfig maps a CRD kind to the operationIds managing it in the Atlas OpenAPI spec
+type ResourceConfig struct {
+	Kind       string   `json:"kind"`
+	Plural     string   `json:"plural,omitempty"`
+	ShortNames []string `json:"shortNames,omitempty"`
+
+	Create string `json:"create"`
+	Get    string `json:"get"`
+	Update string `json:"update,omitempty"`
+	Delete string `json:"delete,omitempty"`
+
+	// IDParameter and IDField are inferred from the get and create operations when not set
+	IDParameter string `json:"idParameter,omitempty"`
+	IDField     string `json:"idField,omitempty"`
+}
+
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read generator config: %w", err)
+	}
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse generator config: %w", err)
+	}
+	for i := range config.Resources {
+		resource := &config.Resources[i]
+		if resource.Kind == "" || resource.Create == "" || resource.Get == "" {
+			return nil, fmt.Errorf("resource %d: kind, create and get are required", i)
+		}
+		if resource.Plural == "" {
+			resource.Plural = strings.ToLower(resource.Kind) + "s"
+		}
+	}
+	return config, nil
+}
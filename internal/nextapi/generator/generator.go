@@ -0,0 +1,349 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/openapi"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/resource"
+	nextapiv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+const (
+	defaultIDField = "id"
+	mongoIDField   = "_id"
+)
+
+// Generate builds the CRD of a resource from its Atlas OpenAPI operations, along with the
+// Definition the generic controller uses to call those operations
+func Generate(doc *openapi.Document, config *ResourceConfig) (*apiextensionsv1.CustomResourceDefinition, error) {
+	create, err := doc.FindOperation(config.Create)
+	if err != nil {
+		return nil, err
+	}
+	get, err := doc.FindOperation(config.Get)
+	if err != nil {
+		return nil, err
+	}
+
+	definition := &resource.Definition{
+		Kind:    config.Kind,
+		Version: nextapiv1.GroupVersion.Version,
+		Create:  toOperation(create),
+		Get:     toOperation(get),
+	}
+	if config.Update != "" {
+		update, err := doc.FindOperation(config.Update)
+		if err != nil {
+			return nil, err
+		}
+		definition.Update = toOperation(update)
+	}
+	if config.Delete != "" {
+		del, err := doc.FindOperation(config.Delete)
+		if err != nil {
+			return nil, err
+		}
+		definition.Delete = toOperation(del)
+	}
+	if err := setID(doc, config, definition, create, get); err != nil {
+		return nil, err
+	}
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+
+	spec, err := specSchema(doc, config, create)
+	if err != nil {
+		return nil, err
+	}
+	annotation, err := definition.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return &apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiextensionsv1.SchemeGroupVersion.String(),
+			Kind:       "CustomResourceDefinition",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        config.Plural + "." + nextapiv1.GroupVersion.Group,
+			Annotations: map[string]string{resource.DefinitionAnnotation: annotation},
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: nextapiv1.GroupVersion.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:       config.Kind,
+				ListKind:   config.Kind + "List",
+				Plural:     config.Plural,
+				Singular:   strings.ToLower(config.Kind),
+				ShortNames: config.ShortNames,
+				Categories: []string{"atlas"},
+			},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    nextapiv1.GroupVersion.Version,
+					Served:  true,
+					Storage: true,
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{Name: "Ready", Type: "string", JSONPath: `.status.conditions[?(@.type=="Ready")].status`},
+						{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+					},
+					Subresources: &apiextensionsv1.CustomResourceSubresources{Status: &apiextensionsv1.CustomResourceSubresourceStatus{}},
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Description: fmt.Sprintf("%s is managed through the Atlas %s operations", config.Kind, operationList(definition)),
+							Type:        openapi.TypeObject,
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"apiVersion": {Type: openapi.TypeString},
+								"kind":       {Type: openapi.TypeString},
+								"metadata":   {Type: openapi.TypeObject},
+								"spec":       *spec,
+								"status":     statusSchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// WriteCRD writes the CRD in the given directory, named as controller-gen does
+func WriteCRD(dir string, crd *apiextensionsv1.CustomResourceDefinition) (string, error) {
+	data, err := yaml.Marshal(crd)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal CRD %s: %w", crd.Name, err)
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s_%s.yaml", crd.Spec.Group, crd.Spec.Names.Plural))
+	if err := os.WriteFile(path, append([]byte("---\n"), data...), 0o600); err != nil {
+		return "", fmt.Errorf("failed to write CRD %s: %w", crd.Name, err)
+	}
+	return path, nil
+}
+
+func toOperation(op *openapi.OperationRef) *resource.Operation {
+	mediaType := ""
+	if op.RequestBody != nil {
+		mediaType, _ = openapi.LatestMediaType(op.RequestBody.Content)
+	}
+	if response := op.SuccessResponse(); mediaType == "" && response != nil {
+		mediaType, _ = openapi.LatestMediaType(response.Content)
+	}
+	return &resource.Operation{
+		ID:        op.OperationID,
+		Method:    op.Method,
+		Path:      op.Path,
+		MediaType: mediaType,
+	}
+}
+
+// setID infers the path parameter identifying a created resource: the one parameter of the
+// get operation the create operation does not have, read from the id field of the create response
+func setID(doc *openapi.Document, config *ResourceConfig, definition *resource.Definition, create, get *openapi.OperationRef) error {
+	definition.IDParameter = config.IDParameter
+	if definition.IDParameter == "" {
+		var missing []string
+		for _, name := range get.PathParameters() {
+			if !slices.Contains(create.PathParameters(), name) {
+				missing = append(missing, name)
+			}
+		}
+		switch len(missing) {
+		case 0:
+			return nil
+		case 1:
+			definition.IDParameter = missing[0]
+		default:
+			return fmt.Errorf("%s: cannot infer the id parameter among %v, set idParameter", config.Kind, missing)
+		}
+	}
+
+	definition.IDField = config.IDField
+	if definition.IDField != "" {
+		return nil
+	}
+	fields, err := responseFields(doc, create)
+	if err != nil {
+		return fmt.Errorf("%s: %w", config.Kind, err)
+	}
+	for _, candidate := range []string{definition.IDParameter, defaultIDField, mongoIDField} {
+		if slices.Contains(fields, candidate) {
+			definition.IDField = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: cannot infer the field of the create response holding %s, set idField", config.Kind, definition.IDParameter)
+}
+
+func responseFields(doc *openapi.Document, op *openapi.OperationRef) ([]string, error) {
+	response := op.SuccessResponse()
+	if response == nil {
+		return nil, nil
+	}
+	_, content := openapi.LatestMediaType(response.Content)
+	if content == nil || content.Schema == nil {
+		return nil, nil
+	}
+	schema, _, err := doc.ResolveSchema(content.Schema)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		fields = append(fields, name)
+	}
+	for _, member := range schema.AllOf {
+		resolved, _, err := doc.ResolveSchema(member)
+		if err != nil {
+			return nil, err
+		}
+		for name := range resolved.Properties {
+			fields = append(fields, name)
+		}
+	}
+	return fields, nil
+}
+
+func specSchema(doc *openapi.Document, config *ResourceConfig, create *openapi.OperationRef) (*apiextensionsv1.JSONSchemaProps, error) {
+	spec := &apiextensionsv1.JSONSchemaProps{
+		Type: openapi.TypeObject,
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			resource.FieldConnectionSecretRef: {
+				Type:        openapi.TypeObject,
+				Description: "Name of the Secret holding the Atlas API credentials, defaults to the operator global credentials",
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"name": {Type: openapi.TypeString},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+
+	if names := create.PathParameters(); len(names) > 0 {
+		params := apiextensionsv1.JSONSchemaProps{
+			Type:        openapi.TypeObject,
+			Description: "Path parameters of the Atlas API operations. They cannot be changed.",
+			Properties:  map[string]apiextensionsv1.JSONSchemaProps{},
+			Required:    names,
+			XValidations: apiextensionsv1.ValidationRules{
+				{Rule: "self == oldSelf", Message: "parameters are immutable"},
+			},
+		}
+		for _, name := range names {
+			param := create.Parameter(name, openapi.ParameterInPath)
+			props := apiextensionsv1.JSONSchemaProps{Type: openapi.TypeString, MinLength: pointer.MakePtr(int64(1))}
+			if param != nil {
+				props.Description = param.Description
+				if param.Schema != nil {
+					props.Pattern = param.Schema.Pattern
+				}
+			}
+			params.Properties[name] = props
+		}
+		spec.Properties[resource.FieldParameters] = params
+		spec.Required = append(spec.Required, resource.FieldParameters)
+	}
+
+	if create.RequestBody != nil {
+		_, content := openapi.LatestMediaType(create.RequestBody.Content)
+		if content == nil || content.Schema == nil {
+			return nil, fmt.Errorf("%s: operation %s has no JSON request body", config.Kind, create.OperationID)
+		}
+		entry, err := doc.ToJSONSchemaProps(content.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("%s: request body of %s: %w", config.Kind, create.OperationID, err)
+		}
+		entry.Description = fmt.Sprintf("Body of the %s request. %s", create.OperationID, entry.Description)
+		entry.Description = strings.TrimSpace(entry.Description)
+		spec.Properties[resource.FieldEntry] = *entry
+		if create.RequestBody.Required {
+			spec.Required = append(spec.Required, resource.FieldEntry)
+		}
+	}
+	return spec, nil
+}
+
+func statusSchema() apiextensionsv1.JSONSchemaProps {
+	return apiextensionsv1.JSONSchemaProps{
+		Type: openapi.TypeObject,
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			resource.FieldConditions: {
+				Type:        openapi.TypeArray,
+				Description: "Conditions holding the status details",
+				Items:       &apiextensionsv1.JSONSchemaPropsOrArray{Schema: conditionSchema()},
+			},
+			resource.FieldParameters: {
+				Type:        openapi.TypeObject,
+				Description: "Path parameters identifying the resource in Atlas",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+					Allows: true,
+					Schema: &apiextensionsv1.JSONSchemaProps{Type: openapi.TypeString},
+				},
+			},
+			resource.FieldEntry: {
+				Type:                   openapi.TypeObject,
+				Description:            "Resource as last returned by Atlas",
+				XPreserveUnknownFields: pointer.MakePtr(true),
+			},
+		},
+	}
+}
+
+func conditionSchema() *apiextensionsv1.JSONSchemaProps {
+	return &apiextensionsv1.JSONSchemaProps{
+		Type:        openapi.TypeObject,
+		Description: "Condition contains details for one aspect of the current state of this API Resource.",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"lastTransitionTime": {Type: openapi.TypeString, Format: "date-time"},
+			"message":            {Type: openapi.TypeString, MaxLength: pointer.MakePtr(int64(32768))},
+			"observedGeneration": {Type: openapi.TypeInteger, Format: "int64", Minimum: pointer.MakePtr(float64(0))},
+			"reason": {
+				Type:      openapi.TypeString,
+				MinLength: pointer.MakePtr(int64(1)),
+				MaxLength: pointer.MakePtr(int64(1024)),
+				Pattern:   `^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$`,
+			},
+			"status": {
+				Type: openapi.TypeString,
+				Enum: []apiextensionsv1.JSON{{Raw: []byte(`"True"`)}, {Raw: []byte(`"False"`)}, {Raw: []byte(`"Unknown"`)}},
+			},
+			"type": {Type: openapi.TypeString, MaxLength: pointer.MakePtr(int64(316))},
+		},
+		Required: []string{"lastTransitionTime", "message", "reason", "status", "type"},
+	}
+}
+
+func operationList(definition *resource.Definition) string {
+	ids := []string{definition.Create.ID, definition.Get.ID}
+	if definition.Update != nil {
+		ids = append(ids, definition.Update.ID)
+	}
+	if definition.Delete != nil {
+		ids = append(ids, definition.Delete.ID)
+	}
+	return strings.Join(ids, ", ")
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/openapi"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/resource"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/test/helper/cel"
+)
+
+func loadTestDocument(t *testing.T) *openapi.Document {
+	t.Helper()
+	doc, err := openapi.Load(filepath.Join("..", "openapi", "testdata", "atlas-api.yaml"))
+	require.NoError(t, err)
+	return doc
+}
+
+func TestGenerate(t *testing.T) {
+	doc := loadTestDocument(t)
+
+	for _, tc := range []struct {
+		title          string
+		config         ResourceConfig
+		wantDefinition resource.Definition
+		wantRequired   []string
+		wantErr        string
+	}{
+		{
+			title: "export bucket without update",
+			config: ResourceConfig{
+				Kind: "ExportBucket", Plural: "exportbuckets",
+				Create: "createExportBucket", Get: "getExportBucket", Delete: "deleteExportBucket",
+			},
+			wantDefinition: resource.Definition{
+				Kind:    "ExportBucket",
+				Version: "v1",
+				Create: &resource.Operation{
+					ID: "createExportBucket", Method: "POST",
+					Path:      "/api/atlas/v2/groups/{groupId}/backup/exportBuckets",
+					MediaType: "application/vnd.atlas.2024-05-30+json",
+				},
+				Get: &resource.Operation{
+					ID: "getExportBucket", Method: "GET",
+					Path:      "/api/atlas/v2/groups/{groupId}/backup/exportBuckets/{exportBucketId}",
+					MediaType: "application/vnd.atlas.2024-05-30+json",
+				},
+				Delete: &resource.Operation{
+					ID: "deleteExportBucket", Method: "DELETE",
+					Path:      "/api/atlas/v2/groups/{groupId}/backup/exportBuckets/{exportBucketId}",
+					MediaType: "application/vnd.atlas.2023-01-01+json",
+				},
+				IDParameter: "exportBucketId",
+				IDField:     "_id",
+			},
+			wantRequired: []string{"parameters", "entry"},
+		},
+		{
+			title: "custom role identified by its name",
+			config: ResourceConfig{
+				Kind: "CustomRole", Plural: "customroles",
+				Create: "createCustomDatabaseRole", Get: "getCustomDatabaseRole",
+				Update: "updateCustomDatabaseRole", Delete: "deleteCustomDatabaseRole",
+			},
+			wantRequired: []string{"parameters", "entry"},
+		},
+		{
+			title:   "unknown operation",
+			config:  ResourceConfig{Kind: "Unknown", Plural: "unknowns", Create: "createUnknown", Get: "getUnknown"},
+			wantErr: "createUnknown",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			crd, err := Generate(doc, &tc.config)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.config.Plural+".atlas.nextapi.mongodb.com", crd.Name)
+			definition, err := resource.Decode(crd.Annotations[resource.DefinitionAnnotation])
+			require.NoError(t, err)
+			if tc.wantDefinition.Kind != "" {
+				assert.Equal(t, &tc.wantDefinition, definition)
+			}
+			spec := crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"]
+			assert.Equal(t, tc.wantRequired, spec.Required)
+			assert.Contains(t, spec.Properties, "connectionSecretRef")
+			assert.Equal(t, []string{"groupId"}, spec.Properties["parameters"].Required)
+		})
+	}
+}
+
+func TestGenerateInfersIDFromParameterName(t *testing.T) {
+	crd, err := Generate(loadTestDocument(t), &ResourceConfig{
+		Kind: "CustomRole", Plural: "customroles",
+		Create: "createCustomDatabaseRole", Get: "getCustomDatabaseRole",
+	})
+	require.NoError(t, err)
+	definition, err := resource.Decode(crd.Annotations[resource.DefinitionAnnotation])
+	require.NoError(t, err)
+	assert.Equal(t, "roleName", definition.IDParameter)
+	assert.Equal(t, "roleName", definition.IDField)
+}
+
+func TestWriteCRDValidatesParameters(t *testing.T) {
+	crd, err := Generate(loadTestDocument(t), &ResourceConfig{
+		Kind: "ExportBucket", Plural: "exportbuckets",
+		Create: "createExportBucket", Get: "getExportBucket", Delete: "deleteExportBucket",
+	})
+	require.NoError(t, err)
+	path, err := WriteCRD(t.TempDir(), crd)
+	require.NoError(t, err)
+	assert.Equal(t, "atlas.nextapi.mongodb.com_exportbuckets.yaml", filepath.Base(path))
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	validator, err := cel.VersionValidatorFromFile(t, path, "v1")
+	require.NoError(t, err)
+
+	object := func(groupID string) map[string]any {
+		return map[string]any{
+			"spec": map[string]any{
+				"parameters": map[string]any{"groupId": groupID},
+				"entry":      map[string]any{"bucketName": "bucket", "cloudProvider": "AWS"},
+			},
+		}
+	}
+	assert.Empty(t, validator(object("a"), object("a")))
+	assert.Equal(t,
+		[]string{"spec.parameters: Invalid value: \"object\": parameters are immutable"},
+		cel.ErrorListAsStrings(validator(object("b"), object("a"))),
+	)
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`resources:
+- kind: ExportBucket
+  create: createExportBucket
+  get: getExportBucket
+`), 0o600))
+	config, err := LoadConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, "exportbuckets", config.Resources[0].Plural)
+
+	require.NoError(t, os.WriteFile(path, []byte("resources:\n- kind: ExportBucket\n"), 0o600))
+	_, err = LoadConfig(path)
+	assert.ErrorContains(t, err, "kind, create and get are required")
+}
@@ -0,0 +1,242 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ParameterInPath = "path"
+
+	componentParametersPrefix = "#/components/parameters/"
+	componentSchemasPrefix    = "#/components/schemas/"
+)
+
+// Document is the subset of an OpenAPI 3 document needed to generate CRDs
+type Document struct {
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Components struct {
+	Schemas    map[string]*Schema    `json:"schemas"`
+	Parameters map[string]*Parameter `json:"parameters"`
+}
+
+type PathItem struct {
+	Parameters []*Parameter `json:"parameters,omitempty"`
+	Get        *Operation   `json:"get,omitempty"`
+	Post       *Operation   `json:"post,omitempty"`
+	Put        *Operation   `json:"put,omitempty"`
+	Patch      *Operation   `json:"patch,omitempty"`
+	Delete     *Operation   `json:"delete,omitempty"`
+}
+
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses,omitempty"`
+}
+
+type Parameter struct {
+	Ref         string  `json:"$ref,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	In          string  `json:"in,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema,omitempty"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content,omitempty"`
+}
+
+type Response struct {
+	Content map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+// Schema is an OpenAPI 3.0 schema object
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties,omitempty"`
+	Enum                 []json.RawMessage  `json:"enum,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	ReadOnly             bool               `json:"readOnly,omitempty"`
+	WriteOnly            bool               `json:"writeOnly,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int64             `json:"minLength,omitempty"`
+	MaxLength            *int64             `json:"maxLength,omitempty"`
+	MinItems             *int64             `json:"minItems,omitempty"`
+	MaxItems             *int64             `json:"maxItems,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+}
+
+// Load reads an OpenAPI document in YAML or JSON
+func Load(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OpenAPI document: %w", err)
+	}
+	return Parse(data)
+}
+
+func Parse(data []byte) (*Document, error) {
+	doc := &Document{}
+	if err := yaml.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document: %w", err)
+	}
+	return doc, nil
+}
+
+// OperationRef is an operation along with the path and method it is served at
+type OperationRef struct {
+	*Operation
+	Path       string
+	Method     string
+	Parameters []*Parameter
+}
+
+// FindOperation looks an operation up by its operationId
+func (d *Document) FindOperation(operationID string) (*OperationRef, error) {
+	for path, item := range d.Paths {
+		for method, op := range item.operations() {
+			if op == nil || op.OperationID != operationID {
+				continue
+			}
+			params, err := d.resolveParameters(append(append([]*Parameter{}, item.Parameters...), op.Parameters...))
+			if err != nil {
+				return nil, fmt.Errorf("operation %s: %w", operationID, err)
+			}
+			return &OperationRef{Operation: op, Path: path, Method: method, Parameters: params}, nil
+		}
+	}
+	return nil, fmt.Errorf("operation %q not found", operationID)
+}
+
+func (p *PathItem) operations() map[string]*Operation {
+	return map[string]*Operation{
+		"GET":    p.Get,
+		"POST":   p.Post,
+		"PUT":    p.Put,
+		"PATCH":  p.Patch,
+		"DELETE": p.Delete,
+	}
+}
+
+func (d *Document) resolveParameters(params []*Parameter) ([]*Parameter, error) {
+	resolved := make([]*Parameter, 0, len(params))
+	for _, param := range params {
+		if param.Ref != "" {
+			name, ok := strings.CutPrefix(param.Ref, componentParametersPrefix)
+			if !ok {
+				return nil, fmt.Errorf("unsupported parameter reference %q", param.Ref)
+			}
+			param, ok = d.Components.Parameters[name]
+			if !ok {
+				return nil, fmt.Errorf("parameter %q not found", name)
+			}
+		}
+		resolved = append(resolved, param)
+	}
+	return resolved, nil
+}
+
+// PathParameters returns the names of the path parameters, in the order they appear in the path
+func (o *OperationRef) PathParameters() []string {
+	names := []string{}
+	for _, segment := range strings.Split(o.Path, "/") {
+		if name, ok := strings.CutPrefix(segment, "{"); ok {
+			names = append(names, strings.TrimSuffix(name, "}"))
+		}
+	}
+	return names
+}
+
+// Parameter returns the parameter with the given name and location
+func (o *OperationRef) Parameter(name, in string) *Parameter {
+	for _, param := range o.Parameters {
+		if param.Name == name && param.In == in {
+			return param
+		}
+	}
+	return nil
+}
+
+// ResolveSchema returns the schema referenced by $ref, or the schema itself
+func (d *Document) ResolveSchema(schema *Schema) (*Schema, string, error) {
+	if schema == nil || schema.Ref == "" {
+		return schema, "", nil
+	}
+	name, ok := strings.CutPrefix(schema.Ref, componentSchemasPrefix)
+	if !ok {
+		return nil, "", fmt.Errorf("unsupported schema reference %q", schema.Ref)
+	}
+	resolved, ok := d.Components.Schemas[name]
+	if !ok {
+		return nil, "", fmt.Errorf("schema %q not found", name)
+	}
+	return resolved, name, nil
+}
+
+// LatestMediaType returns the most recent versioned Atlas media type of the content,
+// falling back to application/json
+func LatestMediaType(content map[string]*MediaType) (string, *MediaType) {
+	latest := ""
+	for mediaType := range content {
+		if strings.HasPrefix(mediaType, "application/vnd.atlas.") && mediaType > latest {
+			latest = mediaType
+		}
+	}
+	if latest == "" {
+		if _, ok := content["application/json"]; !ok {
+			return "", nil
+		}
+		latest = "application/json"
+	}
+	return latest, content[latest]
+}
+
+// SuccessResponse returns the first 2xx response of the operation
+func (o *OperationRef) SuccessResponse() *Response {
+	for _, code := range []string{"200", "201", "202", "204"} {
+		if response, ok := o.Responses[code]; ok {
+			return response
+		}
+	}
+	return nil
+}
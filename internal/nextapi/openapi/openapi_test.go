@@ -0,0 +1,122 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+func loadTestDocument(t *testing.T) *Document {
+	t.Helper()
+	doc, err := Load("testdata/atlas-api.yaml")
+	require.NoError(t, err)
+	return doc
+}
+
+func TestFindOperation(t *testing.T) {
+	doc := loadTestDocument(t)
+
+	op, err := doc.FindOperation("getExportBucket")
+	require.NoError(t, err)
+	assert.Equal(t, "GET", op.Method)
+	assert.Equal(t, "/api/atlas/v2/groups/{groupId}/backup/exportBuckets/{exportBucketId}", op.Path)
+	assert.Equal(t, []string{"groupId", "exportBucketId"}, op.PathParameters())
+	groupID := op.Parameter("groupId", ParameterInPath)
+	require.NotNil(t, groupID, "path item parameters and references are resolved")
+	assert.True(t, groupID.Required)
+
+	_, err = doc.FindOperation("missing")
+	assert.EqualError(t, err, `operation "missing" not found`)
+}
+
+func TestLatestMediaType(t *testing.T) {
+	doc := loadTestDocument(t)
+	op, err := doc.FindOperation("createExportBucket")
+	require.NoError(t, err)
+
+	mediaType, content := LatestMediaType(op.RequestBody.Content)
+	assert.Equal(t, "application/vnd.atlas.2024-05-30+json", mediaType)
+	assert.Equal(t, "#/components/schemas/DiskBackupSnapshotAWSExportBucketRequest", content.Schema.Ref)
+
+	mediaType, _ = LatestMediaType(map[string]*MediaType{"application/json": {}})
+	assert.Equal(t, "application/json", mediaType)
+	mediaType, content = LatestMediaType(nil)
+	assert.Empty(t, mediaType)
+	assert.Nil(t, content)
+}
+
+func TestToJSONSchemaProps(t *testing.T) {
+	doc := loadTestDocument(t)
+
+	t.Run("merges allOf and keeps maps", func(t *testing.T) {
+		props, err := doc.ToJSONSchemaProps(&Schema{Ref: "#/components/schemas/DiskBackupSnapshotAWSExportBucketRequest"})
+		require.NoError(t, err)
+		assert.Equal(t, "object", props.Type)
+		assert.Equal(t, "Export bucket in AWS S3.", props.Description)
+		assert.ElementsMatch(t, []string{"bucketName", "cloudProvider", "iamRoleId", "requirePrivateNetworking", "tags"}, keys(props.Properties))
+		assert.Equal(t, []string{"bucketName", "cloudProvider"}, props.Required)
+		assert.Equal(t, &apiextensionsv1.JSONSchemaPropsOrBool{
+			Allows: true,
+			Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"},
+		}, props.Properties["tags"].AdditionalProperties)
+		assert.Equal(t, []apiextensionsv1.JSON{{Raw: []byte(`"AWS"`)}, {Raw: []byte(`"AZURE"`)}, {Raw: []byte(`"GCP"`)}},
+			props.Properties["cloudProvider"].Enum)
+	})
+
+	t.Run("drops read only properties and unsupported formats", func(t *testing.T) {
+		props, err := doc.ToJSONSchemaProps(&Schema{Ref: "#/components/schemas/DiskBackupSnapshotAWSExportBucketResponse"})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"bucketName", "cloudProvider", "iamRoleId"}, keys(props.Properties))
+
+		link, err := doc.ToJSONSchemaProps(&Schema{Ref: "#/components/schemas/Link"})
+		require.NoError(t, err)
+		assert.Empty(t, link.Properties["href"].Format)
+	})
+
+	t.Run("merges oneOf alternatives preserving unknown fields", func(t *testing.T) {
+		props, err := doc.ToJSONSchemaProps(&Schema{Ref: "#/components/schemas/DatabasePrivilegeAction"})
+		require.NoError(t, err)
+		resource := props.Properties["resources"].Items.Schema
+		assert.Equal(t, pointer.MakePtr(true), resource.XPreserveUnknownFields)
+		assert.ElementsMatch(t, []string{"cluster", "collection", "db"}, keys(resource.Properties))
+		assert.Equal(t, []string{"action"}, props.Required)
+	})
+
+	t.Run("stops at recursive references", func(t *testing.T) {
+		props, err := doc.ToJSONSchemaProps(&Schema{Ref: "#/components/schemas/TreeNode"})
+		require.NoError(t, err)
+		children := props.Properties["children"].Items.Schema
+		assert.Equal(t, &apiextensionsv1.JSONSchemaProps{Type: "object", XPreserveUnknownFields: pointer.MakePtr(true)}, children)
+	})
+
+	t.Run("fails on unknown references", func(t *testing.T) {
+		_, err := doc.ToJSONSchemaProps(&Schema{Ref: "#/components/schemas/Missing"})
+		assert.EqualError(t, err, `schema "Missing" not found`)
+	})
+}
+
+func keys(props map[string]apiextensionsv1.JSONSchemaProps) []string {
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	return names
+}
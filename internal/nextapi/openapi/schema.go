@@ -0,0 +1,288 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+const (
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeString  = "string"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+)
+
+// supportedFormats are the formats Kubernetes validates, others are dropped
+var supportedFormats = []string{"int32", "int64", "float", "double", "byte", "date", "date-time", "password"}
+
+// ToJSONSchemaProps converts an OpenAPI schema into a structural Kubernetes schema.
+// Read only properties are dropped as the schema describes what users set.
+// Recursive and polymorphic schemas are kept as objects preserving unknown fields.
+func (d *Document) ToJSONSchemaProps(schema *Schema) (*apiextensionsv1.JSONSchemaProps, error) {
+	return d.convert(schema, map[string]bool{})
+}
+
+func (d *Document) convert(schema *Schema, visiting map[string]bool) (*apiextensionsv1.JSONSchemaProps, error) {
+	resolved, name, err := d.ResolveSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		if visiting[name] {
+			return preserveUnknownFields(resolved.Description), nil
+		}
+		visiting[name] = true
+		defer delete(visiting, name)
+	}
+	schema = resolved
+
+	if len(schema.AllOf) > 0 {
+		if schema, err = d.mergeAllOf(schema); err != nil {
+			return nil, err
+		}
+	}
+	if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+		return d.convertPolymorphic(schema, visiting)
+	}
+
+	switch schema.Type {
+	case TypeObject, "":
+		return d.convertObject(schema, visiting)
+	case TypeArray:
+		props := &apiextensionsv1.JSONSchemaProps{
+			Type:        TypeArray,
+			Description: schema.Description,
+			Nullable:    schema.Nullable,
+			MinItems:    schema.MinItems,
+			MaxItems:    schema.MaxItems,
+		}
+		if schema.Items == nil {
+			props.Items = &apiextensionsv1.JSONSchemaPropsOrArray{Schema: preserveUnknownFields("")}
+			return props, nil
+		}
+		items, err := d.convert(schema.Items, visiting)
+		if err != nil {
+			return nil, err
+		}
+		props.Items = &apiextensionsv1.JSONSchemaPropsOrArray{Schema: items}
+		return props, nil
+	case TypeString, TypeInteger, TypeNumber, TypeBoolean:
+		return convertScalar(schema), nil
+	default:
+		return nil, fmt.Errorf("unsupported schema type %q", schema.Type)
+	}
+}
+
+func (d *Document) convertObject(schema *Schema, visiting map[string]bool) (*apiextensionsv1.JSONSchemaProps, error) {
+	if len(schema.Properties) == 0 {
+		additional, err := d.additionalProperties(schema)
+		if err != nil {
+			return nil, err
+		}
+		if additional != nil {
+			values, err := d.convert(additional, visiting)
+			if err != nil {
+				return nil, err
+			}
+			return &apiextensionsv1.JSONSchemaProps{
+				Type:                 TypeObject,
+				Description:          schema.Description,
+				Nullable:             schema.Nullable,
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: values},
+			}, nil
+		}
+		if schema.Type == "" {
+			return &apiextensionsv1.JSONSchemaProps{Description: schema.Description, XPreserveUnknownFields: pointer.MakePtr(true)}, nil
+		}
+		return preserveUnknownFields(schema.Description), nil
+	}
+
+	props := &apiextensionsv1.JSONSchemaProps{
+		Type:        TypeObject,
+		Description: schema.Description,
+		Nullable:    schema.Nullable,
+		Properties:  map[string]apiextensionsv1.JSONSchemaProps{},
+	}
+	for name, property := range schema.Properties {
+		readOnly, err := d.isReadOnly(property)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		if readOnly {
+			continue
+		}
+		converted, err := d.convert(property, visiting)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		props.Properties[name] = *converted
+	}
+	for _, name := range schema.Required {
+		if _, ok := props.Properties[name]; ok && !slices.Contains(props.Required, name) {
+			props.Required = append(props.Required, name)
+		}
+	}
+	return props, nil
+}
+
+// convertPolymorphic turns oneOf and anyOf into a single object holding the properties
+// of all the alternatives, as structural schemas cannot express alternatives
+func (d *Document) convertPolymorphic(schema *Schema, visiting map[string]bool) (*apiextensionsv1.JSONSchemaProps, error) {
+	merged := &Schema{Type: TypeObject, Description: schema.Description, Properties: map[string]*Schema{}}
+	for name, property := range schema.Properties {
+		merged.Properties[name] = property
+	}
+	for _, alternative := range append(append([]*Schema{}, schema.OneOf...), schema.AnyOf...) {
+		resolved, _, err := d.ResolveSchema(alternative)
+		if err != nil {
+			return nil, err
+		}
+		if len(resolved.AllOf) > 0 {
+			if resolved, err = d.mergeAllOf(resolved); err != nil {
+				return nil, err
+			}
+		}
+		if resolved.Type != TypeObject && resolved.Type != "" {
+			return &apiextensionsv1.JSONSchemaProps{Description: schema.Description, XPreserveUnknownFields: pointer.MakePtr(true)}, nil
+		}
+		for name, property := range resolved.Properties {
+			if _, ok := merged.Properties[name]; !ok {
+				merged.Properties[name] = property
+			}
+		}
+	}
+	props, err := d.convertObject(merged, visiting)
+	if err != nil {
+		return nil, err
+	}
+	props.XPreserveUnknownFields = pointer.MakePtr(true)
+	return props, nil
+}
+
+// mergeAllOf folds the allOf members of an object schema into a single schema
+func (d *Document) mergeAllOf(schema *Schema) (*Schema, error) {
+	merged := &Schema{
+		Type:        schema.Type,
+		Description: schema.Description,
+		Nullable:    schema.Nullable,
+		OneOf:       schema.OneOf,
+		AnyOf:       schema.AnyOf,
+		Properties:  map[string]*Schema{},
+		Required:    append([]string{}, schema.Required...),
+	}
+	for name, property := range schema.Properties {
+		merged.Properties[name] = property
+	}
+	for _, member := range schema.AllOf {
+		resolved, _, err := d.ResolveSchema(member)
+		if err != nil {
+			return nil, err
+		}
+		if len(resolved.AllOf) > 0 {
+			if resolved, err = d.mergeAllOf(resolved); err != nil {
+				return nil, err
+			}
+		}
+		if resolved.Type != TypeObject && resolved.Type != "" {
+			return resolved, nil
+		}
+		if merged.Type == "" {
+			merged.Type = resolved.Type
+		}
+		if merged.Description == "" {
+			merged.Description = resolved.Description
+		}
+		for name, property := range resolved.Properties {
+			merged.Properties[name] = property
+		}
+		merged.Required = append(merged.Required, resolved.Required...)
+		merged.OneOf = append(merged.OneOf, resolved.OneOf...)
+		merged.AnyOf = append(merged.AnyOf, resolved.AnyOf...)
+		if merged.AdditionalProperties == nil {
+			merged.AdditionalProperties = resolved.AdditionalProperties
+		}
+	}
+	return merged, nil
+}
+
+func (d *Document) isReadOnly(property *Schema) (bool, error) {
+	if property.ReadOnly {
+		return true, nil
+	}
+	resolved, _, err := d.ResolveSchema(property)
+	if err != nil {
+		return false, err
+	}
+	return resolved.ReadOnly, nil
+}
+
+// additionalProperties returns the schema of the values of a map, if any
+func (d *Document) additionalProperties(schema *Schema) (*Schema, error) {
+	if len(schema.AdditionalProperties) == 0 {
+		return nil, nil
+	}
+	var allowed bool
+	if err := json.Unmarshal(schema.AdditionalProperties, &allowed); err == nil {
+		if allowed {
+			return &Schema{}, nil
+		}
+		return nil, nil
+	}
+	values := &Schema{}
+	if err := json.Unmarshal(schema.AdditionalProperties, values); err != nil {
+		return nil, fmt.Errorf("invalid additionalProperties: %w", err)
+	}
+	return values, nil
+}
+
+func convertScalar(schema *Schema) *apiextensionsv1.JSONSchemaProps {
+	props := &apiextensionsv1.JSONSchemaProps{
+		Type:        schema.Type,
+		Description: schema.Description,
+		Nullable:    schema.Nullable,
+		Minimum:     schema.Minimum,
+		Maximum:     schema.Maximum,
+		MinLength:   schema.MinLength,
+		MaxLength:   schema.MaxLength,
+		Pattern:     schema.Pattern,
+	}
+	if slices.Contains(supportedFormats, schema.Format) {
+		props.Format = schema.Format
+	}
+	for _, value := range schema.Enum {
+		if string(value) == "null" {
+			continue
+		}
+		props.Enum = append(props.Enum, apiextensionsv1.JSON{Raw: value})
+	}
+	return props
+}
+
+func preserveUnknownFields(description string) *apiextensionsv1.JSONSchemaProps {
+	return &apiextensionsv1.JSONSchemaProps{
+		Type:                   TypeObject,
+		Description:            description,
+		XPreserveUnknownFields: pointer.MakePtr(true),
+	}
+}
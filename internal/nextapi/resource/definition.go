@@ -0,0 +1,123 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	nextapiv1 "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/v1"
+)
+
+const (
+	// DefinitionAnnotation holds the JSON Definition of a generated CRD
+	DefinitionAnnotation = "atlas.nextapi.mongodb.com/definition"
+
+	FieldConnectionSecretRef = "connectionSecretRef"
+	FieldParameters          = "parameters"
+	FieldEntry               = "entry"
+	FieldConditions          = "conditions"
+)
+
+// Definition describes how the instances of a generated CRD map to Atlas API operations
+type Definition struct {
+	Kind    string `json:"kind"`
+	Version string `json:"version"`
+
+	Create *Operation `json:"create"`
+	Get    *Operation `json:"get"`
+	Update *Operation `json:"update,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+
+	// IDParameter is the path parameter identifying a created resource,
+	// it is read from the IDField of the create response
+	IDParameter string `json:"idParameter,omitempty"`
+	IDField     string `json:"idField,omitempty"`
+}
+
+// Operation is an Atlas API operation
+type Operation struct {
+	ID        string `json:"operationId"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	MediaType string `json:"mediaType,omitempty"`
+}
+
+func (d *Definition) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: nextapiv1.GroupVersion.Group, Version: d.Version, Kind: d.Kind}
+}
+
+func (d *Definition) Validate() error {
+	var errs []error
+	if d.Kind == "" {
+		errs = append(errs, errors.New("kind is required"))
+	}
+	if d.Version == "" {
+		errs = append(errs, errors.New("version is required"))
+	}
+	if d.Create == nil {
+		errs = append(errs, errors.New("create operation is required"))
+	}
+	if d.Get == nil {
+		errs = append(errs, errors.New("get operation is required"))
+	}
+	if d.IDParameter != "" && d.IDField == "" {
+		errs = append(errs, fmt.Errorf("idField is required to read the %s parameter", d.IDParameter))
+	}
+	return errors.Join(errs...)
+}
+
+// Encode returns the Definition as stored in the DefinitionAnnotation
+func (d *Definition) Encode() (string, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func Decode(annotation string) (*Definition, error) {
+	definition := &Definition{}
+	if err := json.Unmarshal([]byte(annotation), definition); err != nil {
+		return nil, fmt.Errorf("invalid definition: %w", err)
+	}
+	if err := definition.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid definition of %s: %w", definition.Kind, err)
+	}
+	return definition, nil
+}
+
+// URLPath fills in the path parameters of the operation
+func (o *Operation) URLPath(params map[string]string) (string, error) {
+	segments := strings.Split(o.Path, "/")
+	for i, segment := range segments {
+		name, ok := strings.CutPrefix(segment, "{")
+		if !ok {
+			continue
+		}
+		name = strings.TrimSuffix(name, "}")
+		value := params[name]
+		if value == "" {
+			return "", fmt.Errorf("missing value of path parameter %q of operation %s", name, o.ID)
+		}
+		segments[i] = url.PathEscape(value)
+	}
+	return strings.Join(segments, "/"), nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestDecode(t *testing.T) {
+	definition, err := Decode(`{"kind":"CustomRole","version":"v1",` +
+		`"create":{"operationId":"createCustomDatabaseRole","method":"POST","path":"/api/atlas/v2/groups/{groupId}/customDBRoles/roles"},` +
+		`"get":{"operationId":"getCustomDatabaseRole","method":"GET","path":"/api/atlas/v2/groups/{groupId}/customDBRoles/roles/{roleName}"},` +
+		`"idParameter":"roleName","idField":"roleName"}`)
+	require.NoError(t, err)
+	assert.Equal(t, schema.GroupVersionKind{Group: "atlas.nextapi.mongodb.com", Version: "v1", Kind: "CustomRole"}, definition.GroupVersionKind())
+
+	encoded, err := definition.Encode()
+	require.NoError(t, err)
+	decoded, err := Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, definition, decoded)
+
+	_, err = Decode(`{"kind":"CustomRole","idParameter":"roleName"}`)
+	assert.EqualError(t, err, "invalid definition of CustomRole: version is required\n"+
+		"create operation is required\n"+
+		"get operation is required\n"+
+		"idField is required to read the roleName parameter")
+
+	_, err = Decode(`not json`)
+	assert.ErrorContains(t, err, "invalid definition")
+}
+
+func TestURLPath(t *testing.T) {
+	op := &Operation{ID: "getCustomDatabaseRole", Path: "/api/atlas/v2/groups/{groupId}/customDBRoles/roles/{roleName}"}
+
+	path, err := op.URLPath(map[string]string{"groupId": "62b6e34b3d91647abb20e7b8", "roleName": "read only/app"})
+	require.NoError(t, err)
+	assert.Equal(t, "/api/atlas/v2/groups/62b6e34b3d91647abb20e7b8/customDBRoles/roles/read%20only%2Fapp", path)
+
+	_, err = op.URLPath(map[string]string{"groupId": "62b6e34b3d91647abb20e7b8"})
+	assert.EqualError(t, err, `missing value of path parameter "roleName" of operation getCustomDatabaseRole`)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -38,15 +40,19 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/connectionsecret"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/watch"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/dryrun"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/featureflags"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/indexer"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/sharding"
 )
 
 const (
@@ -54,6 +60,7 @@ const (
 	DefaultSyncPeriod            = 3 * time.Hour
 	DefaultIndependentSyncPeriod = 15 * time.Minute
 	DefaultLeaderElectionID      = "06d035fb.mongodb.com"
+	ConversionWebhookPath        = "/convert"
 )
 
 type ManagerProvider interface {
@@ -81,14 +88,23 @@ type Builder struct {
 	leaderElection        bool
 	leaderElectionID      string
 
-	atlasDomain        string
-	predicates         []predicate.Predicate
-	apiSecret          client.ObjectKey
-	atlasProvider      atlas.Provider
-	featureFlags       *featureflags.FeatureFlags
-	deletionProtection bool
-	skipNameValidation bool
-	dryRun             bool
+	atlasDomain         string
+	predicates          []predicate.Predicate
+	apiSecret           client.ObjectKey
+	atlasProvider       atlas.Provider
+	featureFlags        *featureflags.FeatureFlags
+	deletionProtection  bool
+	skipNameValidation  bool
+	dryRun              bool
+	cassetteMode        httputil.CassetteMode
+	cassettePath        string
+	atlasCacheTTL       time.Duration
+	shard               *sharding.Shard
+	reconcilerConfig    *controller.ReconcilerConfig
+	conversionWebhook   bool
+	atlasTransport      http.RoundTripper
+	credentialProviders reconciler.CredentialProviders
+	allowedAtlasHosts   []string
 }
 
 func (b *Builder) WithConfig(config *rest.Config) *Builder {
@@ -131,6 +147,12 @@ func (b *Builder) WithAtlasDomain(domain string) *Builder {
 	return b
 }
 
+// WithAllowedAtlasHosts lets the Atlas domain of credentials point to the given hosts, on top of the Atlas ones
+func (b *Builder) WithAllowedAtlasHosts(hosts ...string) *Builder {
+	b.allowedAtlasHosts = hosts
+	return b
+}
+
 func (b *Builder) WithPredicates(predicates []predicate.Predicate) *Builder {
 	b.predicates = predicates
 	return b
@@ -175,6 +197,55 @@ func (b *Builder) WithDryRun(dryRun bool) *Builder {
 	return b
 }
 
+// WithAtlasCassetteMode records all Atlas API traffic to the cassette file,
+// or replays it from there instead of reaching Atlas, depending on mode.
+func (b *Builder) WithAtlasCassetteMode(mode httputil.CassetteMode) *Builder {
+	b.cassetteMode = mode
+	return b
+}
+
+// WithAtlasCassettePath sets the cassette file Atlas API traffic is recorded to or replayed from
+func (b *Builder) WithAtlasCassettePath(path string) *Builder {
+	b.cassettePath = path
+	return b
+}
+
+// WithAtlasCacheTTL caches Atlas GET responses for the given TTL, zero disables caching
+func (b *Builder) WithAtlasCacheTTL(ttl time.Duration) *Builder {
+	b.atlasCacheTTL = ttl
+	return b
+}
+
+// WithAtlasTransport sends all Atlas API requests through the given transport, for instance to use a proxy
+func (b *Builder) WithAtlasTransport(transport http.RoundTripper) *Builder {
+	b.atlasTransport = transport
+	return b
+}
+
+// WithCredentialProviders lets projects read their Atlas API credentials from the given providers instead of Secrets
+func (b *Builder) WithCredentialProviders(providers reconciler.CredentialProviders) *Builder {
+	b.credentialProviders = providers
+	return b
+}
+
+// WithShard restricts the operator to the objects of the given shard
+func (b *Builder) WithShard(shard *sharding.Shard) *Builder {
+	b.shard = shard
+	return b
+}
+
+// WithReconcilerConfig sets the concurrency and work queue settings of the controllers
+func (b *Builder) WithReconcilerConfig(cfg *controller.ReconcilerConfig) *Builder {
+	b.reconcilerConfig = cfg
+	return b
+}
+
+// WithConversionWebhook serves the conversion webhook converting custom resources between API versions
+func (b *Builder) WithConversionWebhook(enable bool) *Builder {
+	b.conversionWebhook = enable
+	return b
+}
+
 // Build builds the cluster object and configures operator controllers
 func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 	mergeDefaults(b)
@@ -188,11 +259,14 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 	}
 
 	if len(b.namespaces) == 0 {
+		// only the credentials and the exported Secrets are visible when watching all namespaces
+		secretTypes, err := labels.NewRequirement(connectionsecret.TypeLabelKey, selection.In, []string{connectionsecret.CredLabelVal, connectionsecret.ExportLabelVal})
+		if err != nil {
+			return nil, fmt.Errorf("failed to build the Secrets cache selector: %w", err)
+		}
 		cacheOpts.ByObject = map[client.Object]cache.ByObject{
 			&corev1.Secret{}: {
-				Label: labels.SelectorFromSet(labels.Set{
-					connectionsecret.TypeLabelKey: connectionsecret.CredLabelVal,
-				}),
+				Label: labels.NewSelector().Add(*secretTypes),
 			},
 		}
 	} else {
@@ -202,6 +276,15 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 		}
 	}
 
+	if b.shard != nil {
+		b.predicates = append(b.predicates, b.shard.Predicate())
+	}
+
+	providerOpts, err := b.atlasProviderOptions()
+	if err != nil {
+		return nil, err
+	}
+
 	controllerRegistry := controller.NewRegistry(
 		b.predicates,
 		b.deletionProtection,
@@ -209,6 +292,10 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 		b.independentSyncPeriod,
 		b.featureFlags,
 		b.apiSecret,
+		b.credentialProviders,
+		b.reconcilerConfig,
+		len(b.namespaces) == 0,
+		b.shard,
 	)
 
 	var akoCluster cluster.Cluster
@@ -230,7 +317,7 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 		}
 
 		if b.atlasProvider == nil {
-			b.atlasProvider = atlas.NewProductionProvider(b.atlasDomain, true, b.logger.Level() < 0)
+			b.atlasProvider = atlas.NewProductionProvider(b.atlasDomain, true, b.logger.Level() < 0, providerOpts...)
 		}
 
 		// We cannot use cluster.Cluster's event recorder. This event recorder has no guarantees about the delivery of events to API server.
@@ -284,8 +371,12 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 			return nil, err
 		}
 
+		if b.conversionWebhook {
+			mgr.GetWebhookServer().Register(ConversionWebhookPath, conversion.NewWebhookHandler(b.scheme))
+		}
+
 		if b.atlasProvider == nil {
-			b.atlasProvider = atlas.NewProductionProvider(b.atlasDomain, false, b.logger.Level() < 0)
+			b.atlasProvider = atlas.NewProductionProvider(b.atlasDomain, false, b.logger.Level() < 0, providerOpts...)
 		}
 
 		if err := controllerRegistry.RegisterWithManager(mgr, b.skipNameValidation, b.atlasProvider); err != nil {
@@ -301,6 +392,35 @@ func (b *Builder) Build(ctx context.Context) (cluster.Cluster, error) {
 	return akoCluster, nil
 }
 
+func (b *Builder) atlasProviderOptions() ([]atlas.ProductionProviderOption, error) {
+	opts := []atlas.ProductionProviderOption{atlas.WithResponseCache(b.atlasCacheTTL), atlas.WithAllowedAtlasHosts(b.allowedAtlasHosts...)}
+	if b.atlasTransport != nil {
+		opts = append(opts, atlas.WithHTTPTransport(b.atlasTransport))
+	}
+
+	switch b.cassetteMode {
+	case httputil.CassetteModeOff:
+		return opts, nil
+	case httputil.CassetteModeRecord, httputil.CassetteModeReplay:
+	default:
+		return nil, fmt.Errorf("unsupported Atlas cassette mode %q", b.cassetteMode)
+	}
+
+	if b.cassettePath == "" {
+		return nil, errors.New("an Atlas cassette path is required to record or replay Atlas API traffic")
+	}
+
+	if b.cassetteMode == httputil.CassetteModeRecord {
+		return append(opts, atlas.WithCassette(b.cassetteMode, httputil.NewCassette(b.cassettePath))), nil
+	}
+
+	cassette, err := httputil.LoadCassette(b.cassettePath)
+	if err != nil {
+		return nil, err
+	}
+	return append(opts, atlas.WithCassette(b.cassetteMode, cassette)), nil
+}
+
 // NewBuilder return a new Builder to construct operator controllers
 func NewBuilder(provider ManagerProvider, scheme *runtime.Scheme, minimumIndependentSyncPeriod time.Duration) *Builder {
 	return &Builder{
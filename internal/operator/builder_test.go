@@ -17,6 +17,9 @@ package operator
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -24,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
@@ -36,10 +40,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	apiv2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v2"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/featureflags"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/mocks/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/sharding"
 )
 
 type managerMock struct {
@@ -51,6 +59,8 @@ type managerMock struct {
 	scheme *runtime.Scheme
 
 	opts ctrl.Options
+
+	webhookServerRequested bool
 }
 
 func (m *managerMock) GetCache() cache.Cache {
@@ -81,6 +91,18 @@ func (m *managerMock) GetClient() client.Client {
 	return m.client
 }
 
+func (m *managerMock) GetAPIReader() client.Reader {
+	return m.client
+}
+
+func (m *managerMock) GetRESTMapper() meta.RESTMapper {
+	return m.client.RESTMapper()
+}
+
+func (m *managerMock) GetConfig() *rest.Config {
+	return &rest.Config{}
+}
+
 func (m *managerMock) GetFieldIndexer() client.FieldIndexer {
 	return &informertest.FakeInformers{}
 }
@@ -95,6 +117,11 @@ func (m *managerMock) New(_ *rest.Config, options manager.Options) (manager.Mana
 	return m, nil
 }
 
+func (m *managerMock) GetWebhookServer() webhook.Server {
+	m.webhookServerRequested = true
+	return m.opts.WebhookServer
+}
+
 func (m *managerMock) AddHealthzCheck(_ string, _ healthz.Checker) error {
 	return nil
 }
@@ -155,6 +182,35 @@ func TestBuildManager(t *testing.T) {
 			expectedNamespacedCache:  true,
 			expectedError:            errors.New("wrong value for independentSyncPeriod. Value should be greater or equal to 5"),
 		},
+		"should error when the Atlas cassette mode is unknown": {
+			configure: func(b *Builder) {
+				b.WithAtlasCassetteMode("rewind").WithAtlasCassettePath("cassette.json")
+			},
+			expectedError: errors.New(`unsupported Atlas cassette mode "rewind"`),
+		},
+		"should error when the Atlas cassette path is missing": {
+			configure: func(b *Builder) {
+				b.WithAtlasCassetteMode(httputil.CassetteModeRecord)
+			},
+			expectedError: errors.New("an Atlas cassette path is required to record or replay Atlas API traffic"),
+		},
+		"should build the manager serving a shard with an unfiltered cache": {
+			configure: func(b *Builder) {
+				b.WithNamespaces("ns1", "ns2", "ns3", "ns4").
+					WithShard(&sharding.Shard{Strategy: sharding.StrategyNamespace, Index: 1, Count: 2})
+			},
+			expectedSyncPeriod:       DefaultSyncPeriod,
+			expectedClusterWideCache: false,
+			expectedNamespacedCache:  true,
+		},
+		"should build the manager recording Atlas traffic": {
+			configure: func(b *Builder) {
+				b.WithAtlasCassetteMode(httputil.CassetteModeRecord).WithAtlasCassettePath(filepath.Join(t.TempDir(), "cassette.json"))
+			},
+			expectedSyncPeriod:       DefaultSyncPeriod,
+			expectedClusterWideCache: true,
+			expectedNamespacedCache:  false,
+		},
 	}
 
 	for name, tt := range tests {
@@ -178,3 +234,27 @@ func TestBuildManager(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildManagerWithConversionWebhook(t *testing.T) {
+	akoScheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(akoScheme))
+	require.NoError(t, apiv2.AddToScheme(akoScheme))
+
+	for name, enabled := range map[string]bool{"enabled": true, "disabled": false} {
+		t.Run(name, func(t *testing.T) {
+			mgrMock := &managerMock{}
+			_, err := NewBuilder(mgrMock, akoScheme, 5*time.Minute).
+				WithConversionWebhook(enabled).
+				WithSkipNameValidation(true).
+				Build(context.Background())
+			require.NoError(t, err)
+
+			assert.Equal(t, enabled, mgrMock.webhookServerRequested)
+			if enabled {
+				server := mgrMock.opts.WebhookServer.(*webhook.DefaultServer)
+				_, pattern := server.WebhookMux().Handler(httptest.NewRequest(http.MethodPost, ConversionWebhookPath, nil))
+				assert.Equal(t, ConversionWebhookPath, pattern)
+			}
+		})
+	}
+}
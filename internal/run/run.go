@@ -20,7 +20,12 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"maps"
+	"net/http"
+	"net/url"
 	"os"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -28,20 +33,31 @@ import (
 	"github.com/go-logr/zapr"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	apiv2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v2"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/collection"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/reconciler"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/credentials"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/featureflags"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/httputil"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/kube"
 	akov2next "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/v1"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/operator"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/sharding"
 	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/version"
 )
 
@@ -61,6 +77,7 @@ func Run(ctx context.Context, fs *flag.FlagSet, args []string) error {
 	akoScheme := runtime.NewScheme()
 	utilruntime.Must(scheme.AddToScheme(akoScheme))
 	utilruntime.Must(akov2.AddToScheme(akoScheme))
+	utilruntime.Must(apiv2.AddToScheme(akoScheme))
 
 	config, err := parseConfiguration(fs, args)
 	if err != nil {
@@ -82,18 +99,49 @@ func Run(ctx context.Context, fs *flag.FlagSet, args []string) error {
 	}
 	setupLog.Info("starting with configuration", zap.Any("config", config), zap.Any("version", version.Version))
 
+	restConfig := ctrl.GetConfigOrDie()
+	var shard *sharding.Shard
+	if config.ShardingStrategy != sharding.StrategyNone {
+		shard, ctx, err = acquireShard(ctx, restConfig, config, setupLog)
+		if err != nil {
+			setupLog.Error(err, "unable to acquire a shard")
+			return fmt.Errorf("unable to acquire a shard: %w", err)
+		}
+	}
+
+	atlasTransport, err := newAtlasTransport(ctx, restConfig, config, setupLog)
+	if err != nil {
+		setupLog.Error(err, "unable to configure the Atlas API transport")
+		return fmt.Errorf("unable to configure the Atlas API transport: %w", err)
+	}
+
+	credentialProviders, err := newCredentialProviders(config)
+	if err != nil {
+		setupLog.Error(err, "unable to configure the credential providers")
+		return fmt.Errorf("unable to configure the credential providers: %w", err)
+	}
+
 	runnable, err := operator.NewBuilder(operator.ManagerProviderFunc(ctrl.NewManager), akoScheme, time.Duration(minimumIndependentSyncPeriod)*time.Minute).
-		WithConfig(ctrl.GetConfigOrDie()).
+		WithConfig(restConfig).
 		WithNamespaces(collection.Keys(config.WatchedNamespaces)...).
 		WithLogger(logger).
 		WithMetricAddress(config.MetricsAddr).
 		WithProbeAddress(config.ProbeAddr).
 		WithLeaderElection(config.EnableLeaderElection).
 		WithAtlasDomain(config.AtlasDomain).
+		WithAllowedAtlasHosts(config.AllowedAtlasHosts...).
 		WithAPISecret(config.GlobalAPISecret).
 		WithDeletionProtection(config.ObjectDeletionProtection).
 		WithIndependentSyncPeriod(time.Duration(config.IndependentSyncPeriod) * time.Minute).
 		WithDryRun(config.DryRun).
+		WithAtlasCassetteMode(httputil.CassetteMode(config.AtlasCassetteMode)).
+		WithAtlasCassettePath(config.AtlasCassettePath).
+		WithAtlasCacheTTL(config.AtlasCacheTTL).
+		WithAtlasTransport(atlasTransport).
+		WithCredentialProviders(credentialProviders).
+		WithShard(shard).
+		WithReconcilerConfig(config.ReconcilerConfig).
+		WithConversionWebhook(config.ConversionWebhook).
 		Build(ctx)
 	if err != nil {
 		setupLog.Error(err, "unable to start operator")
@@ -111,6 +159,7 @@ func Run(ctx context.Context, fs *flag.FlagSet, args []string) error {
 
 type Config struct {
 	AtlasDomain                 string
+	AllowedAtlasHosts           []string
 	EnableLeaderElection        bool
 	MetricsAddr                 string
 	WatchedNamespaces           map[string]bool
@@ -123,13 +172,42 @@ type Config struct {
 	IndependentSyncPeriod       int
 	FeatureFlags                *featureflags.FeatureFlags
 	DryRun                      bool
+	AtlasCassetteMode           string
+	AtlasCassettePath           string
+	AtlasCacheTTL               time.Duration
+	ShardingStrategy            sharding.Strategy
+	ShardCount                  int
+	ReconcilerConfig            *controller.ReconcilerConfig
+	ConversionWebhook           bool
+	AtlasProxyURL               string
+	AtlasProxySecret            string
+	AtlasNoProxy                string
+	AtlasCABundleConfigMap      string
+	CredentialsDir              string
+	VaultAddress                string
+	VaultAuthMount              string
+	VaultRole                   string
+	VaultCAFile                 string
+	VaultRefreshInterval        time.Duration
+	VaultPathTemplate           string
 }
 
 // ParseConfiguration fills the 'OperatorConfig' from the flags passed to the program
 func parseConfiguration(fs *flag.FlagSet, args []string) (Config, error) {
-	var globalAPISecretName string
+	var globalAPISecretName, controllerConfigPath string
+	controllerOptions := &controller.ReconcilerConfig{}
 	config := Config{}
 	fs.StringVar(&config.AtlasDomain, "atlas-domain", operator.DefaultAtlasDomain, "the Atlas URL domain name (with slash in the end).")
+	fs.Func("allowed-atlas-hosts", "Comma separated hosts, with their port if any, the atlasDomain of credentials may point to "+
+		"on top of cloud.mongodb.com, the mongodbgov.com ones and the --atlas-domain one.",
+		func(value string) error {
+			for _, host := range strings.Split(value, ",") {
+				if host = strings.TrimSpace(host); host != "" {
+					config.AllowedAtlasHosts = append(config.AllowedAtlasHosts, host)
+				}
+			}
+			return nil
+		})
 	fs.StringVar(&config.MetricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	fs.StringVar(&config.ProbeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	fs.StringVar(&globalAPISecretName, "global-api-secret-name", "", "The name of the Secret that contains Atlas API keys. "+
@@ -151,6 +229,66 @@ func parseConfiguration(fs *flag.FlagSet, args []string) (Config, error) {
 	)
 	fs.BoolVar(&config.DryRun, "dry-run", false, "If set, the operator will not perform any changes to the Atlas resources, run all reconcilers only Once and emit events for all planned changes")
 
+	fs.StringVar(&config.AtlasCassetteMode, "atlas-cassette-mode", "", "If set, Atlas API traffic is either recorded to or replayed from the file set in --atlas-cassette-path. Available values: record | replay")
+	fs.StringVar(&config.AtlasCassettePath, "atlas-cassette-path", "", "The cassette file used to record or replay Atlas API traffic.")
+
+	fs.DurationVar(&config.AtlasCacheTTL, "atlas-cache-ttl", 0, "If set, successful Atlas API GET responses are cached for this long, per set of credentials, and invalidated on writes to the same resource. Disabled by default.")
+
+	fs.Func("sharding-strategy", "If set, the custom resources are split among the replicas holding one of --shard-count shard Leases, "+
+		"either by a hash of their namespace or by the value of the "+sharding.ShardLabel+" label. Incompatible with --leader-elect. Available values: namespace | label",
+		func(value string) error {
+			switch strategy := sharding.Strategy(value); strategy {
+			case sharding.StrategyNamespace, sharding.StrategyLabel:
+				config.ShardingStrategy = strategy
+				return nil
+			default:
+				return fmt.Errorf("unsupported sharding strategy %q", value)
+			}
+		})
+	fs.IntVar(&config.ShardCount, "shard-count", 1, "The number of shards to split the custom resources into when --sharding-strategy is set.")
+
+	fs.StringVar(&controllerConfigPath, "controller-config", "", "A YAML file setting the maxConcurrentReconciles, baseDelay, maxDelay, qps and burst "+
+		"of all controllers under 'default' and of the controller of a given Kind under 'kinds'.")
+	fs.Var(controllerOptions, "controller-options", "Controller settings of the form Kind:key=value[,key=value], "+
+		"using '"+controller.DefaultReconcilerKind+"' as Kind for all controllers. Takes precedence over --controller-config and can be repeated. "+
+		"Available keys: maxConcurrentReconciles | baseDelay | maxDelay | qps | burst")
+
+	fs.BoolVar(&config.ConversionWebhook, "conversion-webhook", false, "If set, the operator serves the webhook converting AtlasProject and AtlasDeployment "+
+		"between the v1 and v2 API versions on port 9443. It requires the webhook serving certificates in /tmp/k8s-webhook-server/serving-certs.")
+
+	fs.Func("atlas-proxy-url", "If set, Atlas API requests go through this HTTP proxy instead of the one set by the HTTPS_PROXY environment variable.",
+		func(value string) error {
+			proxyURL, err := url.Parse(value)
+			if err != nil {
+				return err
+			}
+			if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" || proxyURL.Host == "" {
+				return fmt.Errorf("%q is not an http or https URL", value)
+			}
+			if proxyURL.User != nil {
+				return errors.New("proxy credentials must be set with --atlas-proxy-secret")
+			}
+			config.AtlasProxyURL = value
+			return nil
+		})
+	fs.StringVar(&config.AtlasProxySecret, "atlas-proxy-secret", "", "The name of a Secret of the operator namespace holding the 'username' and 'password' "+
+		"to authenticate against the --atlas-proxy-url proxy.")
+	fs.StringVar(&config.AtlasNoProxy, "atlas-no-proxy", "", "Comma separated hosts, domains and CIDRs reached without the --atlas-proxy-url proxy, in the NO_PROXY format.")
+	fs.StringVar(&config.AtlasCABundleConfigMap, "atlas-ca-bundle-configmap", "", "The name of a ConfigMap of the operator namespace holding PEM encoded certificates "+
+		"trusted for Atlas API requests, on top of the system ones. All the ConfigMap keys are read.")
+
+	fs.StringVar(&config.CredentialsDir, "credentials-dir", "", "If set, projects with a file credentialsRef read their Atlas API credentials "+
+		"from the files of a directory named after it under this one, such as a projected volume. "+
+		"A {namespace} placeholder is replaced by the namespace of the project, so that projects only read the credentials of their namespace.")
+	fs.StringVar(&config.VaultAddress, "vault-address", "", "If set, projects with a vault credentialsRef read their Atlas API credentials from this HashiCorp Vault server.")
+	fs.StringVar(&config.VaultAuthMount, "vault-auth-mount", credentials.DefaultVaultAuthMount, "The path of the Vault Kubernetes auth method the operator logs in with.")
+	fs.StringVar(&config.VaultRole, "vault-role", "", "The Vault role the operator logs in as.")
+	fs.StringVar(&config.VaultCAFile, "vault-ca-file", "", "A PEM encoded CA bundle trusted for Vault requests, on top of the system certificates.")
+	fs.DurationVar(&config.VaultRefreshInterval, "vault-refresh-interval", credentials.DefaultVaultRefreshInterval, "How long the credentials read from Vault are cached "+
+		"before they are read again to pick up rotated keys.")
+	fs.StringVar(&config.VaultPathTemplate, "vault-path-template", "", "If set, projects only read the Vault secrets under this mount and path, "+
+		"in which a {namespace} placeholder is replaced by the namespace of the project, such as secret/atlas/{namespace}.")
+
 	appVersion := fs.Bool("v", false, "prints application version")
 	if err := fs.Parse(args); err != nil {
 		return Config{}, fmt.Errorf("failed to parse arguments: %w", err)
@@ -174,12 +312,181 @@ func parseConfiguration(fs *flag.FlagSet, args []string) (Config, error) {
 		}
 	}
 
+	if config.ShardingStrategy != sharding.StrategyNone && config.EnableLeaderElection {
+		return Config{}, errors.New("--sharding-strategy and --leader-elect cannot be used together")
+	}
+
+	if config.AtlasProxySecret != "" && config.AtlasProxyURL == "" {
+		return Config{}, errors.New("--atlas-proxy-secret requires --atlas-proxy-url")
+	}
+
+	if config.VaultAddress != "" && config.VaultRole == "" {
+		return Config{}, errors.New("--vault-address requires --vault-role")
+	}
+
+	if config.VaultPathTemplate != "" && !strings.Contains(config.VaultPathTemplate, credentials.NamespacePlaceholder) {
+		return Config{}, fmt.Errorf("--vault-path-template must contain the %s placeholder", credentials.NamespacePlaceholder)
+	}
+
+	if controllerConfigPath != "" {
+		fileConfig, err := controller.LoadReconcilerConfig(controllerConfigPath)
+		if err != nil {
+			return Config{}, err
+		}
+		config.ReconcilerConfig = fileConfig.Merge(controllerOptions)
+	} else if controllerOptions.String() != "" {
+		config.ReconcilerConfig = controllerOptions
+	}
+	if err := config.ReconcilerConfig.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid controller settings: %w", err)
+	}
+
 	configureDeletionProtection(fs, &config)
 
 	config.FeatureFlags = featureflags.NewFeatureFlags(os.Environ)
 	return config, nil
 }
 
+func acquireShard(ctx context.Context, restConfig *rest.Config, config Config, log *zap.SugaredLogger) (*sharding.Shard, context.Context, error) {
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create Lease client: %w", err)
+	}
+	log.Infof("waiting for one of %d shards", config.ShardCount)
+	return sharding.Acquire(ctx, clientset.CoordinationV1(), sharding.Config{
+		Strategy:       config.ShardingStrategy,
+		Count:          config.ShardCount,
+		LeaseNamespace: os.Getenv("OPERATOR_NAMESPACE"),
+		Identity:       os.Getenv("OPERATOR_POD_NAME"),
+	}, log.Named("sharding"))
+}
+
+// newCredentialProviders builds the providers projects may read their Atlas API credentials from instead of Secrets
+func newCredentialProviders(config Config) (reconciler.CredentialProviders, error) {
+	providers := reconciler.CredentialProviders{}
+	if config.CredentialsDir != "" {
+		providers.File = credentials.NewFileProvider(config.CredentialsDir)
+	}
+	if config.VaultAddress != "" {
+		vaultConfig := credentials.VaultConfig{
+			Address:         config.VaultAddress,
+			AuthMount:       config.VaultAuthMount,
+			Role:            config.VaultRole,
+			RefreshInterval: config.VaultRefreshInterval,
+			PathTemplate:    config.VaultPathTemplate,
+		}
+		if config.VaultCAFile != "" {
+			caBundle, err := os.ReadFile(config.VaultCAFile)
+			if err != nil {
+				return providers, fmt.Errorf("failed to read the Vault CA bundle: %w", err)
+			}
+			transport, err := httputil.NewTransport(httputil.TransportConfig{CABundle: caBundle})
+			if err != nil {
+				return providers, err
+			}
+			vaultConfig.HTTPClient = &http.Client{Transport: transport, Timeout: 30 * time.Second}
+		}
+		providers.Vault = credentials.NewVaultProvider(vaultConfig)
+	}
+	return providers, nil
+}
+
+// atlasTransportReloadInterval is how often the proxy credentials and CA bundle are read again
+const atlasTransportReloadInterval = time.Minute
+
+// newAtlasTransport builds the transport of Atlas API requests from the proxy and CA bundle settings,
+// or returns nil when none is set. The transport is rebuilt whenever the proxy credentials Secret or
+// the CA bundle ConfigMap change, until the context is done.
+func newAtlasTransport(ctx context.Context, restConfig *rest.Config, config Config, log *zap.SugaredLogger) (http.RoundTripper, error) {
+	if config.AtlasProxyURL == "" && config.AtlasCABundleConfigMap == "" {
+		return nil, nil
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+	namespace := os.Getenv("OPERATOR_NAMESPACE")
+	transportConfig, err := atlasTransportConfig(ctx, clientset.CoreV1(), namespace, config)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := httputil.NewTransport(transportConfig)
+	if err != nil {
+		return nil, err
+	}
+	reloadable := httputil.NewReloadableTransport(transport)
+	go reloadAtlasTransport(ctx, clientset.CoreV1(), namespace, config, transportConfig, reloadable, atlasTransportReloadInterval, log)
+	return reloadable, nil
+}
+
+// reloadAtlasTransport reads the proxy credentials and CA bundle every interval and replaces the
+// transport when they changed, so that rotating them does not require restarting the operator.
+// The current transport is kept when they cannot be read or are invalid.
+func reloadAtlasTransport(ctx context.Context, client corev1client.CoreV1Interface, namespace string, config Config,
+	current httputil.TransportConfig, transport *httputil.ReloadableTransport, interval time.Duration, log *zap.SugaredLogger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		desired, err := atlasTransportConfig(ctx, client, namespace, config)
+		if err != nil {
+			log.Warnf("failed to reload the Atlas API transport, keeping the current one: %v", err)
+			continue
+		}
+		if reflect.DeepEqual(desired, current) {
+			continue
+		}
+		next, err := httputil.NewTransport(desired)
+		if err != nil {
+			log.Warnf("failed to reload the Atlas API transport, keeping the current one: %v", err)
+			continue
+		}
+		transport.Store(next)
+		current = desired
+		log.Info("reloaded the Atlas API transport after its proxy credentials or CA bundle changed")
+	}
+}
+
+func atlasTransportConfig(ctx context.Context, client corev1client.CoreV1Interface, namespace string, config Config) (httputil.TransportConfig, error) {
+	transportConfig := httputil.TransportConfig{NoProxy: config.AtlasNoProxy}
+
+	if config.AtlasProxyURL != "" {
+		proxyURL, err := url.Parse(config.AtlasProxyURL)
+		if err != nil {
+			return transportConfig, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if config.AtlasProxySecret != "" {
+			secret, err := client.Secrets(namespace).Get(ctx, config.AtlasProxySecret, metav1.GetOptions{})
+			if err != nil {
+				return transportConfig, fmt.Errorf("failed to read proxy credentials: %w", err)
+			}
+			username, password := secret.Data[corev1.BasicAuthUsernameKey], secret.Data[corev1.BasicAuthPasswordKey]
+			if len(username) == 0 {
+				return transportConfig, fmt.Errorf("proxy credentials Secret %s/%s has no %q", namespace, config.AtlasProxySecret, corev1.BasicAuthUsernameKey)
+			}
+			proxyURL.User = url.UserPassword(string(username), string(password))
+		}
+		transportConfig.ProxyURL = proxyURL
+	}
+
+	if config.AtlasCABundleConfigMap != "" {
+		configMap, err := client.ConfigMaps(namespace).Get(ctx, config.AtlasCABundleConfigMap, metav1.GetOptions{})
+		if err != nil {
+			return transportConfig, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		for _, key := range slices.Sorted(maps.Keys(configMap.Data)) {
+			transportConfig.CABundle = append(transportConfig.CABundle, configMap.Data[key]...)
+			transportConfig.CABundle = append(transportConfig.CABundle, '\n')
+		}
+	}
+
+	return transportConfig, nil
+}
+
 func operatorGlobalKeySecretOrDefault(secretNameOverride string) client.ObjectKey {
 	secretName := secretNameOverride
 	if secretName == "" {
@@ -0,0 +1,159 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	DefaultLeaseName     = "mongodb-atlas-operator-shard"
+	DefaultLeaseDuration = 15 * time.Second
+	DefaultRenewDeadline = 10 * time.Second
+	DefaultRetryPeriod   = 2 * time.Second
+)
+
+// Config sets how the shards are split and coordinated
+type Config struct {
+	Strategy Strategy
+	Count    int
+
+	// LeaseNamespace and LeaseName locate the Leases, one per shard, named <LeaseName>-<index>
+	LeaseNamespace string
+	LeaseName      string
+	// Identity is the holder identity of this replica, usually its Pod name
+	Identity string
+
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+}
+
+func (c *Config) validate() error {
+	switch c.Strategy {
+	case StrategyNamespace, StrategyLabel:
+	default:
+		return fmt.Errorf("unsupported sharding strategy %q", c.Strategy)
+	}
+	if c.Count < 1 {
+		return fmt.Errorf("the shard count must be positive, got %d", c.Count)
+	}
+	if c.LeaseNamespace == "" || c.Identity == "" {
+		return errors.New("a Lease namespace and a replica identity are required for sharding")
+	}
+	return nil
+}
+
+func (c *Config) mergeDefaults() {
+	if c.LeaseName == "" {
+		c.LeaseName = DefaultLeaseName
+	}
+	if c.LeaseDuration == 0 {
+		c.LeaseDuration = DefaultLeaseDuration
+	}
+	if c.RenewDeadline == 0 {
+		c.RenewDeadline = DefaultRenewDeadline
+	}
+	if c.RetryPeriod == 0 {
+		c.RetryPeriod = DefaultRetryPeriod
+	}
+}
+
+// Acquire blocks until this replica holds the Lease of one of the shards and returns that shard.
+// The returned context is cancelled as soon as the Lease is lost, so it should be used to run the
+// operator: a replica losing its shard must stop before another one takes over.
+func Acquire(ctx context.Context, leases coordinationv1client.LeasesGetter, cfg Config, log *zap.SugaredLogger) (*Shard, context.Context, error) {
+	cfg.mergeDefaults()
+	if err := cfg.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	acquired := make(chan int, cfg.Count)
+	stopped := make(chan int, cfg.Count)
+	cancels := make([]context.CancelFunc, cfg.Count)
+	for i := range cfg.Count {
+		elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+			Lock: &resourcelock.LeaseLock{
+				LeaseMeta: metav1.ObjectMeta{
+					Namespace: cfg.LeaseNamespace,
+					Name:      fmt.Sprintf("%s-%d", cfg.LeaseName, i),
+				},
+				Client:     leases,
+				LockConfig: resourcelock.ResourceLockConfig{Identity: cfg.Identity},
+			},
+			LeaseDuration:   cfg.LeaseDuration,
+			RenewDeadline:   cfg.RenewDeadline,
+			RetryPeriod:     cfg.RetryPeriod,
+			ReleaseOnCancel: true,
+			Name:            fmt.Sprintf("%s-%d", cfg.LeaseName, i),
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(context.Context) { acquired <- i },
+				// called whenever the election loop ends, whether the Lease was held or not
+				OnStoppedLeading: func() { stopped <- i },
+			},
+		})
+		if err != nil {
+			for _, cancel := range cancels[:i] {
+				cancel()
+			}
+			return nil, nil, fmt.Errorf("failed to configure the Lease of shard %d: %w", i, err)
+		}
+		var electionCtx context.Context
+		electionCtx, cancels[i] = context.WithCancel(ctx)
+		go elector.Run(electionCtx)
+	}
+
+	var index int
+	select {
+	case <-ctx.Done():
+		for _, cancel := range cancels {
+			cancel()
+		}
+		return nil, nil, ctx.Err()
+	case index = <-acquired:
+	}
+
+	// keep only the first shard acquired, releasing any other one
+	for i, cancel := range cancels {
+		if i != index {
+			cancel()
+		}
+	}
+
+	shardCtx, cancelShard := context.WithCancel(ctx)
+	go func() {
+		defer cancels[index]()
+		for i := range stopped {
+			if i == index {
+				log.Warnf("lost the Lease of shard %d, stopping", index)
+				cancelShard()
+				return
+			}
+		}
+	}()
+
+	shard := &Shard{Strategy: cfg.Strategy, Index: index, Count: cfg.Count}
+	log.Infof("serving shard %s", shard)
+	return shard, shardCtx, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testConfig(identity string) Config {
+	return Config{
+		Strategy:       StrategyNamespace,
+		Count:          2,
+		LeaseNamespace: "atlas-operator",
+		Identity:       identity,
+		LeaseDuration:  time.Second,
+		RenewDeadline:  500 * time.Millisecond,
+		RetryPeriod:    100 * time.Millisecond,
+	}
+}
+
+func TestAcquire(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	leases := fake.NewClientset().CoordinationV1()
+	log := zaptest.NewLogger(t).Sugar()
+
+	replica0Ctx, stopReplica0 := context.WithCancel(ctx)
+	shard0, shard0Ctx, err := Acquire(replica0Ctx, leases, testConfig("replica-0"), log)
+	require.NoError(t, err)
+	shard1, _, err := Acquire(ctx, leases, testConfig("replica-1"), log)
+	require.NoError(t, err)
+	assert.NotEqual(t, shard0.Index, shard1.Index, "replicas must serve different shards")
+
+	standby := make(chan *Shard)
+	go func() {
+		shard, _, err := Acquire(ctx, leases, testConfig("replica-2"), log)
+		if err == nil {
+			standby <- shard
+		}
+	}()
+
+	select {
+	case <-standby:
+		t.Fatal("a third replica must wait while all shards are taken")
+	case <-time.After(2 * time.Second):
+	}
+
+	stopReplica0()
+	select {
+	case <-shard0Ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("the shard context must be cancelled along with its parent")
+	}
+
+	select {
+	case shard := <-standby:
+		assert.Equal(t, shard0.Index, shard.Index, "the standby replica must take over the released shard")
+	case <-time.After(10 * time.Second):
+		t.Fatal("the standby replica did not take over the released shard")
+	}
+}
+
+func TestAcquireInvalidConfig(t *testing.T) {
+	leases := fake.NewClientset().CoordinationV1()
+	log := zaptest.NewLogger(t).Sugar()
+
+	cfg := testConfig("replica-0")
+	cfg.Count = 0
+	_, _, err := Acquire(context.Background(), leases, cfg, log)
+	assert.ErrorContains(t, err, "the shard count must be positive")
+
+	cfg = testConfig("")
+	_, _, err = Acquire(context.Background(), leases, cfg, log)
+	assert.ErrorContains(t, err, "a Lease namespace and a replica identity are required")
+}
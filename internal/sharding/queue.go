@@ -0,0 +1,85 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewQueue returns a controller work queue dropping the requests of the objects
+// owned by other shards, so that they never reach the reconciler. The cache itself
+// is left unfiltered, references to objects of other shards are still resolved.
+func NewQueue(shard *Shard, reader client.Reader, obj client.Object) func(string, workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	return func(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		return &shardedQueue{
+			TypedRateLimitingInterface: workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
+				Name: controllerName,
+			}),
+			shard:  shard,
+			reader: reader,
+			obj:    obj,
+		}
+	}
+}
+
+type shardedQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	shard  *Shard
+	reader client.Reader
+	obj    client.Object
+}
+
+func (q *shardedQueue) Add(req reconcile.Request) {
+	if q.owns(req) {
+		q.TypedRateLimitingInterface.Add(req)
+	}
+}
+
+func (q *shardedQueue) AddAfter(req reconcile.Request, duration time.Duration) {
+	if q.owns(req) {
+		q.TypedRateLimitingInterface.AddAfter(req, duration)
+	}
+}
+
+func (q *shardedQueue) AddRateLimited(req reconcile.Request) {
+	if q.owns(req) {
+		q.TypedRateLimitingInterface.AddRateLimited(req)
+	}
+}
+
+// owns tells whether the reconciled object of the request belongs to this shard.
+// Objects that cannot be read, such as deleted ones, are let through for the
+// reconciler to handle.
+func (q *shardedQueue) owns(req reconcile.Request) bool {
+	if !IsSharded(q.obj) {
+		return true
+	}
+	if q.shard.Strategy == StrategyNamespace {
+		return q.shard.OwnsNamespace(req.Namespace)
+	}
+	obj, ok := q.obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return true
+	}
+	if err := q.reader.Get(context.Background(), req.NamespacedName, obj); err != nil {
+		return true
+	}
+	return q.shard.Owns(obj)
+}
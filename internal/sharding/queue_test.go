@@ -0,0 +1,86 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, akov2.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	return scheme
+}
+
+func request(obj client.Object) reconcile.Request {
+	return reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+}
+
+func TestShardedQueue(t *testing.T) {
+	owned := &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: "owned", Namespace: "ns", Labels: map[string]string{ShardLabel: "1"}}}
+	other := &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns"}}
+	k8sClient := fake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(owned, other).Build()
+	rateLimiter := workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]()
+
+	t.Run("label shards only queue their objects", func(t *testing.T) {
+		shard := &Shard{Strategy: StrategyLabel, Index: 1, Count: 2}
+		queue := NewQueue(shard, k8sClient, &akov2.AtlasProject{})("test", rateLimiter)
+		defer queue.ShutDown()
+
+		queue.Add(request(other))
+		queue.AddRateLimited(request(other))
+		queue.Add(request(owned))
+		queue.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: "deleted", Namespace: "ns"}})
+		assert.Equal(t, 2, queue.Len())
+	})
+
+	t.Run("namespace shards only queue the objects of their namespaces", func(t *testing.T) {
+		shard := &Shard{Strategy: StrategyNamespace, Index: 0, Count: 2}
+		queue := NewQueue(shard, k8sClient, &akov2.AtlasProject{})("test", rateLimiter)
+		defer queue.ShutDown()
+
+		owned := 0
+		for _, namespace := range []string{"a", "b", "c", "d", "e", "f"} {
+			queue.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: "p", Namespace: namespace}})
+			if shard.OwnsNamespace(namespace) {
+				owned++
+			}
+		}
+		assert.Equal(t, owned, queue.Len())
+	})
+
+	t.Run("objects that are not sharded are always queued", func(t *testing.T) {
+		shard := &Shard{Strategy: StrategyLabel, Index: 1, Count: 2}
+		queue := NewQueue(shard, k8sClient, &corev1.Secret{})("test", rateLimiter)
+		defer queue.ShutDown()
+
+		queue.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: "secret", Namespace: "ns"}})
+		assert.Equal(t, 1, queue.Len())
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sharding splits the Atlas custom resources among several operator replicas.
+//
+// The keyspace is divided in a fixed number of shards. Each replica serves a single
+// shard for as long as it holds the Lease of that shard, replicas not holding any
+// Lease stay on standby. Objects are assigned to shards either by a hash of their
+// namespace or explicitly with the ShardLabel label.
+package sharding
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	akov2next "github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/v1"
+)
+
+// Strategy is the way objects are assigned to shards
+type Strategy string
+
+const (
+	// StrategyNone disables sharding
+	StrategyNone Strategy = ""
+	// StrategyNamespace assigns objects by a hash of their namespace
+	StrategyNamespace Strategy = "namespace"
+	// StrategyLabel assigns objects by the shard index set in ShardLabel,
+	// objects without the label belong to the first shard
+	StrategyLabel Strategy = "label"
+
+	// ShardLabel holds the shard index of an object when using StrategyLabel
+	ShardLabel = "mongodb.com/atlas-operator-shard"
+)
+
+// shardedGroups are the API groups whose objects are split among shards,
+// any other object, such as Secrets, is visible to all shards
+var shardedGroups = []string{
+	akov2.GroupVersion.Group,
+	akov2next.GroupVersion.Group,
+}
+
+var shardedScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(akov2.AddToScheme(shardedScheme))
+	utilruntime.Must(akov2next.AddToScheme(shardedScheme))
+}
+
+// Shard is the part of the keyspace served by this replica
+type Shard struct {
+	Strategy Strategy
+	Index    int
+	Count    int
+}
+
+func (s *Shard) String() string {
+	return fmt.Sprintf("%d/%d (by %s)", s.Index, s.Count, s.Strategy)
+}
+
+// Owns tells whether the given object belongs to this shard
+func (s *Shard) Owns(obj client.Object) bool {
+	if s.Strategy == StrategyLabel {
+		return s.labelIndex(obj.GetLabels()) == s.Index
+	}
+	return s.OwnsNamespace(obj.GetNamespace())
+}
+
+// OwnsNamespace tells whether the objects of a namespace belong to this shard
+// when sharding by namespace
+func (s *Shard) OwnsNamespace(namespace string) bool {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace))
+	return int(h.Sum32()%uint32(s.Count)) == s.Index
+}
+
+func (s *Shard) labelIndex(objLabels map[string]string) int {
+	value, ok := objLabels[ShardLabel]
+	if !ok {
+		return 0
+	}
+	index, err := strconv.Atoi(value)
+	if err != nil || index < 0 || index >= s.Count {
+		return 0
+	}
+	return index
+}
+
+// Predicate filters out events of the sharded objects not owned by this shard
+func (s *Shard) Predicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return !IsSharded(obj) || s.Owns(obj)
+	})
+}
+
+// IsSharded tells whether the object is split among shards
+func IsSharded(obj runtime.Object) bool {
+	gvk, err := apiutil.GVKForObject(obj, shardedScheme)
+	if err != nil {
+		return false
+	}
+	return IsShardedGroup(gvk.Group)
+}
+
+// IsShardedGroup tells whether the objects of the given API group are split among shards
+func IsShardedGroup(group string) bool {
+	return slices.Contains(shardedGroups, group)
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+func TestOwnsByNamespace(t *testing.T) {
+	const count = 3
+	owners := map[int]int{}
+	for n := range 30 {
+		project := &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: fmt.Sprintf("ns-%d", n)}}
+		owned := 0
+		for i := range count {
+			if (&Shard{Strategy: StrategyNamespace, Index: i, Count: count}).Owns(project) {
+				owned++
+				owners[i]++
+			}
+		}
+		assert.Equal(t, 1, owned, "namespace %s must belong to a single shard", project.Namespace)
+	}
+	assert.Len(t, owners, count, "all shards should get some namespaces")
+}
+
+func TestOwnsByLabel(t *testing.T) {
+	for _, tc := range []struct {
+		title  string
+		labels map[string]string
+		want   int
+	}{
+		{title: "unlabeled objects belong to the first shard", want: 0},
+		{title: "labeled objects belong to their shard", labels: map[string]string{ShardLabel: "2"}, want: 2},
+		{title: "out of range shards fall back to the first one", labels: map[string]string{ShardLabel: "7"}, want: 0},
+		{title: "invalid shards fall back to the first one", labels: map[string]string{ShardLabel: "two"}, want: 0},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			user := &akov2.AtlasDatabaseUser{ObjectMeta: metav1.ObjectMeta{Name: "u", Namespace: "ns", Labels: tc.labels}}
+			for i := range 3 {
+				shard := &Shard{Strategy: StrategyLabel, Index: i, Count: 3}
+				assert.Equal(t, i == tc.want, shard.Owns(user))
+			}
+		})
+	}
+}
+
+func TestPredicate(t *testing.T) {
+	shard := &Shard{Strategy: StrategyLabel, Index: 1, Count: 2}
+	p := shard.Predicate()
+
+	assert.True(t, p.Create(event.CreateEvent{Object: &akov2.AtlasProject{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{ShardLabel: "1"}}}}))
+	assert.False(t, p.Create(event.CreateEvent{Object: &akov2.AtlasProject{}}))
+	assert.True(t, p.Create(event.CreateEvent{Object: &corev1.Secret{}}), "non sharded objects are never filtered")
+}
@@ -0,0 +1,324 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package teammembers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+const (
+	SourceUsernames = "Usernames"
+	SourceConfigMap = "ConfigMap"
+	SourceHTTP      = "HTTP"
+
+	DefaultConfigMapKey    = "usernames"
+	DefaultRefreshInterval = time.Hour
+
+	bearerTokenKey = "token"
+	scimPageSize   = 100
+	maxResponse    = 10 << 20
+)
+
+// Members are the usernames an AtlasTeam should have
+type Members struct {
+	Usernames []string
+	Source    string
+	// FetchedAt is when the usernames were read from an HTTP source
+	FetchedAt time.Time
+	// RefreshAfter is how long until the usernames should be fetched again, zero when they are not fetched
+	RefreshAfter time.Duration
+}
+
+type cachedUsernames struct {
+	// source identifies the endpoint the usernames were fetched from, a change of the
+	// members source of the team invalidates them
+	source    string
+	usernames []string
+	fetchedAt time.Time
+	expiresAt time.Time
+}
+
+// Resolver reads the members of AtlasTeams, caching the usernames fetched from HTTP
+// sources for their refresh interval. Entries are keyed by the UID of the team and
+// dropped once the team is deleted or they expired.
+type Resolver struct {
+	kubeClient client.Reader
+	httpClient *http.Client
+	now        func() time.Time
+
+	lock  sync.Mutex
+	cache map[types.UID]cachedUsernames
+}
+
+func NewResolver(kubeClient client.Reader, httpClient *http.Client) *Resolver {
+	return &Resolver{
+		kubeClient: kubeClient,
+		httpClient: httpClient,
+		now:        time.Now,
+		cache:      map[types.UID]cachedUsernames{},
+	}
+}
+
+// Forget drops the usernames cached for the team, to be called once the team is deleted
+func (r *Resolver) Forget(uid types.UID) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.cache, uid)
+}
+
+// Retain drops the usernames cached for the teams other than the given ones, for callers
+// listing every team rather than watching their deletion
+func (r *Resolver) Retain(uids map[types.UID]bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for uid := range r.cache {
+		if !uids[uid] {
+			delete(r.cache, uid)
+		}
+	}
+}
+
+// sweep drops the expired entries, teams deleted without being seen by Forget are not
+// refreshed anymore so their entries expire. The lock must be held.
+func (r *Resolver) sweep(now time.Time) {
+	for uid, cached := range r.cache {
+		if !now.Before(cached.expiresAt) {
+			delete(r.cache, uid)
+		}
+	}
+}
+
+// Inline returns the members declared in the usernames of the team
+func Inline(team *akov2.AtlasTeam) *Members {
+	usernames := make([]string, 0, len(team.Spec.Usernames))
+	for _, username := range team.Spec.Usernames {
+		usernames = append(usernames, string(username))
+	}
+	return &Members{Usernames: normalize(usernames), Source: SourceUsernames}
+}
+
+// Resolve returns the union of the usernames of the team and those read from its members source
+func (r *Resolver) Resolve(ctx context.Context, team *akov2.AtlasTeam) (*Members, error) {
+	members := Inline(team)
+	source := team.Spec.MembersFrom
+	if source == nil {
+		return members, nil
+	}
+
+	var usernames []string
+	var err error
+	switch {
+	case source.ConfigMapRef != nil:
+		members.Source = SourceConfigMap
+		usernames, err = r.fromConfigMap(ctx, team.Namespace, source.ConfigMapRef)
+	case source.HTTP != nil:
+		members.Source = SourceHTTP
+		usernames, err = r.fromHTTP(ctx, team, source.HTTP, members)
+	default:
+		return nil, errors.New("members source defines neither configMapRef nor http")
+	}
+	if err != nil {
+		return nil, err
+	}
+	members.Usernames = normalize(append(members.Usernames, usernames...))
+	return members, nil
+}
+
+func (r *Resolver) fromConfigMap(ctx context.Context, namespace string, ref *akov2.TeamMembersConfigMapReference) ([]string, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := r.kubeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, configMap); err != nil {
+		return nil, fmt.Errorf("failed to get members ConfigMap %s/%s: %w", namespace, ref.Name, err)
+	}
+	key := ref.Key
+	if key == "" {
+		key = DefaultConfigMapKey
+	}
+	data, ok := configMap.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("members ConfigMap %s/%s has no key %q", namespace, ref.Name, key)
+	}
+	return parseLines(data)
+}
+
+func (r *Resolver) fromHTTP(ctx context.Context, team *akov2.AtlasTeam, source *akov2.TeamMembersHTTPSource, members *Members) ([]string, error) {
+	refreshInterval := source.RefreshInterval.Duration
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+	sourceKey := fmt.Sprintf("%s/%s", source.Format, source.URL)
+	now := r.now()
+
+	r.lock.Lock()
+	cached, ok := r.cache[team.UID]
+	r.lock.Unlock()
+	if !ok || cached.source != sourceKey || now.Sub(cached.fetchedAt) >= refreshInterval {
+		token, err := r.bearerToken(ctx, team.Namespace, source.BearerTokenSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		usernames, err := r.fetch(ctx, source, token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch members from %s: %w", source.URL, err)
+		}
+		if len(normalize(usernames)) == 0 && !source.AllowEmpty {
+			return nil, fmt.Errorf("members source %s returned no usernames, set allowEmpty to accept it", source.URL)
+		}
+		cached = cachedUsernames{source: sourceKey, usernames: usernames, fetchedAt: now, expiresAt: now.Add(refreshInterval)}
+		r.lock.Lock()
+		r.sweep(now)
+		r.cache[team.UID] = cached
+		r.lock.Unlock()
+	}
+
+	members.FetchedAt = cached.fetchedAt
+	members.RefreshAfter = cached.fetchedAt.Add(refreshInterval).Sub(now)
+	return cached.usernames, nil
+}
+
+func (r *Resolver) bearerToken(ctx context.Context, namespace string, ref *api.LocalObjectReference) (string, error) {
+	if ref == nil || ref.Name == "" {
+		return "", nil
+	}
+	secret := &corev1.Secret{}
+	if err := r.kubeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return "", fmt.Errorf("failed to get bearer token Secret %s/%s: %w", namespace, ref.Name, err)
+	}
+	token, ok := secret.Data[bearerTokenKey]
+	if !ok {
+		return "", fmt.Errorf("bearer token Secret %s/%s has no key %q", namespace, ref.Name, bearerTokenKey)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+func (r *Resolver) fetch(ctx context.Context, source *akov2.TeamMembersHTTPSource, token string) ([]string, error) {
+	if source.Format == akov2.TeamMembersFormatSCIM {
+		return r.fetchSCIM(ctx, source.URL, token)
+	}
+	var usernames []string
+	if err := r.getJSON(ctx, source.URL, token, &usernames); err != nil {
+		return nil, err
+	}
+	return usernames, nil
+}
+
+type scimListResponse struct {
+	TotalResults int        `json:"totalResults"`
+	StartIndex   int        `json:"startIndex"`
+	Resources    []scimUser `json:"Resources"`
+}
+
+type scimUser struct {
+	UserName string `json:"userName"`
+	Active   *bool  `json:"active,omitempty"`
+}
+
+// fetchSCIM pages through a SCIM ListResponse of Users, keeping the userName of the active ones
+func (r *Resolver) fetchSCIM(ctx context.Context, rawURL, token string) ([]string, error) {
+	pageURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	var usernames []string
+	for startIndex, seen := 1, 0; ; {
+		query := pageURL.Query()
+		query.Set("startIndex", strconv.Itoa(startIndex))
+		query.Set("count", strconv.Itoa(scimPageSize))
+		pageURL.RawQuery = query.Encode()
+
+		page := scimListResponse{}
+		if err := r.getJSON(ctx, pageURL.String(), token, &page); err != nil {
+			return nil, err
+		}
+		for _, user := range page.Resources {
+			if user.UserName != "" && (user.Active == nil || *user.Active) {
+				usernames = append(usernames, user.UserName)
+			}
+		}
+		if len(page.Resources) == 0 && seen < page.TotalResults {
+			return nil, fmt.Errorf("empty page at index %d of %d results", startIndex, page.TotalResults)
+		}
+		seen += len(page.Resources)
+		if len(page.Resources) == 0 || seen >= page.TotalResults {
+			return usernames, nil
+		}
+		startIndex += len(page.Resources)
+	}
+}
+
+func (r *Resolver) getJSON(ctx context.Context, endpoint, token string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json, application/scim+json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponse)).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func parseLines(data string) ([]string, error) {
+	var usernames []string
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		usernames = append(usernames, line)
+	}
+	return usernames, scanner.Err()
+}
+
+func normalize(usernames []string) []string {
+	normalized := make([]string, 0, len(usernames))
+	for _, username := range usernames {
+		if username = strings.TrimSpace(username); username != "" {
+			normalized = append(normalized, username)
+		}
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
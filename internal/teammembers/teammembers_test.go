@@ -0,0 +1,307 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package teammembers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api"
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+)
+
+func testTeam(source *akov2.TeamMembersSource) *akov2.AtlasTeam {
+	return &akov2.AtlasTeam{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "default", UID: "team-uid"},
+		Spec: akov2.TeamSpec{
+			Name:        "team",
+			Usernames:   []akov2.TeamUser{"inline@example.com"},
+			MembersFrom: source,
+		},
+	}
+}
+
+func testResolver(t *testing.T, objects ...client.Object) *Resolver {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	return NewResolver(kubeClient, http.DefaultClient)
+}
+
+func TestResolveInline(t *testing.T) {
+	team := testTeam(nil)
+	team.Spec.Usernames = append(team.Spec.Usernames, " other@example.com", "inline@example.com")
+
+	members, err := testResolver(t).Resolve(context.Background(), team)
+	require.NoError(t, err)
+	assert.Equal(t, &Members{
+		Usernames: []string{"inline@example.com", "other@example.com"},
+		Source:    SourceUsernames,
+	}, members)
+}
+
+func TestResolveConfigMap(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "members", Namespace: "default"},
+		Data: map[string]string{
+			"usernames": "# platform team\njane@example.com\n\n  john@example.com  \ninline@example.com\n",
+			"other":     "other@example.com",
+		},
+	}
+	for _, tc := range []struct {
+		title             string
+		ref               *akov2.TeamMembersConfigMapReference
+		expectedUsernames []string
+		expectedErr       string
+	}{
+		{
+			title:             "the default key holds one username per line",
+			ref:               &akov2.TeamMembersConfigMapReference{Name: "members"},
+			expectedUsernames: []string{"inline@example.com", "jane@example.com", "john@example.com"},
+		},
+		{
+			title:             "other keys can be read",
+			ref:               &akov2.TeamMembersConfigMapReference{Name: "members", Key: "other"},
+			expectedUsernames: []string{"inline@example.com", "other@example.com"},
+		},
+		{
+			title:       "missing keys fail",
+			ref:         &akov2.TeamMembersConfigMapReference{Name: "members", Key: "missing"},
+			expectedErr: `members ConfigMap default/members has no key "missing"`,
+		},
+		{
+			title:       "missing ConfigMaps fail",
+			ref:         &akov2.TeamMembersConfigMapReference{Name: "missing"},
+			expectedErr: "failed to get members ConfigMap default/missing",
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			team := testTeam(&akov2.TeamMembersSource{ConfigMapRef: tc.ref})
+			members, err := testResolver(t, configMap).Resolve(context.Background(), team)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, SourceConfigMap, members.Source)
+			assert.Equal(t, tc.expectedUsernames, members.Usernames)
+			assert.Zero(t, members.RefreshAfter)
+		})
+	}
+}
+
+func TestResolveHTTPJSON(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("Authorization") != "Bearer s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]string{"jane@example.com", "john@example.com"})
+	}))
+	defer srv.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "directory-token", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("s3cr3t\n")},
+	}
+	team := testTeam(&akov2.TeamMembersSource{HTTP: &akov2.TeamMembersHTTPSource{
+		URL:                  srv.URL,
+		Format:               akov2.TeamMembersFormatJSON,
+		BearerTokenSecretRef: &api.LocalObjectReference{Name: "directory-token"},
+		RefreshInterval:      metav1.Duration{Duration: 10 * time.Minute},
+	}})
+	resolver := testResolver(t, secret)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	resolver.now = func() time.Time { return now }
+
+	members, err := resolver.Resolve(context.Background(), team)
+	require.NoError(t, err)
+	assert.Equal(t, &Members{
+		Usernames:    []string{"inline@example.com", "jane@example.com", "john@example.com"},
+		Source:       SourceHTTP,
+		FetchedAt:    now,
+		RefreshAfter: 10 * time.Minute,
+	}, members)
+
+	now = now.Add(4 * time.Minute)
+	members, err = resolver.Resolve(context.Background(), team)
+	require.NoError(t, err)
+	assert.Equal(t, 6*time.Minute, members.RefreshAfter)
+	assert.Equal(t, 1, requests, "usernames are cached for the refresh interval")
+
+	now = now.Add(6 * time.Minute)
+	members, err = resolver.Resolve(context.Background(), team)
+	require.NoError(t, err)
+	assert.Equal(t, now, members.FetchedAt)
+	assert.Equal(t, 2, requests, "usernames are fetched again after the refresh interval")
+}
+
+func TestResolveHTTPSCIM(t *testing.T) {
+	users := []scimUser{
+		{UserName: "jane@example.com"},
+		{UserName: "john@example.com", Active: new(bool)},
+		{UserName: "alice@example.com"},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `groups.value eq "platform"`, r.URL.Query().Get("filter"))
+		startIndex, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+		require.NoError(t, err)
+		// serve two users per page to exercise the pagination
+		end := min(startIndex+1, len(users))
+		_ = json.NewEncoder(w).Encode(scimListResponse{
+			TotalResults: len(users),
+			StartIndex:   startIndex,
+			Resources:    users[startIndex-1 : end],
+		})
+	}))
+	defer srv.Close()
+
+	team := testTeam(&akov2.TeamMembersSource{HTTP: &akov2.TeamMembersHTTPSource{
+		URL:    srv.URL + "/scim/v2/Users?filter=groups.value+eq+%22platform%22",
+		Format: akov2.TeamMembersFormatSCIM,
+	}})
+	members, err := testResolver(t).Resolve(context.Background(), team)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice@example.com", "inline@example.com", "jane@example.com"}, members.Usernames)
+	assert.Equal(t, DefaultRefreshInterval, members.RefreshAfter)
+}
+
+func TestResolveHTTPFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	team := testTeam(&akov2.TeamMembersSource{HTTP: &akov2.TeamMembersHTTPSource{URL: srv.URL}})
+	_, err := testResolver(t).Resolve(context.Background(), team)
+	assert.ErrorContains(t, err, "failed to fetch members from "+srv.URL+": unexpected status 500 Internal Server Error")
+}
+
+func TestResolveHTTPEmpty(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		format     string
+		allowEmpty bool
+		response   any
+		expectErr  string
+	}{
+		{
+			name:      "empty JSON array is rejected",
+			format:    akov2.TeamMembersFormatJSON,
+			response:  []string{},
+			expectErr: "returned no usernames, set allowEmpty to accept it",
+		},
+		{
+			name:       "empty JSON array is accepted when allowed",
+			format:     akov2.TeamMembersFormatJSON,
+			allowEmpty: true,
+			response:   []string{},
+		},
+		{
+			name:      "SCIM response without active users is rejected",
+			format:    akov2.TeamMembersFormatSCIM,
+			response:  scimListResponse{TotalResults: 1, StartIndex: 1, Resources: []scimUser{{UserName: "gone@example.com", Active: new(bool)}}},
+			expectErr: "returned no usernames, set allowEmpty to accept it",
+		},
+		{
+			name:      "empty SCIM page before the total is reached is rejected",
+			format:    akov2.TeamMembersFormatSCIM,
+			response:  scimListResponse{TotalResults: 3, StartIndex: 1},
+			expectErr: "empty page at index 1 of 3 results",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(tc.response)
+			}))
+			defer srv.Close()
+
+			team := testTeam(&akov2.TeamMembersSource{HTTP: &akov2.TeamMembersHTTPSource{
+				URL:        srv.URL,
+				Format:     tc.format,
+				AllowEmpty: tc.allowEmpty,
+			}})
+			members, err := testResolver(t).Resolve(context.Background(), team)
+			if tc.expectErr != "" {
+				assert.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{"inline@example.com"}, members.Usernames)
+		})
+	}
+}
+
+func TestResolverCacheEviction(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_ = json.NewEncoder(w).Encode([]string{"jane@example.com"})
+	}))
+	defer srv.Close()
+
+	source := &akov2.TeamMembersSource{HTTP: &akov2.TeamMembersHTTPSource{
+		URL:             srv.URL,
+		RefreshInterval: metav1.Duration{Duration: 10 * time.Minute},
+	}}
+	team := testTeam(source)
+	other := testTeam(source)
+	other.UID = "other-uid"
+	resolver := testResolver(t)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	resolver.now = func() time.Time { return now }
+	resolve := func(team *akov2.AtlasTeam) {
+		t.Helper()
+		_, err := resolver.Resolve(context.Background(), team)
+		require.NoError(t, err)
+	}
+
+	resolve(team)
+	resolve(other)
+	assert.Len(t, resolver.cache, 2)
+
+	resolver.Forget(team.UID)
+	assert.NotContains(t, resolver.cache, team.UID)
+
+	resolver.Retain(map[types.UID]bool{team.UID: true})
+	assert.Empty(t, resolver.cache)
+
+	resolve(team)
+	team.Spec.MembersFrom.HTTP.URL = srv.URL + "/other"
+	resolve(team)
+	assert.Equal(t, 4, requests, "a change of the source fetches the usernames again")
+
+	resolve(other)
+	now = now.Add(10 * time.Minute)
+	resolve(other)
+	assert.Equal(t, 6, requests)
+	assert.NotContains(t, resolver.cache, team.UID, "expired entries are swept")
+}
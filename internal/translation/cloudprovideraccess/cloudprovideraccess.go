@@ -0,0 +1,86 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudprovideraccess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+)
+
+// ErrNotFound means the role is missing in Atlas
+var ErrNotFound = errors.New("not found")
+
+type CloudProviderAccessService interface {
+	Get(ctx context.Context, projectID, roleID string) (*Role, error)
+	Create(ctx context.Context, projectID string, role *Role) (*Role, error)
+	Authorize(ctx context.Context, projectID, roleID string, role *Role) (*Role, error)
+	Deauthorize(ctx context.Context, projectID, providerName, roleID string) error
+}
+
+type cloudProviderAccessService struct {
+	cpaAPI admin.CloudProviderAccessApi
+}
+
+func NewCloudProviderAccessServiceFromClientSet(clientSet *atlas.ClientSet) CloudProviderAccessService {
+	return NewCloudProviderAccessService(clientSet.SdkClient20250312002.CloudProviderAccessApi)
+}
+
+func NewCloudProviderAccessService(cpaAPI admin.CloudProviderAccessApi) CloudProviderAccessService {
+	return &cloudProviderAccessService{cpaAPI: cpaAPI}
+}
+
+func (s *cloudProviderAccessService) Get(ctx context.Context, projectID, roleID string) (*Role, error) {
+	role, httpResp, err := s.cpaAPI.GetCloudProviderAccessRole(ctx, projectID, roleID).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cloud provider access role %s: %w", roleID, err)
+	}
+	return fromAtlas(role), nil
+}
+
+func (s *cloudProviderAccessService) Create(ctx context.Context, projectID string, role *Role) (*Role, error) {
+	newRole, _, err := s.cpaAPI.CreateCloudProviderAccessRole(ctx, projectID, toAtlasRequest(role)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cloud provider access role at project %s: %w", projectID, err)
+	}
+	return fromAtlas(newRole), nil
+}
+
+func (s *cloudProviderAccessService) Authorize(ctx context.Context, projectID, roleID string, role *Role) (*Role, error) {
+	authorizedRole, _, err := s.cpaAPI.AuthorizeCloudProviderAccessRole(ctx, projectID, roleID, toAtlasUpdate(role)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to authorize cloud provider access role %s: %w", roleID, err)
+	}
+	return fromAtlas(authorizedRole), nil
+}
+
+func (s *cloudProviderAccessService) Deauthorize(ctx context.Context, projectID, providerName, roleID string) error {
+	httpResp, err := s.cpaAPI.DeauthorizeCloudProviderAccessRole(ctx, projectID, providerName, roleID).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to deauthorize cloud provider access role %s: %w", roleID, err)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudprovideraccess_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	"go.mongodb.org/atlas-sdk/v20250312002/mockadmin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/cloudprovideraccess"
+)
+
+const (
+	testProjectID = "fake-project-id"
+	testRoleID    = "fake-role-id"
+	testRoleArn   = "arn:aws:iam::123456789012:role/atlas"
+)
+
+var ErrFakeFailure = errors.New("fake-failure")
+
+func TestCloudProviderAccessGet(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, tc := range []struct {
+		title        string
+		role         *admin.CloudProviderAccessRole
+		httpResp     *http.Response
+		err          error
+		expectedRole *cloudprovideraccess.Role
+		expectedErr  error
+	}{
+		{
+			title: "AWS roles are converted",
+			role: &admin.CloudProviderAccessRole{
+				ProviderName:               "AWS",
+				RoleId:                     pointer.MakePtr(testRoleID),
+				AtlasAWSAccountArn:         pointer.MakePtr("arn:aws:iam::000000000000:root"),
+				AtlasAssumedRoleExternalId: pointer.MakePtr("external-id"),
+				CreatedDate:                pointer.MakePtr(created),
+			},
+			expectedRole: &cloudprovideraccess.Role{
+				ProviderName:               "AWS",
+				RoleID:                     testRoleID,
+				AtlasAWSAccountArn:         "arn:aws:iam::000000000000:root",
+				AtlasAssumedRoleExternalID: "external-id",
+				CreatedDate:                pointer.MakePtr(created),
+			},
+		},
+		{
+			title: "Azure roles are identified by their _id",
+			role: &admin.CloudProviderAccessRole{
+				ProviderName:       "AZURE",
+				Id:                 pointer.MakePtr(testRoleID),
+				AtlasAzureAppId:    pointer.MakePtr("app-id"),
+				ServicePrincipalId: pointer.MakePtr("principal-id"),
+				TenantId:           pointer.MakePtr("tenant-id"),
+			},
+			expectedRole: &cloudprovideraccess.Role{
+				ProviderName:       "AZURE",
+				RoleID:             testRoleID,
+				AtlasAzureAppID:    "app-id",
+				ServicePrincipalID: "principal-id",
+				TenantID:           "tenant-id",
+			},
+		},
+		{
+			title:       "missing roles are reported as not found",
+			httpResp:    &http.Response{StatusCode: http.StatusNotFound},
+			err:         ErrFakeFailure,
+			expectedErr: cloudprovideraccess.ErrNotFound,
+		},
+		{
+			title:       "other failures are wrapped",
+			err:         ErrFakeFailure,
+			expectedErr: ErrFakeFailure,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			cpaAPI := mockadmin.NewCloudProviderAccessApi(t)
+			cpaAPI.EXPECT().GetCloudProviderAccessRole(mock.Anything, testProjectID, testRoleID).
+				Return(admin.GetCloudProviderAccessRoleApiRequest{ApiService: cpaAPI})
+			cpaAPI.EXPECT().GetCloudProviderAccessRoleExecute(mock.Anything).Return(tc.role, tc.httpResp, tc.err)
+
+			role, err := cloudprovideraccess.NewCloudProviderAccessService(cpaAPI).Get(context.Background(), testProjectID, testRoleID)
+			assert.Equal(t, tc.expectedRole, role)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestCloudProviderAccessCreate(t *testing.T) {
+	cpaAPI := mockadmin.NewCloudProviderAccessApi(t)
+	cpaAPI.EXPECT().CreateCloudProviderAccessRole(mock.Anything, testProjectID, &admin.CloudProviderAccessRoleRequest{
+		ProviderName:       "AZURE",
+		AtlasAzureAppId:    pointer.MakePtr("app-id"),
+		ServicePrincipalId: pointer.MakePtr("principal-id"),
+		TenantId:           pointer.MakePtr("tenant-id"),
+	}).Return(admin.CreateCloudProviderAccessRoleApiRequest{ApiService: cpaAPI})
+	cpaAPI.EXPECT().CreateCloudProviderAccessRoleExecute(mock.Anything).Return(
+		&admin.CloudProviderAccessRole{ProviderName: "AZURE", Id: pointer.MakePtr(testRoleID)}, nil, nil,
+	)
+
+	role, err := cloudprovideraccess.NewCloudProviderAccessService(cpaAPI).Create(context.Background(), testProjectID,
+		cloudprovideraccess.NewRole(&akov2.AtlasCloudProviderAccessSpec{
+			ProviderName: "AZURE",
+			Azure: &akov2.AzureCloudProviderAccess{
+				AtlasAzureAppID:    "app-id",
+				ServicePrincipalID: "principal-id",
+				TenantID:           "tenant-id",
+			},
+		}))
+	require.NoError(t, err)
+	assert.Equal(t, testRoleID, role.RoleID)
+}
+
+func TestCloudProviderAccessAuthorize(t *testing.T) {
+	authorized := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	cpaAPI := mockadmin.NewCloudProviderAccessApi(t)
+	cpaAPI.EXPECT().AuthorizeCloudProviderAccessRole(mock.Anything, testProjectID, testRoleID, &admin.CloudProviderAccessRoleRequestUpdate{
+		ProviderName:      "AWS",
+		IamAssumedRoleArn: pointer.MakePtr(testRoleArn),
+	}).Return(admin.AuthorizeCloudProviderAccessRoleApiRequest{ApiService: cpaAPI})
+	cpaAPI.EXPECT().AuthorizeCloudProviderAccessRoleExecute(mock.Anything).Return(
+		&admin.CloudProviderAccessRole{
+			ProviderName:      "AWS",
+			RoleId:            pointer.MakePtr(testRoleID),
+			IamAssumedRoleArn: pointer.MakePtr(testRoleArn),
+			AuthorizedDate:    pointer.MakePtr(authorized),
+		}, nil, nil,
+	)
+
+	role, err := cloudprovideraccess.NewCloudProviderAccessService(cpaAPI).Authorize(context.Background(), testProjectID, testRoleID,
+		cloudprovideraccess.NewRole(&akov2.AtlasCloudProviderAccessSpec{
+			ProviderName: "AWS",
+			AWS:          &akov2.AWSCloudProviderAccess{IamAssumedRoleArn: testRoleArn},
+		}))
+	require.NoError(t, err)
+	assert.True(t, role.Authorized())
+
+	cpaStatus := status.AtlasCloudProviderAccessStatus{}
+	cloudprovideraccess.ApplyStatus(&cpaStatus, role)
+	assert.Equal(t, status.AtlasCloudProviderAccessStatus{
+		RoleID:            testRoleID,
+		IamAssumedRoleArn: testRoleArn,
+		Authorized:        true,
+		AuthorizedDate:    "2025-01-02T03:04:05Z",
+	}, cpaStatus)
+}
+
+func TestCloudProviderAccessDeauthorize(t *testing.T) {
+	cpaAPI := mockadmin.NewCloudProviderAccessApi(t)
+	cpaAPI.EXPECT().DeauthorizeCloudProviderAccessRole(mock.Anything, testProjectID, "GCP", testRoleID).
+		Return(admin.DeauthorizeCloudProviderAccessRoleApiRequest{ApiService: cpaAPI})
+	cpaAPI.EXPECT().DeauthorizeCloudProviderAccessRoleExecute(mock.Anything).Return(nil, ErrFakeFailure)
+
+	err := cloudprovideraccess.NewCloudProviderAccessService(cpaAPI).Deauthorize(context.Background(), testProjectID, "GCP", testRoleID)
+	assert.ErrorIs(t, err, ErrFakeFailure)
+}
+
+func TestRoleExports(t *testing.T) {
+	role := &cloudprovideraccess.Role{
+		ProviderName:               "AWS",
+		RoleID:                     testRoleID,
+		AtlasAWSAccountArn:         "arn:aws:iam::000000000000:root",
+		AtlasAssumedRoleExternalID: "external-id",
+	}
+	assert.Equal(t, map[string]string{
+		"providerName":               "AWS",
+		"roleId":                     testRoleID,
+		"atlasAWSAccountArn":         "arn:aws:iam::000000000000:root",
+		"atlasAssumedRoleExternalId": "external-id",
+	}, role.Exports())
+
+	gcp := &cloudprovideraccess.Role{ProviderName: "GCP", RoleID: testRoleID, GCPServiceAccountForAtlas: "atlas@gcp.iam"}
+	assert.Equal(t, map[string]string{
+		"providerName":              "GCP",
+		"roleId":                    testRoleID,
+		"gcpServiceAccountForAtlas": "atlas@gcp.iam",
+	}, gcp.Exports())
+}
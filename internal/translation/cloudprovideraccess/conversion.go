@@ -0,0 +1,145 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudprovideraccess
+
+import (
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/timeutil"
+)
+
+// Role is a cloud provider access role as set up in Atlas
+type Role struct {
+	ProviderName string
+	RoleID       string
+
+	AtlasAWSAccountArn         string
+	AtlasAssumedRoleExternalID string
+	IamAssumedRoleArn          string
+
+	AtlasAzureAppID    string
+	ServicePrincipalID string
+	TenantID           string
+
+	GCPServiceAccountForAtlas string
+
+	CreatedDate    *time.Time
+	AuthorizedDate *time.Time
+}
+
+// NewRole returns the role desired by the given custom resource
+func NewRole(spec *akov2.AtlasCloudProviderAccessSpec) *Role {
+	role := &Role{ProviderName: spec.ProviderName}
+	if spec.AWS != nil {
+		role.IamAssumedRoleArn = spec.AWS.IamAssumedRoleArn
+	}
+	if spec.Azure != nil {
+		role.AtlasAzureAppID = spec.Azure.AtlasAzureAppID
+		role.ServicePrincipalID = spec.Azure.ServicePrincipalID
+		role.TenantID = spec.Azure.TenantID
+	}
+	return role
+}
+
+// Authorized tells whether the role was authorized in Atlas
+func (r *Role) Authorized() bool {
+	return r.AuthorizedDate != nil
+}
+
+// Exports returns the Atlas side identifiers of the role needed to set up the trust
+// relationship in the cloud provider
+func (r *Role) Exports() map[string]string {
+	exports := map[string]string{
+		"providerName": r.ProviderName,
+		"roleId":       r.RoleID,
+	}
+	switch provider.ProviderName(r.ProviderName) {
+	case provider.ProviderAWS:
+		exports["atlasAWSAccountArn"] = r.AtlasAWSAccountArn
+		exports["atlasAssumedRoleExternalId"] = r.AtlasAssumedRoleExternalID
+	case provider.ProviderAzure:
+		exports["atlasAzureAppId"] = r.AtlasAzureAppID
+		exports["servicePrincipalId"] = r.ServicePrincipalID
+		exports["tenantId"] = r.TenantID
+	case provider.ProviderGCP:
+		exports["gcpServiceAccountForAtlas"] = r.GCPServiceAccountForAtlas
+	}
+	return exports
+}
+
+// ApplyStatus records the Atlas side state of the role into the custom resource status
+func ApplyStatus(cpaStatus *status.AtlasCloudProviderAccessStatus, role *Role) {
+	cpaStatus.RoleID = role.RoleID
+	cpaStatus.AtlasAWSAccountArn = role.AtlasAWSAccountArn
+	cpaStatus.AtlasAssumedRoleExternalID = role.AtlasAssumedRoleExternalID
+	cpaStatus.IamAssumedRoleArn = role.IamAssumedRoleArn
+	cpaStatus.GCPServiceAccountForAtlas = role.GCPServiceAccountForAtlas
+	cpaStatus.Authorized = role.Authorized()
+	cpaStatus.CreatedDate = formatDate(role.CreatedDate)
+	cpaStatus.AuthorizedDate = formatDate(role.AuthorizedDate)
+}
+
+func formatDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return timeutil.FormatISO8601(*date)
+}
+
+func toAtlasRequest(role *Role) *admin.CloudProviderAccessRoleRequest {
+	return &admin.CloudProviderAccessRoleRequest{
+		ProviderName:       role.ProviderName,
+		AtlasAzureAppId:    pointer.SetOrNil(role.AtlasAzureAppID, ""),
+		ServicePrincipalId: pointer.SetOrNil(role.ServicePrincipalID, ""),
+		TenantId:           pointer.SetOrNil(role.TenantID, ""),
+	}
+}
+
+func toAtlasUpdate(role *Role) *admin.CloudProviderAccessRoleRequestUpdate {
+	return &admin.CloudProviderAccessRoleRequestUpdate{
+		ProviderName:       role.ProviderName,
+		IamAssumedRoleArn:  pointer.SetOrNil(role.IamAssumedRoleArn, ""),
+		AtlasAzureAppId:    pointer.SetOrNil(role.AtlasAzureAppID, ""),
+		ServicePrincipalId: pointer.SetOrNil(role.ServicePrincipalID, ""),
+		TenantId:           pointer.SetOrNil(role.TenantID, ""),
+	}
+}
+
+func fromAtlas(role *admin.CloudProviderAccessRole) *Role {
+	// Azure roles are identified by their _id field rather than roleId
+	roleID := role.GetRoleId()
+	if roleID == "" {
+		roleID = role.GetId()
+	}
+	return &Role{
+		ProviderName:               role.ProviderName,
+		RoleID:                     roleID,
+		AtlasAWSAccountArn:         role.GetAtlasAWSAccountArn(),
+		AtlasAssumedRoleExternalID: role.GetAtlasAssumedRoleExternalId(),
+		IamAssumedRoleArn:          role.GetIamAssumedRoleArn(),
+		AtlasAzureAppID:            role.GetAtlasAzureAppId(),
+		ServicePrincipalID:         role.GetServicePrincipalId(),
+		TenantID:                   role.GetTenantId(),
+		GCPServiceAccountForAtlas:  role.GetGcpServiceAccountForAtlas(),
+		CreatedDate:                role.CreatedDate,
+		AuthorizedDate:             role.AuthorizedDate,
+	}
+}
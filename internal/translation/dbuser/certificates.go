@@ -0,0 +1,82 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbuser
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+)
+
+// Certificate is an Atlas managed X.509 client certificate along with its private key
+type Certificate struct {
+	CertificatePEM []byte
+	PrivateKeyPEM  []byte
+	Certificate    *x509.Certificate
+}
+
+type AtlasCertificatesService interface {
+	Create(ctx context.Context, projectID, username string, monthsUntilExpiration int) (*Certificate, error)
+}
+
+type AtlasCertificates struct {
+	x509API admin.X509AuthenticationApi
+}
+
+func NewAtlasCertificates(api admin.X509AuthenticationApi) *AtlasCertificates {
+	return &AtlasCertificates{x509API: api}
+}
+
+func (c *AtlasCertificates) Create(ctx context.Context, projectID, username string, monthsUntilExpiration int) (*Certificate, error) {
+	userCert := admin.NewUserCert()
+	userCert.SetMonthsUntilExpiration(monthsUntilExpiration)
+	bundle, _, err := c.x509API.CreateDatabaseUserCertificate(ctx, projectID, username, userCert).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create X.509 certificate for database user %q: %w", username, err)
+	}
+	return ParseCertificate([]byte(bundle))
+}
+
+// ParseCertificate splits a PEM bundle holding a client certificate and its private key
+func ParseCertificate(bundle []byte) (*Certificate, error) {
+	cert := &Certificate{}
+	for block, rest := pem.Decode(bundle); block != nil; block, rest = pem.Decode(rest) {
+		switch {
+		case block.Type == "CERTIFICATE":
+			if cert.Certificate == nil {
+				parsed, err := x509.ParseCertificate(block.Bytes)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse X.509 certificate: %w", err)
+				}
+				cert.Certificate = parsed
+			}
+			cert.CertificatePEM = append(cert.CertificatePEM, pem.EncodeToMemory(block)...)
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			cert.PrivateKeyPEM = pem.EncodeToMemory(block)
+		}
+	}
+	if cert.Certificate == nil {
+		return nil, errors.New("no certificate found in the PEM bundle")
+	}
+	if cert.PrivateKeyPEM == nil {
+		return nil, errors.New("no private key found in the PEM bundle")
+	}
+	return cert, nil
+}
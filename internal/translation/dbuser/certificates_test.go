@@ -0,0 +1,104 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbuser
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	"go.mongodb.org/atlas-sdk/v20250312002/mockadmin"
+)
+
+func testCertificateBundle(t *testing.T, username string) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: username},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestParseCertificate(t *testing.T) {
+	bundle := testCertificateBundle(t, "user1")
+
+	t.Run("splits the certificate and the private key", func(t *testing.T) {
+		cert, err := ParseCertificate([]byte(bundle))
+		require.NoError(t, err)
+		assert.Equal(t, "user1", cert.Certificate.Subject.CommonName)
+		assert.Contains(t, string(cert.CertificatePEM), "BEGIN CERTIFICATE")
+		assert.Contains(t, string(cert.PrivateKeyPEM), "BEGIN PRIVATE KEY")
+	})
+
+	t.Run("fails without a private key", func(t *testing.T) {
+		cert, err := ParseCertificate([]byte(bundle[:len(bundle)/2]))
+		assert.Nil(t, cert)
+		assert.Error(t, err)
+	})
+
+	t.Run("fails without a certificate", func(t *testing.T) {
+		cert, err := ParseCertificate([]byte("not a PEM bundle"))
+		assert.Nil(t, cert)
+		assert.ErrorContains(t, err, "no certificate found")
+	})
+}
+
+func TestAtlasCertificatesCreate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("issues a certificate", func(t *testing.T) {
+		api := mockadmin.NewX509AuthenticationApi(t)
+		api.EXPECT().CreateDatabaseUserCertificate(ctx, "project-id", "user1", mock.MatchedBy(func(userCert *admin.UserCert) bool {
+			return userCert.GetMonthsUntilExpiration() == 6
+		})).Return(admin.CreateDatabaseUserCertificateApiRequest{ApiService: api})
+		api.EXPECT().CreateDatabaseUserCertificateExecute(mock.Anything).Return(testCertificateBundle(t, "user1"), nil, nil)
+
+		cert, err := NewAtlasCertificates(api).Create(ctx, "project-id", "user1", 6)
+		require.NoError(t, err)
+		assert.Equal(t, "user1", cert.Certificate.Subject.CommonName)
+	})
+
+	t.Run("fails when Atlas fails", func(t *testing.T) {
+		api := mockadmin.NewX509AuthenticationApi(t)
+		api.EXPECT().CreateDatabaseUserCertificate(ctx, "project-id", "user1", mock.Anything).
+			Return(admin.CreateDatabaseUserCertificateApiRequest{ApiService: api})
+		api.EXPECT().CreateDatabaseUserCertificateExecute(mock.Anything).Return("", nil, errors.New("unavailable"))
+
+		cert, err := NewAtlasCertificates(api).Create(ctx, "project-id", "user1", 6)
+		assert.Nil(t, cert)
+		assert.ErrorContains(t, err, "unavailable")
+	})
+}
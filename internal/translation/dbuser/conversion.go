@@ -82,6 +82,8 @@ func (u *User) clearedSpecClone() *akov2.AtlasDatabaseUserSpec {
 	clone.PasswordSecret = nil
 	clone.ExternalProjectRef = nil
 	clone.ConnectionSecret = nil
+	clone.X509 = nil // certificate settings are managed by the operator, not part of the Atlas user
+	clone.ServiceAccountRef = nil
 	return &clone
 }
 
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.mongodb.org/atlas-sdk/v20250312002/admin"
 
@@ -58,6 +59,9 @@ type Cluster struct {
 	ReplicaSet     []status.ReplicaSet
 	ZoneID         string
 
+	FeatureCompatibilityVersion string
+	FCVPinnedUntil              *time.Time
+
 	customResource            *akov2.AtlasDeployment
 	computeAutoscalingEnabled bool
 	instanceSizeOverride      string
@@ -576,7 +580,9 @@ func clusterFromAtlas(clusterDesc *admin.ClusterDescription20240805) *Cluster {
 			PrivateSrv:      connectionStrings.GetPrivateSrv(),
 			PrivateEndpoint: pes,
 		},
-		ReplicaSet: replicaSetFromAtlas(clusterDesc.GetReplicationSpecs()),
+		ReplicaSet:                  replicaSetFromAtlas(clusterDesc.GetReplicationSpecs()),
+		FeatureCompatibilityVersion: clusterDesc.GetFeatureCompatibilityVersion(),
+		FCVPinnedUntil:              clusterDesc.FeatureCompatibilityVersionExpirationDate,
 		AdvancedDeploymentSpec: &akov2.AdvancedDeploymentSpec{
 			Name:                         clusterDesc.GetName(),
 			ClusterType:                  clusterDesc.GetClusterType(),
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/atlas-sdk/v20250312002/admin"
 
@@ -44,6 +45,7 @@ type DeploymentService interface {
 	UpgradeToDedicated(ctx context.Context, currentDeployment, targetDeployment Deployment) (Deployment, error)
 	ClusterWithProcessArgs(ctx context.Context, cluster *Cluster) error
 	UpdateProcessArgs(ctx context.Context, cluster *Cluster) error
+	PinFeatureCompatibilityVersion(ctx context.Context, projectID, clusterName string, until time.Time) error
 }
 
 type GlobalClusterService interface {
@@ -312,7 +314,12 @@ func (ds *ProductionAtlasDeployments) DeleteDeployment(ctx context.Context, depl
 
 	switch deployment.(type) {
 	case *Cluster:
-		_, err = ds.clustersAPI.DeleteCluster(ctx, deployment.GetProjectID(), deployment.GetName()).Execute()
+		request := ds.clustersAPI.DeleteCluster(ctx, deployment.GetProjectID(), deployment.GetName())
+		if cr := deployment.GetCustomResource(); cr != nil && cr.Spec.FinalSnapshot != nil {
+			// keep the final snapshot, Atlas removes the snapshots of a deleted cluster otherwise
+			request = request.RetainBackups(true)
+		}
+		_, err = request.Execute()
 	case *Serverless:
 		_, _, err = ds.serverlessAPI.DeleteServerlessInstance(ctx, deployment.GetProjectID(), deployment.GetName()).Execute()
 	case *Flex:
@@ -376,6 +383,15 @@ func (ds *ProductionAtlasDeployments) UpdateProcessArgs(ctx context.Context, clu
 	return nil
 }
 
+func (ds *ProductionAtlasDeployments) PinFeatureCompatibilityVersion(ctx context.Context, projectID, clusterName string, until time.Time) error {
+	_, err := ds.clustersAPI.PinFeatureCompatibilityVersion(ctx, projectID, clusterName, &admin.PinFCV{ExpirationDate: &until}).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to pin feature compatibility version: %w", err)
+	}
+
+	return nil
+}
+
 func (ds *ProductionAtlasDeployments) GetCustomZones(ctx context.Context, projectID, clusterName string) (map[string]string, error) {
 	geosharding, _, err := ds.globalClusterAPI.GetManagedNamespace(ctx, projectID, clusterName).Execute()
 	if err != nil {
@@ -18,9 +18,12 @@ package deployment
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -730,6 +733,28 @@ func TestDeleteDeployment(t *testing.T) {
 			},
 			result: expectedGeoShardedCluster(),
 		},
+		"should retain the backups of a cluster with a final snapshot": {
+			deployment: func() *akov2.AtlasDeployment {
+				d := geoShardedCluster()
+				d.Spec.FinalSnapshot = &akov2.FinalSnapshot{RetentionInDays: 7}
+				return d
+			}(),
+			apiMocker: func() (admin.ClustersApi, admin.ServerlessInstancesApi, admin.FlexClustersApi) {
+				clusterAPI := mockadmin.NewClustersApi(t)
+				clusterAPI.EXPECT().DeleteCluster(context.Background(), "project-id", "cluster0").
+					Return(admin.DeleteClusterApiRequest{ApiService: clusterAPI})
+				clusterAPI.EXPECT().DeleteClusterExecute(mock.MatchedBy(func(req admin.DeleteClusterApiRequest) bool {
+					retainBackups := reflect.ValueOf(req).FieldByName("retainBackups")
+					return !retainBackups.IsNil() && retainBackups.Elem().Bool()
+				})).
+					Return(nil, nil)
+
+				serverlessInstanceAPI := mockadmin.NewServerlessInstancesApi(t)
+				flexAPI := mockadmin.NewFlexClustersApi(t)
+
+				return clusterAPI, serverlessInstanceAPI, flexAPI
+			},
+		},
 		"should delete a serverless instance": {
 			deployment: serverlessInstance(),
 			apiMocker: func() (admin.ClustersApi, admin.ServerlessInstancesApi, admin.FlexClustersApi) {
@@ -998,6 +1023,48 @@ func TestUpdateProcessArgs(t *testing.T) {
 	}
 }
 
+func TestPinFeatureCompatibilityVersion(t *testing.T) {
+	until := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		apiMocker func() admin.ClustersApi
+		err       error
+	}{
+		"should fail to pin the feature compatibility version": {
+			apiMocker: func() admin.ClustersApi {
+				clusterAPI := mockadmin.NewClustersApi(t)
+				clusterAPI.EXPECT().PinFeatureCompatibilityVersion(context.Background(), "project-id", "cluster0", &admin.PinFCV{ExpirationDate: &until}).
+					Return(admin.PinFeatureCompatibilityVersionApiRequest{ApiService: clusterAPI})
+				clusterAPI.EXPECT().PinFeatureCompatibilityVersionExecute(mock.AnythingOfType("admin.PinFeatureCompatibilityVersionApiRequest")).
+					Return(nil, errors.New("failed to pin"))
+
+				return clusterAPI
+			},
+			err: fmt.Errorf("failed to pin feature compatibility version: %w", errors.New("failed to pin")),
+		},
+		"should pin the feature compatibility version": {
+			apiMocker: func() admin.ClustersApi {
+				clusterAPI := mockadmin.NewClustersApi(t)
+				clusterAPI.EXPECT().PinFeatureCompatibilityVersion(context.Background(), "project-id", "cluster0", &admin.PinFCV{ExpirationDate: &until}).
+					Return(admin.PinFeatureCompatibilityVersionApiRequest{ApiService: clusterAPI})
+				clusterAPI.EXPECT().PinFeatureCompatibilityVersionExecute(mock.AnythingOfType("admin.PinFeatureCompatibilityVersionApiRequest")).
+					Return(nil, nil)
+
+				return clusterAPI
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			service := NewAtlasDeployments(tt.apiMocker(), nil, nil, nil, false)
+
+			err := service.PinFeatureCompatibilityVersion(context.Background(), "project-id", "cluster0", until)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
 func TestUpgradeCluster(t *testing.T) {
 	tests := map[string]struct {
 		currentDeployment Deployment
@@ -0,0 +1,306 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploymentaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/paging"
+)
+
+const (
+	OutageSimulationStateSimulating = "SIMULATING"
+	OutageSimulationStateComplete   = "COMPLETE"
+
+	SnapshotStatusCompleted = "completed"
+	SnapshotStatusFailed    = "failed"
+
+	snapshotTypeOnDemand = "onDemand"
+
+	ExportJobStateSuccessful = "Successful"
+	ExportJobStateFailed     = "Failed"
+	ExportJobStateCancelled  = "Cancelled"
+
+	outageTypeRegion = "REGION"
+
+	// clockSkew is how much earlier than the given time Atlas may have recorded
+	// a snapshot or export job the operator asked for
+	clockSkew = 5 * time.Minute
+)
+
+// ErrNotFound means there is no outage simulation, snapshot or export job to report about
+var ErrNotFound = errors.New("not found")
+
+type DeploymentActionService interface {
+	TestFailover(ctx context.Context, projectID, clusterName string) error
+	StartOutageSimulation(ctx context.Context, projectID, clusterName string, simulation *akov2.OutageSimulation) (*OutageSimulation, error)
+	GetOutageSimulation(ctx context.Context, projectID, clusterName string) (*OutageSimulation, error)
+	EndOutageSimulation(ctx context.Context, projectID, clusterName string) (*OutageSimulation, error)
+	TakeSnapshot(ctx context.Context, projectID, clusterName string, snapshot *akov2.OnDemandSnapshot) (*Snapshot, error)
+	GetSnapshot(ctx context.Context, projectID, clusterName, snapshotID string) (*Snapshot, error)
+	FindSnapshot(ctx context.Context, projectID, clusterName string, snapshot *akov2.OnDemandSnapshot, since time.Time) (*Snapshot, error)
+	ExportSnapshot(ctx context.Context, projectID, clusterName, exportBucketID string, export *akov2.SnapshotExport) (*ExportJob, error)
+	GetExportJob(ctx context.Context, projectID, clusterName, exportJobID string) (*ExportJob, error)
+	FindExportJob(ctx context.Context, projectID, clusterName, exportBucketID, snapshotID string, since time.Time) (*ExportJob, error)
+}
+
+// OutageSimulation is a regional outage simulated on a deployment
+type OutageSimulation struct {
+	ID    string
+	State string
+}
+
+// Snapshot is an on-demand snapshot of a deployment
+type Snapshot struct {
+	ID     string
+	Status string
+}
+
+// ExportJob is the export of a snapshot of a deployment to a bucket
+type ExportJob struct {
+	ID    string
+	State string
+}
+
+type deploymentActionService struct {
+	clustersAPI admin.ClustersApi
+	outageAPI   admin.ClusterOutageSimulationApi
+	backupsAPI  admin.CloudBackupsApi
+}
+
+func NewDeploymentActionServiceFromClientSet(clientSet *atlas.ClientSet) DeploymentActionService {
+	client := clientSet.SdkClient20250312002
+	return NewDeploymentActionService(client.ClustersApi, client.ClusterOutageSimulationApi, client.CloudBackupsApi)
+}
+
+func NewDeploymentActionService(clustersAPI admin.ClustersApi, outageAPI admin.ClusterOutageSimulationApi, backupsAPI admin.CloudBackupsApi) DeploymentActionService {
+	return &deploymentActionService{clustersAPI: clustersAPI, outageAPI: outageAPI, backupsAPI: backupsAPI}
+}
+
+func (s *deploymentActionService) TestFailover(ctx context.Context, projectID, clusterName string) error {
+	_, err := s.clustersAPI.TestFailover(ctx, projectID, clusterName).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to test failover of cluster %s: %w", clusterName, err)
+	}
+	return nil
+}
+
+func (s *deploymentActionService) StartOutageSimulation(ctx context.Context, projectID, clusterName string, simulation *akov2.OutageSimulation) (*OutageSimulation, error) {
+	filters := make([]admin.AtlasClusterOutageSimulationOutageFilter, 0, len(simulation.Regions))
+	for _, region := range simulation.Regions {
+		filters = append(filters, admin.AtlasClusterOutageSimulationOutageFilter{
+			CloudProvider: pointer.MakePtr(region.CloudProvider),
+			RegionName:    pointer.MakePtr(region.RegionName),
+			Type:          pointer.MakePtr(outageTypeRegion),
+		})
+	}
+	started, _, err := s.outageAPI.StartOutageSimulation(ctx, projectID, clusterName, &admin.ClusterOutageSimulation{OutageFilters: &filters}).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start outage simulation of cluster %s: %w", clusterName, err)
+	}
+	return outageSimulationFromAtlas(started), nil
+}
+
+func (s *deploymentActionService) GetOutageSimulation(ctx context.Context, projectID, clusterName string) (*OutageSimulation, error) {
+	simulation, httpResp, err := s.outageAPI.GetOutageSimulation(ctx, projectID, clusterName).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outage simulation of cluster %s: %w", clusterName, err)
+	}
+	return outageSimulationFromAtlas(simulation), nil
+}
+
+func (s *deploymentActionService) EndOutageSimulation(ctx context.Context, projectID, clusterName string) (*OutageSimulation, error) {
+	ended, httpResp, err := s.outageAPI.EndOutageSimulation(ctx, projectID, clusterName).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to end outage simulation of cluster %s: %w", clusterName, err)
+	}
+	return outageSimulationFromAtlas(ended), nil
+}
+
+func (s *deploymentActionService) TakeSnapshot(ctx context.Context, projectID, clusterName string, snapshot *akov2.OnDemandSnapshot) (*Snapshot, error) {
+	request := &admin.DiskBackupOnDemandSnapshotRequest{}
+	if snapshot != nil {
+		request.Description = pointer.MakePtrOrNil(snapshot.Description)
+		request.RetentionInDays = pointer.MakePtrOrNil(snapshot.RetentionInDays)
+	}
+	taken, _, err := s.backupsAPI.TakeSnapshot(ctx, projectID, clusterName, request).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to take snapshot of cluster %s: %w", clusterName, err)
+	}
+	return &Snapshot{ID: taken.GetId(), Status: taken.GetStatus()}, nil
+}
+
+func (s *deploymentActionService) GetSnapshot(ctx context.Context, projectID, clusterName, snapshotID string) (*Snapshot, error) {
+	cluster, _, err := s.clustersAPI.GetCluster(ctx, projectID, clusterName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster %s: %w", clusterName, err)
+	}
+
+	var snapshotStatus string
+	var httpResp *http.Response
+	switch cluster.GetClusterType() {
+	case "SHARDED", "GEOSHARDED":
+		var snapshot *admin.DiskBackupShardedClusterSnapshot
+		snapshot, httpResp, err = s.backupsAPI.GetShardedClusterBackup(ctx, projectID, clusterName, snapshotID).Execute()
+		snapshotStatus = snapshot.GetStatus()
+	default:
+		var snapshot *admin.DiskBackupReplicaSet
+		snapshot, httpResp, err = s.backupsAPI.GetReplicaSetBackup(ctx, projectID, clusterName, snapshotID).Execute()
+		snapshotStatus = snapshot.GetStatus()
+	}
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot %s of cluster %s: %w", snapshotID, clusterName, err)
+	}
+	return &Snapshot{ID: snapshotID, Status: snapshotStatus}, nil
+}
+
+// FindSnapshot returns the on-demand snapshot with the given description taken closest to the given time,
+// allowing for Atlas to have recorded it up to clockSkew earlier
+func (s *deploymentActionService) FindSnapshot(ctx context.Context, projectID, clusterName string, snapshot *akov2.OnDemandSnapshot, since time.Time) (*Snapshot, error) {
+	cluster, _, err := s.clustersAPI.GetCluster(ctx, projectID, clusterName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster %s: %w", clusterName, err)
+	}
+
+	var description string
+	if snapshot != nil {
+		description = snapshot.Description
+	}
+	var found *Snapshot
+	var foundAt time.Time
+	match := func(id, snapshotType, snapshotDescription, snapshotStatus string, createdAt time.Time) {
+		if snapshotType != snapshotTypeOnDemand || snapshotDescription != description || createdAt.Before(since.Add(-clockSkew)) {
+			return
+		}
+		if found == nil || closer(createdAt, foundAt, since) {
+			found, foundAt = &Snapshot{ID: id, Status: snapshotStatus}, createdAt
+		}
+	}
+	switch cluster.GetClusterType() {
+	case "SHARDED", "GEOSHARDED":
+		snapshots, _, err := s.backupsAPI.ListShardedClusterBackups(ctx, projectID, clusterName).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list snapshots of cluster %s: %w", clusterName, err)
+		}
+		// Atlas returns every snapshot of a sharded cluster at once, an incomplete
+		// list could hide the snapshot and have it taken again
+		if len(snapshots.GetResults()) < snapshots.GetTotalCount() {
+			return nil, fmt.Errorf("failed to list snapshots of cluster %s: got %d of %d", clusterName, len(snapshots.GetResults()), snapshots.GetTotalCount())
+		}
+		for _, snapshot := range snapshots.GetResults() {
+			match(snapshot.GetId(), snapshot.GetSnapshotType(), snapshot.GetDescription(), snapshot.GetStatus(), snapshot.GetCreatedAt())
+		}
+	default:
+		snapshots, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.DiskBackupReplicaSet], *http.Response, error) {
+			return s.backupsAPI.ListReplicaSetBackups(ctx, projectID, clusterName).PageNum(pageNum).Execute()
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list snapshots of cluster %s: %w", clusterName, err)
+		}
+		for _, snapshot := range snapshots {
+			match(snapshot.GetId(), snapshot.GetSnapshotType(), snapshot.GetDescription(), snapshot.GetStatus(), snapshot.GetCreatedAt())
+		}
+	}
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	return found, nil
+}
+
+func (s *deploymentActionService) ExportSnapshot(ctx context.Context, projectID, clusterName, exportBucketID string, export *akov2.SnapshotExport) (*ExportJob, error) {
+	request := admin.NewDiskBackupExportJobRequest(exportBucketID, export.SnapshotID)
+	if len(export.CustomData) > 0 {
+		keys := make([]string, 0, len(export.CustomData))
+		for key := range export.CustomData {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		labels := make([]admin.BackupLabel, 0, len(keys))
+		for _, key := range keys {
+			labels = append(labels, admin.BackupLabel{Key: pointer.MakePtr(key), Value: pointer.MakePtr(export.CustomData[key])})
+		}
+		request.CustomData = &labels
+	}
+	job, _, err := s.backupsAPI.CreateBackupExportJob(ctx, projectID, clusterName, request).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to export snapshot %s of cluster %s: %w", export.SnapshotID, clusterName, err)
+	}
+	return &ExportJob{ID: job.GetId(), State: job.GetState()}, nil
+}
+
+func (s *deploymentActionService) GetExportJob(ctx context.Context, projectID, clusterName, exportJobID string) (*ExportJob, error) {
+	job, httpResp, err := s.backupsAPI.GetBackupExportJob(ctx, projectID, clusterName, exportJobID).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export job %s of cluster %s: %w", exportJobID, clusterName, err)
+	}
+	return &ExportJob{ID: job.GetId(), State: job.GetState()}, nil
+}
+
+// FindExportJob returns the export job of the snapshot to the bucket created closest to the given time,
+// allowing for Atlas to have recorded it up to clockSkew earlier
+func (s *deploymentActionService) FindExportJob(ctx context.Context, projectID, clusterName, exportBucketID, snapshotID string, since time.Time) (*ExportJob, error) {
+	jobs, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.DiskBackupExportJob], *http.Response, error) {
+		return s.backupsAPI.ListBackupExportJobs(ctx, projectID, clusterName).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list export jobs of cluster %s: %w", clusterName, err)
+	}
+
+	var found *admin.DiskBackupExportJob
+	for i := range jobs {
+		job := &jobs[i]
+		if job.ExportBucketId != exportBucketID || job.GetSnapshotId() != snapshotID || job.GetCreatedAt().Before(since.Add(-clockSkew)) {
+			continue
+		}
+		if found == nil || closer(job.GetCreatedAt(), found.GetCreatedAt(), since) {
+			found = job
+		}
+	}
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	return &ExportJob{ID: found.GetId(), State: found.GetState()}, nil
+}
+
+// closer tells whether a is closer to the given time than b
+func closer(a, b, to time.Time) bool {
+	return a.Sub(to).Abs() < b.Sub(to).Abs()
+}
+
+func outageSimulationFromAtlas(simulation *admin.ClusterOutageSimulation) *OutageSimulation {
+	return &OutageSimulation{ID: simulation.GetId(), State: simulation.GetState()}
+}
@@ -0,0 +1,438 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploymentaction_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	"go.mongodb.org/atlas-sdk/v20250312002/mockadmin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/deploymentaction"
+)
+
+const (
+	testProjectID   = "fake-project-id"
+	testClusterName = "my-cluster"
+	testSnapshotID  = "fake-snapshot-id"
+	testBucketID    = "fake-bucket-id"
+	testExportJobID = "fake-export-job-id"
+)
+
+var ErrFakeFailure = errors.New("fake-failure")
+
+var testSince = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func TestTestFailover(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		err         error
+		expectedErr error
+	}{
+		{
+			title: "failover is requested",
+		},
+		{
+			title:       "failures are reported",
+			err:         ErrFakeFailure,
+			expectedErr: ErrFakeFailure,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			clustersAPI := mockadmin.NewClustersApi(t)
+			clustersAPI.EXPECT().TestFailover(mock.Anything, testProjectID, testClusterName).
+				Return(admin.TestFailoverApiRequest{ApiService: clustersAPI})
+			clustersAPI.EXPECT().TestFailoverExecute(mock.AnythingOfType("admin.TestFailoverApiRequest")).
+				Return(nil, tc.err)
+			s := deploymentaction.NewDeploymentActionService(clustersAPI, nil, nil)
+
+			err := s.TestFailover(context.Background(), testProjectID, testClusterName)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestStartOutageSimulation(t *testing.T) {
+	outageAPI := mockadmin.NewClusterOutageSimulationApi(t)
+	outageAPI.EXPECT().StartOutageSimulation(mock.Anything, testProjectID, testClusterName, &admin.ClusterOutageSimulation{
+		OutageFilters: &[]admin.AtlasClusterOutageSimulationOutageFilter{
+			{CloudProvider: pointer.MakePtr("AWS"), RegionName: pointer.MakePtr("US_EAST_1"), Type: pointer.MakePtr("REGION")},
+		},
+	}).Return(admin.StartOutageSimulationApiRequest{ApiService: outageAPI})
+	outageAPI.EXPECT().StartOutageSimulationExecute(mock.AnythingOfType("admin.StartOutageSimulationApiRequest")).
+		Return(&admin.ClusterOutageSimulation{Id: pointer.MakePtr("fake-simulation-id"), State: pointer.MakePtr("START_REQUESTED")}, nil, nil)
+	s := deploymentaction.NewDeploymentActionService(nil, outageAPI, nil)
+
+	simulation, err := s.StartOutageSimulation(context.Background(), testProjectID, testClusterName, &akov2.OutageSimulation{
+		Regions: []akov2.OutageSimulationRegion{{CloudProvider: "AWS", RegionName: "US_EAST_1"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &deploymentaction.OutageSimulation{ID: "fake-simulation-id", State: "START_REQUESTED"}, simulation)
+}
+
+func TestGetOutageSimulation(t *testing.T) {
+	for _, tc := range []struct {
+		title              string
+		simulation         *admin.ClusterOutageSimulation
+		httpResp           *http.Response
+		err                error
+		expectedSimulation *deploymentaction.OutageSimulation
+		expectedErr        error
+	}{
+		{
+			title:              "simulations are converted",
+			simulation:         &admin.ClusterOutageSimulation{Id: pointer.MakePtr("fake-simulation-id"), State: pointer.MakePtr("SIMULATING")},
+			expectedSimulation: &deploymentaction.OutageSimulation{ID: "fake-simulation-id", State: "SIMULATING"},
+		},
+		{
+			title:       "missing simulations are not found",
+			httpResp:    &http.Response{StatusCode: http.StatusNotFound},
+			err:         ErrFakeFailure,
+			expectedErr: deploymentaction.ErrNotFound,
+		},
+		{
+			title:       "other failures are reported",
+			err:         ErrFakeFailure,
+			expectedErr: ErrFakeFailure,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			outageAPI := mockadmin.NewClusterOutageSimulationApi(t)
+			outageAPI.EXPECT().GetOutageSimulation(mock.Anything, testProjectID, testClusterName).
+				Return(admin.GetOutageSimulationApiRequest{ApiService: outageAPI})
+			outageAPI.EXPECT().GetOutageSimulationExecute(mock.AnythingOfType("admin.GetOutageSimulationApiRequest")).
+				Return(tc.simulation, tc.httpResp, tc.err)
+			s := deploymentaction.NewDeploymentActionService(nil, outageAPI, nil)
+
+			simulation, err := s.GetOutageSimulation(context.Background(), testProjectID, testClusterName)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedSimulation, simulation)
+		})
+	}
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	backupsAPI := mockadmin.NewCloudBackupsApi(t)
+	backupsAPI.EXPECT().TakeSnapshot(mock.Anything, testProjectID, testClusterName, &admin.DiskBackupOnDemandSnapshotRequest{
+		Description:     pointer.MakePtr("before migration"),
+		RetentionInDays: pointer.MakePtr(3),
+	}).Return(admin.TakeSnapshotApiRequest{ApiService: backupsAPI})
+	backupsAPI.EXPECT().TakeSnapshotExecute(mock.AnythingOfType("admin.TakeSnapshotApiRequest")).
+		Return(&admin.DiskBackupSnapshot{Id: pointer.MakePtr(testSnapshotID), Status: pointer.MakePtr("queued")}, nil, nil)
+	s := deploymentaction.NewDeploymentActionService(nil, nil, backupsAPI)
+
+	snapshot, err := s.TakeSnapshot(context.Background(), testProjectID, testClusterName, &akov2.OnDemandSnapshot{
+		Description:     "before migration",
+		RetentionInDays: 3,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &deploymentaction.Snapshot{ID: testSnapshotID, Status: "queued"}, snapshot)
+}
+
+func TestGetSnapshot(t *testing.T) {
+	for _, tc := range []struct {
+		title            string
+		clusterType      string
+		backupsAPI       func(t *testing.T) admin.CloudBackupsApi
+		expectedSnapshot *deploymentaction.Snapshot
+		expectedErr      error
+	}{
+		{
+			title:       "replica set snapshots are read",
+			clusterType: "REPLICASET",
+			backupsAPI: func(t *testing.T) admin.CloudBackupsApi {
+				backupsAPI := mockadmin.NewCloudBackupsApi(t)
+				backupsAPI.EXPECT().GetReplicaSetBackup(mock.Anything, testProjectID, testClusterName, testSnapshotID).
+					Return(admin.GetReplicaSetBackupApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().GetReplicaSetBackupExecute(mock.AnythingOfType("admin.GetReplicaSetBackupApiRequest")).
+					Return(&admin.DiskBackupReplicaSet{Status: pointer.MakePtr("completed")}, nil, nil)
+				return backupsAPI
+			},
+			expectedSnapshot: &deploymentaction.Snapshot{ID: testSnapshotID, Status: "completed"},
+		},
+		{
+			title:       "sharded cluster snapshots are read",
+			clusterType: "SHARDED",
+			backupsAPI: func(t *testing.T) admin.CloudBackupsApi {
+				backupsAPI := mockadmin.NewCloudBackupsApi(t)
+				backupsAPI.EXPECT().GetShardedClusterBackup(mock.Anything, testProjectID, testClusterName, testSnapshotID).
+					Return(admin.GetShardedClusterBackupApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().GetShardedClusterBackupExecute(mock.AnythingOfType("admin.GetShardedClusterBackupApiRequest")).
+					Return(&admin.DiskBackupShardedClusterSnapshot{Status: pointer.MakePtr("inProgress")}, nil, nil)
+				return backupsAPI
+			},
+			expectedSnapshot: &deploymentaction.Snapshot{ID: testSnapshotID, Status: "inProgress"},
+		},
+		{
+			title:       "missing snapshots are not found",
+			clusterType: "REPLICASET",
+			backupsAPI: func(t *testing.T) admin.CloudBackupsApi {
+				backupsAPI := mockadmin.NewCloudBackupsApi(t)
+				backupsAPI.EXPECT().GetReplicaSetBackup(mock.Anything, testProjectID, testClusterName, testSnapshotID).
+					Return(admin.GetReplicaSetBackupApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().GetReplicaSetBackupExecute(mock.AnythingOfType("admin.GetReplicaSetBackupApiRequest")).
+					Return(nil, &http.Response{StatusCode: http.StatusNotFound}, ErrFakeFailure)
+				return backupsAPI
+			},
+			expectedErr: deploymentaction.ErrNotFound,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			clustersAPI := mockadmin.NewClustersApi(t)
+			clustersAPI.EXPECT().GetCluster(mock.Anything, testProjectID, testClusterName).
+				Return(admin.GetClusterApiRequest{ApiService: clustersAPI})
+			clustersAPI.EXPECT().GetClusterExecute(mock.AnythingOfType("admin.GetClusterApiRequest")).
+				Return(&admin.ClusterDescription20240805{ClusterType: pointer.MakePtr(tc.clusterType)}, nil, nil)
+			s := deploymentaction.NewDeploymentActionService(clustersAPI, nil, tc.backupsAPI(t))
+
+			snapshot, err := s.GetSnapshot(context.Background(), testProjectID, testClusterName, testSnapshotID)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedSnapshot, snapshot)
+		})
+	}
+}
+
+func TestFindSnapshot(t *testing.T) {
+	for _, tc := range []struct {
+		title            string
+		clusterType      string
+		backupsAPI       func(t *testing.T) admin.CloudBackupsApi
+		expectedSnapshot *deploymentaction.Snapshot
+		expectedErr      error
+		expectedErrMsg   string
+	}{
+		{
+			title:       "the matching on-demand snapshot closest to the start is found",
+			clusterType: "REPLICASET",
+			backupsAPI: func(t *testing.T) admin.CloudBackupsApi {
+				backupsAPI := mockadmin.NewCloudBackupsApi(t)
+				backupsAPI.EXPECT().ListReplicaSetBackups(mock.Anything, testProjectID, testClusterName).
+					Return(admin.ListReplicaSetBackupsApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().ListReplicaSetBackupsExecute(mock.AnythingOfType("admin.ListReplicaSetBackupsApiRequest")).
+					Return(&admin.PaginatedCloudBackupReplicaSet{
+						Results: &[]admin.DiskBackupReplicaSet{
+							{Id: pointer.MakePtr("too-early"), SnapshotType: pointer.MakePtr("onDemand"), Description: pointer.MakePtr("before migration"), CreatedAt: pointer.MakePtr(testSince.Add(-10 * time.Minute))},
+							{Id: pointer.MakePtr("scheduled"), SnapshotType: pointer.MakePtr("scheduled"), Description: pointer.MakePtr("before migration"), CreatedAt: pointer.MakePtr(testSince.Add(time.Second))},
+							{Id: pointer.MakePtr("other-description"), SnapshotType: pointer.MakePtr("onDemand"), Description: pointer.MakePtr("other"), CreatedAt: pointer.MakePtr(testSince.Add(time.Second))},
+							{Id: pointer.MakePtr("later"), SnapshotType: pointer.MakePtr("onDemand"), Description: pointer.MakePtr("before migration"), CreatedAt: pointer.MakePtr(testSince.Add(time.Minute)), Status: pointer.MakePtr("queued")},
+							{Id: pointer.MakePtr(testSnapshotID), SnapshotType: pointer.MakePtr("onDemand"), Description: pointer.MakePtr("before migration"), CreatedAt: pointer.MakePtr(testSince.Add(time.Second)), Status: pointer.MakePtr("inProgress")},
+						},
+						TotalCount: pointer.MakePtr(5),
+					}, nil, nil)
+				return backupsAPI
+			},
+			expectedSnapshot: &deploymentaction.Snapshot{ID: testSnapshotID, Status: "inProgress"},
+		},
+		{
+			title:       "sharded cluster snapshots are found",
+			clusterType: "SHARDED",
+			backupsAPI: func(t *testing.T) admin.CloudBackupsApi {
+				backupsAPI := mockadmin.NewCloudBackupsApi(t)
+				backupsAPI.EXPECT().ListShardedClusterBackups(mock.Anything, testProjectID, testClusterName).
+					Return(admin.ListShardedClusterBackupsApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().ListShardedClusterBackupsExecute(mock.AnythingOfType("admin.ListShardedClusterBackupsApiRequest")).
+					Return(&admin.PaginatedCloudBackupShardedClusterSnapshot{
+						Results: &[]admin.DiskBackupShardedClusterSnapshot{
+							{Id: pointer.MakePtr(testSnapshotID), SnapshotType: pointer.MakePtr("onDemand"), Description: pointer.MakePtr("before migration"), CreatedAt: pointer.MakePtr(testSince), Status: pointer.MakePtr("queued")},
+						},
+						TotalCount: pointer.MakePtr(1),
+					}, nil, nil)
+				return backupsAPI
+			},
+			expectedSnapshot: &deploymentaction.Snapshot{ID: testSnapshotID, Status: "queued"},
+		},
+		{
+			title:       "incomplete sharded cluster snapshot lists fail",
+			clusterType: "SHARDED",
+			backupsAPI: func(t *testing.T) admin.CloudBackupsApi {
+				backupsAPI := mockadmin.NewCloudBackupsApi(t)
+				backupsAPI.EXPECT().ListShardedClusterBackups(mock.Anything, testProjectID, testClusterName).
+					Return(admin.ListShardedClusterBackupsApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().ListShardedClusterBackupsExecute(mock.AnythingOfType("admin.ListShardedClusterBackupsApiRequest")).
+					Return(&admin.PaginatedCloudBackupShardedClusterSnapshot{
+						Results:    &[]admin.DiskBackupShardedClusterSnapshot{{Id: pointer.MakePtr("other")}},
+						TotalCount: pointer.MakePtr(2),
+					}, nil, nil)
+				return backupsAPI
+			},
+			expectedErrMsg: "failed to list snapshots of cluster my-cluster: got 1 of 2",
+		},
+		{
+			title:       "snapshots Atlas recorded slightly before the start are found",
+			clusterType: "REPLICASET",
+			backupsAPI: func(t *testing.T) admin.CloudBackupsApi {
+				backupsAPI := mockadmin.NewCloudBackupsApi(t)
+				backupsAPI.EXPECT().ListReplicaSetBackups(mock.Anything, testProjectID, testClusterName).
+					Return(admin.ListReplicaSetBackupsApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().ListReplicaSetBackupsExecute(mock.AnythingOfType("admin.ListReplicaSetBackupsApiRequest")).
+					Return(&admin.PaginatedCloudBackupReplicaSet{
+						Results: &[]admin.DiskBackupReplicaSet{
+							{Id: pointer.MakePtr(testSnapshotID), SnapshotType: pointer.MakePtr("onDemand"), Description: pointer.MakePtr("before migration"), CreatedAt: pointer.MakePtr(testSince.Add(-2 * time.Minute)), Status: pointer.MakePtr("queued")},
+						},
+						TotalCount: pointer.MakePtr(1),
+					}, nil, nil)
+				return backupsAPI
+			},
+			expectedSnapshot: &deploymentaction.Snapshot{ID: testSnapshotID, Status: "queued"},
+		},
+		{
+			title:       "snapshots never taken are not found",
+			clusterType: "REPLICASET",
+			backupsAPI: func(t *testing.T) admin.CloudBackupsApi {
+				backupsAPI := mockadmin.NewCloudBackupsApi(t)
+				backupsAPI.EXPECT().ListReplicaSetBackups(mock.Anything, testProjectID, testClusterName).
+					Return(admin.ListReplicaSetBackupsApiRequest{ApiService: backupsAPI})
+				backupsAPI.EXPECT().ListReplicaSetBackupsExecute(mock.AnythingOfType("admin.ListReplicaSetBackupsApiRequest")).
+					Return(&admin.PaginatedCloudBackupReplicaSet{Results: &[]admin.DiskBackupReplicaSet{}}, nil, nil)
+				return backupsAPI
+			},
+			expectedErr: deploymentaction.ErrNotFound,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			clustersAPI := mockadmin.NewClustersApi(t)
+			clustersAPI.EXPECT().GetCluster(mock.Anything, testProjectID, testClusterName).
+				Return(admin.GetClusterApiRequest{ApiService: clustersAPI})
+			clustersAPI.EXPECT().GetClusterExecute(mock.AnythingOfType("admin.GetClusterApiRequest")).
+				Return(&admin.ClusterDescription20240805{ClusterType: pointer.MakePtr(tc.clusterType)}, nil, nil)
+			s := deploymentaction.NewDeploymentActionService(clustersAPI, nil, tc.backupsAPI(t))
+
+			snapshot, err := s.FindSnapshot(context.Background(), testProjectID, testClusterName, &akov2.OnDemandSnapshot{Description: "before migration"}, testSince)
+			if tc.expectedErrMsg != "" {
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			} else {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			}
+			assert.Equal(t, tc.expectedSnapshot, snapshot)
+		})
+	}
+}
+
+func TestExportSnapshot(t *testing.T) {
+	backupsAPI := mockadmin.NewCloudBackupsApi(t)
+	backupsAPI.EXPECT().CreateBackupExportJob(mock.Anything, testProjectID, testClusterName, &admin.DiskBackupExportJobRequest{
+		ExportBucketId: testBucketID,
+		SnapshotId:     testSnapshotID,
+		CustomData: &[]admin.BackupLabel{
+			{Key: pointer.MakePtr("reason"), Value: pointer.MakePtr("audit")},
+			{Key: pointer.MakePtr("team"), Value: pointer.MakePtr("dba")},
+		},
+	}).Return(admin.CreateBackupExportJobApiRequest{ApiService: backupsAPI})
+	backupsAPI.EXPECT().CreateBackupExportJobExecute(mock.AnythingOfType("admin.CreateBackupExportJobApiRequest")).
+		Return(&admin.DiskBackupExportJob{Id: pointer.MakePtr(testExportJobID), State: pointer.MakePtr("Queued")}, nil, nil)
+	s := deploymentaction.NewDeploymentActionService(nil, nil, backupsAPI)
+
+	job, err := s.ExportSnapshot(context.Background(), testProjectID, testClusterName, testBucketID, &akov2.SnapshotExport{
+		SnapshotID: testSnapshotID,
+		CustomData: map[string]string{"team": "dba", "reason": "audit"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &deploymentaction.ExportJob{ID: testExportJobID, State: "Queued"}, job)
+}
+
+func TestGetExportJob(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		job         *admin.DiskBackupExportJob
+		httpResp    *http.Response
+		err         error
+		expectedJob *deploymentaction.ExportJob
+		expectedErr error
+	}{
+		{
+			title:       "export jobs are reported",
+			job:         &admin.DiskBackupExportJob{Id: pointer.MakePtr(testExportJobID), State: pointer.MakePtr("InProgress")},
+			expectedJob: &deploymentaction.ExportJob{ID: testExportJobID, State: "InProgress"},
+		},
+		{
+			title:       "missing export jobs are reported as not found",
+			httpResp:    &http.Response{StatusCode: http.StatusNotFound},
+			err:         ErrFakeFailure,
+			expectedErr: deploymentaction.ErrNotFound,
+		},
+		{
+			title:       "other failures are wrapped",
+			err:         ErrFakeFailure,
+			expectedErr: ErrFakeFailure,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			backupsAPI := mockadmin.NewCloudBackupsApi(t)
+			backupsAPI.EXPECT().GetBackupExportJob(mock.Anything, testProjectID, testClusterName, testExportJobID).
+				Return(admin.GetBackupExportJobApiRequest{ApiService: backupsAPI})
+			backupsAPI.EXPECT().GetBackupExportJobExecute(mock.AnythingOfType("admin.GetBackupExportJobApiRequest")).
+				Return(tc.job, tc.httpResp, tc.err)
+			s := deploymentaction.NewDeploymentActionService(nil, nil, backupsAPI)
+
+			job, err := s.GetExportJob(context.Background(), testProjectID, testClusterName, testExportJobID)
+			assert.Equal(t, tc.expectedJob, job)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestFindExportJob(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		jobs        []admin.DiskBackupExportJob
+		expectedJob *deploymentaction.ExportJob
+		expectedErr error
+	}{
+		{
+			title: "the export of the snapshot to the bucket closest to the start is found",
+			jobs: []admin.DiskBackupExportJob{
+				{Id: pointer.MakePtr("too-early"), ExportBucketId: testBucketID, SnapshotId: pointer.MakePtr(testSnapshotID), CreatedAt: pointer.MakePtr(testSince.Add(-10 * time.Minute))},
+				{Id: pointer.MakePtr("other-bucket"), ExportBucketId: "other-bucket-id", SnapshotId: pointer.MakePtr(testSnapshotID), CreatedAt: pointer.MakePtr(testSince)},
+				{Id: pointer.MakePtr("other-snapshot"), ExportBucketId: testBucketID, SnapshotId: pointer.MakePtr("other-snapshot-id"), CreatedAt: pointer.MakePtr(testSince)},
+				{Id: pointer.MakePtr("later"), ExportBucketId: testBucketID, SnapshotId: pointer.MakePtr(testSnapshotID), CreatedAt: pointer.MakePtr(testSince.Add(time.Minute))},
+				{Id: pointer.MakePtr(testExportJobID), ExportBucketId: testBucketID, SnapshotId: pointer.MakePtr(testSnapshotID), CreatedAt: pointer.MakePtr(testSince), State: pointer.MakePtr("Queued")},
+			},
+			expectedJob: &deploymentaction.ExportJob{ID: testExportJobID, State: "Queued"},
+		},
+		{
+			title: "export jobs Atlas recorded slightly before the start are found",
+			jobs: []admin.DiskBackupExportJob{
+				{Id: pointer.MakePtr(testExportJobID), ExportBucketId: testBucketID, SnapshotId: pointer.MakePtr(testSnapshotID), CreatedAt: pointer.MakePtr(testSince.Add(-2 * time.Minute)), State: pointer.MakePtr("Queued")},
+			},
+			expectedJob: &deploymentaction.ExportJob{ID: testExportJobID, State: "Queued"},
+		},
+		{
+			title:       "export jobs never created are not found",
+			expectedErr: deploymentaction.ErrNotFound,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			backupsAPI := mockadmin.NewCloudBackupsApi(t)
+			backupsAPI.EXPECT().ListBackupExportJobs(mock.Anything, testProjectID, testClusterName).
+				Return(admin.ListBackupExportJobsApiRequest{ApiService: backupsAPI})
+			backupsAPI.EXPECT().ListBackupExportJobsExecute(mock.AnythingOfType("admin.ListBackupExportJobsApiRequest")).
+				Return(&admin.PaginatedApiAtlasDiskBackupExportJob{Results: &tc.jobs, TotalCount: pointer.MakePtr(len(tc.jobs))}, nil, nil)
+			s := deploymentaction.NewDeploymentActionService(nil, nil, backupsAPI)
+
+			job, err := s.FindExportJob(context.Background(), testProjectID, testClusterName, testBucketID, testSnapshotID, testSince)
+			assert.Equal(t, tc.expectedJob, job)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportbucket
+
+import (
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/provider"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1/status"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+// ExportBucket is a bucket Atlas exports backup snapshots to
+type ExportBucket struct {
+	ID            string
+	CloudProvider string
+	BucketName    string
+	ServiceURL    string
+	RoleID        string
+}
+
+// NewExportBucket returns the export bucket desired by the given custom resource,
+// accessed through the given cloud provider access role
+func NewExportBucket(spec *akov2.AtlasBackupExportBucketSpec, roleID string) *ExportBucket {
+	return &ExportBucket{
+		CloudProvider: spec.CloudProvider,
+		BucketName:    spec.BucketName,
+		ServiceURL:    spec.ServiceURL,
+		RoleID:        roleID,
+	}
+}
+
+// ApplyStatus sets the Atlas side identifiers of the export bucket in the given status
+func ApplyStatus(bucketStatus *status.AtlasBackupExportBucketStatus, bucket *ExportBucket) {
+	bucketStatus.ExportBucketID = bucket.ID
+	bucketStatus.RoleID = bucket.RoleID
+}
+
+func toAtlas(bucket *ExportBucket) *admin.DiskBackupSnapshotExportBucketRequest {
+	req := admin.NewDiskBackupSnapshotExportBucketRequest(bucket.CloudProvider)
+	req.BucketName = pointer.MakePtrOrNil(bucket.BucketName)
+	req.ServiceUrl = pointer.MakePtrOrNil(bucket.ServiceURL)
+	// AWS buckets take the role as iamRoleId, Azure and GCP ones as roleId
+	if bucket.CloudProvider == string(provider.ProviderAWS) {
+		req.IamRoleId = pointer.MakePtrOrNil(bucket.RoleID)
+	} else {
+		req.RoleId = pointer.MakePtrOrNil(bucket.RoleID)
+	}
+	return req
+}
+
+func fromAtlas(bucket *admin.DiskBackupSnapshotExportBucketResponse) *ExportBucket {
+	roleID := bucket.GetRoleId()
+	if bucket.GetCloudProvider() == string(provider.ProviderAWS) {
+		roleID = bucket.GetIamRoleId()
+	}
+	return &ExportBucket{
+		ID:            bucket.GetId(),
+		CloudProvider: bucket.GetCloudProvider(),
+		BucketName:    bucket.GetBucketName(),
+		ServiceURL:    bucket.GetServiceUrl(),
+		RoleID:        roleID,
+	}
+}
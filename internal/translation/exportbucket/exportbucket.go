@@ -0,0 +1,77 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+)
+
+// ErrNotFound means the export bucket is missing in Atlas
+var ErrNotFound = errors.New("not found")
+
+type ExportBucketService interface {
+	Get(ctx context.Context, projectID, exportBucketID string) (*ExportBucket, error)
+	Create(ctx context.Context, projectID string, bucket *ExportBucket) (*ExportBucket, error)
+	Delete(ctx context.Context, projectID, exportBucketID string) error
+}
+
+type exportBucketService struct {
+	backupsAPI admin.CloudBackupsApi
+}
+
+func NewExportBucketServiceFromClientSet(clientSet *atlas.ClientSet) ExportBucketService {
+	return NewExportBucketService(clientSet.SdkClient20250312002.CloudBackupsApi)
+}
+
+func NewExportBucketService(backupsAPI admin.CloudBackupsApi) ExportBucketService {
+	return &exportBucketService{backupsAPI: backupsAPI}
+}
+
+func (s *exportBucketService) Get(ctx context.Context, projectID, exportBucketID string) (*ExportBucket, error) {
+	bucket, httpResp, err := s.backupsAPI.GetExportBucket(ctx, projectID, exportBucketID).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export bucket %s: %w", exportBucketID, err)
+	}
+	return fromAtlas(bucket), nil
+}
+
+func (s *exportBucketService) Create(ctx context.Context, projectID string, bucket *ExportBucket) (*ExportBucket, error) {
+	newBucket, _, err := s.backupsAPI.CreateExportBucket(ctx, projectID, toAtlas(bucket)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export bucket at project %s: %w", projectID, err)
+	}
+	return fromAtlas(newBucket), nil
+}
+
+func (s *exportBucketService) Delete(ctx context.Context, projectID, exportBucketID string) error {
+	httpResp, err := s.backupsAPI.DeleteExportBucket(ctx, projectID, exportBucketID).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete export bucket %s: %w", exportBucketID, err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportbucket_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	"go.mongodb.org/atlas-sdk/v20250312002/mockadmin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/exportbucket"
+)
+
+const (
+	testProjectID = "fake-project-id"
+	testBucketID  = "fake-bucket-id"
+	testRoleID    = "fake-role-id"
+)
+
+var ErrFakeFailure = errors.New("fake-failure")
+
+func TestExportBucketGet(t *testing.T) {
+	for _, tc := range []struct {
+		title          string
+		bucket         *admin.DiskBackupSnapshotExportBucketResponse
+		httpResp       *http.Response
+		err            error
+		expectedBucket *exportbucket.ExportBucket
+		expectedErr    error
+	}{
+		{
+			title: "AWS buckets take the role from iamRoleId",
+			bucket: &admin.DiskBackupSnapshotExportBucketResponse{
+				Id:            testBucketID,
+				CloudProvider: "AWS",
+				BucketName:    "snapshots",
+				IamRoleId:     pointer.MakePtr(testRoleID),
+			},
+			expectedBucket: &exportbucket.ExportBucket{
+				ID:            testBucketID,
+				CloudProvider: "AWS",
+				BucketName:    "snapshots",
+				RoleID:        testRoleID,
+			},
+		},
+		{
+			title: "Azure buckets take the role from roleId",
+			bucket: &admin.DiskBackupSnapshotExportBucketResponse{
+				Id:            testBucketID,
+				CloudProvider: "AZURE",
+				BucketName:    "exportcontainer",
+				ServiceUrl:    pointer.MakePtr("https://account.blob.core.windows.net/exportcontainer"),
+				RoleId:        pointer.MakePtr(testRoleID),
+			},
+			expectedBucket: &exportbucket.ExportBucket{
+				ID:            testBucketID,
+				CloudProvider: "AZURE",
+				BucketName:    "exportcontainer",
+				ServiceURL:    "https://account.blob.core.windows.net/exportcontainer",
+				RoleID:        testRoleID,
+			},
+		},
+		{
+			title:       "missing buckets are reported as not found",
+			httpResp:    &http.Response{StatusCode: http.StatusNotFound},
+			err:         ErrFakeFailure,
+			expectedErr: exportbucket.ErrNotFound,
+		},
+		{
+			title:       "other failures are wrapped",
+			err:         ErrFakeFailure,
+			expectedErr: ErrFakeFailure,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			backupsAPI := mockadmin.NewCloudBackupsApi(t)
+			backupsAPI.EXPECT().GetExportBucket(mock.Anything, testProjectID, testBucketID).
+				Return(admin.GetExportBucketApiRequest{ApiService: backupsAPI})
+			backupsAPI.EXPECT().GetExportBucketExecute(mock.Anything).Return(tc.bucket, tc.httpResp, tc.err)
+
+			bucket, err := exportbucket.NewExportBucketService(backupsAPI).Get(context.Background(), testProjectID, testBucketID)
+			assert.Equal(t, tc.expectedBucket, bucket)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestExportBucketCreate(t *testing.T) {
+	for _, tc := range []struct {
+		title           string
+		spec            akov2.AtlasBackupExportBucketSpec
+		expectedRequest *admin.DiskBackupSnapshotExportBucketRequest
+		response        *admin.DiskBackupSnapshotExportBucketResponse
+		expectedBucket  *exportbucket.ExportBucket
+	}{
+		{
+			title: "AWS buckets send the role as iamRoleId",
+			spec:  akov2.AtlasBackupExportBucketSpec{CloudProvider: "AWS", BucketName: "snapshots"},
+			expectedRequest: &admin.DiskBackupSnapshotExportBucketRequest{
+				CloudProvider: "AWS",
+				BucketName:    pointer.MakePtr("snapshots"),
+				IamRoleId:     pointer.MakePtr(testRoleID),
+			},
+			response: &admin.DiskBackupSnapshotExportBucketResponse{
+				Id:            testBucketID,
+				CloudProvider: "AWS",
+				BucketName:    "snapshots",
+				IamRoleId:     pointer.MakePtr(testRoleID),
+			},
+			expectedBucket: &exportbucket.ExportBucket{
+				ID:            testBucketID,
+				CloudProvider: "AWS",
+				BucketName:    "snapshots",
+				RoleID:        testRoleID,
+			},
+		},
+		{
+			title: "Azure buckets send the service URL and the role as roleId",
+			spec: akov2.AtlasBackupExportBucketSpec{
+				CloudProvider: "AZURE",
+				ServiceURL:    "https://account.blob.core.windows.net/exportcontainer",
+			},
+			expectedRequest: &admin.DiskBackupSnapshotExportBucketRequest{
+				CloudProvider: "AZURE",
+				ServiceUrl:    pointer.MakePtr("https://account.blob.core.windows.net/exportcontainer"),
+				RoleId:        pointer.MakePtr(testRoleID),
+			},
+			response: &admin.DiskBackupSnapshotExportBucketResponse{
+				Id:            testBucketID,
+				CloudProvider: "AZURE",
+				BucketName:    "exportcontainer",
+				ServiceUrl:    pointer.MakePtr("https://account.blob.core.windows.net/exportcontainer"),
+				RoleId:        pointer.MakePtr(testRoleID),
+			},
+			expectedBucket: &exportbucket.ExportBucket{
+				ID:            testBucketID,
+				CloudProvider: "AZURE",
+				BucketName:    "exportcontainer",
+				ServiceURL:    "https://account.blob.core.windows.net/exportcontainer",
+				RoleID:        testRoleID,
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			backupsAPI := mockadmin.NewCloudBackupsApi(t)
+			backupsAPI.EXPECT().CreateExportBucket(mock.Anything, testProjectID, tc.expectedRequest).
+				Return(admin.CreateExportBucketApiRequest{ApiService: backupsAPI})
+			backupsAPI.EXPECT().CreateExportBucketExecute(mock.Anything).Return(tc.response, nil, nil)
+
+			bucket, err := exportbucket.NewExportBucketService(backupsAPI).Create(
+				context.Background(), testProjectID, exportbucket.NewExportBucket(&tc.spec, testRoleID))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedBucket, bucket)
+		})
+	}
+}
+
+func TestExportBucketDelete(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		httpResp    *http.Response
+		err         error
+		expectedErr error
+	}{
+		{
+			title: "buckets are deleted",
+		},
+		{
+			title:       "missing buckets are reported as not found",
+			httpResp:    &http.Response{StatusCode: http.StatusNotFound},
+			err:         ErrFakeFailure,
+			expectedErr: exportbucket.ErrNotFound,
+		},
+		{
+			title:       "other failures are wrapped",
+			err:         ErrFakeFailure,
+			expectedErr: ErrFakeFailure,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			backupsAPI := mockadmin.NewCloudBackupsApi(t)
+			backupsAPI.EXPECT().DeleteExportBucket(mock.Anything, testProjectID, testBucketID).
+				Return(admin.DeleteExportBucketApiRequest{ApiService: backupsAPI})
+			backupsAPI.EXPECT().DeleteExportBucketExecute(mock.Anything).Return(tc.httpResp, tc.err)
+
+			err := exportbucket.NewExportBucketService(backupsAPI).Delete(context.Background(), testProjectID, testBucketID)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityprovider
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+const (
+	ProtocolOIDC = "OIDC"
+	ProtocolSAML = "SAML"
+
+	IdpTypeWorkload = "WORKLOAD"
+
+	AuthorizationTypeUser = "USER"
+
+	defaultUserClaim = "sub"
+)
+
+// IdentityProvider is a federation identity provider as set up in Atlas,
+// only the settings of its protocol are set
+type IdentityProvider struct {
+	ID                string
+	OktaIdpID         string
+	DisplayName       string
+	Description       string
+	Protocol          string
+	IdpType           string
+	IssuerURI         string
+	AssociatedDomains []string
+
+	Audience          string
+	AuthorizationType string
+	ClientID          string
+	GroupsClaim       string
+	UserClaim         string
+	RequestedScopes   []string
+
+	SSOURL                     string
+	RequestBinding             string
+	ResponseSignatureAlgorithm string
+	SSODebugEnabled            bool
+	Status                     string
+	Certificates               []Certificate
+}
+
+// Certificate is a SAML signing certificate, Atlas only reports its validity
+// so the Content is only known on the desired side
+type Certificate struct {
+	Content   string
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// NewWorkloadIdentityProvider returns the OIDC Workload identity provider trusting the
+// service account tokens of the given issuer
+func NewWorkloadIdentityProvider(spec *akov2.WorkloadIdentityProvider, issuerURI string) *IdentityProvider {
+	userClaim := spec.UserClaim
+	if userClaim == "" {
+		userClaim = defaultUserClaim
+	}
+	return &IdentityProvider{
+		DisplayName:       spec.DisplayName,
+		Description:       spec.Description,
+		Protocol:          ProtocolOIDC,
+		IdpType:           IdpTypeWorkload,
+		IssuerURI:         issuerURI,
+		Audience:          spec.Audience,
+		AuthorizationType: AuthorizationTypeUser,
+		UserClaim:         userClaim,
+	}
+}
+
+// NewFromSpec returns the identity provider declared by an AtlasIdentityProvider
+func NewFromSpec(spec *akov2.AtlasIdentityProviderSpec) (*IdentityProvider, error) {
+	idp := &IdentityProvider{
+		DisplayName:       spec.DisplayName,
+		Description:       spec.Description,
+		Protocol:          spec.Protocol,
+		IssuerURI:         spec.IssuerURI,
+		AssociatedDomains: spec.AssociatedDomains,
+	}
+	if spec.OIDC != nil {
+		idp.IdpType = spec.OIDC.IdpType
+		idp.Audience = spec.OIDC.Audience
+		idp.AuthorizationType = spec.OIDC.AuthorizationType
+		idp.ClientID = spec.OIDC.ClientID
+		idp.GroupsClaim = spec.OIDC.GroupsClaim
+		idp.UserClaim = spec.OIDC.UserClaim
+		idp.RequestedScopes = spec.OIDC.RequestedScopes
+	}
+	if spec.SAML != nil {
+		certs := make([]Certificate, 0, len(spec.SAML.Certificates))
+		for i, content := range spec.SAML.Certificates {
+			cert, err := parseCertificate(content)
+			if err != nil {
+				return nil, fmt.Errorf("invalid certificate %d: %w", i, err)
+			}
+			certs = append(certs, *cert)
+		}
+		idp.SSOURL = spec.SAML.SSOURL
+		idp.RequestBinding = spec.SAML.RequestBinding
+		idp.ResponseSignatureAlgorithm = spec.SAML.ResponseSignatureAlgorithm
+		idp.SSODebugEnabled = spec.SAML.SSODebugEnabled
+		idp.Status = spec.SAML.Status
+		idp.Certificates = certs
+	}
+	return idp, nil
+}
+
+func parseCertificate(content string) (*Certificate, error) {
+	block, _ := pem.Decode([]byte(content))
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return &Certificate{Content: content, NotBefore: cert.NotBefore, NotAfter: cert.NotAfter}, nil
+}
+
+// EqualSettings tells whether both identity providers share the same settings, regardless of their ID
+func (idp *IdentityProvider) EqualSettings(other *IdentityProvider) bool {
+	if idp == nil || other == nil {
+		return idp == other
+	}
+	return reflect.DeepEqual(idp.comparable(), other.comparable())
+}
+
+func (idp *IdentityProvider) comparable() *IdentityProvider {
+	c := *idp
+	c.ID, c.OktaIdpID = "", ""
+	c.AssociatedDomains = sortedOrNil(c.AssociatedDomains)
+	c.RequestedScopes = sortedOrNil(c.RequestedScopes)
+	c.Certificates = make([]Certificate, 0, len(idp.Certificates))
+	for _, cert := range idp.Certificates {
+		c.Certificates = append(c.Certificates, Certificate{
+			NotBefore: cert.NotBefore.UTC().Truncate(time.Second),
+			NotAfter:  cert.NotAfter.UTC().Truncate(time.Second),
+		})
+	}
+	slices.SortFunc(c.Certificates, func(a, b Certificate) int {
+		return a.NotAfter.Compare(b.NotAfter)
+	})
+	return &c
+}
+
+func sortedOrNil(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	return slices.Sorted(slices.Values(values))
+}
+
+func toAtlasCreate(idp *IdentityProvider) *admin.FederationOidcIdentityProviderUpdate {
+	return &admin.FederationOidcIdentityProviderUpdate{
+		DisplayName:       pointer.MakePtrOrNil(idp.DisplayName),
+		Description:       pointer.MakePtrOrNil(idp.Description),
+		Protocol:          pointer.MakePtrOrNil(idp.Protocol),
+		IdpType:           pointer.MakePtrOrNil(idp.IdpType),
+		IssuerUri:         pointer.MakePtrOrNil(idp.IssuerURI),
+		AssociatedDomains: pointer.GetOrNilIfEmpty(idp.AssociatedDomains),
+		Audience:          pointer.MakePtrOrNil(idp.Audience),
+		AuthorizationType: pointer.MakePtrOrNil(idp.AuthorizationType),
+		ClientId:          pointer.MakePtrOrNil(idp.ClientID),
+		GroupsClaim:       pointer.MakePtrOrNil(idp.GroupsClaim),
+		UserClaim:         pointer.MakePtrOrNil(idp.UserClaim),
+		RequestedScopes:   pointer.GetOrNilIfEmpty(idp.RequestedScopes),
+	}
+}
+
+func toAtlasUpdate(idp *IdentityProvider) *admin.FederationIdentityProviderUpdate {
+	update := &admin.FederationIdentityProviderUpdate{
+		DisplayName:       pointer.MakePtrOrNil(idp.DisplayName),
+		Description:       pointer.MakePtrOrNil(idp.Description),
+		Protocol:          pointer.MakePtrOrNil(idp.Protocol),
+		IdpType:           pointer.MakePtrOrNil(idp.IdpType),
+		IssuerUri:         pointer.MakePtrOrNil(idp.IssuerURI),
+		AssociatedDomains: pointer.GetOrNilIfEmpty(idp.AssociatedDomains),
+		Audience:          pointer.MakePtrOrNil(idp.Audience),
+		AuthorizationType: pointer.MakePtrOrNil(idp.AuthorizationType),
+		ClientId:          pointer.MakePtrOrNil(idp.ClientID),
+		GroupsClaim:       pointer.MakePtrOrNil(idp.GroupsClaim),
+		UserClaim:         pointer.MakePtrOrNil(idp.UserClaim),
+		RequestedScopes:   pointer.GetOrNilIfEmpty(idp.RequestedScopes),
+	}
+	if idp.Protocol == ProtocolSAML {
+		update.SsoUrl = pointer.MakePtrOrNil(idp.SSOURL)
+		update.RequestBinding = pointer.MakePtrOrNil(idp.RequestBinding)
+		update.ResponseSignatureAlgorithm = pointer.MakePtrOrNil(idp.ResponseSignatureAlgorithm)
+		update.SsoDebugEnabled = pointer.MakePtr(idp.SSODebugEnabled)
+		update.Status = pointer.MakePtrOrNil(idp.Status)
+		certs := make([]admin.X509CertificateUpdate, 0, len(idp.Certificates))
+		for _, cert := range idp.Certificates {
+			certs = append(certs, admin.X509CertificateUpdate{
+				Content:   pointer.MakePtrOrNil(cert.Content),
+				NotBefore: pointer.MakePtr(cert.NotBefore),
+				NotAfter:  pointer.MakePtr(cert.NotAfter),
+			})
+		}
+		update.PemFileInfo = &admin.PemFileInfoUpdate{Certificates: &certs}
+	}
+	return update
+}
+
+func fromAtlas(idp *admin.FederationIdentityProvider) *IdentityProvider {
+	result := &IdentityProvider{
+		ID:                idp.GetId(),
+		OktaIdpID:         idp.GetOktaIdpId(),
+		DisplayName:       idp.GetDisplayName(),
+		Description:       idp.GetDescription(),
+		Protocol:          idp.GetProtocol(),
+		IdpType:           idp.GetIdpType(),
+		IssuerURI:         idp.GetIssuerUri(),
+		AssociatedDomains: idp.GetAssociatedDomains(),
+	}
+	switch result.Protocol {
+	case ProtocolOIDC:
+		result.Audience = idp.GetAudience()
+		result.AuthorizationType = idp.GetAuthorizationType()
+		result.ClientID = idp.GetClientId()
+		result.GroupsClaim = idp.GetGroupsClaim()
+		result.UserClaim = idp.GetUserClaim()
+		result.RequestedScopes = idp.GetRequestedScopes()
+	case ProtocolSAML:
+		certs := []Certificate{}
+		for _, cert := range idp.PemFileInfo.GetCertificates() {
+			certs = append(certs, Certificate{NotBefore: cert.GetNotBefore(), NotAfter: cert.GetNotAfter()})
+		}
+		result.SSOURL = idp.GetSsoUrl()
+		result.RequestBinding = idp.GetRequestBinding()
+		result.ResponseSignatureAlgorithm = idp.GetResponseSignatureAlgorithm()
+		result.SSODebugEnabled = idp.GetSsoDebugEnabled()
+		result.Status = idp.GetStatus()
+		result.Certificates = certs
+	}
+	return result
+}
+
+func fromAtlasOIDC(idp *admin.FederationOidcIdentityProvider) *IdentityProvider {
+	return &IdentityProvider{
+		ID:                idp.GetId(),
+		OktaIdpID:         idp.GetOktaIdpId(),
+		DisplayName:       idp.GetDisplayName(),
+		Description:       idp.GetDescription(),
+		Protocol:          idp.GetProtocol(),
+		IdpType:           idp.GetIdpType(),
+		IssuerURI:         idp.GetIssuerUri(),
+		AssociatedDomains: idp.GetAssociatedDomains(),
+		Audience:          idp.GetAudience(),
+		AuthorizationType: idp.GetAuthorizationType(),
+		ClientID:          idp.GetClientId(),
+		GroupsClaim:       idp.GetGroupsClaim(),
+		UserClaim:         idp.GetUserClaim(),
+		RequestedScopes:   idp.GetRequestedScopes(),
+	}
+}
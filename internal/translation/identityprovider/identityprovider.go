@@ -0,0 +1,167 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+)
+
+// ErrNotFound means the identity provider is missing in Atlas
+var ErrNotFound = errors.New("not found")
+
+type IdentityProviderService interface {
+	Get(ctx context.Context, federationSettingsID, id string) (*IdentityProvider, error)
+	Create(ctx context.Context, federationSettingsID string, idp *IdentityProvider) (*IdentityProvider, error)
+	Update(ctx context.Context, federationSettingsID, id string, idp *IdentityProvider) (*IdentityProvider, error)
+	Delete(ctx context.Context, federationSettingsID, id string) error
+	FederationSettingsID(ctx context.Context, orgID string) (string, error)
+	FindByIssuer(ctx context.Context, federationSettingsID, protocol, issuerURI string) (*IdentityProvider, error)
+	ConnectOrg(ctx context.Context, federationSettingsID, orgID string, idp *IdentityProvider) error
+	DisconnectOrg(ctx context.Context, federationSettingsID, orgID string, idp *IdentityProvider) error
+}
+
+type identityProviderService struct {
+	federationAPI admin.FederatedAuthenticationApi
+}
+
+func NewIdentityProviderServiceFromClientSet(clientSet *atlas.ClientSet) IdentityProviderService {
+	return NewIdentityProviderService(clientSet.SdkClient20250312002.FederatedAuthenticationApi)
+}
+
+func NewIdentityProviderService(federationAPI admin.FederatedAuthenticationApi) IdentityProviderService {
+	return &identityProviderService{federationAPI: federationAPI}
+}
+
+func (s *identityProviderService) Get(ctx context.Context, federationSettingsID, id string) (*IdentityProvider, error) {
+	idp, httpResp, err := s.federationAPI.GetIdentityProvider(ctx, federationSettingsID, id).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identity provider %s: %w", id, err)
+	}
+	return fromAtlas(idp), nil
+}
+
+func (s *identityProviderService) Create(ctx context.Context, federationSettingsID string, idp *IdentityProvider) (*IdentityProvider, error) {
+	newIDP, _, err := s.federationAPI.CreateIdentityProvider(ctx, federationSettingsID, toAtlasCreate(idp)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create identity provider %q: %w", idp.DisplayName, err)
+	}
+	return fromAtlasOIDC(newIDP), nil
+}
+
+func (s *identityProviderService) Update(ctx context.Context, federationSettingsID, id string, idp *IdentityProvider) (*IdentityProvider, error) {
+	updatedIDP, _, err := s.federationAPI.UpdateIdentityProvider(ctx, federationSettingsID, id, toAtlasUpdate(idp)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update identity provider %s: %w", id, err)
+	}
+	return fromAtlas(updatedIDP), nil
+}
+
+func (s *identityProviderService) Delete(ctx context.Context, federationSettingsID, id string) error {
+	httpResp, err := s.federationAPI.DeleteIdentityProvider(ctx, federationSettingsID, id).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete identity provider %s: %w", id, err)
+	}
+	return nil
+}
+
+func (s *identityProviderService) FederationSettingsID(ctx context.Context, orgID string) (string, error) {
+	settings, _, err := s.federationAPI.GetFederationSettings(ctx, orgID).Execute()
+	if err != nil {
+		return "", fmt.Errorf("failed to get federation settings for organization %s: %w", orgID, err)
+	}
+	return settings.GetId(), nil
+}
+
+func (s *identityProviderService) FindByIssuer(ctx context.Context, federationSettingsID, protocol, issuerURI string) (*IdentityProvider, error) {
+	idps, _, err := s.federationAPI.ListIdentityProviders(ctx, federationSettingsID).Protocol([]string{protocol}).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s identity providers: %w", protocol, err)
+	}
+	for _, idp := range idps.GetResults() {
+		if idp.GetIssuerUri() == issuerURI {
+			return fromAtlas(&idp), nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// ConnectOrg makes SAML identity providers the UI access identity provider of the
+// organization and allows OIDC ones for data access
+func (s *identityProviderService) ConnectOrg(ctx context.Context, federationSettingsID, orgID string, idp *IdentityProvider) error {
+	return s.updateOrgConfig(ctx, federationSettingsID, orgID, func(config *admin.ConnectedOrgConfig) bool {
+		if idp.Protocol == ProtocolSAML {
+			if config.GetIdentityProviderId() == idp.OktaIdpID {
+				return false
+			}
+			config.SetIdentityProviderId(idp.OktaIdpID)
+			return true
+		}
+		ids := config.GetDataAccessIdentityProviderIds()
+		if slices.Contains(ids, idp.ID) {
+			return false
+		}
+		config.SetDataAccessIdentityProviderIds(append(slices.Clone(ids), idp.ID))
+		return true
+	})
+}
+
+// DisconnectOrg stops allowing OIDC identity providers for data access, the UI access
+// identity provider of an organization cannot be unset, so SAML ones are left connected
+func (s *identityProviderService) DisconnectOrg(ctx context.Context, federationSettingsID, orgID string, idp *IdentityProvider) error {
+	if idp.Protocol == ProtocolSAML {
+		return nil
+	}
+	return s.updateOrgConfig(ctx, federationSettingsID, orgID, func(config *admin.ConnectedOrgConfig) bool {
+		ids := config.GetDataAccessIdentityProviderIds()
+		if !slices.Contains(ids, idp.ID) {
+			return false
+		}
+		config.SetDataAccessIdentityProviderIds(slices.DeleteFunc(slices.Clone(ids), func(id string) bool {
+			return id == idp.ID
+		}))
+		return true
+	})
+}
+
+func (s *identityProviderService) updateOrgConfig(ctx context.Context, federationSettingsID, orgID string, mutate func(*admin.ConnectedOrgConfig) bool) error {
+	config, httpResp, err := s.federationAPI.GetConnectedOrgConfig(ctx, federationSettingsID, orgID).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get the configuration of organization %s: %w", orgID, err)
+	}
+	if !mutate(config) {
+		return nil
+	}
+	if _, _, err := s.federationAPI.UpdateConnectedOrgConfig(ctx, federationSettingsID, orgID, config).Execute(); err != nil {
+		return fmt.Errorf("failed to update the configuration of organization %s: %w", orgID, err)
+	}
+	return nil
+}
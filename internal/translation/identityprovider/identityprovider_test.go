@@ -0,0 +1,424 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityprovider_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	"go.mongodb.org/atlas-sdk/v20250312002/mockadmin"
+
+	akov2 "github.com/mongodb/mongodb-atlas-kubernetes/v2/api/v1"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/identityprovider"
+)
+
+const (
+	testFederationSettingsID = "fake-federation-settings-id"
+	testIDPID                = "fake-idp-id"
+	testIssuer               = "https://oidc.example.com/cluster"
+)
+
+var ErrFakeFailure = errors.New("fake-failure")
+
+func testWorkloadIDP() *identityprovider.IdentityProvider {
+	return identityprovider.NewWorkloadIdentityProvider(&akov2.WorkloadIdentityProvider{
+		DisplayName: "my-cluster",
+		Audience:    "atlas",
+	}, testIssuer)
+}
+
+func TestNewWorkloadIdentityProvider(t *testing.T) {
+	assert.Equal(t, &identityprovider.IdentityProvider{
+		DisplayName:       "my-cluster",
+		Protocol:          "OIDC",
+		IdpType:           "WORKLOAD",
+		IssuerURI:         testIssuer,
+		Audience:          "atlas",
+		AuthorizationType: "USER",
+		UserClaim:         "sub",
+	}, testWorkloadIDP())
+}
+
+func TestEqualSettings(t *testing.T) {
+	idp := testWorkloadIDP()
+	withID := testWorkloadIDP()
+	withID.ID = testIDPID
+	assert.True(t, idp.EqualSettings(withID))
+
+	changed := testWorkloadIDP()
+	changed.Audience = "other"
+	assert.False(t, idp.EqualSettings(changed))
+	assert.False(t, idp.EqualSettings(nil))
+}
+
+func TestIdentityProviderGet(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		idp         *admin.FederationIdentityProvider
+		httpResp    *http.Response
+		err         error
+		expectedIDP *identityprovider.IdentityProvider
+		expectedErr error
+	}{
+		{
+			title: "OIDC providers are converted",
+			idp: &admin.FederationIdentityProvider{
+				Id:                testIDPID,
+				DisplayName:       pointer.MakePtr("my-cluster"),
+				Protocol:          pointer.MakePtr("OIDC"),
+				IdpType:           pointer.MakePtr("WORKLOAD"),
+				IssuerUri:         pointer.MakePtr(testIssuer),
+				Audience:          pointer.MakePtr("atlas"),
+				AuthorizationType: pointer.MakePtr("USER"),
+				UserClaim:         pointer.MakePtr("sub"),
+			},
+			expectedIDP: func() *identityprovider.IdentityProvider {
+				idp := testWorkloadIDP()
+				idp.ID = testIDPID
+				return idp
+			}(),
+		},
+		{
+			title:       "missing providers are not found",
+			httpResp:    &http.Response{StatusCode: http.StatusNotFound},
+			err:         ErrFakeFailure,
+			expectedErr: identityprovider.ErrNotFound,
+		},
+		{
+			title:       "other failures are reported",
+			err:         ErrFakeFailure,
+			expectedErr: ErrFakeFailure,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			ctx := context.Background()
+			api := mockadmin.NewFederatedAuthenticationApi(t)
+			api.EXPECT().GetIdentityProvider(ctx, testFederationSettingsID, testIDPID).
+				Return(admin.GetIdentityProviderApiRequest{ApiService: api})
+			api.EXPECT().GetIdentityProviderExecute(mock.Anything).Return(tc.idp, tc.httpResp, tc.err)
+
+			idp, err := identityprovider.NewIdentityProviderService(api).Get(ctx, testFederationSettingsID, testIDPID)
+			assert.Equal(t, tc.expectedIDP, idp)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestIdentityProviderCreate(t *testing.T) {
+	ctx := context.Background()
+	api := mockadmin.NewFederatedAuthenticationApi(t)
+	api.EXPECT().CreateIdentityProvider(ctx, testFederationSettingsID, &admin.FederationOidcIdentityProviderUpdate{
+		DisplayName:       pointer.MakePtr("my-cluster"),
+		Protocol:          pointer.MakePtr("OIDC"),
+		IdpType:           pointer.MakePtr("WORKLOAD"),
+		IssuerUri:         pointer.MakePtr(testIssuer),
+		Audience:          pointer.MakePtr("atlas"),
+		AuthorizationType: pointer.MakePtr("USER"),
+		UserClaim:         pointer.MakePtr("sub"),
+	}).Return(admin.CreateIdentityProviderApiRequest{ApiService: api})
+	api.EXPECT().CreateIdentityProviderExecute(mock.Anything).Return(&admin.FederationOidcIdentityProvider{
+		Id:          testIDPID,
+		DisplayName: pointer.MakePtr("my-cluster"),
+		Protocol:    pointer.MakePtr("OIDC"),
+	}, nil, nil)
+
+	idp, err := identityprovider.NewIdentityProviderService(api).Create(ctx, testFederationSettingsID, testWorkloadIDP())
+	require.NoError(t, err)
+	assert.Equal(t, testIDPID, idp.ID)
+}
+
+func TestIdentityProviderUpdate(t *testing.T) {
+	ctx := context.Background()
+	api := mockadmin.NewFederatedAuthenticationApi(t)
+	api.EXPECT().UpdateIdentityProvider(ctx, testFederationSettingsID, testIDPID, mock.MatchedBy(func(update *admin.FederationIdentityProviderUpdate) bool {
+		return update.GetAudience() == "atlas" && update.GetIssuerUri() == testIssuer
+	})).Return(admin.UpdateIdentityProviderApiRequest{ApiService: api})
+	api.EXPECT().UpdateIdentityProviderExecute(mock.Anything).Return(nil, nil, ErrFakeFailure)
+
+	idp, err := identityprovider.NewIdentityProviderService(api).Update(ctx, testFederationSettingsID, testIDPID, testWorkloadIDP())
+	assert.Nil(t, idp)
+	assert.ErrorIs(t, err, ErrFakeFailure)
+}
+
+func TestIdentityProviderDelete(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		httpResp    *http.Response
+		err         error
+		expectedErr error
+	}{
+		{
+			title: "providers are deleted",
+		},
+		{
+			title:       "missing providers are not found",
+			httpResp:    &http.Response{StatusCode: http.StatusNotFound},
+			err:         ErrFakeFailure,
+			expectedErr: identityprovider.ErrNotFound,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			ctx := context.Background()
+			api := mockadmin.NewFederatedAuthenticationApi(t)
+			api.EXPECT().DeleteIdentityProvider(ctx, testFederationSettingsID, testIDPID).
+				Return(admin.DeleteIdentityProviderApiRequest{ApiService: api})
+			api.EXPECT().DeleteIdentityProviderExecute(mock.Anything).Return(tc.httpResp, tc.err)
+
+			err := identityprovider.NewIdentityProviderService(api).Delete(ctx, testFederationSettingsID, testIDPID)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func testCertificate(t *testing.T, notBefore, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestNewFromSpec(t *testing.T) {
+	notBefore := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := notBefore.AddDate(1, 0, 0)
+	cert := testCertificate(t, notBefore, notAfter)
+
+	t.Run("OIDC settings are converted", func(t *testing.T) {
+		idp, err := identityprovider.NewFromSpec(&akov2.AtlasIdentityProviderSpec{
+			Protocol:    "OIDC",
+			DisplayName: "corporate",
+			IssuerURI:   testIssuer,
+			OIDC: &akov2.OIDCIdentityProviderSettings{
+				IdpType:           "WORKFORCE",
+				Audience:          "atlas",
+				ClientID:          "client",
+				AuthorizationType: "GROUP",
+				GroupsClaim:       "groups",
+				UserClaim:         "sub",
+				RequestedScopes:   []string{"openid"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &identityprovider.IdentityProvider{
+			DisplayName:       "corporate",
+			Protocol:          "OIDC",
+			IdpType:           "WORKFORCE",
+			IssuerURI:         testIssuer,
+			Audience:          "atlas",
+			AuthorizationType: "GROUP",
+			ClientID:          "client",
+			GroupsClaim:       "groups",
+			UserClaim:         "sub",
+			RequestedScopes:   []string{"openid"},
+		}, idp)
+	})
+
+	t.Run("SAML certificates are parsed", func(t *testing.T) {
+		idp, err := identityprovider.NewFromSpec(&akov2.AtlasIdentityProviderSpec{
+			Protocol:  "SAML",
+			IssuerURI: testIssuer,
+			SAML: &akov2.SAMLIdentityProviderSettings{
+				SSOURL:       "https://sso.example.com",
+				Status:       "ACTIVE",
+				Certificates: []string{cert},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []identityprovider.Certificate{{Content: cert, NotBefore: notBefore, NotAfter: notAfter}}, idp.Certificates)
+	})
+
+	t.Run("invalid SAML certificates are rejected", func(t *testing.T) {
+		_, err := identityprovider.NewFromSpec(&akov2.AtlasIdentityProviderSpec{
+			Protocol: "SAML",
+			SAML:     &akov2.SAMLIdentityProviderSettings{Certificates: []string{"not a certificate"}},
+		})
+		assert.ErrorContains(t, err, "invalid certificate 0: no PEM data found")
+	})
+}
+
+func TestEqualSettingsSAML(t *testing.T) {
+	notBefore := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	spec := &identityprovider.IdentityProvider{
+		Protocol:          "SAML",
+		AssociatedDomains: []string{"b.example.com", "a.example.com"},
+		SSOURL:            "https://sso.example.com",
+		Certificates: []identityprovider.Certificate{
+			{Content: "pem", NotBefore: notBefore, NotAfter: notBefore.AddDate(1, 0, 0)},
+		},
+	}
+	atlas := &identityprovider.IdentityProvider{
+		ID:                testIDPID,
+		OktaIdpID:         "okta-id",
+		Protocol:          "SAML",
+		AssociatedDomains: []string{"a.example.com", "b.example.com"},
+		SSOURL:            "https://sso.example.com",
+		Certificates: []identityprovider.Certificate{
+			{NotBefore: notBefore.In(time.FixedZone("CET", 3600)), NotAfter: notBefore.AddDate(1, 0, 0)},
+		},
+	}
+	assert.True(t, spec.EqualSettings(atlas))
+
+	atlas.Certificates[0].NotAfter = notBefore.AddDate(2, 0, 0)
+	assert.False(t, spec.EqualSettings(atlas), "rotated certificates must be detected")
+}
+
+func TestFederationSettingsID(t *testing.T) {
+	ctx := context.Background()
+	api := mockadmin.NewFederatedAuthenticationApi(t)
+	api.EXPECT().GetFederationSettings(ctx, "org-id").
+		Return(admin.GetFederationSettingsApiRequest{ApiService: api})
+	api.EXPECT().GetFederationSettingsExecute(mock.Anything).
+		Return(&admin.OrgFederationSettings{Id: pointer.MakePtr(testFederationSettingsID)}, nil, nil)
+
+	id, err := identityprovider.NewIdentityProviderService(api).FederationSettingsID(ctx, "org-id")
+	require.NoError(t, err)
+	assert.Equal(t, testFederationSettingsID, id)
+}
+
+func TestFindByIssuer(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		idps        []admin.FederationIdentityProvider
+		expectedID  string
+		expectedErr error
+	}{
+		{
+			title: "providers are matched by issuer",
+			idps: []admin.FederationIdentityProvider{
+				{Id: "other", Protocol: pointer.MakePtr("SAML"), IssuerUri: pointer.MakePtr("https://other.example.com")},
+				{Id: testIDPID, Protocol: pointer.MakePtr("SAML"), IssuerUri: pointer.MakePtr(testIssuer)},
+			},
+			expectedID: testIDPID,
+		},
+		{
+			title: "missing providers are not found",
+			idps: []admin.FederationIdentityProvider{
+				{Id: "other", Protocol: pointer.MakePtr("SAML"), IssuerUri: pointer.MakePtr("https://other.example.com")},
+			},
+			expectedErr: identityprovider.ErrNotFound,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			ctx := context.Background()
+			api := mockadmin.NewFederatedAuthenticationApi(t)
+			api.EXPECT().ListIdentityProviders(ctx, testFederationSettingsID).
+				Return(admin.ListIdentityProvidersApiRequest{ApiService: api})
+			api.EXPECT().ListIdentityProvidersExecute(mock.Anything).
+				Return(&admin.PaginatedFederationIdentityProvider{Results: &tc.idps}, nil, nil)
+
+			idp, err := identityprovider.NewIdentityProviderService(api).FindByIssuer(ctx, testFederationSettingsID, "SAML", testIssuer)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedID != "" {
+				assert.Equal(t, tc.expectedID, idp.ID)
+			}
+		})
+	}
+}
+
+func TestOrgConnection(t *testing.T) {
+	oidc := &identityprovider.IdentityProvider{ID: testIDPID, Protocol: "OIDC"}
+	saml := &identityprovider.IdentityProvider{ID: testIDPID, OktaIdpID: "okta-id", Protocol: "SAML"}
+	for _, tc := range []struct {
+		title          string
+		disconnect     bool
+		idp            *identityprovider.IdentityProvider
+		config         *admin.ConnectedOrgConfig
+		expectedConfig *admin.ConnectedOrgConfig
+	}{
+		{
+			title:  "OIDC providers are allowed for data access",
+			idp:    oidc,
+			config: &admin.ConnectedOrgConfig{OrgId: "org-id", DataAccessIdentityProviderIds: &[]string{"other"}},
+			expectedConfig: &admin.ConnectedOrgConfig{
+				OrgId:                         "org-id",
+				DataAccessIdentityProviderIds: &[]string{"other", testIDPID},
+			},
+		},
+		{
+			title:  "connected OIDC providers are left untouched",
+			idp:    oidc,
+			config: &admin.ConnectedOrgConfig{OrgId: "org-id", DataAccessIdentityProviderIds: &[]string{testIDPID}},
+		},
+		{
+			title:  "SAML providers become the UI access provider",
+			idp:    saml,
+			config: &admin.ConnectedOrgConfig{OrgId: "org-id"},
+			expectedConfig: &admin.ConnectedOrgConfig{
+				OrgId:              "org-id",
+				IdentityProviderId: pointer.MakePtr("okta-id"),
+			},
+		},
+		{
+			title:      "OIDC providers are disconnected",
+			disconnect: true,
+			idp:        oidc,
+			config:     &admin.ConnectedOrgConfig{OrgId: "org-id", DataAccessIdentityProviderIds: &[]string{"other", testIDPID}},
+			expectedConfig: &admin.ConnectedOrgConfig{
+				OrgId:                         "org-id",
+				DataAccessIdentityProviderIds: &[]string{"other"},
+			},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			ctx := context.Background()
+			api := mockadmin.NewFederatedAuthenticationApi(t)
+			api.EXPECT().GetConnectedOrgConfig(ctx, testFederationSettingsID, "org-id").
+				Return(admin.GetConnectedOrgConfigApiRequest{ApiService: api})
+			api.EXPECT().GetConnectedOrgConfigExecute(mock.Anything).Return(tc.config, nil, nil)
+			if tc.expectedConfig != nil {
+				api.EXPECT().UpdateConnectedOrgConfig(ctx, testFederationSettingsID, "org-id", tc.expectedConfig).
+					Return(admin.UpdateConnectedOrgConfigApiRequest{ApiService: api})
+				api.EXPECT().UpdateConnectedOrgConfigExecute(mock.Anything).Return(tc.expectedConfig, nil, nil)
+			}
+
+			service := identityprovider.NewIdentityProviderService(api)
+			var err error
+			if tc.disconnect {
+				err = service.DisconnectOrg(ctx, testFederationSettingsID, "org-id", tc.idp)
+			} else {
+				err = service.ConnectOrg(ctx, testFederationSettingsID, "org-id", tc.idp)
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDisconnectOrgLeavesSAMLProviders(t *testing.T) {
+	api := mockadmin.NewFederatedAuthenticationApi(t)
+	err := identityprovider.NewIdentityProviderService(api).DisconnectOrg(context.Background(), testFederationSettingsID, "org-id",
+		&identityprovider.IdentityProvider{ID: testIDPID, Protocol: "SAML"})
+	assert.NoError(t, err)
+}
@@ -43,6 +43,7 @@ type NetworkContainerService interface {
 	Create(ctx context.Context, projectID string, cfg *NetworkContainerConfig) (*NetworkContainer, error)
 	Get(ctx context.Context, projectID, containerID string) (*NetworkContainer, error)
 	Find(ctx context.Context, projectID string, cfg *NetworkContainerConfig) (*NetworkContainer, error)
+	List(ctx context.Context, projectID, provider string) ([]*NetworkContainer, error)
 	Update(ctx context.Context, projectID, containerID string, cfg *NetworkContainerConfig) (*NetworkContainer, error)
 	Delete(ctx context.Context, projectID, containerID string) error
 }
@@ -79,15 +80,12 @@ func (np *networkContainerService) Get(ctx context.Context, projectID, container
 }
 
 func (np *networkContainerService) Find(ctx context.Context, projectID string, cfg *NetworkContainerConfig) (*NetworkContainer, error) {
-	atlasContainers, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.CloudProviderContainer], *http.Response, error) {
-		return np.peeringAPI.ListPeeringContainerByCloudProvider(ctx, projectID).ProviderName(cfg.Provider).PageNum(pageNum).Execute()
-	})
+	atlasContainers, err := np.List(ctx, projectID, cfg.Provider)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list containers at project %s: %w", projectID, err)
+		return nil, err
 	}
 	containers := []*NetworkContainer{}
-	for _, atlasContainer := range atlasContainers {
-		container := fromAtlas(&atlasContainer)
+	for _, container := range atlasContainers {
 		switch provider.ProviderName(cfg.Provider) {
 		case provider.ProviderGCP:
 			if container.CIDRBlock == cfg.CIDRBlock {
@@ -108,6 +106,20 @@ func (np *networkContainerService) Find(ctx context.Context, projectID string, c
 	return containers[0], nil
 }
 
+func (np *networkContainerService) List(ctx context.Context, projectID, provider string) ([]*NetworkContainer, error) {
+	atlasContainers, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.CloudProviderContainer], *http.Response, error) {
+		return np.peeringAPI.ListPeeringContainerByCloudProvider(ctx, projectID).ProviderName(provider).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers at project %s: %w", projectID, err)
+	}
+	containers := make([]*NetworkContainer, 0, len(atlasContainers))
+	for _, atlasContainer := range atlasContainers {
+		containers = append(containers, fromAtlas(&atlasContainer))
+	}
+	return containers, nil
+}
+
 func (np *networkContainerService) Update(ctx context.Context, projectID, containerID string, cfg *NetworkContainerConfig) (*NetworkContainer, error) {
 	updatedContainer, _, err := np.peeringAPI.UpdatePeeringContainer(ctx, projectID, containerID, toAtlasConfig(cfg)).Execute()
 	if err != nil {
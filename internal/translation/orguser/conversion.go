@@ -0,0 +1,120 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orguser
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312006/admin"
+)
+
+const (
+	MembershipStatusPending = "PENDING"
+	MembershipStatusActive  = "ACTIVE"
+
+	RoleOrgOwner = "ORG_OWNER"
+)
+
+// OrgUser is a member of an Atlas organization, or a user invited to it
+type OrgUser struct {
+	ID                  string
+	Username            string
+	OrgRoles            []string
+	ProjectRoles        map[string][]string
+	MembershipStatus    string
+	InvitationExpiresAt *time.Time
+	// TeamIDs are the teams of the organization the user belongs to, as reported by Atlas
+	TeamIDs []string
+}
+
+// EqualRoles tells whether both users hold the same organization and project roles
+func (u *OrgUser) EqualRoles(other *OrgUser) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	return reflect.DeepEqual(normalizeRoles(u.OrgRoles), normalizeRoles(other.OrgRoles)) &&
+		reflect.DeepEqual(normalizeProjectRoles(u.ProjectRoles), normalizeProjectRoles(other.ProjectRoles))
+}
+
+// IsPending tells whether the user has not accepted the invitation to the organization yet
+func (u *OrgUser) IsPending() bool {
+	return u.MembershipStatus == MembershipStatusPending
+}
+
+func normalizeRoles(roles []string) []string {
+	if len(roles) == 0 {
+		return nil
+	}
+	return slices.Compact(slices.Sorted(slices.Values(roles)))
+}
+
+func normalizeProjectRoles(projectRoles map[string][]string) map[string][]string {
+	normalized := map[string][]string{}
+	for projectID, roles := range projectRoles {
+		if len(roles) > 0 {
+			normalized[projectID] = normalizeRoles(roles)
+		}
+	}
+	return normalized
+}
+
+func toAtlasRoles(user *OrgUser) admin.OrgUserRolesRequest {
+	assignments := make([]admin.GroupRoleAssignment, 0, len(user.ProjectRoles))
+	for _, projectID := range slices.Sorted(maps.Keys(user.ProjectRoles)) {
+		roles := normalizeRoles(user.ProjectRoles[projectID])
+		if len(roles) == 0 {
+			continue
+		}
+		assignments = append(assignments, admin.GroupRoleAssignment{
+			GroupId:    admin.PtrString(projectID),
+			GroupRoles: &roles,
+		})
+	}
+	return admin.OrgUserRolesRequest{
+		OrgRoles:             normalizeRoles(user.OrgRoles),
+		GroupRoleAssignments: &assignments,
+	}
+}
+
+func toAtlasCreate(user *OrgUser) *admin.OrgUserRequest {
+	return &admin.OrgUserRequest{
+		Username: user.Username,
+		Roles:    toAtlasRoles(user),
+	}
+}
+
+func toAtlasUpdate(user *OrgUser) *admin.OrgUserUpdateRequest {
+	roles := toAtlasRoles(user)
+	return &admin.OrgUserUpdateRequest{Roles: &roles}
+}
+
+func fromAtlas(user *admin.OrgUserResponse) *OrgUser {
+	projectRoles := map[string][]string{}
+	for _, assignment := range user.Roles.GetGroupRoleAssignments() {
+		projectRoles[assignment.GetGroupId()] = assignment.GetGroupRoles()
+	}
+	return &OrgUser{
+		ID:                  user.GetId(),
+		Username:            user.GetUsername(),
+		OrgRoles:            user.Roles.GetOrgRoles(),
+		ProjectRoles:        projectRoles,
+		MembershipStatus:    user.GetOrgMembershipStatus(),
+		InvitationExpiresAt: user.InvitationExpiresAt,
+		TeamIDs:             user.GetTeamIds(),
+	}
+}
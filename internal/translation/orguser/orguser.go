@@ -0,0 +1,116 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orguser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312006/admin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/controller/atlas"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/paging"
+)
+
+// ErrNotFound means the user is neither a member of the organization nor invited to it
+var ErrNotFound = errors.New("not found")
+
+type OrgUserService interface {
+	Get(ctx context.Context, orgID, userID string) (*OrgUser, error)
+	FindByUsername(ctx context.Context, orgID, username string) (*OrgUser, error)
+	List(ctx context.Context, orgID string) ([]OrgUser, error)
+	Invite(ctx context.Context, orgID string, user *OrgUser) (*OrgUser, error)
+	UpdateRoles(ctx context.Context, orgID, userID string, user *OrgUser) (*OrgUser, error)
+	Remove(ctx context.Context, orgID, userID string) error
+}
+
+type orgUserService struct {
+	usersAPI admin.MongoDBCloudUsersApi
+}
+
+func NewOrgUserServiceFromClientSet(clientSet *atlas.ClientSet) OrgUserService {
+	return NewOrgUserService(clientSet.SdkClient20250312006.MongoDBCloudUsersApi)
+}
+
+func NewOrgUserService(usersAPI admin.MongoDBCloudUsersApi) OrgUserService {
+	return &orgUserService{usersAPI: usersAPI}
+}
+
+func (s *orgUserService) Get(ctx context.Context, orgID, userID string) (*OrgUser, error) {
+	user, httpResp, err := s.usersAPI.GetOrganizationUser(ctx, orgID, userID).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return nil, errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %s of organization %s: %w", userID, orgID, err)
+	}
+	return fromAtlas(user), nil
+}
+
+func (s *orgUserService) FindByUsername(ctx context.Context, orgID, username string) (*OrgUser, error) {
+	users, _, err := s.usersAPI.ListOrganizationUsers(ctx, orgID).Username(username).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up user %q in organization %s: %w", username, orgID, err)
+	}
+	for _, user := range users.GetResults() {
+		if user.GetUsername() == username {
+			return fromAtlas(&user), nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *orgUserService) List(ctx context.Context, orgID string) ([]OrgUser, error) {
+	atlasUsers, err := paging.ListAll(ctx, func(ctx context.Context, pageNum int) (paging.Response[admin.OrgUserResponse], *http.Response, error) {
+		return s.usersAPI.ListOrganizationUsers(ctx, orgID).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users of organization %s: %w", orgID, err)
+	}
+	users := make([]OrgUser, 0, len(atlasUsers))
+	for _, user := range atlasUsers {
+		users = append(users, *fromAtlas(&user))
+	}
+	return users, nil
+}
+
+func (s *orgUserService) Invite(ctx context.Context, orgID string, user *OrgUser) (*OrgUser, error) {
+	invited, _, err := s.usersAPI.CreateOrganizationUser(ctx, orgID, toAtlasCreate(user)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to invite user %q to organization %s: %w", user.Username, orgID, err)
+	}
+	return fromAtlas(invited), nil
+}
+
+func (s *orgUserService) UpdateRoles(ctx context.Context, orgID, userID string, user *OrgUser) (*OrgUser, error) {
+	updated, _, err := s.usersAPI.UpdateOrganizationUser(ctx, orgID, userID, toAtlasUpdate(user)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update the roles of user %q in organization %s: %w", user.Username, orgID, err)
+	}
+	return fromAtlas(updated), nil
+}
+
+func (s *orgUserService) Remove(ctx context.Context, orgID, userID string) error {
+	httpResp, err := s.usersAPI.RemoveOrganizationUser(ctx, orgID, userID).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return errors.Join(err, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove user %s from organization %s: %w", userID, orgID, err)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orguser_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312006/admin"
+	"go.mongodb.org/atlas-sdk/v20250312006/mockadmin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/translation/orguser"
+)
+
+const (
+	testOrgID     = "fake-org-id"
+	testUserID    = "fake-user-id"
+	testProjectID = "fake-project-id"
+	testUsername  = "jane.doe@example.com"
+)
+
+var ErrFakeFailure = errors.New("fake-failure")
+
+func testUser() *orguser.OrgUser {
+	return &orguser.OrgUser{
+		Username:     testUsername,
+		OrgRoles:     []string{"ORG_MEMBER"},
+		ProjectRoles: map[string][]string{testProjectID: {"GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_WRITE"}},
+	}
+}
+
+func testAtlasUser(status string) *admin.OrgUserResponse {
+	return &admin.OrgUserResponse{
+		Id:                  testUserID,
+		Username:            testUsername,
+		OrgMembershipStatus: status,
+		Roles: admin.OrgUserRolesResponse{
+			OrgRoles: &[]string{"ORG_MEMBER"},
+			GroupRoleAssignments: &[]admin.GroupRoleAssignment{
+				{GroupId: pointer.MakePtr(testProjectID), GroupRoles: &[]string{"GROUP_DATA_ACCESS_READ_WRITE", "GROUP_READ_ONLY"}},
+			},
+		},
+	}
+}
+
+func TestEqualRoles(t *testing.T) {
+	user := testUser()
+	reordered := testUser()
+	reordered.ID = testUserID
+	reordered.ProjectRoles[testProjectID] = []string{"GROUP_DATA_ACCESS_READ_WRITE", "GROUP_READ_ONLY"}
+	reordered.ProjectRoles["project-without-roles"] = nil
+	assert.True(t, user.EqualRoles(reordered))
+
+	changed := testUser()
+	changed.OrgRoles = []string{"ORG_READ_ONLY"}
+	assert.False(t, user.EqualRoles(changed))
+
+	withoutProject := testUser()
+	withoutProject.ProjectRoles = nil
+	assert.False(t, user.EqualRoles(withoutProject))
+	assert.False(t, user.EqualRoles(nil))
+}
+
+func TestOrgUserGet(t *testing.T) {
+	expiresAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		title        string
+		user         *admin.OrgUserResponse
+		httpResp     *http.Response
+		err          error
+		expectedUser *orguser.OrgUser
+		expectedErr  error
+	}{
+		{
+			title: "pending invitations are converted",
+			user: func() *admin.OrgUserResponse {
+				user := testAtlasUser(orguser.MembershipStatusPending)
+				user.InvitationExpiresAt = &expiresAt
+				return user
+			}(),
+			expectedUser: &orguser.OrgUser{
+				ID:                  testUserID,
+				Username:            testUsername,
+				OrgRoles:            []string{"ORG_MEMBER"},
+				ProjectRoles:        map[string][]string{testProjectID: {"GROUP_DATA_ACCESS_READ_WRITE", "GROUP_READ_ONLY"}},
+				MembershipStatus:    orguser.MembershipStatusPending,
+				InvitationExpiresAt: &expiresAt,
+			},
+		},
+		{
+			title:       "missing users are not found",
+			httpResp:    &http.Response{StatusCode: http.StatusNotFound},
+			err:         ErrFakeFailure,
+			expectedErr: orguser.ErrNotFound,
+		},
+		{
+			title:       "other failures are reported",
+			err:         ErrFakeFailure,
+			expectedErr: ErrFakeFailure,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			ctx := context.Background()
+			api := mockadmin.NewMongoDBCloudUsersApi(t)
+			api.EXPECT().GetOrganizationUser(ctx, testOrgID, testUserID).
+				Return(admin.GetOrganizationUserApiRequest{ApiService: api})
+			api.EXPECT().GetOrganizationUserExecute(mock.Anything).Return(tc.user, tc.httpResp, tc.err)
+
+			user, err := orguser.NewOrgUserService(api).Get(ctx, testOrgID, testUserID)
+			assert.Equal(t, tc.expectedUser, user)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestOrgUserFindByUsername(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		results     []admin.OrgUserResponse
+		expectedID  string
+		expectedErr error
+	}{
+		{
+			title:      "matching users are found",
+			results:    []admin.OrgUserResponse{*testAtlasUser(orguser.MembershipStatusActive)},
+			expectedID: testUserID,
+		},
+		{
+			title:       "users with other usernames are not found",
+			results:     []admin.OrgUserResponse{{Id: "other", Username: "john.doe@example.com"}},
+			expectedErr: orguser.ErrNotFound,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			ctx := context.Background()
+			api := mockadmin.NewMongoDBCloudUsersApi(t)
+			api.EXPECT().ListOrganizationUsers(ctx, testOrgID).
+				Return(admin.ListOrganizationUsersApiRequest{ApiService: api})
+			api.EXPECT().ListOrganizationUsersExecute(mock.Anything).
+				Return(&admin.PaginatedOrgUser{Results: &tc.results, TotalCount: pointer.MakePtr(len(tc.results))}, nil, nil)
+
+			user, err := orguser.NewOrgUserService(api).FindByUsername(ctx, testOrgID, testUsername)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.expectedID, user.ID)
+			}
+		})
+	}
+}
+
+func TestOrgUserList(t *testing.T) {
+	ctx := context.Background()
+	api := mockadmin.NewMongoDBCloudUsersApi(t)
+	api.EXPECT().ListOrganizationUsers(ctx, testOrgID).
+		Return(admin.ListOrganizationUsersApiRequest{ApiService: api})
+	api.EXPECT().ListOrganizationUsersExecute(mock.Anything).Return(&admin.PaginatedOrgUser{
+		Results: &[]admin.OrgUserResponse{
+			*testAtlasUser(orguser.MembershipStatusActive),
+			{Id: "owner-id", Username: "owner@example.com", Roles: admin.OrgUserRolesResponse{OrgRoles: &[]string{"ORG_OWNER"}}},
+		},
+		TotalCount: pointer.MakePtr(2),
+	}, nil, nil)
+
+	users, err := orguser.NewOrgUserService(api).List(ctx, testOrgID)
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, testUsername, users[0].Username)
+	assert.Equal(t, []string{"ORG_OWNER"}, users[1].OrgRoles)
+}
+
+func TestOrgUserInvite(t *testing.T) {
+	ctx := context.Background()
+	api := mockadmin.NewMongoDBCloudUsersApi(t)
+	api.EXPECT().CreateOrganizationUser(ctx, testOrgID, &admin.OrgUserRequest{
+		Username: testUsername,
+		Roles: admin.OrgUserRolesRequest{
+			OrgRoles: []string{"ORG_MEMBER"},
+			GroupRoleAssignments: &[]admin.GroupRoleAssignment{
+				{GroupId: pointer.MakePtr(testProjectID), GroupRoles: &[]string{"GROUP_DATA_ACCESS_READ_WRITE", "GROUP_READ_ONLY"}},
+			},
+		},
+	}).Return(admin.CreateOrganizationUserApiRequest{ApiService: api})
+	api.EXPECT().CreateOrganizationUserExecute(mock.Anything).Return(testAtlasUser(orguser.MembershipStatusPending), nil, nil)
+
+	user, err := orguser.NewOrgUserService(api).Invite(ctx, testOrgID, testUser())
+	require.NoError(t, err)
+	assert.Equal(t, testUserID, user.ID)
+	assert.True(t, user.IsPending())
+}
+
+func TestOrgUserUpdateRoles(t *testing.T) {
+	ctx := context.Background()
+	api := mockadmin.NewMongoDBCloudUsersApi(t)
+	api.EXPECT().UpdateOrganizationUser(ctx, testOrgID, testUserID, &admin.OrgUserUpdateRequest{
+		Roles: &admin.OrgUserRolesRequest{
+			OrgRoles:             []string{"ORG_READ_ONLY"},
+			GroupRoleAssignments: &[]admin.GroupRoleAssignment{},
+		},
+	}).Return(admin.UpdateOrganizationUserApiRequest{ApiService: api})
+	api.EXPECT().UpdateOrganizationUserExecute(mock.Anything).Return(nil, nil, ErrFakeFailure)
+
+	user, err := orguser.NewOrgUserService(api).UpdateRoles(ctx, testOrgID, testUserID, &orguser.OrgUser{
+		Username: testUsername,
+		OrgRoles: []string{"ORG_READ_ONLY"},
+	})
+	assert.Nil(t, user)
+	assert.ErrorIs(t, err, ErrFakeFailure)
+}
+
+func TestOrgUserRemove(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		httpResp    *http.Response
+		err         error
+		expectedErr error
+	}{
+		{
+			title: "users are removed",
+		},
+		{
+			title:       "missing users are not found",
+			httpResp:    &http.Response{StatusCode: http.StatusNotFound},
+			err:         ErrFakeFailure,
+			expectedErr: orguser.ErrNotFound,
+		},
+		{
+			title:       "other failures are reported",
+			err:         ErrFakeFailure,
+			expectedErr: ErrFakeFailure,
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			ctx := context.Background()
+			api := mockadmin.NewMongoDBCloudUsersApi(t)
+			api.EXPECT().RemoveOrganizationUser(ctx, testOrgID, testUserID).
+				Return(admin.RemoveOrganizationUserApiRequest{ApiService: api})
+			api.EXPECT().RemoveOrganizationUserExecute(mock.Anything).Return(tc.httpResp, tc.err)
+
+			err := orguser.NewOrgUserService(api).Remove(ctx, testOrgID, testUserID)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -498,3 +498,22 @@ func MapPrivateEndpoints(akoInterfaces, atlasInterfaces []EndpointInterface) map
 
 	return m
 }
+
+// Exports returns the details of the private endpoint service needed to create the endpoints in the cloud provider
+func Exports(peService EndpointService) map[string]string {
+	exports := map[string]string{
+		"provider":  peService.Provider(),
+		"region":    peService.Region(),
+		"serviceId": peService.ServiceID(),
+	}
+	switch pe := peService.(type) {
+	case *AWSService:
+		exports["serviceName"] = pe.ServiceName
+	case *AzureService:
+		exports["serviceName"] = pe.ServiceName
+		exports["resourceId"] = pe.ResourceID
+	case *GCPService:
+		exports["serviceAttachmentNames"] = strings.Join(pe.AttachmentNames, ",")
+	}
+	return exports
+}
@@ -628,3 +628,40 @@ func TestDeletePrivateEndpointInterface(t *testing.T) {
 		})
 	}
 }
+
+func TestExports(t *testing.T) {
+	aws := &AWSService{
+		CommonEndpointService: CommonEndpointService{ID: "aws-svc", CloudRegion: "US_EAST_1"},
+		ServiceName:           "com.amazonaws.vpce.us-east-1.vpce-svc-123",
+	}
+	assert.Equal(t, map[string]string{
+		"provider":    "AWS",
+		"region":      "US_EAST_1",
+		"serviceId":   "aws-svc",
+		"serviceName": "com.amazonaws.vpce.us-east-1.vpce-svc-123",
+	}, Exports(aws))
+
+	azure := &AzureService{
+		CommonEndpointService: CommonEndpointService{ID: "azure-svc", CloudRegion: "GERMANY_NORTH"},
+		ServiceName:           "pls_123",
+		ResourceID:            "/subscriptions/123/resourceGroups/atlas/providers/Microsoft.Network/privateLinkServices/pls_123",
+	}
+	assert.Equal(t, map[string]string{
+		"provider":    "AZURE",
+		"region":      "GERMANY_NORTH",
+		"serviceId":   "azure-svc",
+		"serviceName": "pls_123",
+		"resourceId":  "/subscriptions/123/resourceGroups/atlas/providers/Microsoft.Network/privateLinkServices/pls_123",
+	}, Exports(azure))
+
+	gcp := &GCPService{
+		CommonEndpointService: CommonEndpointService{ID: "gcp-svc", CloudRegion: "EUROPE_WEST_3"},
+		AttachmentNames:       []string{"projects/p/regions/europe-west3/serviceAttachments/sa-1", "projects/p/regions/europe-west3/serviceAttachments/sa-2"},
+	}
+	assert.Equal(t, map[string]string{
+		"provider":               "GCP",
+		"region":                 "EUROPE_WEST_3",
+		"serviceId":              "gcp-svc",
+		"serviceAttachmentNames": "projects/p/regions/europe-west3/serviceAttachments/sa-1,projects/p/regions/europe-west3/serviceAttachments/sa-2",
+	}, Exports(gcp))
+}
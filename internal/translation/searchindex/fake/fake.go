@@ -21,16 +21,21 @@ import (
 )
 
 type FakeAtlasSearch struct {
-	GetIndexFunc    func(ctx context.Context, projectID, clusterName, indexName, indexID string) (*searchindex.SearchIndex, error)
-	CreateIndexFunc func(ctx context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error)
-	DeleteIndexFunc func(ctx context.Context, projectID, clusterName, indexID string) error
-	UpdateIndexFunc func(ctx context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error)
+	GetIndexFunc       func(ctx context.Context, projectID, clusterName, indexName, indexID string) (*searchindex.SearchIndex, error)
+	GetIndexByNameFunc func(ctx context.Context, projectID, clusterName, dbName, collectionName, indexName string) (*searchindex.SearchIndex, error)
+	CreateIndexFunc    func(ctx context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error)
+	DeleteIndexFunc    func(ctx context.Context, projectID, clusterName, indexID string) error
+	UpdateIndexFunc    func(ctx context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error)
 }
 
 func (fas *FakeAtlasSearch) GetIndex(ctx context.Context, projectID, clusterName, indexName, indexID string) (*searchindex.SearchIndex, error) {
 	return fas.GetIndexFunc(ctx, projectID, clusterName, indexName, indexID)
 }
 
+func (fas *FakeAtlasSearch) GetIndexByName(ctx context.Context, projectID, clusterName, dbName, collectionName, indexName string) (*searchindex.SearchIndex, error) {
+	return fas.GetIndexByNameFunc(ctx, projectID, clusterName, dbName, collectionName, indexName)
+}
+
 func (fas *FakeAtlasSearch) CreateIndex(ctx context.Context, projectID, clusterName string, index *searchindex.SearchIndex) (*searchindex.SearchIndex, error) {
 	return fas.CreateIndexFunc(ctx, projectID, clusterName, index)
 }
@@ -30,6 +30,7 @@ var (
 
 type AtlasSearchIdxService interface {
 	GetIndex(ctx context.Context, projectID, clusterName, indexName, indexID string) (*SearchIndex, error)
+	GetIndexByName(ctx context.Context, projectID, clusterName, dbName, collectionName, indexName string) (*SearchIndex, error)
 	CreateIndex(ctx context.Context, projectID, clusterName string, index *SearchIndex) (*SearchIndex, error)
 	DeleteIndex(ctx context.Context, projectID, clusterName, indexID string) error
 	UpdateIndex(ctx context.Context, projectID, clusterName string, index *SearchIndex) (*SearchIndex, error)
@@ -63,6 +64,24 @@ func (si *SearchIndexes) GetIndex(ctx context.Context, projectID, clusterName, i
 	return stateInAtlas, nil
 }
 
+func (si *SearchIndexes) GetIndexByName(ctx context.Context, projectID, clusterName, dbName, collectionName, indexName string) (*SearchIndex, error) {
+	resp, httpResp, err := si.searchAPI.GetAtlasSearchIndexByName(ctx, projectID, clusterName, collectionName, dbName, indexName).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return nil, errors.Join(err, ErrNotFound)
+		}
+		return nil, err
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("got empty index %s.%s/%s", dbName, collectionName, indexName)
+	}
+	stateInAtlas, err := fromAtlas(*resp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert index %s.%s/%s: %w", dbName, collectionName, indexName, err)
+	}
+	return stateInAtlas, nil
+}
+
 func (si *SearchIndexes) CreateIndex(ctx context.Context, projectID, clusterName string, index *SearchIndex) (*SearchIndex, error) {
 	atlasIndex, err := index.toAtlasCreateView()
 	if err != nil {
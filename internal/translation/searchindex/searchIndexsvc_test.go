@@ -0,0 +1,65 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package searchindex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	"go.mongodb.org/atlas-sdk/v20250312002/mockadmin"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/pointer"
+)
+
+func TestGetIndexByName(t *testing.T) {
+	t.Run("returns the index", func(t *testing.T) {
+		searchAPI := mockadmin.NewAtlasSearchApi(t)
+		searchAPI.EXPECT().GetAtlasSearchIndexByName(mock.Anything, "project-id", "cluster", "collection", "db", "index").
+			Return(admin.GetAtlasSearchIndexByNameApiRequest{ApiService: searchAPI})
+		searchAPI.EXPECT().GetAtlasSearchIndexByNameExecute(mock.Anything).Return(&admin.SearchIndexResponse{
+			IndexID:          pointer.MakePtr("index-id"),
+			Name:             pointer.MakePtr("index"),
+			Database:         pointer.MakePtr("db"),
+			CollectionName:   pointer.MakePtr("collection"),
+			Type:             pointer.MakePtr("search"),
+			Status:           pointer.MakePtr("BUILDING"),
+			Queryable:        pointer.MakePtr(false),
+			LatestDefinition: &admin.BaseSearchIndexResponseLatestDefinition{},
+		}, &http.Response{StatusCode: http.StatusOK}, nil)
+
+		index, err := NewSearchIndexes(searchAPI).GetIndexByName(context.Background(), "project-id", "cluster", "db", "collection", "index")
+		require.NoError(t, err)
+		assert.Equal(t, "index-id", index.GetID())
+		assert.Equal(t, "BUILDING", index.GetStatus())
+		assert.False(t, index.IsQueryable())
+	})
+
+	t.Run("returns not found", func(t *testing.T) {
+		searchAPI := mockadmin.NewAtlasSearchApi(t)
+		searchAPI.EXPECT().GetAtlasSearchIndexByName(mock.Anything, "project-id", "cluster", "collection", "db", "index").
+			Return(admin.GetAtlasSearchIndexByNameApiRequest{ApiService: searchAPI})
+		searchAPI.EXPECT().GetAtlasSearchIndexByNameExecute(mock.Anything).
+			Return(nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found"))
+
+		_, err := NewSearchIndexes(searchAPI).GetIndexByName(context.Background(), "project-id", "cluster", "db", "collection", "index")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
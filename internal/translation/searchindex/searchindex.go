@@ -38,6 +38,16 @@ type SearchIndex struct {
 	akov2.AtlasSearchIndexConfigSpec
 	ID     *string
 	Status *string
+	// Queryable and Hosts are only informative, they are ignored when comparing indexes
+	Queryable *bool
+	Hosts     []HostStatus
+}
+
+// HostStatus is the status of an index on a single host of the deployment
+type HostStatus struct {
+	Hostname  string
+	Status    string
+	Queryable bool
 }
 
 func (s *SearchIndex) GetID() string {
@@ -48,6 +58,10 @@ func (s *SearchIndex) GetStatus() string {
 	return pointer.GetOrDefault(s.Status, "")
 }
 
+func (s *SearchIndex) IsQueryable() bool {
+	return pointer.GetOrDefault(s.Queryable, false)
+}
+
 // NewSearchIndex requires both parts of search index: data-related part from a Deployment, and
 // index configuration represented in a separate CRD. Partial construction is disabled as it won't work
 // for comparing indexes between each other.
@@ -116,11 +130,28 @@ func fromAtlas(index admin.SearchIndexResponse) (*SearchIndex, error) {
 			SearchAnalyzer: index.LatestDefinition.SearchAnalyzer,
 			StoredSource:   storedSource,
 		},
-		ID:     index.IndexID,
-		Status: index.Status,
+		ID:        index.IndexID,
+		Status:    index.Status,
+		Queryable: index.Queryable,
+		Hosts:     hostsFromAtlas(index.StatusDetail),
 	}, errors.Join(errs...)
 }
 
+func hostsFromAtlas(in *[]admin.VectorSearchHostStatusDetail) []HostStatus {
+	if in == nil || len(*in) == 0 {
+		return nil
+	}
+	hosts := make([]HostStatus, 0, len(*in))
+	for _, detail := range *in {
+		hosts = append(hosts, HostStatus{
+			Hostname:  detail.GetHostname(),
+			Status:    detail.GetStatus(),
+			Queryable: detail.GetQueryable(),
+		})
+	}
+	return hosts
+}
+
 // cleanup normalizes the search index for comparison
 func (s *SearchIndex) cleanup(cleaners ...indexCleaner) {
 	s.ID = nil
@@ -202,6 +202,10 @@ func Test_NewSearchIndexFromAtlas(t *testing.T) {
 					Name:           pointer.MakePtr("name"),
 					Status:         pointer.MakePtr("ACTIVE"),
 					Type:           pointer.MakePtr("search"),
+					Queryable:      pointer.MakePtr(true),
+					StatusDetail: &[]admin.VectorSearchHostStatusDetail{
+						{Hostname: pointer.MakePtr("host-0"), Status: pointer.MakePtr("READY"), Queryable: pointer.MakePtr(true)},
+					},
 					LatestDefinition: &admin.BaseSearchIndexResponseLatestDefinition{
 						Analyzer: pointer.MakePtr("lucene.standard"),
 						Analyzers: &([]admin.AtlasSearchAnalyzer{
@@ -247,8 +251,10 @@ func Test_NewSearchIndexFromAtlas(t *testing.T) {
 				},
 			},
 			want: &SearchIndex{
-				ID:     pointer.MakePtr("indexID"),
-				Status: pointer.MakePtr("ACTIVE"),
+				ID:        pointer.MakePtr("indexID"),
+				Status:    pointer.MakePtr("ACTIVE"),
+				Queryable: pointer.MakePtr(true),
+				Hosts:     []HostStatus{{Hostname: "host-0", Status: "READY", Queryable: true}},
 				SearchIndex: akov2.SearchIndex{
 					Name:           "name",
 					DBName:         "db",
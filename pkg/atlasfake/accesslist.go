@@ -0,0 +1,108 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfake
+
+import (
+	"net/http"
+)
+
+const (
+	kindAccessList = "accessList"
+)
+
+func (s *Server) accessList(groupID string) *collection {
+	return s.store.collection(kindAccessList, groupID)
+}
+
+// accessListKey returns the value identifying an entry, the same way Atlas
+// accepts it as entryValue: a CIDR block, an IP address or a security group
+func accessListKey(entry object) string {
+	for _, field := range []string{"cidrBlock", "awsSecurityGroup", "ipAddress"} {
+		if value := stringField(entry, field); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func (s *Server) accessListEntry(groupID, entryValue string) (object, bool) {
+	return s.accessList(groupID).find(func(entry object) bool {
+		return stringField(entry, "cidrBlock") == entryValue ||
+			stringField(entry, "ipAddress") == entryValue ||
+			stringField(entry, "awsSecurityGroup") == entryValue
+	})
+}
+
+func (s *Server) createAccessListEntries(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	entries := []object{}
+	if err := decode(r, &entries); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	for _, entry := range entries {
+		if ip := stringField(entry, "ipAddress"); ip != "" && stringField(entry, "cidrBlock") == "" {
+			entry["cidrBlock"] = ip + "/32"
+		}
+		entry["groupId"] = p["groupId"]
+		s.accessList(p["groupId"]).put(accessListKey(entry), entry)
+	}
+	writePageWithStatus(w, r, http.StatusCreated, s.accessList(p["groupId"]).list())
+}
+
+func (s *Server) listAccessListEntries(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	writePage(w, r, s.accessList(p["groupId"]).list())
+}
+
+func (s *Server) getAccessListEntry(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	entry, ok := s.accessListEntry(p["groupId"], p["entryValue"])
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "RESOURCE_NOT_FOUND", "No access list entry %s exists.", p["entryValue"])
+		return
+	}
+	writeJSON(w, r, http.StatusOK, entry)
+}
+
+func (s *Server) getAccessListEntryStatus(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	if _, ok := s.accessListEntry(p["groupId"], p["entryValue"]); !ok {
+		writeError(w, r, http.StatusNotFound, "RESOURCE_NOT_FOUND", "No access list entry %s exists.", p["entryValue"])
+		return
+	}
+	writeJSON(w, r, http.StatusOK, object{"STATUS": "ACTIVE"})
+}
+
+func (s *Server) deleteAccessListEntry(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	entry, ok := s.accessListEntry(p["groupId"], p["entryValue"])
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "RESOURCE_NOT_FOUND", "No access list entry %s exists.", p["entryValue"])
+		return
+	}
+	s.accessList(p["groupId"]).delete(accessListKey(entry))
+	writeNoContent(w, http.StatusNoContent)
+}
@@ -0,0 +1,344 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	kindClusters      = "clusters"
+	kindFlexClusters  = "flexClusters"
+	kindSearchIndexes = "searchIndexes"
+
+	defaultMongoDBMajorVersion = "8.0"
+
+	stateIdle = "IDLE"
+)
+
+func (s *Server) clusters(groupID string) *collection {
+	return s.store.collection(kindClusters, groupID)
+}
+
+func (s *Server) flexClusters(groupID string) *collection {
+	return s.store.collection(kindFlexClusters, groupID)
+}
+
+func (s *Server) searchIndexes(groupID, clusterName string) *collection {
+	return s.store.collection(kindSearchIndexes, groupID, clusterName)
+}
+
+// cluster fetches the dedicated cluster referenced by the clusterName path parameter,
+// writing the same errors Atlas returns when it does not exist or is a flex cluster
+func (s *Server) cluster(w http.ResponseWriter, r *http.Request, p params) (object, bool) {
+	groupID, name := p["groupId"], p["clusterName"]
+	if cluster, ok := s.clusters(groupID).get(name); ok {
+		return cluster, true
+	}
+	if _, ok := s.flexClusters(groupID).get(name); ok {
+		writeError(w, r, http.StatusBadRequest, "CANNOT_USE_FLEX_CLUSTER_IN_CLUSTER_API",
+			"Cannot use the cluster API to interact with flex cluster %s.", name)
+		return nil, false
+	}
+	writeError(w, r, http.StatusNotFound, "CLUSTER_NOT_FOUND", "No cluster named %s exists in group %s.", name, groupID)
+	return nil, false
+}
+
+func (s *Server) clusterNameTaken(groupID, name string) bool {
+	_, isCluster := s.clusters(groupID).get(name)
+	_, isFlex := s.flexClusters(groupID).get(name)
+	return isCluster || isFlex
+}
+
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	cluster := object{}
+	if err := decode(r, &cluster); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	groupID, name := p["groupId"], stringField(cluster, "name")
+	if s.clusterNameTaken(groupID, name) {
+		writeError(w, r, http.StatusBadRequest, "DUPLICATE_CLUSTER_NAME", "A cluster named %s is already present in group %s.", name, groupID)
+		return
+	}
+	majorVersion := stringField(cluster, "mongoDBMajorVersion")
+	if majorVersion == "" {
+		majorVersion = defaultMongoDBMajorVersion
+	}
+	cluster["id"] = newID()
+	cluster["groupId"] = groupID
+	cluster["createDate"] = s.timestamp()
+	cluster["stateName"] = stateIdle
+	cluster["mongoDBMajorVersion"] = majorVersion
+	cluster["mongoDBVersion"] = majorVersion + ".0"
+	cluster["paused"] = cluster["paused"] == true
+	cluster["connectionStrings"] = connectionStrings(name)
+	s.clusters(groupID).put(name, cluster)
+	writeJSON(w, r, http.StatusCreated, cluster)
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	writePage(w, r, s.clusters(p["groupId"]).list())
+}
+
+func (s *Server) getCluster(w http.ResponseWriter, r *http.Request, p params) {
+	cluster, ok := s.cluster(w, r, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, r, http.StatusOK, cluster)
+}
+
+func (s *Server) getClusterStatus(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.cluster(w, r, p); !ok {
+		return
+	}
+	writeJSON(w, r, http.StatusOK, object{"changeStatus": "APPLIED"})
+}
+
+func (s *Server) updateCluster(w http.ResponseWriter, r *http.Request, p params) {
+	cluster, ok := s.cluster(w, r, p)
+	if !ok {
+		return
+	}
+	patch := object{}
+	if err := decode(r, &patch); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	merge(cluster, patch, "id", "name", "groupId", "createDate", "stateName", "connectionStrings", "mongoDBVersion")
+	if majorVersion := stringField(patch, "mongoDBMajorVersion"); majorVersion != "" {
+		cluster["mongoDBVersion"] = majorVersion + ".0"
+	}
+	writeJSON(w, r, http.StatusOK, cluster)
+}
+
+func (s *Server) deleteCluster(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.cluster(w, r, p); !ok {
+		return
+	}
+	groupID, name := p["groupId"], p["clusterName"]
+	s.clusters(groupID).delete(name)
+	for _, index := range s.searchIndexes(groupID, name).list() {
+		s.searchIndexes(groupID, name).delete(stringField(index, "indexID"))
+	}
+	writeNoContent(w, http.StatusAccepted)
+}
+
+// flexCluster fetches the flex cluster referenced by the name path parameter,
+// writing the same errors Atlas returns when it does not exist or is a dedicated cluster
+func (s *Server) flexCluster(w http.ResponseWriter, r *http.Request, p params) (object, bool) {
+	groupID, name := p["groupId"], p["name"]
+	if flex, ok := s.flexClusters(groupID).get(name); ok {
+		return flex, true
+	}
+	if _, ok := s.clusters(groupID).get(name); ok {
+		writeError(w, r, http.StatusBadRequest, "CANNOT_USE_NON_FLEX_CLUSTER_IN_FLEX_API",
+			"Cannot use the flex API to interact with cluster %s.", name)
+		return nil, false
+	}
+	writeError(w, r, http.StatusNotFound, "CLUSTER_NOT_FOUND", "No cluster named %s exists in group %s.", name, groupID)
+	return nil, false
+}
+
+func (s *Server) createFlexCluster(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	flex := object{}
+	if err := decode(r, &flex); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	groupID, name := p["groupId"], stringField(flex, "name")
+	if s.clusterNameTaken(groupID, name) {
+		writeError(w, r, http.StatusBadRequest, "DUPLICATE_CLUSTER_NAME", "A cluster named %s is already present in group %s.", name, groupID)
+		return
+	}
+	flex["id"] = newID()
+	flex["groupId"] = groupID
+	flex["createDate"] = s.timestamp()
+	flex["stateName"] = stateIdle
+	flex["clusterType"] = "REPLICASET"
+	flex["mongoDBVersion"] = defaultMongoDBMajorVersion + ".0"
+	flex["connectionStrings"] = connectionStrings(name)
+	s.flexClusters(groupID).put(name, flex)
+	writeJSON(w, r, http.StatusCreated, flex)
+}
+
+func (s *Server) listFlexClusters(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	writePage(w, r, s.flexClusters(p["groupId"]).list())
+}
+
+func (s *Server) getFlexCluster(w http.ResponseWriter, r *http.Request, p params) {
+	flex, ok := s.flexCluster(w, r, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, r, http.StatusOK, flex)
+}
+
+func (s *Server) updateFlexCluster(w http.ResponseWriter, r *http.Request, p params) {
+	flex, ok := s.flexCluster(w, r, p)
+	if !ok {
+		return
+	}
+	patch := object{}
+	if err := decode(r, &patch); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	merge(flex, patch, "id", "name", "groupId", "createDate", "stateName", "connectionStrings", "providerSettings")
+	writeJSON(w, r, http.StatusOK, flex)
+}
+
+func (s *Server) deleteFlexCluster(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.flexCluster(w, r, p); !ok {
+		return
+	}
+	s.flexClusters(p["groupId"]).delete(p["name"])
+	writeNoContent(w, http.StatusAccepted)
+}
+
+// Serverless instances are deprecated and never stored by the fake.
+// The endpoints still answer as Atlas does, as the operator probes them to find deployments.
+
+func (s *Server) listServerlessInstances(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	writePage(w, r, nil)
+}
+
+func (s *Server) getServerlessInstance(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	if s.clusterNameTaken(p["groupId"], p["name"]) {
+		writeError(w, r, http.StatusBadRequest, "CANNOT_USE_CLUSTER_IN_SERVERLESS_INSTANCE_API",
+			"Cannot use the serverless instance API to interact with cluster %s.", p["name"])
+		return
+	}
+	writeError(w, r, http.StatusNotFound, "SERVERLESS_INSTANCE_NOT_FOUND", "No serverless instance named %s exists.", p["name"])
+}
+
+func (s *Server) createSearchIndex(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.cluster(w, r, p); !ok {
+		return
+	}
+	index := object{}
+	if err := decode(r, &index); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	indexes := s.searchIndexes(p["groupId"], p["clusterName"])
+	if _, exists := indexes.find(sameSearchIndex(index)); exists {
+		writeError(w, r, http.StatusConflict, "ATLAS_SEARCH_DUPLICATE_INDEX",
+			"Index %s already exists on %s.%s.", index["name"], index["database"], index["collectionName"])
+		return
+	}
+	if stringField(index, "type") == "" {
+		index["type"] = "search"
+	}
+	index["indexID"] = newID()
+	index["status"] = "READY"
+	index["queryable"] = true
+	index["latestDefinition"] = index["definition"]
+	delete(index, "definition")
+	indexes.put(stringField(index, "indexID"), index)
+	writeJSON(w, r, http.StatusCreated, index)
+}
+
+func (s *Server) listSearchIndexes(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.cluster(w, r, p); !ok {
+		return
+	}
+	result := []object{}
+	for _, index := range s.searchIndexes(p["groupId"], p["clusterName"]).list() {
+		if stringField(index, "database") == p["databaseName"] && stringField(index, "collectionName") == p["collectionName"] {
+			result = append(result, index)
+		}
+	}
+	writeJSON(w, r, http.StatusOK, result)
+}
+
+func (s *Server) searchIndex(w http.ResponseWriter, r *http.Request, p params) (object, bool) {
+	if _, ok := s.cluster(w, r, p); !ok {
+		return nil, false
+	}
+	index, ok := s.searchIndexes(p["groupId"], p["clusterName"]).get(p["indexId"])
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "ATLAS_SEARCH_INDEX_NOT_FOUND", "No search index with ID %s exists.", p["indexId"])
+		return nil, false
+	}
+	return index, true
+}
+
+func (s *Server) getSearchIndex(w http.ResponseWriter, r *http.Request, p params) {
+	index, ok := s.searchIndex(w, r, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, r, http.StatusOK, index)
+}
+
+func (s *Server) updateSearchIndex(w http.ResponseWriter, r *http.Request, p params) {
+	index, ok := s.searchIndex(w, r, p)
+	if !ok {
+		return
+	}
+	update := object{}
+	if err := decode(r, &update); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	index["latestDefinition"] = update["definition"]
+	writeJSON(w, r, http.StatusOK, index)
+}
+
+func (s *Server) deleteSearchIndex(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.searchIndex(w, r, p); !ok {
+		return
+	}
+	s.searchIndexes(p["groupId"], p["clusterName"]).delete(p["indexId"])
+	writeNoContent(w, http.StatusAccepted)
+}
+
+func sameSearchIndex(index object) func(object) bool {
+	return func(other object) bool {
+		return stringField(other, "name") == stringField(index, "name") &&
+			stringField(other, "database") == stringField(index, "database") &&
+			stringField(other, "collectionName") == stringField(index, "collectionName")
+	}
+}
+
+func connectionStrings(clusterName string) object {
+	host := fmt.Sprintf("%s.%s.mongodb.net", strings.ToLower(clusterName), newID()[:5])
+	return object{
+		"standard":    fmt.Sprintf("mongodb://%s:27017", host),
+		"standardSrv": fmt.Sprintf("mongodb+srv://%s", host),
+	}
+}
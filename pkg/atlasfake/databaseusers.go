@@ -0,0 +1,100 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfake
+
+import (
+	"net/http"
+)
+
+const (
+	kindDatabaseUsers = "databaseUsers"
+)
+
+func (s *Server) databaseUsers(groupID string) *collection {
+	return s.store.collection(kindDatabaseUsers, groupID)
+}
+
+func databaseUserKey(databaseName, username string) string {
+	return databaseName + "/" + username
+}
+
+func (s *Server) createDatabaseUser(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	user := object{}
+	if err := decode(r, &user); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	key := databaseUserKey(stringField(user, "databaseName"), stringField(user, "username"))
+	if _, exists := s.databaseUsers(p["groupId"]).get(key); exists {
+		writeError(w, r, http.StatusConflict, "USER_ALREADY_EXISTS", "The user %s already exists.", user["username"])
+		return
+	}
+	user["groupId"] = p["groupId"]
+	// Atlas never returns passwords
+	delete(user, "password")
+	s.databaseUsers(p["groupId"]).put(key, user)
+	writeJSON(w, r, http.StatusCreated, user)
+}
+
+func (s *Server) listDatabaseUsers(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	writePage(w, r, s.databaseUsers(p["groupId"]).list())
+}
+
+func (s *Server) getDatabaseUser(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	user, ok := s.databaseUsers(p["groupId"]).get(databaseUserKey(p["databaseName"], p["username"]))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "USERNAME_NOT_FOUND", "No user with username %s exists.", p["username"])
+		return
+	}
+	writeJSON(w, r, http.StatusOK, user)
+}
+
+func (s *Server) updateDatabaseUser(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	user, ok := s.databaseUsers(p["groupId"]).get(databaseUserKey(p["databaseName"], p["username"]))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "USERNAME_NOT_FOUND", "No user with username %s exists.", p["username"])
+		return
+	}
+	patch := object{}
+	if err := decode(r, &patch); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	merge(user, patch, "groupId", "databaseName", "username", "password")
+	writeJSON(w, r, http.StatusOK, user)
+}
+
+func (s *Server) deleteDatabaseUser(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	if !s.databaseUsers(p["groupId"]).delete(databaseUserKey(p["databaseName"], p["username"])) {
+		writeError(w, r, http.StatusNotFound, "USER_NOT_FOUND", "No user with username %s exists.", p["username"])
+		return
+	}
+	writeNoContent(w, http.StatusNoContent)
+}
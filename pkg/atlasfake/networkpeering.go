@@ -0,0 +1,220 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfake
+
+import (
+	"net/http"
+)
+
+const (
+	kindContainers = "containers"
+	kindPeers      = "peers"
+
+	providerAWS   = "AWS"
+	providerAzure = "AZURE"
+	providerGCP   = "GCP"
+)
+
+func (s *Server) containers(groupID string) *collection {
+	return s.store.collection(kindContainers, groupID)
+}
+
+func (s *Server) peers(groupID string) *collection {
+	return s.store.collection(kindPeers, groupID)
+}
+
+func providerFilter(r *http.Request) string {
+	providerName := r.URL.Query().Get("providerName")
+	if providerName == "" {
+		return providerAWS
+	}
+	return providerName
+}
+
+func byProvider(items []object, providerName string) []object {
+	result := []object{}
+	for _, item := range items {
+		if stringField(item, "providerName") == providerName {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func (s *Server) container(w http.ResponseWriter, r *http.Request, p params) (object, bool) {
+	if _, ok := s.group(w, r, p); !ok {
+		return nil, false
+	}
+	container, ok := s.containers(p["groupId"]).get(p["containerId"])
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "CLOUD_PROVIDER_CONTAINER_NOT_FOUND", "Container %s not found.", p["containerId"])
+		return nil, false
+	}
+	return container, true
+}
+
+func (s *Server) createContainer(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	container := object{}
+	if err := decode(r, &container); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	id := newID()
+	container["id"] = id
+	container["provisioned"] = false
+	switch stringField(container, "providerName") {
+	case providerAWS:
+		container["vpcId"] = "vpc-" + id[:17]
+	case providerAzure:
+		container["azureSubscriptionId"] = id
+		container["vnetName"] = "vnet_" + id
+	case providerGCP:
+		container["gcpProjectId"] = "p-" + id[:12]
+		container["networkName"] = "nt-" + id
+	}
+	s.containers(p["groupId"]).put(id, container)
+	writeJSON(w, r, http.StatusCreated, container)
+}
+
+func (s *Server) listContainers(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	writePage(w, r, byProvider(s.containers(p["groupId"]).list(), providerFilter(r)))
+}
+
+func (s *Server) listAllContainers(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	writePage(w, r, s.containers(p["groupId"]).list())
+}
+
+func (s *Server) getContainer(w http.ResponseWriter, r *http.Request, p params) {
+	container, ok := s.container(w, r, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, r, http.StatusOK, container)
+}
+
+func (s *Server) updateContainer(w http.ResponseWriter, r *http.Request, p params) {
+	container, ok := s.container(w, r, p)
+	if !ok {
+		return
+	}
+	patch := object{}
+	if err := decode(r, &patch); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	merge(container, patch, "id", "providerName", "provisioned")
+	writeJSON(w, r, http.StatusOK, container)
+}
+
+func (s *Server) deleteContainer(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.container(w, r, p); !ok {
+		return
+	}
+	if _, inUse := s.peers(p["groupId"]).find(byField("containerId", p["containerId"])); inUse {
+		writeError(w, r, http.StatusConflict, "CONTAINERS_IN_USE", "Container %s is still in use.", p["containerId"])
+		return
+	}
+	s.containers(p["groupId"]).delete(p["containerId"])
+	writeNoContent(w, http.StatusNoContent)
+}
+
+func (s *Server) peer(w http.ResponseWriter, r *http.Request, p params) (object, bool) {
+	if _, ok := s.group(w, r, p); !ok {
+		return nil, false
+	}
+	peer, ok := s.peers(p["groupId"]).get(p["peerId"])
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "PEER_NOT_FOUND", "Peer %s not found.", p["peerId"])
+		return nil, false
+	}
+	return peer, true
+}
+
+func (s *Server) createPeer(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	peer := object{}
+	if err := decode(r, &peer); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	containerID := stringField(peer, "containerId")
+	container, ok := s.containers(p["groupId"]).get(containerID)
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "CLOUD_PROVIDER_CONTAINER_NOT_FOUND", "Container %s not found.", containerID)
+		return
+	}
+	container["provisioned"] = true
+	id := newID()
+	peer["id"] = id
+	if stringField(peer, "providerName") == "" {
+		peer["providerName"] = container["providerName"]
+	}
+	if stringField(peer, "providerName") == providerAWS {
+		peer["statusName"] = "AVAILABLE"
+		peer["connectionId"] = "pcx-" + id[:17]
+	} else {
+		peer["status"] = "AVAILABLE"
+	}
+	s.peers(p["groupId"]).put(id, peer)
+	writeJSON(w, r, http.StatusCreated, peer)
+}
+
+func (s *Server) listPeers(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	writePage(w, r, byProvider(s.peers(p["groupId"]).list(), providerFilter(r)))
+}
+
+func (s *Server) getPeer(w http.ResponseWriter, r *http.Request, p params) {
+	peer, ok := s.peer(w, r, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, r, http.StatusOK, peer)
+}
+
+func (s *Server) updatePeer(w http.ResponseWriter, r *http.Request, p params) {
+	peer, ok := s.peer(w, r, p)
+	if !ok {
+		return
+	}
+	patch := object{}
+	if err := decode(r, &patch); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	merge(peer, patch, "id", "containerId", "providerName", "status", "statusName", "connectionId")
+	writeJSON(w, r, http.StatusOK, peer)
+}
+
+func (s *Server) deletePeer(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.peer(w, r, p); !ok {
+		return
+	}
+	s.peers(p["groupId"]).delete(p["peerId"])
+	writeNoContent(w, http.StatusAccepted)
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const (
+	kindEndpointServices = "endpointServices"
+	kindEndpoints        = "endpoints"
+
+	statusAvailable = "AVAILABLE"
+)
+
+func (s *Server) endpointServices(groupID string) *collection {
+	return s.store.collection(kindEndpointServices, groupID)
+}
+
+func (s *Server) endpoints(groupID, serviceID string) *collection {
+	return s.store.collection(kindEndpoints, groupID, serviceID)
+}
+
+// endpointIDsField is the field of the endpoint service listing its endpoints for each provider
+func endpointIDsField(provider string) string {
+	switch provider {
+	case providerAzure:
+		return "privateEndpoints"
+	case providerGCP:
+		return "endpointGroupNames"
+	default:
+		return "interfaceEndpoints"
+	}
+}
+
+func (s *Server) endpointService(w http.ResponseWriter, r *http.Request, p params) (object, bool) {
+	if _, ok := s.group(w, r, p); !ok {
+		return nil, false
+	}
+	service, ok := s.endpointServices(p["groupId"]).get(p["endpointServiceId"])
+	if !ok || stringField(service, "cloudProvider") != strings.ToUpper(p["cloudProvider"]) {
+		writeError(w, r, http.StatusNotFound, "PRIVATE_ENDPOINT_SERVICE_NOT_FOUND",
+			"Private endpoint service %s not found.", p["endpointServiceId"])
+		return nil, false
+	}
+	return service, true
+}
+
+func (s *Server) createEndpointService(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	request := object{}
+	if err := decode(r, &request); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	id := newID()
+	provider := stringField(request, "providerName")
+	region := stringField(request, "region")
+	service := object{
+		"id":                       id,
+		"cloudProvider":            provider,
+		"regionName":               region,
+		"status":                   statusAvailable,
+		endpointIDsField(provider): []string{},
+	}
+	switch provider {
+	case providerAWS:
+		service["endpointServiceName"] = fmt.Sprintf("com.amazonaws.vpce.%s.vpce-svc-%s", strings.ToLower(region), id[:17])
+	case providerAzure:
+		service["privateLinkServiceName"] = "pls_" + id
+		service["privateLinkServiceResourceId"] = fmt.Sprintf(
+			"/subscriptions/%s/resourceGroups/rg_%s/providers/Microsoft.Network/privateLinkServices/pls_%s", id, id, id)
+	case providerGCP:
+		attachments := make([]string, 0, 3)
+		for i := range 3 {
+			attachments = append(attachments, fmt.Sprintf("projects/p-%s/regions/%s/serviceAttachments/sa-%d", id[:12], strings.ToLower(region), i))
+		}
+		service["serviceAttachmentNames"] = attachments
+	}
+	s.endpointServices(p["groupId"]).put(id, service)
+	writeJSON(w, r, http.StatusCreated, service)
+}
+
+func (s *Server) listEndpointServices(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	provider := strings.ToUpper(p["cloudProvider"])
+	result := []object{}
+	for _, service := range s.endpointServices(p["groupId"]).list() {
+		if stringField(service, "cloudProvider") == provider {
+			result = append(result, service)
+		}
+	}
+	writeJSON(w, r, http.StatusOK, result)
+}
+
+func (s *Server) getEndpointService(w http.ResponseWriter, r *http.Request, p params) {
+	service, ok := s.endpointService(w, r, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, r, http.StatusOK, service)
+}
+
+func (s *Server) deleteEndpointService(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.endpointService(w, r, p); !ok {
+		return
+	}
+	if s.endpoints(p["groupId"], p["endpointServiceId"]).len() > 0 {
+		writeError(w, r, http.StatusConflict, "PRIVATE_ENDPOINT_SERVICE_HAS_ENDPOINTS",
+			"Private endpoint service %s still has endpoints.", p["endpointServiceId"])
+		return
+	}
+	s.endpointServices(p["groupId"]).delete(p["endpointServiceId"])
+	writeNoContent(w, http.StatusAccepted)
+}
+
+func (s *Server) createEndpoint(w http.ResponseWriter, r *http.Request, p params) {
+	service, ok := s.endpointService(w, r, p)
+	if !ok {
+		return
+	}
+	request := object{}
+	if err := decode(r, &request); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	provider := stringField(service, "cloudProvider")
+	endpoint := object{"cloudProvider": provider}
+	var id string
+	switch provider {
+	case providerAzure:
+		id = stringField(request, "id")
+		endpoint["privateEndpointResourceId"] = id
+		endpoint["privateEndpointIPAddress"] = request["privateEndpointIPAddress"]
+		endpoint["status"] = statusAvailable
+	case providerGCP:
+		id = stringField(request, "endpointGroupName")
+		endpoint["endpointGroupName"] = id
+		endpoint["endpoints"] = request["endpoints"]
+		endpoint["status"] = statusAvailable
+	default:
+		id = stringField(request, "id")
+		endpoint["interfaceEndpointId"] = id
+		endpoint["connectionStatus"] = statusAvailable
+	}
+	s.endpoints(p["groupId"], p["endpointServiceId"]).put(id, endpoint)
+	field := endpointIDsField(provider)
+	ids, _ := service[field].([]string)
+	service[field] = append(slices.Clone(ids), id)
+	writeJSON(w, r, http.StatusCreated, endpoint)
+}
+
+func (s *Server) endpoint(w http.ResponseWriter, r *http.Request, p params) (object, bool) {
+	if _, ok := s.endpointService(w, r, p); !ok {
+		return nil, false
+	}
+	endpoint, ok := s.endpoints(p["groupId"], p["endpointServiceId"]).get(p["endpointId"])
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "PRIVATE_ENDPOINT_SERVICE_NOT_FOUND",
+			"Private endpoint %s not found in service %s.", p["endpointId"], p["endpointServiceId"])
+		return nil, false
+	}
+	return endpoint, true
+}
+
+func (s *Server) getEndpoint(w http.ResponseWriter, r *http.Request, p params) {
+	endpoint, ok := s.endpoint(w, r, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, r, http.StatusOK, endpoint)
+}
+
+func (s *Server) deleteEndpoint(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.endpoint(w, r, p); !ok {
+		return
+	}
+	service, _ := s.endpointServices(p["groupId"]).get(p["endpointServiceId"])
+	s.endpoints(p["groupId"], p["endpointServiceId"]).delete(p["endpointId"])
+	field := endpointIDsField(stringField(service, "cloudProvider"))
+	ids, _ := service[field].([]string)
+	service[field] = slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return id == p["endpointId"] })
+	writeNoContent(w, http.StatusAccepted)
+}
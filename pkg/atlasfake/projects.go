@@ -0,0 +1,112 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfake
+
+import (
+	"net/http"
+)
+
+const (
+	kindGroups = "groups"
+)
+
+func (s *Server) groups() *collection {
+	return s.store.collection(kindGroups)
+}
+
+// group fetches the project referenced by the groupId path parameter,
+// writing a not found error if it does not exist
+func (s *Server) group(w http.ResponseWriter, r *http.Request, p params) (object, bool) {
+	group, ok := s.groups().get(p["groupId"])
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "GROUP_NOT_FOUND", "No group with ID %s exists.", p["groupId"])
+		return nil, false
+	}
+	return group, true
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request, _ params) {
+	group := object{}
+	if err := decode(r, &group); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	name := stringField(group, "name")
+	if _, exists := s.groups().find(byField("name", name)); exists {
+		writeError(w, r, http.StatusConflict, "GROUP_ALREADY_EXISTS", "A group with name %s already exists.", name)
+		return
+	}
+	group["id"] = newID()
+	group["created"] = s.timestamp()
+	group["clusterCount"] = 0
+	s.groups().put(stringField(group, "id"), group)
+	writeJSON(w, r, http.StatusOK, group)
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request, _ params) {
+	writePage(w, r, s.groups().list())
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, r *http.Request, p params) {
+	group, ok := s.group(w, r, p)
+	if !ok {
+		return
+	}
+	group["clusterCount"] = s.clusters(p["groupId"]).len() + s.flexClusters(p["groupId"]).len()
+	writeJSON(w, r, http.StatusOK, group)
+}
+
+func (s *Server) getGroupByName(w http.ResponseWriter, r *http.Request, p params) {
+	group, ok := s.groups().find(byField("name", p["groupName"]))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "NOT_IN_GROUP", "Group %s does not exist or the user is not in it.", p["groupName"])
+		return
+	}
+	writeJSON(w, r, http.StatusOK, group)
+}
+
+func (s *Server) updateGroup(w http.ResponseWriter, r *http.Request, p params) {
+	group, ok := s.group(w, r, p)
+	if !ok {
+		return
+	}
+	patch := object{}
+	if err := decode(r, &patch); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	merge(group, patch, "id", "orgId", "created")
+	writeJSON(w, r, http.StatusOK, group)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	groupID := p["groupId"]
+	if s.clusters(groupID).len()+s.flexClusters(groupID).len() > 0 {
+		writeError(w, r, http.StatusConflict, "CANNOT_CLOSE_GROUP_ACTIVE_ATLAS_CLUSTERS",
+			"Cannot close group %s while it has active clusters.", groupID)
+		return
+	}
+	s.groups().delete(groupID)
+	writeNoContent(w, http.StatusNoContent)
+}
+
+func byField(name, value string) func(object) bool {
+	return func(obj object) bool {
+		return stringField(obj, name) == value
+	}
+}
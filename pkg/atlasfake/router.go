@@ -0,0 +1,81 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfake
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type params map[string]string
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, p params)
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+// router matches Atlas paths segment by segment.
+//
+// The standard library mux cannot be used here, as Atlas mixes literal and
+// wildcard segments in ways that ServeMux considers conflicting
+// (e.g. teams/byName/{teamName} vs teams/{teamId}/users). Routes are
+// evaluated in registration order and the first match wins.
+type router struct {
+	routes []route
+}
+
+func (rt *router) handle(method, pattern string, h handlerFunc) {
+	rt.routes = append(rt.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  h,
+	})
+}
+
+func (rt *router) match(method, escapedPath string) (handlerFunc, params, bool) {
+	segments := strings.Split(strings.Trim(escapedPath, "/"), "/")
+	for _, r := range rt.routes {
+		if r.method != method || len(r.segments) != len(segments) {
+			continue
+		}
+		p, ok := matchSegments(r.segments, segments)
+		if ok {
+			return r.handler, p, true
+		}
+	}
+	return nil, nil, false
+}
+
+func matchSegments(pattern, segments []string) (params, bool) {
+	p := params{}
+	for i, pat := range pattern {
+		value, err := url.PathUnescape(segments[i])
+		if err != nil {
+			return nil, false
+		}
+		if strings.HasPrefix(pat, "{") && strings.HasSuffix(pat, "}") {
+			p[strings.Trim(pat, "{}")] = value
+			continue
+		}
+		if pat != value {
+			return nil, false
+		}
+	}
+	return p, true
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfake
+
+import (
+	"net/http"
+)
+
+func (s *Server) routes() *router {
+	rt := &router{}
+
+	rt.handle(http.MethodGet, "/groups", s.listGroups)
+	rt.handle(http.MethodPost, "/groups", s.createGroup)
+	rt.handle(http.MethodGet, "/groups/byName/{groupName}", s.getGroupByName)
+	rt.handle(http.MethodGet, "/groups/{groupId}", s.getGroup)
+	rt.handle(http.MethodPatch, "/groups/{groupId}", s.updateGroup)
+	rt.handle(http.MethodDelete, "/groups/{groupId}", s.deleteGroup)
+
+	rt.handle(http.MethodGet, "/groups/{groupId}/clusters", s.listClusters)
+	rt.handle(http.MethodPost, "/groups/{groupId}/clusters", s.createCluster)
+	rt.handle(http.MethodGet, "/groups/{groupId}/clusters/{clusterName}", s.getCluster)
+	rt.handle(http.MethodPatch, "/groups/{groupId}/clusters/{clusterName}", s.updateCluster)
+	rt.handle(http.MethodDelete, "/groups/{groupId}/clusters/{clusterName}", s.deleteCluster)
+	rt.handle(http.MethodGet, "/groups/{groupId}/clusters/{clusterName}/status", s.getClusterStatus)
+
+	rt.handle(http.MethodPost, "/groups/{groupId}/clusters/{clusterName}/search/indexes", s.createSearchIndex)
+	rt.handle(http.MethodGet, "/groups/{groupId}/clusters/{clusterName}/search/indexes/{databaseName}/{collectionName}", s.listSearchIndexes)
+	rt.handle(http.MethodGet, "/groups/{groupId}/clusters/{clusterName}/search/indexes/{indexId}", s.getSearchIndex)
+	rt.handle(http.MethodPatch, "/groups/{groupId}/clusters/{clusterName}/search/indexes/{indexId}", s.updateSearchIndex)
+	rt.handle(http.MethodDelete, "/groups/{groupId}/clusters/{clusterName}/search/indexes/{indexId}", s.deleteSearchIndex)
+
+	rt.handle(http.MethodGet, "/groups/{groupId}/flexClusters", s.listFlexClusters)
+	rt.handle(http.MethodPost, "/groups/{groupId}/flexClusters", s.createFlexCluster)
+	rt.handle(http.MethodGet, "/groups/{groupId}/flexClusters/{name}", s.getFlexCluster)
+	rt.handle(http.MethodPatch, "/groups/{groupId}/flexClusters/{name}", s.updateFlexCluster)
+	rt.handle(http.MethodDelete, "/groups/{groupId}/flexClusters/{name}", s.deleteFlexCluster)
+
+	rt.handle(http.MethodGet, "/groups/{groupId}/serverless", s.listServerlessInstances)
+	rt.handle(http.MethodGet, "/groups/{groupId}/serverless/{name}", s.getServerlessInstance)
+
+	rt.handle(http.MethodGet, "/groups/{groupId}/databaseUsers", s.listDatabaseUsers)
+	rt.handle(http.MethodPost, "/groups/{groupId}/databaseUsers", s.createDatabaseUser)
+	rt.handle(http.MethodGet, "/groups/{groupId}/databaseUsers/{databaseName}/{username}", s.getDatabaseUser)
+	rt.handle(http.MethodPatch, "/groups/{groupId}/databaseUsers/{databaseName}/{username}", s.updateDatabaseUser)
+	rt.handle(http.MethodDelete, "/groups/{groupId}/databaseUsers/{databaseName}/{username}", s.deleteDatabaseUser)
+
+	rt.handle(http.MethodGet, "/groups/{groupId}/accessList", s.listAccessListEntries)
+	rt.handle(http.MethodPost, "/groups/{groupId}/accessList", s.createAccessListEntries)
+	rt.handle(http.MethodGet, "/groups/{groupId}/accessList/{entryValue}", s.getAccessListEntry)
+	rt.handle(http.MethodDelete, "/groups/{groupId}/accessList/{entryValue}", s.deleteAccessListEntry)
+	rt.handle(http.MethodGet, "/groups/{groupId}/accessList/{entryValue}/status", s.getAccessListEntryStatus)
+
+	rt.handle(http.MethodGet, "/groups/{groupId}/containers", s.listContainers)
+	rt.handle(http.MethodPost, "/groups/{groupId}/containers", s.createContainer)
+	rt.handle(http.MethodGet, "/groups/{groupId}/containers/all", s.listAllContainers)
+	rt.handle(http.MethodGet, "/groups/{groupId}/containers/{containerId}", s.getContainer)
+	rt.handle(http.MethodPatch, "/groups/{groupId}/containers/{containerId}", s.updateContainer)
+	rt.handle(http.MethodDelete, "/groups/{groupId}/containers/{containerId}", s.deleteContainer)
+
+	rt.handle(http.MethodGet, "/groups/{groupId}/peers", s.listPeers)
+	rt.handle(http.MethodPost, "/groups/{groupId}/peers", s.createPeer)
+	rt.handle(http.MethodGet, "/groups/{groupId}/peers/{peerId}", s.getPeer)
+	rt.handle(http.MethodPatch, "/groups/{groupId}/peers/{peerId}", s.updatePeer)
+	rt.handle(http.MethodDelete, "/groups/{groupId}/peers/{peerId}", s.deletePeer)
+
+	rt.handle(http.MethodPost, "/groups/{groupId}/privateEndpoint/endpointService", s.createEndpointService)
+	rt.handle(http.MethodGet, "/groups/{groupId}/privateEndpoint/{cloudProvider}/endpointService", s.listEndpointServices)
+	rt.handle(http.MethodGet, "/groups/{groupId}/privateEndpoint/{cloudProvider}/endpointService/{endpointServiceId}", s.getEndpointService)
+	rt.handle(http.MethodDelete, "/groups/{groupId}/privateEndpoint/{cloudProvider}/endpointService/{endpointServiceId}", s.deleteEndpointService)
+	rt.handle(http.MethodPost, "/groups/{groupId}/privateEndpoint/{cloudProvider}/endpointService/{endpointServiceId}/endpoint", s.createEndpoint)
+	rt.handle(http.MethodGet, "/groups/{groupId}/privateEndpoint/{cloudProvider}/endpointService/{endpointServiceId}/endpoint/{endpointId}", s.getEndpoint)
+	rt.handle(http.MethodDelete, "/groups/{groupId}/privateEndpoint/{cloudProvider}/endpointService/{endpointServiceId}/endpoint/{endpointId}", s.deleteEndpoint)
+
+	rt.handle(http.MethodGet, "/groups/{groupId}/teams", s.listProjectTeams)
+	rt.handle(http.MethodPost, "/groups/{groupId}/teams", s.addProjectTeams)
+	rt.handle(http.MethodPatch, "/groups/{groupId}/teams/{teamId}", s.updateProjectTeamRoles)
+	rt.handle(http.MethodDelete, "/groups/{groupId}/teams/{teamId}", s.removeProjectTeam)
+
+	rt.handle(http.MethodGet, "/orgs/{orgId}/teams", s.listTeams)
+	rt.handle(http.MethodPost, "/orgs/{orgId}/teams", s.createTeam)
+	rt.handle(http.MethodGet, "/orgs/{orgId}/teams/byName/{teamName}", s.getTeamByName)
+	rt.handle(http.MethodGet, "/orgs/{orgId}/teams/{teamId}", s.getTeam)
+	rt.handle(http.MethodPatch, "/orgs/{orgId}/teams/{teamId}", s.renameTeam)
+	rt.handle(http.MethodDelete, "/orgs/{orgId}/teams/{teamId}", s.deleteTeam)
+	rt.handle(http.MethodGet, "/orgs/{orgId}/teams/{teamId}/users", s.listTeamUsers)
+	rt.handle(http.MethodPost, "/orgs/{orgId}/teams/{teamId}/users", s.addTeamUsers)
+	rt.handle(http.MethodDelete, "/orgs/{orgId}/teams/{teamId}/users/{userId}", s.removeTeamUser)
+
+	rt.handle(http.MethodGet, "/users/byName/{userName}", s.getUserByName)
+	rt.handle(http.MethodGet, "/users/{userId}", s.getUser)
+
+	return rt
+}
@@ -0,0 +1,212 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package atlasfake implements an in-memory, stateful fake of the Atlas Admin API.
+//
+// The fake covers the subset of the API the operator relies on: projects (groups), clusters,
+// flex clusters, database users, IP access lists, network containers and peerings, private
+// endpoints, teams and search indexes. It is meant to be served with httptest so that the
+// operator, or any Atlas SDK client, can be pointed at it with the --atlas-domain flag:
+//
+//	srv := atlasfake.NewServer()
+//	ts := srv.Start()
+//	defer ts.Close()
+//	// use ts.URL + "/" as the Atlas domain
+//
+// Resources are provisioned synchronously, so clusters and endpoints are reported as ready
+// right after creation. Any digest credentials are accepted.
+package atlasfake
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// APIPrefix is the path prefix of all Atlas Admin API v2 endpoints
+	APIPrefix = "/api/atlas/v2"
+
+	defaultItemsPerPage = 100
+	defaultContentType  = "application/json"
+)
+
+// object is the JSON representation of any Atlas resource kept by the fake
+type object = map[string]any
+
+// Server is a stateful fake of the Atlas Admin API
+type Server struct {
+	mu     sync.Mutex
+	store  *store
+	router *router
+	now    func() time.Time
+}
+
+// NewServer returns an empty fake Atlas server
+func NewServer() *Server {
+	s := &Server{
+		store: newStore(),
+		now:   time.Now,
+	}
+	s.router = s.routes()
+	return s
+}
+
+// Start serves the fake on a new httptest server.
+// Callers are responsible for closing the returned server.
+func (s *Server) Start() *httptest.Server {
+	return httptest.NewServer(s)
+}
+
+// Domain returns the value to be passed as Atlas domain for the given test server
+func Domain(ts *httptest.Server) string {
+	return ts.URL + "/"
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.EscapedPath()
+	if !strings.HasPrefix(path, APIPrefix+"/") {
+		writeError(w, r, http.StatusNotFound, "RESOURCE_NOT_FOUND", "path %s is not part of the Atlas Admin API", path)
+		return
+	}
+
+	h, params, ok := s.router.match(r.Method, strings.TrimPrefix(path, APIPrefix))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "RESOURCE_NOT_FOUND", "%s %s is not supported by the fake Atlas server", r.Method, path)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	h(w, r, params)
+}
+
+func newID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
+
+func decode(r *http.Request, v any) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to decode request body: %w", err)
+	}
+	return nil
+}
+
+func contentType(r *http.Request) string {
+	accept := r.Header.Get("Accept")
+	if strings.Contains(accept, "json") {
+		return strings.TrimSpace(strings.Split(accept, ",")[0])
+	}
+	return defaultContentType
+}
+
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, v any) {
+	w.Header().Set("Content-Type", contentType(r))
+	w.WriteHeader(status)
+	if v != nil {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+}
+
+func writeNoContent(w http.ResponseWriter, status int) {
+	w.WriteHeader(status)
+}
+
+// writeError renders an error with the same shape as the Atlas ApiError model,
+// so that admin.IsErrorCode works on the client side
+func writeError(w http.ResponseWriter, r *http.Request, status int, code, format string, args ...any) {
+	writeJSON(w, r, status, object{
+		"error":     status,
+		"errorCode": code,
+		"reason":    http.StatusText(status),
+		"detail":    fmt.Sprintf(format, args...),
+	})
+}
+
+func writeBadRequest(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(w, r, http.StatusBadRequest, "INVALID_JSON", "%v", err)
+}
+
+// writePage renders a paginated result honoring the pageNum and itemsPerPage query parameters
+func writePage(w http.ResponseWriter, r *http.Request, items []object) {
+	writePageWithStatus(w, r, http.StatusOK, items)
+}
+
+func writePageWithStatus(w http.ResponseWriter, r *http.Request, status int, items []object) {
+	pageNum := queryInt(r, "pageNum", 1)
+	itemsPerPage := queryInt(r, "itemsPerPage", defaultItemsPerPage)
+	start := (pageNum - 1) * itemsPerPage
+	end := start + itemsPerPage
+	if start > len(items) {
+		start = len(items)
+	}
+	if end > len(items) {
+		end = len(items)
+	}
+	results := items[start:end]
+	if results == nil {
+		results = []object{}
+	}
+	writeJSON(w, r, status, object{
+		"results":    results,
+		"totalCount": len(items),
+		"links":      []object{},
+	})
+}
+
+func queryInt(r *http.Request, name string, defaultValue int) int {
+	value, err := strconv.Atoi(r.URL.Query().Get(name))
+	if err != nil || value < 1 {
+		return defaultValue
+	}
+	return value
+}
+
+// merge applies a shallow JSON merge of the patch into the target object,
+// ignoring any read only field
+func merge(target, patch object, readOnly ...string) {
+	for k, v := range patch {
+		if !slices.Contains(readOnly, k) {
+			target[k] = v
+		}
+	}
+}
+
+func stringField(obj object, name string) string {
+	s, _ := obj[name].(string)
+	return s
+}
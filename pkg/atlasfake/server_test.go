@@ -0,0 +1,349 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfake_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312002/admin"
+	"k8s.io/utils/ptr"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/pkg/atlasfake"
+)
+
+const testOrgID = "0123456789abcdef01234567"
+
+func newClient(t *testing.T) (*atlasfake.Server, *admin.APIClient) {
+	t.Helper()
+	srv := atlasfake.NewServer()
+	ts := srv.Start()
+	t.Cleanup(ts.Close)
+	client, err := admin.NewClient(
+		admin.UseBaseURL(atlasfake.Domain(ts)),
+		admin.UseDigestAuth("public", "private"),
+	)
+	require.NoError(t, err)
+	return srv, client
+}
+
+func createProject(t *testing.T, client *admin.APIClient, name string) string {
+	t.Helper()
+	group, _, err := client.ProjectsApi.CreateProject(context.Background(), &admin.Group{Name: name, OrgId: testOrgID}).Execute()
+	require.NoError(t, err)
+	return group.GetId()
+}
+
+func TestProjects(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+
+	id := createProject(t, client, "my-project")
+	assert.Len(t, id, 24)
+
+	_, _, err := client.ProjectsApi.CreateProject(ctx, &admin.Group{Name: "my-project", OrgId: testOrgID}).Execute()
+	assert.True(t, admin.IsErrorCode(err, "GROUP_ALREADY_EXISTS"))
+
+	group, _, err := client.ProjectsApi.GetProjectByName(ctx, "my-project").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, id, group.GetId())
+	assert.Equal(t, testOrgID, group.GetOrgId())
+
+	_, _, err = client.ProjectsApi.GetProjectByName(ctx, "missing").Execute()
+	assert.True(t, admin.IsErrorCode(err, "NOT_IN_GROUP"))
+
+	groups, _, err := client.ProjectsApi.ListProjects(ctx).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 1, groups.GetTotalCount())
+
+	_, err = client.ProjectsApi.DeleteProject(ctx, id).Execute()
+	require.NoError(t, err)
+	_, _, err = client.ProjectsApi.GetProject(ctx, id).Execute()
+	assert.True(t, admin.IsErrorCode(err, "GROUP_NOT_FOUND"))
+}
+
+func TestClusters(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+	groupID := createProject(t, client, "clusters")
+
+	cluster, rsp, err := client.ClustersApi.CreateCluster(ctx, groupID, &admin.ClusterDescription20240805{
+		Name:        ptr.To("cluster0"),
+		ClusterType: ptr.To("REPLICASET"),
+	}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rsp.StatusCode)
+	assert.Equal(t, "IDLE", cluster.GetStateName())
+	assert.NotEmpty(t, cluster.ConnectionStrings.GetStandardSrv())
+
+	_, _, err = client.ClustersApi.CreateCluster(ctx, groupID, &admin.ClusterDescription20240805{Name: ptr.To("cluster0")}).Execute()
+	assert.True(t, admin.IsErrorCode(err, "DUPLICATE_CLUSTER_NAME"))
+
+	updated, _, err := client.ClustersApi.UpdateCluster(ctx, groupID, "cluster0", &admin.ClusterDescription20240805{
+		Paused: ptr.To(true),
+	}).Execute()
+	require.NoError(t, err)
+	assert.True(t, updated.GetPaused())
+	assert.Equal(t, cluster.GetId(), updated.GetId())
+
+	_, _, err = client.FlexClustersApi.GetFlexCluster(ctx, groupID, "cluster0").Execute()
+	assert.True(t, admin.IsErrorCode(err, "CANNOT_USE_NON_FLEX_CLUSTER_IN_FLEX_API"))
+
+	_, err = client.ProjectsApi.DeleteProject(ctx, groupID).Execute()
+	assert.True(t, admin.IsErrorCode(err, "CANNOT_CLOSE_GROUP_ACTIVE_ATLAS_CLUSTERS"))
+
+	_, err = client.ClustersApi.DeleteCluster(ctx, groupID, "cluster0").Execute()
+	require.NoError(t, err)
+	_, _, err = client.ClustersApi.GetCluster(ctx, groupID, "cluster0").Execute()
+	assert.True(t, admin.IsErrorCode(err, "CLUSTER_NOT_FOUND"))
+}
+
+func TestFlexClusters(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+	groupID := createProject(t, client, "flex")
+
+	_, _, err := client.FlexClustersApi.CreateFlexCluster(ctx, groupID, &admin.FlexClusterDescriptionCreate20241113{
+		Name: "flex0",
+		ProviderSettings: admin.FlexProviderSettingsCreate20241113{
+			BackingProviderName: "AWS",
+			RegionName:          "US_EAST_1",
+		},
+	}).Execute()
+	require.NoError(t, err)
+
+	_, _, err = client.ClustersApi.GetCluster(ctx, groupID, "flex0").Execute()
+	assert.True(t, admin.IsErrorCode(err, "CANNOT_USE_FLEX_CLUSTER_IN_CLUSTER_API"))
+
+	_, _, err = client.ServerlessInstancesApi.GetServerlessInstance(ctx, groupID, "flex0").Execute()
+	assert.True(t, admin.IsErrorCode(err, "CANNOT_USE_CLUSTER_IN_SERVERLESS_INSTANCE_API"))
+
+	flex, _, err := client.FlexClustersApi.GetFlexCluster(ctx, groupID, "flex0").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "IDLE", flex.GetStateName())
+}
+
+func TestDatabaseUsers(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+	groupID := createProject(t, client, "users")
+
+	user := &admin.CloudDatabaseUser{
+		GroupId:      groupID,
+		DatabaseName: "admin",
+		Username:     "app",
+		Password:     ptr.To("secret"),
+		Roles:        &[]admin.DatabaseUserRole{{RoleName: "readWrite", DatabaseName: "app"}},
+	}
+	_, _, err := client.DatabaseUsersApi.CreateDatabaseUser(ctx, groupID, user).Execute()
+	require.NoError(t, err)
+	_, _, err = client.DatabaseUsersApi.CreateDatabaseUser(ctx, groupID, user).Execute()
+	assert.True(t, admin.IsErrorCode(err, "USER_ALREADY_EXISTS"))
+
+	got, _, err := client.DatabaseUsersApi.GetDatabaseUser(ctx, groupID, "admin", "app").Execute()
+	require.NoError(t, err)
+	assert.Empty(t, got.GetPassword())
+	assert.Equal(t, "readWrite", got.GetRoles()[0].RoleName)
+
+	_, err = client.DatabaseUsersApi.DeleteDatabaseUser(ctx, groupID, "admin", "app").Execute()
+	require.NoError(t, err)
+	_, _, err = client.DatabaseUsersApi.GetDatabaseUser(ctx, groupID, "admin", "app").Execute()
+	assert.True(t, admin.IsErrorCode(err, "USERNAME_NOT_FOUND"))
+}
+
+func TestAccessList(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+	groupID := createProject(t, client, "access-list")
+
+	entries, _, err := client.ProjectIPAccessListApi.CreateProjectIpAccessList(ctx, groupID, &[]admin.NetworkPermissionEntry{
+		{IpAddress: ptr.To("10.0.0.1")},
+		{CidrBlock: ptr.To("192.168.0.0/24")},
+	}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 2, entries.GetTotalCount())
+
+	entry, _, err := client.ProjectIPAccessListApi.GetProjectIpList(ctx, groupID, "10.0.0.1").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1/32", entry.GetCidrBlock())
+
+	status, _, err := client.ProjectIPAccessListApi.GetProjectIpAccessListStatus(ctx, groupID, "192.168.0.0/24").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "ACTIVE", status.GetSTATUS())
+
+	_, err = client.ProjectIPAccessListApi.DeleteProjectIpAccessList(ctx, groupID, "192.168.0.0/24").Execute()
+	require.NoError(t, err)
+	list, _, err := client.ProjectIPAccessListApi.ListProjectIpAccessLists(ctx, groupID).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.GetTotalCount())
+}
+
+func TestNetworkPeering(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+	groupID := createProject(t, client, "peering")
+
+	container, _, err := client.NetworkPeeringApi.CreatePeeringContainer(ctx, groupID, &admin.CloudProviderContainer{
+		ProviderName:   ptr.To("AWS"),
+		AtlasCidrBlock: ptr.To("10.8.0.0/21"),
+		RegionName:     ptr.To("US_EAST_1"),
+	}).Execute()
+	require.NoError(t, err)
+	assert.False(t, container.GetProvisioned())
+	assert.NotEmpty(t, container.GetVpcId())
+
+	peer, _, err := client.NetworkPeeringApi.CreatePeeringConnection(ctx, groupID, &admin.BaseNetworkPeeringConnectionSettings{
+		ContainerId:         container.GetId(),
+		ProviderName:        ptr.To("AWS"),
+		AccepterRegionName:  ptr.To("us-east-1"),
+		AwsAccountId:        ptr.To("123456789012"),
+		RouteTableCidrBlock: ptr.To("10.0.0.0/16"),
+		VpcId:               ptr.To("vpc-0123456789abcdef0"),
+	}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "AVAILABLE", peer.GetStatusName())
+
+	_, err = client.NetworkPeeringApi.DeletePeeringContainer(ctx, groupID, container.GetId()).Execute()
+	assert.True(t, admin.IsErrorCode(err, "CONTAINERS_IN_USE"))
+
+	got, _, err := client.NetworkPeeringApi.GetPeeringContainer(ctx, groupID, container.GetId()).Execute()
+	require.NoError(t, err)
+	assert.True(t, got.GetProvisioned())
+
+	azure, _, err := client.NetworkPeeringApi.ListPeeringContainerByCloudProvider(ctx, groupID).ProviderName("AZURE").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 0, azure.GetTotalCount())
+
+	_, _, err = client.NetworkPeeringApi.DeletePeeringConnection(ctx, groupID, peer.GetId()).Execute()
+	require.NoError(t, err)
+	_, err = client.NetworkPeeringApi.DeletePeeringContainer(ctx, groupID, container.GetId()).Execute()
+	require.NoError(t, err)
+}
+
+func TestPrivateEndpoints(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+	groupID := createProject(t, client, "private-endpoints")
+
+	service, _, err := client.PrivateEndpointServicesApi.CreatePrivateEndpointService(ctx, groupID, &admin.CloudProviderEndpointServiceRequest{
+		ProviderName: "AWS",
+		Region:       "US_EAST_1",
+	}).Execute()
+	require.NoError(t, err)
+	assert.NotEmpty(t, service.GetEndpointServiceName())
+
+	_, _, err = client.PrivateEndpointServicesApi.CreatePrivateEndpoint(ctx, groupID, "AWS", service.GetId(), &admin.CreateEndpointRequest{
+		Id: ptr.To("vpce-0123456789abcdef0"),
+	}).Execute()
+	require.NoError(t, err)
+
+	got, _, err := client.PrivateEndpointServicesApi.GetPrivateEndpointService(ctx, groupID, "AWS", service.GetId()).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vpce-0123456789abcdef0"}, got.GetInterfaceEndpoints())
+
+	endpoint, _, err := client.PrivateEndpointServicesApi.GetPrivateEndpoint(ctx, groupID, "AWS", "vpce-0123456789abcdef0", service.GetId()).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "AVAILABLE", endpoint.GetConnectionStatus())
+
+	_, err = client.PrivateEndpointServicesApi.DeletePrivateEndpoint(ctx, groupID, "AWS", "vpce-0123456789abcdef0", service.GetId()).Execute()
+	require.NoError(t, err)
+	_, err = client.PrivateEndpointServicesApi.DeletePrivateEndpointService(ctx, groupID, "AWS", service.GetId()).Execute()
+	require.NoError(t, err)
+	_, _, err = client.PrivateEndpointServicesApi.GetPrivateEndpointService(ctx, groupID, "AWS", service.GetId()).Execute()
+	assert.True(t, admin.IsErrorCode(err, "PRIVATE_ENDPOINT_SERVICE_NOT_FOUND"))
+}
+
+func TestTeams(t *testing.T) {
+	ctx := context.Background()
+	srv, client := newClient(t)
+	groupID := createProject(t, client, "teams")
+
+	team, _, err := client.TeamsApi.CreateTeam(ctx, testOrgID, &admin.Team{
+		Name:      "devs",
+		Usernames: []string{"alice@example.com"},
+	}).Execute()
+	require.NoError(t, err)
+
+	bobID := srv.AddUser("bob@example.com")
+	_, _, err = client.TeamsApi.AddTeamUser(ctx, testOrgID, team.GetId(), &[]admin.AddUserToTeam{{Id: bobID}}).Execute()
+	require.NoError(t, err)
+
+	users, _, err := client.MongoDBCloudUsersApi.ListTeamUsers(ctx, testOrgID, team.GetId()).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 2, users.GetTotalCount())
+
+	byName, _, err := client.TeamsApi.GetTeamByName(ctx, testOrgID, "devs").Execute()
+	require.NoError(t, err)
+	assert.Equal(t, team.GetId(), byName.GetId())
+
+	_, _, err = client.TeamsApi.AddAllTeamsToProject(ctx, groupID, &[]admin.TeamRole{
+		{TeamId: team.Id, RoleNames: &[]string{"GROUP_READ_ONLY"}},
+	}).Execute()
+	require.NoError(t, err)
+	projectTeams, _, err := client.TeamsApi.ListProjectTeams(ctx, groupID).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 1, projectTeams.GetTotalCount())
+
+	_, err = client.TeamsApi.RemoveProjectTeam(ctx, groupID, team.GetId()).Execute()
+	require.NoError(t, err)
+	_, err = client.TeamsApi.DeleteTeam(ctx, testOrgID, team.GetId()).Execute()
+	require.NoError(t, err)
+	_, _, err = client.TeamsApi.GetTeamById(ctx, testOrgID, team.GetId()).Execute()
+	assert.True(t, admin.IsErrorCode(err, "TEAM_NOT_FOUND"))
+}
+
+func TestSearchIndexes(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+	groupID := createProject(t, client, "search")
+	_, _, err := client.ClustersApi.CreateCluster(ctx, groupID, &admin.ClusterDescription20240805{Name: ptr.To("cluster0")}).Execute()
+	require.NoError(t, err)
+
+	request := &admin.SearchIndexCreateRequest{
+		Name:           "default",
+		Database:       "db",
+		CollectionName: "coll",
+		Type:           ptr.To("search"),
+	}
+	index, _, err := client.AtlasSearchApi.CreateAtlasSearchIndex(ctx, groupID, "cluster0", request).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "READY", index.GetStatus())
+
+	_, _, err = client.AtlasSearchApi.CreateAtlasSearchIndex(ctx, groupID, "cluster0", request).Execute()
+	assert.True(t, admin.IsErrorCode(err, "ATLAS_SEARCH_DUPLICATE_INDEX"))
+
+	indexes, _, err := client.AtlasSearchApi.ListAtlasSearchIndexes(ctx, groupID, "cluster0", "coll", "db").Execute()
+	require.NoError(t, err)
+	assert.Len(t, indexes, 1)
+
+	_, err = client.AtlasSearchApi.DeleteAtlasSearchIndex(ctx, groupID, "cluster0", index.GetIndexID()).Execute()
+	require.NoError(t, err)
+	_, _, err = client.AtlasSearchApi.GetAtlasSearchIndex(ctx, groupID, "cluster0", index.GetIndexID()).Execute()
+	assert.True(t, admin.IsErrorCode(err, "ATLAS_SEARCH_INDEX_NOT_FOUND"))
+}
+
+func TestUnsupportedPath(t *testing.T) {
+	srv := atlasfake.NewServer()
+	ts := srv.Start()
+	defer ts.Close()
+
+	rsp, err := http.Get(ts.URL + atlasfake.APIPrefix + "/unknown")
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, rsp.StatusCode)
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfake
+
+import (
+	"strings"
+)
+
+// collection is an insertion ordered set of objects indexed by key
+type collection struct {
+	keys  []string
+	items map[string]object
+}
+
+func (c *collection) get(key string) (object, bool) {
+	obj, ok := c.items[key]
+	return obj, ok
+}
+
+func (c *collection) put(key string, obj object) {
+	if _, ok := c.items[key]; !ok {
+		c.keys = append(c.keys, key)
+	}
+	c.items[key] = obj
+}
+
+func (c *collection) delete(key string) bool {
+	if _, ok := c.items[key]; !ok {
+		return false
+	}
+	delete(c.items, key)
+	for i, k := range c.keys {
+		if k == key {
+			c.keys = append(c.keys[:i], c.keys[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (c *collection) list() []object {
+	result := make([]object, 0, len(c.keys))
+	for _, k := range c.keys {
+		result = append(result, c.items[k])
+	}
+	return result
+}
+
+func (c *collection) find(match func(object) bool) (object, bool) {
+	for _, k := range c.keys {
+		if match(c.items[k]) {
+			return c.items[k], true
+		}
+	}
+	return nil, false
+}
+
+func (c *collection) len() int {
+	return len(c.keys)
+}
+
+// store holds one collection per resource kind and parent scope,
+// for instance the clusters of a given project
+type store struct {
+	collections map[string]*collection
+}
+
+func newStore() *store {
+	return &store{collections: map[string]*collection{}}
+}
+
+func (s *store) collection(kind string, scope ...string) *collection {
+	key := strings.Join(append([]string{kind}, scope...), "/")
+	c, ok := s.collections[key]
+	if !ok {
+		c = &collection{items: map[string]object{}}
+		s.collections[key] = c
+	}
+	return c
+}
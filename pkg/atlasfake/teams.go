@@ -0,0 +1,251 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atlasfake
+
+import (
+	"net/http"
+)
+
+const (
+	kindUsers        = "users"
+	kindTeams        = "teams"
+	kindTeamUsers    = "teamUsers"
+	kindProjectTeams = "projectTeams"
+)
+
+func (s *Server) users() *collection {
+	return s.store.collection(kindUsers)
+}
+
+func (s *Server) teams(orgID string) *collection {
+	return s.store.collection(kindTeams, orgID)
+}
+
+func (s *Server) teamUsers(teamID string) *collection {
+	return s.store.collection(kindTeamUsers, teamID)
+}
+
+func (s *Server) projectTeams(groupID string) *collection {
+	return s.store.collection(kindProjectTeams, groupID)
+}
+
+// AddUser registers an Atlas (cloud) user in the fake and returns its ID.
+// Registering an existing username returns the ID of the existing user.
+func (s *Server) AddUser(username string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return stringField(s.ensureUser(username), "id")
+}
+
+func (s *Server) ensureUser(username string) object {
+	if user, ok := s.users().find(byField("username", username)); ok {
+		return user
+	}
+	user := object{
+		"id":           newID(),
+		"username":     username,
+		"emailAddress": username,
+		"firstName":    username,
+		"lastName":     username,
+		"country":      "US",
+		"createdAt":    s.timestamp(),
+	}
+	s.users().put(stringField(user, "id"), user)
+	return user
+}
+
+func (s *Server) getUserByName(w http.ResponseWriter, r *http.Request, p params) {
+	user, ok := s.users().find(byField("username", p["userName"]))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "USER_NOT_FOUND", "No user with username %s exists.", p["userName"])
+		return
+	}
+	writeJSON(w, r, http.StatusOK, user)
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request, p params) {
+	user, ok := s.users().get(p["userId"])
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "USER_NOT_FOUND", "No user with ID %s exists.", p["userId"])
+		return
+	}
+	writeJSON(w, r, http.StatusOK, user)
+}
+
+func (s *Server) team(w http.ResponseWriter, r *http.Request, p params) (object, bool) {
+	team, ok := s.teams(p["orgId"]).get(p["teamId"])
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "TEAM_NOT_FOUND", "No team with ID %s exists in organization %s.", p["teamId"], p["orgId"])
+		return nil, false
+	}
+	return team, true
+}
+
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request, p params) {
+	request := struct {
+		Name      string   `json:"name"`
+		Usernames []string `json:"usernames"`
+	}{}
+	if err := decode(r, &request); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	if _, exists := s.teams(p["orgId"]).find(byField("name", request.Name)); exists {
+		writeError(w, r, http.StatusConflict, "DUPLICATE_TEAM_NAME", "A team named %s already exists.", request.Name)
+		return
+	}
+	team := object{"id": newID(), "name": request.Name}
+	teamID := stringField(team, "id")
+	s.teams(p["orgId"]).put(teamID, team)
+	for _, username := range request.Usernames {
+		user := s.ensureUser(username)
+		s.teamUsers(teamID).put(stringField(user, "id"), user)
+	}
+	writeJSON(w, r, http.StatusCreated, object{"id": teamID, "name": request.Name, "usernames": request.Usernames})
+}
+
+func (s *Server) listTeams(w http.ResponseWriter, r *http.Request, p params) {
+	writePage(w, r, s.teams(p["orgId"]).list())
+}
+
+func (s *Server) getTeam(w http.ResponseWriter, r *http.Request, p params) {
+	team, ok := s.team(w, r, p)
+	if !ok {
+		return
+	}
+	writeJSON(w, r, http.StatusOK, team)
+}
+
+func (s *Server) getTeamByName(w http.ResponseWriter, r *http.Request, p params) {
+	team, ok := s.teams(p["orgId"]).find(byField("name", p["teamName"]))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "TEAM_NOT_FOUND", "No team named %s exists in organization %s.", p["teamName"], p["orgId"])
+		return
+	}
+	writeJSON(w, r, http.StatusOK, team)
+}
+
+func (s *Server) renameTeam(w http.ResponseWriter, r *http.Request, p params) {
+	team, ok := s.team(w, r, p)
+	if !ok {
+		return
+	}
+	patch := object{}
+	if err := decode(r, &patch); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	team["name"] = patch["name"]
+	writeJSON(w, r, http.StatusOK, team)
+}
+
+func (s *Server) deleteTeam(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.team(w, r, p); !ok {
+		return
+	}
+	s.teams(p["orgId"]).delete(p["teamId"])
+	writeNoContent(w, http.StatusNoContent)
+}
+
+func (s *Server) listTeamUsers(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.team(w, r, p); !ok {
+		return
+	}
+	writePage(w, r, s.teamUsers(p["teamId"]).list())
+}
+
+func (s *Server) addTeamUsers(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.team(w, r, p); !ok {
+		return
+	}
+	request := []struct {
+		ID string `json:"id"`
+	}{}
+	if err := decode(r, &request); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	for _, u := range request {
+		user, ok := s.users().get(u.ID)
+		if !ok {
+			writeError(w, r, http.StatusNotFound, "USER_NOT_FOUND", "No user with ID %s exists.", u.ID)
+			return
+		}
+		s.teamUsers(p["teamId"]).put(u.ID, user)
+	}
+	writePageWithStatus(w, r, http.StatusCreated, s.teamUsers(p["teamId"]).list())
+}
+
+func (s *Server) removeTeamUser(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.team(w, r, p); !ok {
+		return
+	}
+	if !s.teamUsers(p["teamId"]).delete(p["userId"]) {
+		writeError(w, r, http.StatusNotFound, "USER_NOT_FOUND", "No user with ID %s exists in team %s.", p["userId"], p["teamId"])
+		return
+	}
+	writeNoContent(w, http.StatusNoContent)
+}
+
+func (s *Server) addProjectTeams(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	roles := []object{}
+	if err := decode(r, &roles); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	for _, role := range roles {
+		s.projectTeams(p["groupId"]).put(stringField(role, "teamId"), role)
+	}
+	writePageWithStatus(w, r, http.StatusOK, s.projectTeams(p["groupId"]).list())
+}
+
+func (s *Server) listProjectTeams(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	writePage(w, r, s.projectTeams(p["groupId"]).list())
+}
+
+func (s *Server) updateProjectTeamRoles(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	role, ok := s.projectTeams(p["groupId"]).get(p["teamId"])
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "TEAM_NOT_FOUND", "Team %s is not assigned to group %s.", p["teamId"], p["groupId"])
+		return
+	}
+	patch := object{}
+	if err := decode(r, &patch); err != nil {
+		writeBadRequest(w, r, err)
+		return
+	}
+	role["roleNames"] = patch["roleNames"]
+	writePage(w, r, s.projectTeams(p["groupId"]).list())
+}
+
+func (s *Server) removeProjectTeam(w http.ResponseWriter, r *http.Request, p params) {
+	if _, ok := s.group(w, r, p); !ok {
+		return
+	}
+	if !s.projectTeams(p["groupId"]).delete(p["teamId"]) {
+		writeError(w, r, http.StatusNotFound, "TEAM_NOT_FOUND", "Team %s is not assigned to group %s.", p["teamId"], p["groupId"])
+		return
+	}
+	writeNoContent(w, http.StatusNoContent)
+}
@@ -121,7 +121,6 @@ func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (reconc
 	logger.Info("reconcile started", "req", req)
 
 	t := new(T)
-	obj := any(t).(StatusObject)
 	clientObj := any(t).(client.Object)
 	if u, ok := clientObj.(*unstructured.Unstructured); ok {
 		u.SetGroupVersionKind(r.unstructuredGVK)
@@ -136,7 +135,7 @@ func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (reconc
 		return ctrl.Result{}, fmt.Errorf("unable to get object: %w", err)
 	}
 
-	currentStatus := newStatusObject(obj)
+	currentStatus := newStatusObject(t)
 	currentState := state.GetState(currentStatus.Status.Conditions)
 
 	logger.Info("reconcile started", "currentState", currentState)
@@ -151,7 +150,7 @@ func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (reconc
 		stateStatus = false
 	}
 
-	newStatus := newStatusObject(obj)
+	newStatus := newStatusObject(t)
 	observedGeneration := getObservedGeneration(clientObj, currentStatus.Status.Conditions, result.NextState)
 	newStatusConditions := newStatus.Status.Conditions
 	state.EnsureState(&newStatusConditions, observedGeneration, result.NextState, result.StateMsg, stateStatus)
@@ -262,7 +261,7 @@ func (r *Reconciler[T]) ReconcileState(ctx context.Context, t *T) (Result, error
 
 		err error
 	)
-	statusObj := newStatusObject(any(t).(StatusObject))
+	statusObj := newStatusObject(t)
 	currentState := state.GetState(statusObj.Status.Conditions)
 
 	if currentState == state.StateInitial {
@@ -24,7 +24,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -580,3 +582,60 @@ type fakeCluster struct {
 
 func (f *fakeCluster) GetClient() client.Client   { return f.cli }
 func (f *fakeCluster) GetScheme() *runtime.Scheme { return f.cli.Scheme() }
+
+func TestReconcileUnstructured(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "test.dummy.example.com", Version: "v1", Kind: "Thing"}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(gvk.GroupVersion().WithKind("ThingList"), &unstructured.UnstructuredList{})
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetNamespace("default")
+	obj.SetName("mything")
+	obj.SetGeneration(2)
+	require.NoError(t, unstructured.SetNestedSlice(obj.Object, []any{
+		map[string]any{
+			"type":               "State",
+			"status":             "True",
+			"reason":             string(state.StateCreated),
+			"message":            "",
+			"lastTransitionTime": "2025-01-01T00:00:00Z",
+			"observedGeneration": int64(1),
+		},
+	}, "status", "conditions"))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(obj).WithStatusSubresource(obj).Build()
+	var handled state.ResourceState
+	r := NewUnstructuredStateReconciler(&dummyUnstructuredReconciler{
+		handleState: func(_ context.Context, u *unstructured.Unstructured) (Result, error) {
+			handled = state.GetState(getConditions(u))
+			return Result{NextState: state.StateUpdated, StateMsg: "Updated."}, nil
+		},
+	}, gvk)
+	r.cluster = &fakeCluster{cli: c}
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(obj)})
+	require.NoError(t, err)
+	assert.Equal(t, state.StateCreated, handled)
+
+	got := &unstructured.Unstructured{}
+	got.SetGroupVersionKind(gvk)
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(obj), got))
+	conditions := getConditions(got)
+	assert.Equal(t, state.StateUpdated, state.GetState(conditions))
+	ready := meta.FindStatusCondition(conditions, state.ReadyCondition)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+	assert.Equal(t, int64(2), ready.ObservedGeneration)
+}
+
+// Dummy reconciler implementing StateReconciler[unstructured.Unstructured]
+type dummyUnstructuredReconciler struct {
+	StateHandler[unstructured.Unstructured]
+	handleState func(context.Context, *unstructured.Unstructured) (Result, error)
+}
+
+func (d *dummyUnstructuredReconciler) HandleCreated(ctx context.Context, u *unstructured.Unstructured) (Result, error) {
+	return d.handleState(ctx, u)
+}
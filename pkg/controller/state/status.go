@@ -20,6 +20,8 @@ import (
 	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -36,8 +38,34 @@ type statusResource struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
-func newStatusObject(statusObj StatusObject) *resource {
-	return &resource{Status: statusResource{Conditions: statusObj.GetConditions()}}
+func newStatusObject(obj any) *resource {
+	return &resource{Status: statusResource{Conditions: getConditions(obj)}}
+}
+
+// getConditions returns the conditions of typed objects implementing StatusObject
+// and of unstructured objects, read from status.conditions
+func getConditions(obj any) []metav1.Condition {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return obj.(StatusObject).GetConditions()
+	}
+	rawConditions, found, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil || !found {
+		return []metav1.Condition{}
+	}
+	conditions := make([]metav1.Condition, 0, len(rawConditions))
+	for _, rawCondition := range rawConditions {
+		fields, ok := rawCondition.(map[string]any)
+		if !ok {
+			continue
+		}
+		condition := metav1.Condition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(fields, &condition); err != nil {
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
 }
 
 func patchStatus(ctx context.Context, c client.Client, obj client.Object, status any) error {
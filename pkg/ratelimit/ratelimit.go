@@ -21,10 +21,55 @@ import (
 	"k8s.io/client-go/util/workqueue"
 )
 
+const (
+	DefaultBaseDelay = 15 * time.Second
+	DefaultMaxDelay  = time.Minute
+	DefaultQPS       = 10
+	DefaultBurst     = 100
+)
+
+// Config tunes the retries of a work queue
+type Config struct {
+	// BaseDelay and MaxDelay bound the exponential backoff of a failing item
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// QPS and Burst limit the overall retry speed of the queue, not per item
+	QPS   float64
+	Burst int
+}
+
+// DefaultConfig returns the settings used by NewRateLimiter
+func DefaultConfig() Config {
+	return Config{
+		BaseDelay: DefaultBaseDelay,
+		MaxDelay:  DefaultMaxDelay,
+		QPS:       DefaultQPS,
+		Burst:     DefaultBurst,
+	}
+}
+
 func NewRateLimiter[T comparable]() workqueue.TypedRateLimiter[T] {
+	return NewConfiguredRateLimiter[T](DefaultConfig())
+}
+
+// NewConfiguredRateLimiter returns a rate limiter using the given settings,
+// unset settings fall back to their defaults
+func NewConfiguredRateLimiter[T comparable](cfg Config) workqueue.TypedRateLimiter[T] {
+	defaults := DefaultConfig()
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = defaults.BaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = defaults.MaxDelay
+	}
+	if cfg.QPS <= 0 {
+		cfg.QPS = defaults.QPS
+	}
+	if cfg.Burst <= 0 {
+		cfg.Burst = defaults.Burst
+	}
 	return workqueue.NewTypedMaxOfRateLimiter(
-		workqueue.NewTypedItemExponentialFailureRateLimiter[T](15*time.Second, time.Minute),
-		// 10 qps, 100 bucket size.  This is only for retry speed and its only the overall factor (not per item)
-		&workqueue.TypedBucketRateLimiter[T]{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+		workqueue.NewTypedItemExponentialFailureRateLimiter[T](cfg.BaseDelay, cfg.MaxDelay),
+		&workqueue.TypedBucketRateLimiter[T]{Limiter: rate.NewLimiter(rate.Limit(cfg.QPS), cfg.Burst)},
 	)
 }
@@ -16,6 +16,7 @@ package ratelimit_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -27,3 +28,26 @@ func TestNewRateLimiter(t *testing.T) {
 	limiter := ratelimit.NewRateLimiter[reconcile.Request]()
 	assert.NotNil(t, limiter, "NewRateLimiter should return a non-nil RateLimiter")
 }
+
+func TestNewConfiguredRateLimiter(t *testing.T) {
+	item := reconcile.Request{}
+
+	t.Run("unset settings fall back to the defaults", func(t *testing.T) {
+		assert.Equal(t, ratelimit.NewRateLimiter[reconcile.Request](), ratelimit.NewConfiguredRateLimiter[reconcile.Request](ratelimit.Config{}))
+	})
+
+	t.Run("backoff follows the configured delays", func(t *testing.T) {
+		limiter := ratelimit.NewConfiguredRateLimiter[reconcile.Request](ratelimit.Config{
+			BaseDelay: time.Second,
+			MaxDelay:  3 * time.Second,
+			QPS:       1000,
+			Burst:     1000,
+		})
+		assert.Equal(t, time.Second, limiter.When(item))
+		assert.Equal(t, 2*time.Second, limiter.When(item))
+		assert.Equal(t, 3*time.Second, limiter.When(item))
+		assert.Equal(t, 3*time.Second, limiter.When(item))
+		limiter.Forget(item)
+		assert.Equal(t, time.Second, limiter.When(item))
+	})
+}
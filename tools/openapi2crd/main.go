@@ -0,0 +1,69 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/generator"
+	"github.com/mongodb/mongodb-atlas-kubernetes/v2/internal/nextapi/openapi"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("openapi2crd", flag.ContinueOnError)
+	spec := flags.String("spec", "", "path to the Atlas OpenAPI specification")
+	config := flags.String("config", "internal/nextapi/resources.yaml", "path to the resources configuration")
+	output := flags.String("output", "internal/next-crds", "directory to write the CRDs to")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *spec == "" {
+		return fmt.Errorf("-spec is required")
+	}
+
+	doc, err := openapi.Load(*spec)
+	if err != nil {
+		return err
+	}
+	cfg, err := generator.LoadConfig(*config)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(*output, 0o750); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	for i := range cfg.Resources {
+		crd, err := generator.Generate(doc, &cfg.Resources[i])
+		if err != nil {
+			return err
+		}
+		path, err := generator.WriteCRD(*output, crd)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "generated %s\n", path)
+	}
+	return nil
+}
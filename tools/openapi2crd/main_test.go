@@ -0,0 +1,49 @@
+// Copyright 2025 MongoDB Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	config := filepath.Join(dir, "resources.yaml")
+	require.NoError(t, os.WriteFile(config, []byte(`resources:
+- kind: ExportBucket
+  create: createExportBucket
+  get: getExportBucket
+  delete: deleteExportBucket
+`), 0o600))
+	output := filepath.Join(dir, "crds")
+
+	out := &bytes.Buffer{}
+	err := run([]string{
+		"-spec", filepath.Join("..", "..", "internal", "nextapi", "openapi", "testdata", "atlas-api.yaml"),
+		"-config", config,
+		"-output", output,
+	}, out)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(output, "atlas.nextapi.mongodb.com_exportbuckets.yaml"))
+	assert.Contains(t, out.String(), "atlas.nextapi.mongodb.com_exportbuckets.yaml")
+
+	assert.ErrorContains(t, run([]string{"-config", config}, out), "-spec is required")
+}